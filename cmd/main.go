@@ -9,16 +9,20 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"sync"
 	"time"
 
 	vmv1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
 	_ "go.uber.org/automaxprocs"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	ctrlconfig "sigs.k8s.io/controller-runtime/pkg/config"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
@@ -27,11 +31,15 @@ import (
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	policyv1alpha1 "sigs.k8s.io/network-policy-api/apis/v1alpha1"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
 	crdv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/audit"
 	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/adminnetworkpolicy"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/gateway"
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/ingress"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/inventory"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/ipaddressallocation"
 	namespacecontroller "github.com/vmware-tanzu/nsx-operator/pkg/controllers/namespace"
@@ -67,6 +75,7 @@ import (
 	ipaddressallocationservice "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/ipaddressallocation"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/vpc"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 	pkgutil "github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
@@ -80,6 +89,14 @@ var (
 	roleMaster           = "master"
 	roleStandby          = "standby"
 	restoreMode          = false
+	leaderElectionID     = "nsx-operator"
+	// leaderElectionLeaseDuration and leaderElectionRenewDeadline are the defaults of controller-runtime, set
+	// explicitly as the lease fence relies on them.
+	leaderElectionLeaseDuration = 15 * time.Second
+	leaderElectionRenewDeadline = 10 * time.Second
+	// shardRestoreCheckInterval is the interval to check if the restore is done before starting the sharded
+	// controllers.
+	shardRestoreCheckInterval = 30 * time.Second
 )
 
 func init() {
@@ -89,6 +106,7 @@ func init() {
 	utilruntime.Must(v1alpha1.AddToScheme(scheme))
 	utilruntime.Must(vmv1alpha1.AddToScheme(scheme))
 	utilruntime.Must(gatewayv1.Install(scheme))
+	utilruntime.Must(policyv1alpha1.Install(scheme))
 	config.AddFlags()
 
 	cf, err = config.NewNSXOperatorConfigFromFile()
@@ -108,7 +126,9 @@ func init() {
 		nsxOperatorPodName = os.Getenv("NSX_OPERATOR_NAME")
 	}
 
-	if cf.HAEnabled() {
+	if cf.ShardingEnabled() {
+		log.Info("HA mode enabled with sharding", "shardCount", cf.ShardCount)
+	} else if cf.HAEnabled() {
 		log.Info("HA mode enabled")
 	} else {
 		log.Info("HA mode disabled")
@@ -117,49 +137,61 @@ func init() {
 	if metrics.AreMetricsExposed(cf) {
 		metrics.InitializePrometheusMetrics()
 	}
+	metrics.InitializeControllerConfigMetrics(cf)
 }
 
-func startServiceController(mgr manager.Manager, nsxClient *nsx.Client) {
-	// Generate webhook certificates and start refreshing webhook certificates periodically
-	if config.HasVPCNamespaces() {
-		if err := pkgutil.GenerateWebhookCerts(); err != nil {
-			log.Error(err, "Failed to generate webhook certificates")
-			os.Exit(1)
-		}
-		log.Info("Successfully generated webhook certificates")
-		go refreshCertPeriodically()
-	}
+// serviceControllers holds the NSX services and the reconcilers initialized by prepareServiceControllers.
+type serviceControllers struct {
+	reconcilerList      []pkgutil.ReconcilerProvider
+	subnetSetReconcile  *subnetset.SubnetSetReconciler
+	ipblocksInfoService *ipblocksinfo.IPBlocksInfoService
+	inventoryService    *inventoryservice.InventoryService
+
+	// started records the reconcilers already started, the sharded controllers are started before the election in
+	// sharding mode.
+	startedMu sync.Mutex
+	started   map[pkgutil.ReconcilerProvider]bool
+}
 
-	// Initialize and start the system health reporter
-	if config.HasVPCNamespaces() && cf.EnableInventory && cf.CoeConfig.EnableSha {
-		health.Start(nsxClient, cf, mgr.GetClient())
+// startController starts the controller of the reconciler once. If it is already started, only its webhooks are
+// registered.
+func (sc *serviceControllers) startController(mgr manager.Manager, reconciler pkgutil.ReconcilerProvider, hookServer webhook.Server) error {
+	sc.startedMu.Lock()
+	defer sc.startedMu.Unlock()
+	if sc.started == nil {
+		sc.started = map[pkgutil.ReconcilerProvider]bool{}
+	}
+	if sc.started[reconciler] {
+		if sharded, ok := reconciler.(pkgutil.ShardedReconcilerProvider); ok && hookServer != nil {
+			sharded.RegisterWebhooks(mgr, hookServer)
+		}
+		return nil
 	}
+	sc.started[reconciler] = true
+	return reconciler.StartController(mgr, hookServer)
+}
 
+// prepareServiceControllers initializes the NSX services and creates the reconcilers. It only reads from NSX and
+// Kubernetes, so in HA mode it also runs on the standby replicas to have the NSX stores and the informer caches
+// ready before the leader election.
+func prepareServiceControllers(mgr manager.Manager, nsxClient *nsx.Client) *serviceControllers {
 	//  Embed the common commonService to sub-services.
 	commonService := common.Service{
-		Client:    mgr.GetClient(),
-		NSXClient: nsxClient,
-		NSXConfig: cf,
+		Client:             mgr.GetClient(),
+		NSXClient:          nsxClient,
+		NSXConfig:          cf,
+		RecordStoreQueries: true,
 	}
+	sc := &serviceControllers{}
 
-	checkLicense(nsxClient, cf.LicenseValidationInterval)
-
-	if cf.K8sConfig.EnableRestore && config.HasVPCNamespaces() {
-		var err error
-		restoreMode, err = pkgutil.CompareNSXRestore(mgr.GetClient(), nsxClient)
-		if err != nil {
-			log.Error(err, "NSX restore check failed")
-			os.Exit(1)
-		}
-	} else {
-		restoreMode = false
+	licenseReporter := &pkgutil.LicenseReporter{
+		Client:   mgr.GetClient(),
+		Recorder: mgr.GetEventRecorderFor("nsx-operator"), //nolint:staticcheck // record.EventRecorder; LicenseReporter not on events.EventRecorder yet
+		Elected:  mgr.Elected(),
 	}
-
-	var reconcilerList []pkgutil.ReconcilerProvider
+	checkLicense(nsxClient, cf.LicenseValidationInterval, licenseReporter)
 
 	var vpcService *vpc.VPCService
-	var hookServer webhook.Server
-	var subnetSetReconcile *subnetset.SubnetSetReconciler
 
 	if config.HasVPCNamespaces() {
 		// Check NSX version for VPC networking mode
@@ -204,20 +236,18 @@ func startServiceController(mgr manager.Manager, nsxClient *nsx.Client) {
 			log.Error(err, "Failed to initialize DNS record service", "controller", "DNS")
 			os.Exit(1)
 		}
-		ipblocksInfoService := ipblocksinfo.InitializeIPBlocksInfoService(commonService, subnetService)
+		// The periodic sync of IPBlocksInfo is started on the leader.
+		sc.ipblocksInfoService = ipblocksinfo.NewIPBlocksInfoService(commonService, subnetService)
 
 		subnetBindingService, err := subnetbindingservice.InitializeService(commonService)
 		if err != nil {
 			log.Error(err, "Failed to initialize SubnetConnectionBindingMap commonService")
 			os.Exit(1)
 		}
-		var inventoryService *inventoryservice.InventoryService
 		if cf.EnableInventory {
-			inventoryService, err = inventoryservice.InitializeService(commonService, false)
-			if err != nil {
-				log.Error(err, "Failed to initialize inventory commonService", "controller", "Inventory")
-				os.Exit(1)
-			}
+			// The inventory store is initialized on the leader, as the NSX container cluster may be created.
+			sc.inventoryService = inventoryservice.NewInventoryService(commonService)
+			metrics.InitializeInventoryMetrics()
 		}
 		subnetIPReservationService, err := subnetipreservationservice.InitializeService(commonService, subnetPortService)
 		if err != nil {
@@ -225,32 +255,14 @@ func startServiceController(mgr manager.Manager, nsxClient *nsx.Client) {
 			os.Exit(1)
 		}
 
-		if _, err := os.Stat(config.WebhookCertDir); errors.Is(err, os.ErrNotExist) {
-			log.Error(err, "Server cert not found, disabling webhook server", "cert", config.WebhookCertDir)
-		} else {
-			hookServer = webhook.NewServer(webhook.Options{
-				Port:    config.WebhookServerPort,
-				CertDir: config.WebhookCertDir,
-				TLSOpts: []func(*tls.Config){
-					func(cfg *tls.Config) {
-						cfg.MinVersion = tls.VersionTLS13
-					},
-				},
-			})
-			if err := mgr.Add(hookServer); err != nil {
-				log.Error(err, "Failed to add hook server")
-				os.Exit(1)
-			}
-		}
-
 		// Create controllers which only supports VPC
-		subnetSetReconcile = subnetset.NewSubnetSetReconciler(mgr, subnetService, subnetPortService, vpcService, subnetBindingService)
-		reconcilerList = append(
-			reconcilerList,
-			networkinfocontroller.NewNetworkInfoReconciler(mgr, vpcService, ipblocksInfoService, dnsRecordService),
+		sc.subnetSetReconcile = subnetset.NewSubnetSetReconciler(mgr, subnetService, subnetPortService, vpcService, subnetBindingService)
+		sc.reconcilerList = append(
+			sc.reconcilerList,
+			networkinfocontroller.NewNetworkInfoReconciler(mgr, vpcService, sc.ipblocksInfoService, dnsRecordService),
 			namespacecontroller.NewNamespaceReconciler(mgr, cf, vpcService, subnetService, subnetPortService),
 			subnet.NewSubnetReconciler(mgr, subnetService, subnetPortService, vpcService, subnetBindingService),
-			subnetSetReconcile,
+			sc.subnetSetReconcile,
 			node.NewNodeReconciler(mgr, nodeService),
 			staticroutecontroller.NewStaticRouteReconciler(mgr, staticRouteService),
 			// SubnetPort may use IPAddressAllocation for AddressBinding, reconcile IPAddressAllocation first
@@ -258,38 +270,225 @@ func startServiceController(mgr manager.Manager, nsxClient *nsx.Client) {
 			subnetport.NewSubnetPortReconciler(mgr, subnetPortService, subnetService, vpcService, ipAddressAllocationService),
 			pod.NewPodReconciler(mgr, subnetPortService, subnetService, vpcService, nodeService),
 			networkpolicycontroller.NewNetworkPolicyReconciler(mgr, commonService, vpcService),
-			gateway.NewGatewayReconciler(mgr, dnsRecordService),
+			adminnetworkpolicy.NewAdminNetworkPolicyReconciler(mgr, commonService, vpcService),
+			adminnetworkpolicy.NewBaselineAdminNetworkPolicyReconciler(mgr, commonService, vpcService),
+			gateway.NewGatewayReconciler(mgr, cf, dnsRecordService),
 			subnetbindingcontroller.NewReconciler(mgr, subnetService, subnetBindingService),
 			subnetipreservationcontroller.NewReconciler(mgr, subnetIPReservationService, subnetService),
 		)
 		if lbReconciler := service.NewServiceLbReconciler(mgr, commonService, dnsRecordService); lbReconciler != nil {
-			reconcilerList = append(reconcilerList, lbReconciler)
+			sc.reconcilerList = append(sc.reconcilerList, lbReconciler)
+		}
+		if ingressReconciler := ingress.NewIngressReconciler(mgr, commonService, dnsRecordService); ingressReconciler != nil {
+			sc.reconcilerList = append(sc.reconcilerList, ingressReconciler)
 		}
 		// StatefulSet controller is always registered so that after NSX upgrades (e.g. to 9.2.0+)
 		// replica/GC logic can run without restarting the operator. Reconcile and CollectGarbage
 		// no-op until NSX version supports STS pods and vpc_wcp_enhance=true in config; delete cleanup still runs.
-		reconcilerList = append(reconcilerList, statefulsetcontroller.NewStatefulSetReconciler(mgr, subnetPortService))
+		sc.reconcilerList = append(sc.reconcilerList, statefulsetcontroller.NewStatefulSetReconciler(mgr, subnetPortService))
 		if nsx.StatefulSetPodSubnetPortFeatureEnabled(commonService.NSXClient, commonService.NSXConfig) {
 			log.Info("NSX version and config allow StatefulSet Pod feature; StatefulSet controller will run replica/GC work")
 		} else {
 			log.Info("StatefulSet Pod feature gated (NSX version and/or vpc_wcp_enhance!=true); StatefulSet controller registered but replica/GC no-op until enabled")
 		}
 		if cf.EnableInventory {
-			reconcilerList = append(reconcilerList, inventory.NewInventoryController(mgr.GetClient(), inventoryService, cf))
+			sc.reconcilerList = append(sc.reconcilerList, inventory.NewInventoryController(mgr.GetClient(), sc.inventoryService, cf))
 		}
 	}
 
 	// Add controllers which can run in non-VPC mode
-	reconcilerList = append(reconcilerList, securitypolicycontroller.NewSecurityPolicyReconciler(mgr, commonService, vpcService))
+	sc.reconcilerList = append(sc.reconcilerList, securitypolicycontroller.NewSecurityPolicyReconciler(mgr, commonService, vpcService))
 
 	// Add the NSXServiceAccount controller.
 	if cf.EnableAntreaNSXInterworking {
-		reconcilerList = append(reconcilerList, nsxserviceaccountcontroller.NewNSXServiceAccountReconciler(mgr, commonService))
+		sc.reconcilerList = append(sc.reconcilerList, nsxserviceaccountcontroller.NewNSXServiceAccountReconciler(mgr, commonService))
+	}
+
+	warmInformers(mgr)
+	return sc
+}
+
+// warmInformers starts the informers of the resources watched by the controllers, so the controllers started after
+// the leader election find the informer caches synced.
+func warmInformers(mgr manager.Manager) {
+	objs := []client.Object{&v1alpha1.SecurityPolicy{}}
+	if config.HasVPCNamespaces() {
+		objs = []client.Object{
+			&corev1.Namespace{}, &corev1.Node{}, &corev1.Pod{}, &corev1.Service{}, &networkingv1.NetworkPolicy{},
+			&crdv1alpha1.NetworkInfo{}, &crdv1alpha1.Subnet{}, &crdv1alpha1.SubnetSet{}, &crdv1alpha1.SubnetPort{},
+			&crdv1alpha1.IPAddressAllocation{}, &crdv1alpha1.StaticRoute{}, &crdv1alpha1.SubnetConnectionBindingMap{},
+			&crdv1alpha1.SubnetIPReservation{}, &crdv1alpha1.SecurityPolicy{},
+		}
+	}
+	for _, obj := range objs {
+		// The informer is synced after the manager starts the cache.
+		if _, err := mgr.GetCache().GetInformer(context.TODO(), obj, cache.BlockUntilSynced(false)); err != nil {
+			log.Info("Failed to start informer on standby, it is started with the controller", "type", fmt.Sprintf("%T", obj), "error", err)
+		}
+	}
+}
+
+// refreshStoresPeriodically keeps the NSX stores of a standby replica up to date until it is elected.
+func refreshStoresPeriodically(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := common.RefreshResourceStores(); err != nil {
+				log.Error(err, "Failed to refresh NSX stores on standby")
+			}
+		}
+	}
+}
+
+// updateStoresPeriodically updates the NSX stores with the changes made by the other replicas in sharding mode. The
+// stores are used by the running controllers, so they are updated incrementally, and synced with NSX less often to
+// remove the resources deleted by the other replicas.
+func updateStoresPeriodically(updateInterval, syncInterval time.Duration) {
+	updateTicker := time.NewTicker(updateInterval)
+	defer updateTicker.Stop()
+	syncTicker := time.NewTicker(syncInterval)
+	defer syncTicker.Stop()
+	for {
+		select {
+		case <-updateTicker.C:
+			if err := common.UpdateResourceStores(); err != nil {
+				log.Error(err, "Failed to update NSX stores")
+			}
+		case <-syncTicker.C:
+			if err := common.SyncResourceStores(); err != nil {
+				log.Error(err, "Failed to sync NSX stores")
+			}
+		}
+	}
+}
+
+// enableSharding starts the shard manager distributing the namespaces of the sharded controllers across the replicas.
+func enableSharding(mgr manager.Manager) {
+	// The Leases are read directly from the API server, to avoid caching all the Leases of the cluster.
+	leaseClient, err := client.New(mgr.GetConfig(), client.Options{Scheme: mgr.GetScheme()})
+	if err != nil {
+		log.Error(err, "Failed to create client for the shard Leases")
+		os.Exit(1)
+	}
+	shardManager, err := pkgutil.NewShardManager(leaseClient, nsxOperatorNamespace, cf.ShardCount, time.Duration(cf.ShardLeaseDuration)*time.Second,
+		func(context.Context) error {
+			// The previous holders of the acquired shards may have changed NSX since the last update.
+			return common.UpdateResourceStores()
+		})
+	if err != nil {
+		log.Error(err, "Failed to create shard manager")
+		os.Exit(1)
+	}
+	if err := mgr.Add(shardManager); err != nil {
+		log.Error(err, "Failed to add shard manager")
+		os.Exit(1)
+	}
+	metrics.InitializeShardMetrics()
+	pkgutil.SetShardManager(shardManager)
+}
+
+// startShardedControllers starts the sharded controllers on all the replicas in sharding mode. The restore after NSX
+// restore is done by the leader for all the namespaces, so they are started once no restore is pending.
+func startShardedControllers(mgr manager.Manager, nsxClient *nsx.Client, sc *serviceControllers) {
+	if cf.K8sConfig.EnableRestore && config.HasVPCNamespaces() {
+		for {
+			restoreMode, err := pkgutil.CompareNSXRestore(mgr.GetClient(), nsxClient)
+			if err == nil && !restoreMode {
+				break
+			}
+			log.Info("Waiting for the restore before starting the sharded controllers", "restoreMode", restoreMode, "error", err)
+			time.Sleep(shardRestoreCheckInterval)
+		}
+	}
+	// The webhook server runs on the leader, the webhooks are registered when this replica is elected.
+	for _, reconciler := range sc.reconcilerList {
+		if _, ok := reconciler.(pkgutil.ShardedReconcilerProvider); ok {
+			if err := sc.startController(mgr, reconciler, nil); err != nil {
+				log.Error(err, "Failed to start the sharded controllers")
+				os.Exit(1)
+			}
+		}
+	}
+	log.Info("Started the sharded controllers")
+}
+
+// startServiceController starts the controllers and the other parts writing to NSX or Kubernetes, in HA mode it
+// runs on the leader only.
+func startServiceController(mgr manager.Manager, nsxClient *nsx.Client, sc *serviceControllers) {
+	// Install webhook certificates with the provider in the [cert] section and start renewing them before they expire
+	if config.HasVPCNamespaces() {
+		kubeClient := kubernetes.NewForConfigOrDie(mgr.GetConfig())
+		certRotator, err := pkgutil.NewCertRotator(cf, kubeClient, pkgutil.WebhookCertTarget(kubeClient))
+		if err != nil {
+			log.Error(err, "Failed to create webhook certificate rotator")
+			os.Exit(1)
+		}
+		metrics.InitializeCertMetrics()
+		if _, err := certRotator.Sync(context.Background()); err != nil {
+			log.Error(err, "Failed to install webhook certificates")
+			os.Exit(1)
+		}
+		log.Info("Successfully installed webhook certificates", "provider", certRotator.Provider.Name())
+		go certRotator.Run(context.Background())
+		health.RegisterHandler(health.CheckWebhookCertificate, health.CertExpiryCheck(certRotator))
+	}
+
+	// Initialize and start the system health reporter
+	if config.HasVPCNamespaces() && cf.EnableInventory && cf.CoeConfig.EnableSha {
+		if sc.inventoryService != nil {
+			health.RegisterHandler(health.CheckInventorySync, health.InventorySyncLagCheck(sc.inventoryService.SyncLag))
+		}
+		health.Start(nsxClient, cf, mgr.GetClient())
+	}
+
+	if cf.K8sConfig.EnableRestore && config.HasVPCNamespaces() {
+		var err error
+		restoreMode, err = pkgutil.CompareNSXRestore(mgr.GetClient(), nsxClient)
+		if err != nil {
+			log.Error(err, "NSX restore check failed")
+			os.Exit(1)
+		}
+	} else {
+		restoreMode = false
+	}
+
+	var hookServer webhook.Server
+	if config.HasVPCNamespaces() {
+		if sc.inventoryService != nil {
+			if err := sc.inventoryService.Initialize(false); err != nil {
+				log.Error(err, "Failed to initialize inventory commonService", "controller", "Inventory")
+				os.Exit(1)
+			}
+		}
+		go sc.ipblocksInfoService.StartPeriodicSync()
+
+		if _, err := os.Stat(config.WebhookCertDir); errors.Is(err, os.ErrNotExist) {
+			log.Error(err, "Server cert not found, disabling webhook server", "cert", config.WebhookCertDir)
+		} else {
+			hookServer = webhook.NewServer(webhook.Options{
+				Port:    config.WebhookServerPort,
+				CertDir: config.WebhookCertDir,
+				TLSOpts: []func(*tls.Config){
+					func(cfg *tls.Config) {
+						cfg.MinVersion = tls.VersionTLS13
+					},
+				},
+			})
+			if err := mgr.Add(hookServer); err != nil {
+				log.Error(err, "Failed to add hook server")
+				os.Exit(1)
+			}
+		}
 	}
 
 	if restoreMode {
-		subnetSetReconcile.EnableRestoreMode()
-		err := pkgutil.ProcessRestore(reconcilerList, mgr.GetClient())
+		sc.subnetSetReconcile.EnableRestoreMode()
+		metrics.InitializeRestoreMetrics()
+		err := pkgutil.ProcessRestore(sc.reconcilerList, mgr.GetClient(), mgr.GetEventRecorderFor("nsx-operator")) //nolint:staticcheck // record.EventRecorder; restore Events not on events.EventRecorder yet
 		if err != nil {
 			log.Error(err, "Failed to process restore")
 			os.Exit(1)
@@ -299,9 +498,9 @@ func startServiceController(mgr manager.Manager, nsxClient *nsx.Client) {
 	}
 
 	log.Info("Enter normal mode")
-	for _, reconciler := range reconcilerList {
+	for _, reconciler := range sc.reconcilerList {
 		if reconciler != nil {
-			if err := reconciler.StartController(mgr, hookServer); err != nil {
+			if err := sc.startController(mgr, reconciler, hookServer); err != nil {
 				log.Error(err, "Failed to start the controllers")
 				os.Exit(1)
 			}
@@ -334,19 +533,53 @@ func startServiceController(mgr manager.Manager, nsxClient *nsx.Client) {
 }
 
 func electMaster(mgr manager.Manager, nsxClient *nsx.Client) {
+	// In HA mode, there can be a brief period where both the old and new leader operators are active
+	// simultaneously. After a time synchronization by NTP, the new operator may acquire the lease before the old
+	// operator recognizes it has lost the lease. The lease fence observes the renewals of the old leader while on
+	// standby, and delays the new leader until the lease duration and the renew deadline of the last renewal have
+	// passed.
+	fence, err := pkgutil.NewLeaseFence(mgr.GetAPIReader(), nsxOperatorNamespace, leaderElectionID, leaderElectionLeaseDuration, leaderElectionRenewDeadline)
+	if err != nil {
+		log.Error(err, "Failed to create lease fence")
+		os.Exit(1)
+	}
+	standbyCtx, cancelStandby := context.WithCancel(context.Background())
+	go fence.Observe(standbyCtx)
+
+	// In sharding mode the sharded controllers run on all the replicas, and the other controllers and the global work,
+	// such as the garbage collection, the inventory and the restore, run on the leader.
+	if cf.ShardingEnabled() {
+		enableSharding(mgr)
+	}
+	log.Info("Initializing NSX services before the election")
+	sc := prepareServiceControllers(mgr, nsxClient)
+	if cf.ShardingEnabled() {
+		go updateStoresPeriodically(time.Duration(cf.ShardStoreUpdateInterval)*time.Second, time.Duration(cf.ShardStoreSyncInterval)*time.Second)
+		go startShardedControllers(mgr, nsxClient, sc)
+	} else if cf.StandbyStoreRefreshInterval > 0 {
+		go refreshStoresPeriodically(standbyCtx, time.Duration(cf.StandbyStoreRefreshInterval)*time.Second)
+	}
+
 	log.Info("I'm trying to be elected as master")
 	<-mgr.Elected()
+	electedTime := time.Now()
 	log.Info("I'm the master now")
-	// In HA mode, there can be a brief period where both the old and new leader
-	// operators are active simultaneously. After a time synchronization by NTP,
-	// the new operator may acquire the lease before the old operator recognizes
-	// it has lost the lease, leading to a potential race condition. To mitigate this,
-	// the new master operator is configured to wait for 15 seconds, which is
-	// slightly longer than the default Leader Election Renew Deadline (10 seconds),
-	// ensuring a smooth transition.
-	log.Info("Waiting a 15-second delay to let the old instance know that it has lost its lease")
-	time.Sleep(15 * time.Second)
-	startServiceController(mgr, nsxClient)
+	metrics.InitializeFailoverMetrics(electedTime)
+
+	err = fence.Wait(context.Background())
+	cancelStandby()
+	if err != nil {
+		log.Error(err, "Lease fencing check failed")
+		os.Exit(1)
+	}
+	pkgutil.SetLeader(true)
+	// The stores may miss the changes of the previous leader since the last refresh.
+	if err := common.UpdateResourceStores(); err != nil {
+		log.Error(err, "Failed to update NSX stores after the election")
+		os.Exit(1)
+	}
+	log.Info("Starting controllers", "secondsSinceElected", time.Since(electedTime).Seconds())
+	startServiceController(mgr, nsxClient, sc)
 }
 
 func main() {
@@ -363,7 +596,9 @@ func main() {
 		Metrics:                 metricsserver.Options{BindAddress: config.MetricsAddr},
 		LeaderElection:          cf.HAEnabled(),
 		LeaderElectionNamespace: nsxOperatorNamespace,
-		LeaderElectionID:        "nsx-operator",
+		LeaderElectionID:        leaderElectionID,
+		LeaseDuration:           &leaderElectionLeaseDuration,
+		RenewDeadline:           &leaderElectionRenewDeadline,
 		Controller: ctrlconfig.Controller{
 			CacheSyncTimeout: pkgutil.GetCacheSyncTimeout(),
 		},
@@ -373,6 +608,17 @@ func main() {
 		os.Exit(1)
 	}
 
+	shutdownTracing, err := tracing.Initialize(cf)
+	if err != nil {
+		log.Error(err, "Failed to initialize tracing")
+		os.Exit(1)
+	}
+	closeAudit, err := audit.Initialize(cf)
+	if err != nil {
+		log.Error(err, "Failed to initialize audit log")
+		os.Exit(1)
+	}
+
 	// nsxClient is used to interact with NSX API.
 	nsxClient := nsx.GetClient(cf)
 	if nsxClient == nil {
@@ -389,7 +635,9 @@ func main() {
 	if cf.HAEnabled() {
 		go electMaster(mgr, nsxClient)
 	} else {
-		go startServiceController(mgr, nsxClient)
+		go func() {
+			startServiceController(mgr, nsxClient, prepareServiceControllers(mgr, nsxClient))
+		}()
 	}
 
 	if metrics.AreMetricsExposed(cf) {
@@ -406,7 +654,17 @@ func main() {
 	}
 
 	log.Info("Starting manager")
-	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
+	err = mgr.Start(ctrl.SetupSignalHandler())
+	// Flush the pending spans before exiting.
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	if shutdownErr := shutdownTracing(shutdownCtx); shutdownErr != nil {
+		log.Error(shutdownErr, "Failed to shut down tracing")
+	}
+	cancel()
+	if closeErr := closeAudit(); closeErr != nil {
+		log.Error(closeErr, "Failed to close audit log")
+	}
+	if err != nil {
 		log.Error(err, "Failed to start manager")
 		os.Exit(1)
 	}
@@ -433,45 +691,48 @@ func updateHealthMetricsPeriodically(nsxClient *nsx.Client) {
 	}
 }
 
-func checkLicense(nsxClient *nsx.Client, interval int) {
-	err := nsxClient.ValidateLicense(true)
-	if err != nil {
-		os.Exit(1)
-	}
-	// if there is no dfw license enabled, check the license more frequently
-	// if the customer set it in config, use it, else use licenseTimeoutNoDFW
-	if interval == 0 {
-		if !util.GetDFWLicense() {
-			interval = config.LicenseIntervalForDFW
-		} else {
-			interval = config.LicenseInterval
+// checkLicense waits until the NSX license check succeeds, then checks the license periodically. The license state is
+// published by the reporter instead of exiting. The DFW features are paused while the DFW license is missing, and all
+// the controllers are paused while the container or VPC license is missing.
+func checkLicense(nsxClient *nsx.Client, interval int, reporter *pkgutil.LicenseReporter) {
+	metrics.InitializeLicenseMetrics()
+	for {
+		err := nsxClient.ValidateLicense(true)
+		reporter.Report(err)
+		if err == nil {
+			break
 		}
+		log.Error(err, "NSX license check failed, retrying", "interval", config.LicenseIntervalForFailure)
+		time.Sleep(time.Duration(config.LicenseIntervalForFailure) * time.Second)
 	}
-	go updateLicensePeriodically(nsxClient, time.Duration(interval)*time.Second)
+	go updateLicensePeriodically(nsxClient, interval, reporter)
 }
 
-func updateLicensePeriodically(nsxClient *nsx.Client, interval time.Duration) {
+func updateLicensePeriodically(nsxClient *nsx.Client, interval int, reporter *pkgutil.LicenseReporter) {
+	var err error
 	for {
-		<-time.After(interval)
-		err := nsxClient.ValidateLicense(false)
+		<-time.After(licenseCheckInterval(interval, err))
+		err = nsxClient.ValidateLicense(false)
+		reporter.Report(err)
 		if err != nil {
-			os.Exit(1)
+			log.Error(err, "NSX license check failed")
 		}
 	}
 }
 
-func refreshCertPeriodically() {
-	ticker := time.NewTicker(30 * 24 * time.Hour) // 30 days
-	defer ticker.Stop()
-
-	for range ticker.C {
-		log.Info("Refreshing webhook certificates...")
-		if err := pkgutil.GenerateWebhookCerts(); err != nil {
-			log.Error(err, "Failed to refresh webhook certificates")
-		} else {
-			log.Info("Successfully refreshed webhook certificates")
-		}
-	}
+// licenseCheckInterval returns the interval to the next license check. A failed check is retried sooner, else if the
+// customer set the interval in config, use it. Without the DFW license, the license is checked more frequently.
+func licenseCheckInterval(interval int, lastErr error) time.Duration {
+	switch {
+	case lastErr != nil:
+		interval = config.LicenseIntervalForFailure
+	case interval > 0:
+	case !util.GetDFWLicense():
+		interval = config.LicenseIntervalForDFW
+	default:
+		interval = config.LicenseInterval
+	}
+	return time.Duration(interval) * time.Second
 }
 
 // updatePodLabels updates the role label of pods based on the master election.
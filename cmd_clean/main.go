@@ -11,6 +11,7 @@ import (
 
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
+	"github.com/vmware-tanzu/nsx-operator/pkg/audit"
 	"github.com/vmware-tanzu/nsx-operator/pkg/clean"
 	"github.com/vmware-tanzu/nsx-operator/pkg/config"
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
@@ -44,6 +45,8 @@ var (
 	cluster     string
 	envoyHost   string
 	envoyPort   int
+	auditFile   string
+	enableAudit bool
 )
 
 func main() {
@@ -61,6 +64,8 @@ func main() {
 	flag.StringVar(&envoyHost, "envoyhost", "", "envoy host")
 	flag.IntVar(&envoyPort, "envoyport", 0, "envoy port")
 	flag.IntVar(&config.LogLevel, "log-level", 2, "Use zap-core log system.")
+	flag.BoolVar(&enableAudit, "audit", false, "write the NSX changes to the audit log")
+	flag.StringVar(&auditFile, "audit-file", "", "audit log file, the audit log is written to stdout if it is empty")
 	flag.Parse()
 
 	cf = config.NewNSXOpertorConfig()
@@ -77,13 +82,23 @@ func main() {
 	cf.Cluster = cluster
 	cf.EnvoyHost = envoyHost
 	cf.EnvoyPort = envoyPort
+	cf.EnableAudit = enableAudit
+	cf.AuditFile = auditFile
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Minute*5)
 	defer cancel()
 	log = logger.ZapCustomLogger(cf.DefaultConfig.Debug, config.LogLevel)
 	logger.Log = log
 	logf.SetLogger(log.Logger)
-	err := clean.Clean(ctx, cf, &log.Logger, cf.DefaultConfig.Debug, config.LogLevel)
+	closeAudit, err := audit.Initialize(cf)
+	if err != nil {
+		log.Error(err, "Failed to initialize audit log")
+		os.Exit(1)
+	}
+	err = clean.Clean(ctx, cf, &log.Logger, cf.DefaultConfig.Debug, config.LogLevel)
+	if closeErr := closeAudit(); closeErr != nil {
+		log.Error(closeErr, "Failed to close audit log")
+	}
 	if err != nil {
 		log.Error(err, "Failed to clean nsx resources")
 		os.Exit(1)
@@ -5,29 +5,37 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
-	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/component-base/metrics/legacyregistry"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	vpcv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
 	"github.com/vmware-tanzu/nsx-operator/pkg/config"
 	"github.com/vmware-tanzu/nsx-operator/pkg/eas"
+	"github.com/vmware-tanzu/nsx-operator/pkg/eas/explain"
+	"github.com/vmware-tanzu/nsx-operator/pkg/eas/restorestatus"
 	"github.com/vmware-tanzu/nsx-operator/pkg/eas/server"
+	"github.com/vmware-tanzu/nsx-operator/pkg/eas/storage"
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
 	pkgutil "github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
 // scheme holds only the types that the VPC info provider and EAS storage layer
-// read from kube-apiserver (VPCNetworkConfiguration, Subnet, core types …).
+// read from kube-apiserver (VPCNetworkConfiguration, Subnet, core types, the
+// Gateway API owners of DNS records …).
 // EAS API types (VPCIPAddressUsage etc.) are served entirely from NSX data
 // and live in the generic API server's own scheme (pkg/eas/server/scheme.go).
 var scheme = runtime.NewScheme()
@@ -35,6 +43,7 @@ var scheme = runtime.NewScheme()
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(vpcv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(gatewayv1.Install(scheme))
 }
 
 func main() {
@@ -56,22 +65,6 @@ func main() {
 	// "log.SetLogger was never called" warning.
 	logf.SetLogger(log.Logger)
 
-	log.Info("Starting NSX Extension API Server")
-
-	// Generate TLS certificates for the EAS HTTPS server.
-	// The generic API server (k8s.io/apiserver) uses dynamic certificate loading
-	// via dynamiccertificates.NewDynamicServingContentFromFiles, so it
-	// automatically picks up renewed cert files without a pod restart.
-	// The returned CA PEM is injected into the APIService caBundle so that
-	// kube-apiserver can verify the EAS TLS connection.
-	caCert, err := pkgutil.GenerateEASCerts()
-	if err != nil {
-		log.Error(err, "Failed to generate EAS certificates")
-		os.Exit(1)
-	}
-	log.Info("EAS certificates generated successfully")
-	go refreshEASCertPeriodically()
-
 	// Initialize NSX client.
 	nsxClient := nsx.GetClient(cf)
 	if nsxClient == nil {
@@ -98,35 +91,65 @@ func main() {
 	}
 
 	vpcProvider := eas.NewK8sVPCInfoProvider(client)
+
+	// "eas [flags] explain ..." checks the connectivity between two workloads
+	// with the NSX rules and exits, instead of starting the server.
+	if flag.NArg() > 0 && flag.Arg(0) == explain.Command {
+		checker := storage.NewConnectivityCheckStorage(nsxClient, vpcProvider)
+		if err := explain.Run(context.Background(), flag.Args()[1:], checker, os.Stdout); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to explain connectivity: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// "eas [flags] restore-status" prints the progress of the restore after NSX restore and exits.
+	if flag.NArg() > 0 && flag.Arg(0) == restorestatus.Command {
+		if err := restorestatus.Run(context.Background(), client, os.Stdout); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to get restore status: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	log.Info("Starting NSX Extension API Server")
+
+	// Install the TLS certificate of the EAS HTTPS server with the provider
+	// selected in the [cert] section.
+	// The generic API server (k8s.io/apiserver) uses dynamic certificate loading
+	// via dynamiccertificates.NewDynamicServingContentFromFiles, so it
+	// automatically picks up renewed cert files without a pod restart.
+	// The CA bundle is injected into the APIService caBundle so that
+	// kube-apiserver can verify the EAS TLS connection.
+	certRotator, err := pkgutil.NewCertRotator(cf, kubernetes.NewForConfigOrDie(cfg), pkgutil.EASCertTarget())
+	if err != nil {
+		log.Error(err, "Failed to create EAS certificate rotator")
+		os.Exit(1)
+	}
+	// The generic API server serves the legacy registry on /metrics.
+	legacyregistry.RawMustRegister(metrics.CertExpiryTimestamp, metrics.CertRotationTotal)
+	cert, err := certRotator.Sync(context.Background())
+	if err != nil {
+		log.Error(err, "Failed to install EAS certificates")
+		os.Exit(1)
+	}
+	log.Info("EAS certificates installed successfully", "provider", certRotator.Provider.Name(), "notAfter", cert.NotAfter)
+
 	// Pass the kubeconfig file from ncp.ini [k8s].kubeconfig so the EAS server
 	// uses it for delegated auth/authz calls instead of reading the
 	// extension-apiserver-authentication ConfigMap from kube-system.
-	srv := server.NewEASServer(nsxClient, vpcProvider, client, cfg, cf.K8sConfig.KubeConfigFile, caCert)
+	srv := server.NewEASServer(nsxClient, vpcProvider, client, cfg, cf.K8sConfig.KubeConfigFile, cert.CABundle)
+	certRotator.Target.PatchCABundle = srv.UpdateCABundle
 
 	// Run until SIGTERM or SIGINT.
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
+	go certRotator.Run(ctx)
+
 	log.Info("Starting EAS server")
 	if err := srv.Start(ctx); err != nil {
 		log.Error(err, "EAS server exited with error")
 		os.Exit(1)
 	}
 }
-
-// refreshEASCertPeriodically regenerates the EAS TLS certificate every 30 days.
-// The generic API server loads certs via dynamiccertificates.NewDynamicServingContentFromFiles,
-// which watches the cert files for changes and reloads them automatically — so new
-// certs are picked up without a pod restart.
-func refreshEASCertPeriodically() {
-	ticker := time.NewTicker(30 * 24 * time.Hour)
-	defer ticker.Stop()
-	for range ticker.C {
-		logger.Log.Info("Refreshing EAS certificates...")
-		if _, err := pkgutil.GenerateEASCerts(); err != nil {
-			logger.Log.Error(err, "Failed to refresh EAS certificates")
-		} else {
-			logger.Log.Info("EAS certificates refreshed successfully")
-		}
-	}
-}
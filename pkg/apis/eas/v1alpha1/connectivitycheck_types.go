@@ -0,0 +1,108 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConnectivityCheckEndpoint is an endpoint of the checked flow.
+// Exactly one of Pod, SubnetPort and IP must be set.
+type ConnectivityCheckEndpoint struct {
+	// Namespace of the Pod or SubnetPort. Defaults to the namespace of the ConnectivityCheck. The user must be allowed to create ConnectivityCheck in the namespace.
+	Namespace string `json:"namespace,omitempty"`
+	// Name of the Pod.
+	Pod string `json:"pod,omitempty"`
+	// Name of the SubnetPort, e.g. the SubnetPort of a VM.
+	SubnetPort string `json:"subnetPort,omitempty"`
+	// IP address of an endpoint outside of the VPC, on which no rule is enforced.
+	IP string `json:"ip,omitempty"`
+}
+
+// ConnectivityCheckSpec is the flow to check.
+type ConnectivityCheckSpec struct {
+	Source      ConnectivityCheckEndpoint `json:"source"`
+	Destination ConnectivityCheckEndpoint `json:"destination"`
+	// Protocol of the flow, TCP, UDP, SCTP, ICMP or ICMPv6.
+	// +kubebuilder:validation:Enum=TCP;UDP;SCTP;ICMP;ICMPv6
+	// +kubebuilder:default=TCP
+	Protocol string `json:"protocol,omitempty"`
+	// Destination port of the flow for TCP, UDP and SCTP.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port,omitempty"`
+	// ICMP type of the flow for ICMP and ICMPv6.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=255
+	ICMPType *int32 `json:"icmpType,omitempty"`
+	// ICMP code of the flow for ICMP and ICMPv6.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=255
+	ICMPCode *int32 `json:"icmpCode,omitempty"`
+}
+
+// ConnectivityCheckRule is an NSX rule evaluated for the flow.
+type ConnectivityCheckRule struct {
+	// Stage in which the rule is evaluated, Egress on the source or Ingress on the destination.
+	Stage string `json:"stage"`
+	// Distributed firewall category of the security policy, e.g. Environment or Application.
+	Category string `json:"category,omitempty"`
+	// NSX policy path of the security policy.
+	PolicyPath string `json:"policyPath,omitempty"`
+	// NSX policy path of the rule.
+	RulePath string `json:"rulePath,omitempty"`
+	// NSX display name of the rule.
+	RuleName string `json:"ruleName,omitempty"`
+	// Action of the rule, e.g. ALLOW, DROP, REJECT or JUMP_TO_APPLICATION.
+	Action string `json:"action,omitempty"`
+	// Sequence number of the rule within the security policy.
+	SequenceNumber int64 `json:"sequenceNumber,omitempty"`
+	// Whether the flow is matched by the rule.
+	Matched bool `json:"matched"`
+	// Why the flow is not matched by the rule.
+	Reason string `json:"reason,omitempty"`
+}
+
+// ConnectivityCheckStatus is the result of the check.
+type ConnectivityCheckStatus struct {
+	// Verdict of the flow, ALLOW, DROP, REJECT, or NO_MATCHING_OPERATOR_RULE if no rule created by the operator
+	// is matched. The VPC default rule and the policies not created by the operator are not evaluated.
+	Verdict string `json:"verdict,omitempty"`
+	// The rule deciding the verdict; omitted when no rule is matched.
+	DecidingRule *ConnectivityCheckRule `json:"decidingRule,omitempty"`
+	// Rules evaluated for the flow, in the evaluation order.
+	// +listType=atomic
+	Trace []ConnectivityCheckRule `json:"trace,omitempty"`
+	// Details of the evaluation, e.g. the limitations applied.
+	Message string `json:"message,omitempty"`
+}
+
+// +genclient
+// +genclient:onlyVerbs=create
+//+kubebuilder:object:root=true
+//+kubebuilder:storageversion
+
+// ConnectivityCheck simulates a flow between two workloads offline with the NSX rules generated for the
+// SecurityPolicy CRs and NetworkPolicies in the namespaces of the workloads. It is create-only and the
+// result is returned in the status of the response, nothing is persisted.
+type ConnectivityCheck struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConnectivityCheckSpec   `json:"spec"`
+	Status ConnectivityCheckStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ConnectivityCheckList contains a list of ConnectivityCheck.
+type ConnectivityCheckList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ConnectivityCheck `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ConnectivityCheck{}, &ConnectivityCheckList{})
+}
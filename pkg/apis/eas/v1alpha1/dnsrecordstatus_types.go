@@ -0,0 +1,68 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DNSRecordOwner identifies a Kubernetes resource that owns or contributes to a DNS record.
+type DNSRecordOwner struct {
+	// Kind of the owner resource, e.g. Gateway, HTTPRoute, Service or Ingress.
+	Kind string `json:"kind"`
+	// Namespace of the owner resource.
+	Namespace string `json:"namespace"`
+	// Name of the owner resource.
+	Name string `json:"name"`
+}
+
+// +genclient
+//+kubebuilder:object:root=true
+//+kubebuilder:storageversion
+
+// DNSRecordStatus exposes a DNS record published to NSX by nsx-operator.
+// The DNSRecordStatus name is the NSX DNS record ID, and the namespace is the namespace of the primary owner
+// or of a contributing owner.
+// A DNS request that nsx-operator rejected, e.g. for a hostname outside the allowed DNS zones or conflicting
+// with another owner, is listed in the namespace of its owner with the name rejected-<kind>-<name> and a Reason.
+type DNSRecordStatus struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Fully qualified domain name of the record.
+	FQDN string `json:"fqdn,omitempty"`
+	// DNS record type, e.g. A, AAAA or CNAME.
+	RecordType string `json:"recordType,omitempty"`
+	// Record values, e.g. IP addresses for A/AAAA records.
+	// +listType=atomic
+	Values []string `json:"values,omitempty"`
+	// NSX policy path of the DNS zone holding the record.
+	Zone string `json:"zone,omitempty"`
+	// Time to live of the record in seconds.
+	TTL int64 `json:"ttl,omitempty"`
+	// Primary owner of the record.
+	Owner DNSRecordOwner `json:"owner"`
+	// Other resources sharing the same FQDN with the primary owner.
+	// +listType=atomic
+	ContributingOwners []DNSRecordOwner `json:"contributingOwners,omitempty"`
+	// NSX realization state of the record.
+	// +kubebuilder:validation:Enum=Realized;InProgress;Error;Unknown
+	RealizationState RealizationState `json:"realizationState,omitempty"`
+	// Reason why the DNS request of the owner was rejected, e.g. DNSRecordFailed. It is empty for published records.
+	Reason string `json:"reason,omitempty"`
+	// Realization error details reported by NSX, or the rejection details reported by the owner.
+	Message string `json:"message,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// DNSRecordStatusList contains a list of DNSRecordStatus.
+type DNSRecordStatusList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DNSRecordStatus `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DNSRecordStatus{}, &DNSRecordStatusList{})
+}
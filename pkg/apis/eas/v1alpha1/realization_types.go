@@ -0,0 +1,14 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+package v1alpha1
+
+// RealizationState is the NSX realization state of a resource.
+type RealizationState string
+
+const (
+	RealizationStateRealized   RealizationState = "Realized"
+	RealizationStateInProgress RealizationState = "InProgress"
+	RealizationStateError      RealizationState = "Error"
+	RealizationStateUnknown    RealizationState = "Unknown"
+)
@@ -0,0 +1,104 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RealizedRuleGroup is an NSX group referenced by a realized rule.
+type RealizedRuleGroup struct {
+	// NSX policy path of the group.
+	Path string `json:"path"`
+	// Number of effective IP address members of the group.
+	// Only reported for VPC groups; omitted when not available.
+	MemberCount *int64 `json:"memberCount,omitempty"`
+}
+
+// RealizedRuleStatistics is the traffic statistics of a realized rule reported by NSX.
+type RealizedRuleStatistics struct {
+	// Number of flows which hit the rule.
+	HitCount int64 `json:"hitCount"`
+	// Number of packets processed by the rule.
+	PacketCount int64 `json:"packetCount"`
+	// Number of bytes processed by the rule.
+	ByteCount int64 `json:"byteCount"`
+	// Number of sessions handled by the rule.
+	SessionCount int64 `json:"sessionCount"`
+}
+
+// RealizedRule is an NSX rule generated for a SecurityPolicy or NetworkPolicy rule.
+type RealizedRule struct {
+	// NSX ID of the rule.
+	ID string `json:"id"`
+	// NSX display name of the rule.
+	DisplayName string `json:"displayName,omitempty"`
+	// NSX policy path of the rule.
+	Path string `json:"path,omitempty"`
+	// Action of the rule, e.g. ALLOW, DROP or REJECT.
+	Action string `json:"action,omitempty"`
+	// Direction of the rule, IN or OUT.
+	Direction string `json:"direction,omitempty"`
+	// Sequence number of the rule within the security policy.
+	SequenceNumber int64 `json:"sequenceNumber,omitempty"`
+	// Source groups of the rule.
+	// +listType=atomic
+	SourceGroups []RealizedRuleGroup `json:"sourceGroups,omitempty"`
+	// Destination groups of the rule.
+	// +listType=atomic
+	DestinationGroups []RealizedRuleGroup `json:"destinationGroups,omitempty"`
+	// Groups the rule is applied to.
+	// +listType=atomic
+	AppliedTo []RealizedRuleGroup `json:"appliedTo,omitempty"`
+	// Service entries of the rule, e.g. TCP/80 or ANY.
+	// +listType=atomic
+	ServiceEntries []string `json:"serviceEntries,omitempty"`
+	// NSX realization state of the rule.
+	// +kubebuilder:validation:Enum=Realized;InProgress;Error;Unknown
+	RealizationState RealizationState `json:"realizationState,omitempty"`
+	// Realization error details reported by NSX.
+	Message string `json:"message,omitempty"`
+	// Traffic statistics of the rule; omitted when NSX does not report them.
+	Statistics *RealizedRuleStatistics `json:"statistics,omitempty"`
+}
+
+// +genclient
+//+kubebuilder:object:root=true
+//+kubebuilder:storageversion
+
+// SecurityPolicyRuleStats exposes the NSX rules generated for a SecurityPolicy CR or a NetworkPolicy,
+// together with their realization state and traffic statistics.
+// The SecurityPolicyRuleStats name is the NSX security policy ID. A NetworkPolicy may map to two
+// NSX security policies, one for the allow rules and one for the isolation rules.
+type SecurityPolicyRuleStats struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Kind of the resource owning the NSX security policy, SecurityPolicy or NetworkPolicy.
+	OwnerKind string `json:"ownerKind,omitempty"`
+	// Name of the resource owning the NSX security policy.
+	OwnerName string `json:"ownerName,omitempty"`
+	// NSX policy path of the security policy.
+	PolicyPath string `json:"policyPath,omitempty"`
+	// NSX realization state of the security policy.
+	// +kubebuilder:validation:Enum=Realized;InProgress;Error;Unknown
+	RealizationState RealizationState `json:"realizationState,omitempty"`
+	// Realization error details reported by NSX.
+	Message string `json:"message,omitempty"`
+	// NSX rules of the security policy, ordered by sequence number.
+	// +listType=atomic
+	Rules []RealizedRule `json:"rules,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// SecurityPolicyRuleStatsList contains a list of SecurityPolicyRuleStats.
+type SecurityPolicyRuleStatsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecurityPolicyRuleStats `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SecurityPolicyRuleStats{}, &SecurityPolicyRuleStatsList{})
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SubnetPortAddressBinding is an IP/MAC binding realized on the NSX subnet port.
+type SubnetPortAddressBinding struct {
+	// IP address of the binding.
+	IPAddress string `json:"ipAddress,omitempty"`
+	// MAC address of the binding.
+	MACAddress string `json:"macAddress,omitempty"`
+	// VLAN ID of the binding.
+	VLANID int64 `json:"vlanID,omitempty"`
+}
+
+// +genclient
+//+kubebuilder:object:root=true
+//+kubebuilder:storageversion
+
+// SubnetPortState exposes the runtime state of the NSX subnet port and VIF backing a SubnetPort or Pod.
+// The SubnetPortState name should be the same as the SubnetPort CR name or the Pod name.
+type SubnetPortState struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Kind of the resource owning the port, SubnetPort or Pod.
+	OwnerKind string `json:"ownerKind,omitempty"`
+	// NSX policy path of the subnet port.
+	PortPath string `json:"portPath,omitempty"`
+	// NSX policy path of the Subnet the port is attached to.
+	SubnetPath string `json:"subnetPath,omitempty"`
+	// ID of the VIF attachment.
+	AttachmentID string `json:"attachmentID,omitempty"`
+	// Admin state of the port, UP or DOWN.
+	AdminState string `json:"adminState,omitempty"`
+	// Operational state of the port reported by NSX runtime, e.g. UP, DOWN or UNKNOWN.
+	OperationalState string `json:"operationalState,omitempty"`
+	// NSX realization state of the port.
+	// +kubebuilder:validation:Enum=Realized;InProgress;Error;Unknown
+	RealizationState RealizationState `json:"realizationState,omitempty"`
+	// Realization error details reported by NSX.
+	Message string `json:"message,omitempty"`
+	// IDs of the transport nodes hosting the VIF.
+	// +listType=atomic
+	TransportNodeIDs []string `json:"transportNodeIDs,omitempty"`
+	// Address bindings realized on the port, including DHCP bindings.
+	// +listType=atomic
+	RealizedBindings []SubnetPortAddressBinding `json:"realizedBindings,omitempty"`
+	// Address bindings that NSX detected as duplicates of other ports.
+	// +listType=atomic
+	DuplicateBindings []SubnetPortAddressBinding `json:"duplicateBindings,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// SubnetPortStateList contains a list of SubnetPortState.
+type SubnetPortStateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SubnetPortState `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SubnetPortState{}, &SubnetPortStateList{})
+}
@@ -47,6 +47,147 @@ func (in *CIDRUsage) DeepCopy() *CIDRUsage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectivityCheck) DeepCopyInto(out *ConnectivityCheck) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectivityCheck.
+func (in *ConnectivityCheck) DeepCopy() *ConnectivityCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectivityCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConnectivityCheck) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectivityCheckEndpoint) DeepCopyInto(out *ConnectivityCheckEndpoint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectivityCheckEndpoint.
+func (in *ConnectivityCheckEndpoint) DeepCopy() *ConnectivityCheckEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectivityCheckEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectivityCheckList) DeepCopyInto(out *ConnectivityCheckList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ConnectivityCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectivityCheckList.
+func (in *ConnectivityCheckList) DeepCopy() *ConnectivityCheckList {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectivityCheckList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConnectivityCheckList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectivityCheckRule) DeepCopyInto(out *ConnectivityCheckRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectivityCheckRule.
+func (in *ConnectivityCheckRule) DeepCopy() *ConnectivityCheckRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectivityCheckRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectivityCheckSpec) DeepCopyInto(out *ConnectivityCheckSpec) {
+	*out = *in
+	out.Source = in.Source
+	out.Destination = in.Destination
+	if in.ICMPType != nil {
+		in, out := &in.ICMPType, &out.ICMPType
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ICMPCode != nil {
+		in, out := &in.ICMPCode, &out.ICMPCode
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectivityCheckSpec.
+func (in *ConnectivityCheckSpec) DeepCopy() *ConnectivityCheckSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectivityCheckSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectivityCheckStatus) DeepCopyInto(out *ConnectivityCheckStatus) {
+	*out = *in
+	if in.DecidingRule != nil {
+		in, out := &in.DecidingRule, &out.DecidingRule
+		*out = new(ConnectivityCheckRule)
+		**out = **in
+	}
+	if in.Trace != nil {
+		in, out := &in.Trace, &out.Trace
+		*out = make([]ConnectivityCheckRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectivityCheckStatus.
+func (in *ConnectivityCheckStatus) DeepCopy() *ConnectivityCheckStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectivityCheckStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DHCPIPPoolUsage) DeepCopyInto(out *DHCPIPPoolUsage) {
 	*out = *in
@@ -62,6 +203,89 @@ func (in *DHCPIPPoolUsage) DeepCopy() *DHCPIPPoolUsage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSRecordOwner) DeepCopyInto(out *DNSRecordOwner) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecordOwner.
+func (in *DNSRecordOwner) DeepCopy() *DNSRecordOwner {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSRecordOwner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSRecordStatus) DeepCopyInto(out *DNSRecordStatus) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Owner = in.Owner
+	if in.ContributingOwners != nil {
+		in, out := &in.ContributingOwners, &out.ContributingOwners
+		*out = make([]DNSRecordOwner, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecordStatus.
+func (in *DNSRecordStatus) DeepCopy() *DNSRecordStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSRecordStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DNSRecordStatus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSRecordStatusList) DeepCopyInto(out *DNSRecordStatusList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DNSRecordStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecordStatusList.
+func (in *DNSRecordStatusList) DeepCopy() *DNSRecordStatusList {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSRecordStatusList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DNSRecordStatusList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPBlockUsage) DeepCopyInto(out *IPBlockUsage) {
 	*out = *in
@@ -189,6 +413,151 @@ func (in *RangeUsage) DeepCopy() *RangeUsage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RealizedRule) DeepCopyInto(out *RealizedRule) {
+	*out = *in
+	if in.SourceGroups != nil {
+		in, out := &in.SourceGroups, &out.SourceGroups
+		*out = make([]RealizedRuleGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DestinationGroups != nil {
+		in, out := &in.DestinationGroups, &out.DestinationGroups
+		*out = make([]RealizedRuleGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AppliedTo != nil {
+		in, out := &in.AppliedTo, &out.AppliedTo
+		*out = make([]RealizedRuleGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ServiceEntries != nil {
+		in, out := &in.ServiceEntries, &out.ServiceEntries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Statistics != nil {
+		in, out := &in.Statistics, &out.Statistics
+		*out = new(RealizedRuleStatistics)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RealizedRule.
+func (in *RealizedRule) DeepCopy() *RealizedRule {
+	if in == nil {
+		return nil
+	}
+	out := new(RealizedRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RealizedRuleGroup) DeepCopyInto(out *RealizedRuleGroup) {
+	*out = *in
+	if in.MemberCount != nil {
+		in, out := &in.MemberCount, &out.MemberCount
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RealizedRuleGroup.
+func (in *RealizedRuleGroup) DeepCopy() *RealizedRuleGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(RealizedRuleGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RealizedRuleStatistics) DeepCopyInto(out *RealizedRuleStatistics) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RealizedRuleStatistics.
+func (in *RealizedRuleStatistics) DeepCopy() *RealizedRuleStatistics {
+	if in == nil {
+		return nil
+	}
+	out := new(RealizedRuleStatistics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityPolicyRuleStats) DeepCopyInto(out *SecurityPolicyRuleStats) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]RealizedRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityPolicyRuleStats.
+func (in *SecurityPolicyRuleStats) DeepCopy() *SecurityPolicyRuleStats {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityPolicyRuleStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecurityPolicyRuleStats) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityPolicyRuleStatsList) DeepCopyInto(out *SecurityPolicyRuleStatsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SecurityPolicyRuleStats, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityPolicyRuleStatsList.
+func (in *SecurityPolicyRuleStatsList) DeepCopy() *SecurityPolicyRuleStatsList {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityPolicyRuleStatsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecurityPolicyRuleStatsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubnetDHCPServerStats) DeepCopyInto(out *SubnetDHCPServerStats) {
 	*out = *in
@@ -313,6 +682,93 @@ func (in *SubnetIPPoolsList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetPortAddressBinding) DeepCopyInto(out *SubnetPortAddressBinding) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetPortAddressBinding.
+func (in *SubnetPortAddressBinding) DeepCopy() *SubnetPortAddressBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetPortAddressBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetPortState) DeepCopyInto(out *SubnetPortState) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.TransportNodeIDs != nil {
+		in, out := &in.TransportNodeIDs, &out.TransportNodeIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RealizedBindings != nil {
+		in, out := &in.RealizedBindings, &out.RealizedBindings
+		*out = make([]SubnetPortAddressBinding, len(*in))
+		copy(*out, *in)
+	}
+	if in.DuplicateBindings != nil {
+		in, out := &in.DuplicateBindings, &out.DuplicateBindings
+		*out = make([]SubnetPortAddressBinding, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetPortState.
+func (in *SubnetPortState) DeepCopy() *SubnetPortState {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetPortState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SubnetPortState) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetPortStateList) DeepCopyInto(out *SubnetPortStateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SubnetPortState, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetPortStateList.
+func (in *SubnetPortStateList) DeepCopy() *SubnetPortStateList {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetPortStateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SubnetPortStateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UsageDetails) DeepCopyInto(out *UsageDetails) {
 	*out = *in
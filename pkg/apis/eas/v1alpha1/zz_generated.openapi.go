@@ -17,73 +17,90 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.AllocatedByVPC":            schema_pkg_apis_eas_v1alpha1_AllocatedByVPC(ref),
-		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.CIDRUsage":                 schema_pkg_apis_eas_v1alpha1_CIDRUsage(ref),
-		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.DHCPIPPoolUsage":           schema_pkg_apis_eas_v1alpha1_DHCPIPPoolUsage(ref),
-		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.IPBlockUsage":              schema_pkg_apis_eas_v1alpha1_IPBlockUsage(ref),
-		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.IPBlockUsageList":          schema_pkg_apis_eas_v1alpha1_IPBlockUsageList(ref),
-		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.IPPoolRange":               schema_pkg_apis_eas_v1alpha1_IPPoolRange(ref),
-		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.PoolUsage":                 schema_pkg_apis_eas_v1alpha1_PoolUsage(ref),
-		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.RangeUsage":                schema_pkg_apis_eas_v1alpha1_RangeUsage(ref),
-		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.SubnetDHCPServerStats":     schema_pkg_apis_eas_v1alpha1_SubnetDHCPServerStats(ref),
-		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.SubnetDHCPServerStatsList": schema_pkg_apis_eas_v1alpha1_SubnetDHCPServerStatsList(ref),
-		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.SubnetIPPools":             schema_pkg_apis_eas_v1alpha1_SubnetIPPools(ref),
-		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.SubnetIPPoolsList":         schema_pkg_apis_eas_v1alpha1_SubnetIPPoolsList(ref),
-		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.UsageDetails":              schema_pkg_apis_eas_v1alpha1_UsageDetails(ref),
-		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.VPCIPAddress":              schema_pkg_apis_eas_v1alpha1_VPCIPAddress(ref),
-		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.VPCIPAddressBlock":         schema_pkg_apis_eas_v1alpha1_VPCIPAddressBlock(ref),
-		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.VPCIPAddressUsage":         schema_pkg_apis_eas_v1alpha1_VPCIPAddressUsage(ref),
-		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.VPCIPAddressUsageList":     schema_pkg_apis_eas_v1alpha1_VPCIPAddressUsageList(ref),
-		v1.APIGroup{}.OpenAPIModelName():                                                       schema_pkg_apis_meta_v1_APIGroup(ref),
-		v1.APIGroupList{}.OpenAPIModelName():                                                   schema_pkg_apis_meta_v1_APIGroupList(ref),
-		v1.APIResource{}.OpenAPIModelName():                                                    schema_pkg_apis_meta_v1_APIResource(ref),
-		v1.APIResourceList{}.OpenAPIModelName():                                                schema_pkg_apis_meta_v1_APIResourceList(ref),
-		v1.APIVersions{}.OpenAPIModelName():                                                    schema_pkg_apis_meta_v1_APIVersions(ref),
-		v1.ApplyOptions{}.OpenAPIModelName():                                                   schema_pkg_apis_meta_v1_ApplyOptions(ref),
-		v1.Condition{}.OpenAPIModelName():                                                      schema_pkg_apis_meta_v1_Condition(ref),
-		v1.CreateOptions{}.OpenAPIModelName():                                                  schema_pkg_apis_meta_v1_CreateOptions(ref),
-		v1.DeleteOptions{}.OpenAPIModelName():                                                  schema_pkg_apis_meta_v1_DeleteOptions(ref),
-		v1.Duration{}.OpenAPIModelName():                                                       schema_pkg_apis_meta_v1_Duration(ref),
-		v1.FieldSelectorRequirement{}.OpenAPIModelName():                                       schema_pkg_apis_meta_v1_FieldSelectorRequirement(ref),
-		v1.FieldsV1{}.OpenAPIModelName():                                                       schema_pkg_apis_meta_v1_FieldsV1(ref),
-		v1.GetOptions{}.OpenAPIModelName():                                                     schema_pkg_apis_meta_v1_GetOptions(ref),
-		v1.GroupKind{}.OpenAPIModelName():                                                      schema_pkg_apis_meta_v1_GroupKind(ref),
-		v1.GroupResource{}.OpenAPIModelName():                                                  schema_pkg_apis_meta_v1_GroupResource(ref),
-		v1.GroupVersion{}.OpenAPIModelName():                                                   schema_pkg_apis_meta_v1_GroupVersion(ref),
-		v1.GroupVersionForDiscovery{}.OpenAPIModelName():                                       schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
-		v1.GroupVersionKind{}.OpenAPIModelName():                                               schema_pkg_apis_meta_v1_GroupVersionKind(ref),
-		v1.GroupVersionResource{}.OpenAPIModelName():                                           schema_pkg_apis_meta_v1_GroupVersionResource(ref),
-		v1.InternalEvent{}.OpenAPIModelName():                                                  schema_pkg_apis_meta_v1_InternalEvent(ref),
-		v1.LabelSelector{}.OpenAPIModelName():                                                  schema_pkg_apis_meta_v1_LabelSelector(ref),
-		v1.LabelSelectorRequirement{}.OpenAPIModelName():                                       schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
-		v1.List{}.OpenAPIModelName():                                                           schema_pkg_apis_meta_v1_List(ref),
-		v1.ListMeta{}.OpenAPIModelName():                                                       schema_pkg_apis_meta_v1_ListMeta(ref),
-		v1.ListOptions{}.OpenAPIModelName():                                                    schema_pkg_apis_meta_v1_ListOptions(ref),
-		v1.ManagedFieldsEntry{}.OpenAPIModelName():                                             schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
-		v1.MicroTime{}.OpenAPIModelName():                                                      schema_pkg_apis_meta_v1_MicroTime(ref),
-		v1.ObjectMeta{}.OpenAPIModelName():                                                     schema_pkg_apis_meta_v1_ObjectMeta(ref),
-		v1.OwnerReference{}.OpenAPIModelName():                                                 schema_pkg_apis_meta_v1_OwnerReference(ref),
-		v1.PartialObjectMetadata{}.OpenAPIModelName():                                          schema_pkg_apis_meta_v1_PartialObjectMetadata(ref),
-		v1.PartialObjectMetadataList{}.OpenAPIModelName():                                      schema_pkg_apis_meta_v1_PartialObjectMetadataList(ref),
-		v1.Patch{}.OpenAPIModelName():                                                          schema_pkg_apis_meta_v1_Patch(ref),
-		v1.PatchOptions{}.OpenAPIModelName():                                                   schema_pkg_apis_meta_v1_PatchOptions(ref),
-		v1.Preconditions{}.OpenAPIModelName():                                                  schema_pkg_apis_meta_v1_Preconditions(ref),
-		v1.RootPaths{}.OpenAPIModelName():                                                      schema_pkg_apis_meta_v1_RootPaths(ref),
-		v1.ServerAddressByClientCIDR{}.OpenAPIModelName():                                      schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
-		v1.Status{}.OpenAPIModelName():                                                         schema_pkg_apis_meta_v1_Status(ref),
-		v1.StatusCause{}.OpenAPIModelName():                                                    schema_pkg_apis_meta_v1_StatusCause(ref),
-		v1.StatusDetails{}.OpenAPIModelName():                                                  schema_pkg_apis_meta_v1_StatusDetails(ref),
-		v1.Table{}.OpenAPIModelName():                                                          schema_pkg_apis_meta_v1_Table(ref),
-		v1.TableColumnDefinition{}.OpenAPIModelName():                                          schema_pkg_apis_meta_v1_TableColumnDefinition(ref),
-		v1.TableOptions{}.OpenAPIModelName():                                                   schema_pkg_apis_meta_v1_TableOptions(ref),
-		v1.TableRow{}.OpenAPIModelName():                                                       schema_pkg_apis_meta_v1_TableRow(ref),
-		v1.TableRowCondition{}.OpenAPIModelName():                                              schema_pkg_apis_meta_v1_TableRowCondition(ref),
-		v1.Time{}.OpenAPIModelName():                                                           schema_pkg_apis_meta_v1_Time(ref),
-		v1.Timestamp{}.OpenAPIModelName():                                                      schema_pkg_apis_meta_v1_Timestamp(ref),
-		v1.TypeMeta{}.OpenAPIModelName():                                                       schema_pkg_apis_meta_v1_TypeMeta(ref),
-		v1.UpdateOptions{}.OpenAPIModelName():                                                  schema_pkg_apis_meta_v1_UpdateOptions(ref),
-		v1.WatchEvent{}.OpenAPIModelName():                                                     schema_pkg_apis_meta_v1_WatchEvent(ref),
-		version.Info{}.OpenAPIModelName():                                                      schema_k8sio_apimachinery_pkg_version_Info(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.AllocatedByVPC":              schema_pkg_apis_eas_v1alpha1_AllocatedByVPC(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.CIDRUsage":                   schema_pkg_apis_eas_v1alpha1_CIDRUsage(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.ConnectivityCheck":           schema_pkg_apis_eas_v1alpha1_ConnectivityCheck(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.ConnectivityCheckEndpoint":   schema_pkg_apis_eas_v1alpha1_ConnectivityCheckEndpoint(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.ConnectivityCheckList":       schema_pkg_apis_eas_v1alpha1_ConnectivityCheckList(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.ConnectivityCheckRule":       schema_pkg_apis_eas_v1alpha1_ConnectivityCheckRule(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.ConnectivityCheckSpec":       schema_pkg_apis_eas_v1alpha1_ConnectivityCheckSpec(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.ConnectivityCheckStatus":     schema_pkg_apis_eas_v1alpha1_ConnectivityCheckStatus(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.DHCPIPPoolUsage":             schema_pkg_apis_eas_v1alpha1_DHCPIPPoolUsage(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.DNSRecordOwner":              schema_pkg_apis_eas_v1alpha1_DNSRecordOwner(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.DNSRecordStatus":             schema_pkg_apis_eas_v1alpha1_DNSRecordStatus(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.DNSRecordStatusList":         schema_pkg_apis_eas_v1alpha1_DNSRecordStatusList(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.IPBlockUsage":                schema_pkg_apis_eas_v1alpha1_IPBlockUsage(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.IPBlockUsageList":            schema_pkg_apis_eas_v1alpha1_IPBlockUsageList(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.IPPoolRange":                 schema_pkg_apis_eas_v1alpha1_IPPoolRange(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.PoolUsage":                   schema_pkg_apis_eas_v1alpha1_PoolUsage(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.RangeUsage":                  schema_pkg_apis_eas_v1alpha1_RangeUsage(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.RealizedRule":                schema_pkg_apis_eas_v1alpha1_RealizedRule(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.RealizedRuleGroup":           schema_pkg_apis_eas_v1alpha1_RealizedRuleGroup(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.RealizedRuleStatistics":      schema_pkg_apis_eas_v1alpha1_RealizedRuleStatistics(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.SecurityPolicyRuleStats":     schema_pkg_apis_eas_v1alpha1_SecurityPolicyRuleStats(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.SecurityPolicyRuleStatsList": schema_pkg_apis_eas_v1alpha1_SecurityPolicyRuleStatsList(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.SubnetDHCPServerStats":       schema_pkg_apis_eas_v1alpha1_SubnetDHCPServerStats(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.SubnetDHCPServerStatsList":   schema_pkg_apis_eas_v1alpha1_SubnetDHCPServerStatsList(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.SubnetIPPools":               schema_pkg_apis_eas_v1alpha1_SubnetIPPools(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.SubnetIPPoolsList":           schema_pkg_apis_eas_v1alpha1_SubnetIPPoolsList(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.SubnetPortAddressBinding":    schema_pkg_apis_eas_v1alpha1_SubnetPortAddressBinding(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.SubnetPortState":             schema_pkg_apis_eas_v1alpha1_SubnetPortState(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.SubnetPortStateList":         schema_pkg_apis_eas_v1alpha1_SubnetPortStateList(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.UsageDetails":                schema_pkg_apis_eas_v1alpha1_UsageDetails(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.VPCIPAddress":                schema_pkg_apis_eas_v1alpha1_VPCIPAddress(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.VPCIPAddressBlock":           schema_pkg_apis_eas_v1alpha1_VPCIPAddressBlock(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.VPCIPAddressUsage":           schema_pkg_apis_eas_v1alpha1_VPCIPAddressUsage(ref),
+		"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.VPCIPAddressUsageList":       schema_pkg_apis_eas_v1alpha1_VPCIPAddressUsageList(ref),
+		v1.APIGroup{}.OpenAPIModelName():                                                         schema_pkg_apis_meta_v1_APIGroup(ref),
+		v1.APIGroupList{}.OpenAPIModelName():                                                     schema_pkg_apis_meta_v1_APIGroupList(ref),
+		v1.APIResource{}.OpenAPIModelName():                                                      schema_pkg_apis_meta_v1_APIResource(ref),
+		v1.APIResourceList{}.OpenAPIModelName():                                                  schema_pkg_apis_meta_v1_APIResourceList(ref),
+		v1.APIVersions{}.OpenAPIModelName():                                                      schema_pkg_apis_meta_v1_APIVersions(ref),
+		v1.ApplyOptions{}.OpenAPIModelName():                                                     schema_pkg_apis_meta_v1_ApplyOptions(ref),
+		v1.Condition{}.OpenAPIModelName():                                                        schema_pkg_apis_meta_v1_Condition(ref),
+		v1.CreateOptions{}.OpenAPIModelName():                                                    schema_pkg_apis_meta_v1_CreateOptions(ref),
+		v1.DeleteOptions{}.OpenAPIModelName():                                                    schema_pkg_apis_meta_v1_DeleteOptions(ref),
+		v1.Duration{}.OpenAPIModelName():                                                         schema_pkg_apis_meta_v1_Duration(ref),
+		v1.FieldSelectorRequirement{}.OpenAPIModelName():                                         schema_pkg_apis_meta_v1_FieldSelectorRequirement(ref),
+		v1.FieldsV1{}.OpenAPIModelName():                                                         schema_pkg_apis_meta_v1_FieldsV1(ref),
+		v1.GetOptions{}.OpenAPIModelName():                                                       schema_pkg_apis_meta_v1_GetOptions(ref),
+		v1.GroupKind{}.OpenAPIModelName():                                                        schema_pkg_apis_meta_v1_GroupKind(ref),
+		v1.GroupResource{}.OpenAPIModelName():                                                    schema_pkg_apis_meta_v1_GroupResource(ref),
+		v1.GroupVersion{}.OpenAPIModelName():                                                     schema_pkg_apis_meta_v1_GroupVersion(ref),
+		v1.GroupVersionForDiscovery{}.OpenAPIModelName():                                         schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
+		v1.GroupVersionKind{}.OpenAPIModelName():                                                 schema_pkg_apis_meta_v1_GroupVersionKind(ref),
+		v1.GroupVersionResource{}.OpenAPIModelName():                                             schema_pkg_apis_meta_v1_GroupVersionResource(ref),
+		v1.InternalEvent{}.OpenAPIModelName():                                                    schema_pkg_apis_meta_v1_InternalEvent(ref),
+		v1.LabelSelector{}.OpenAPIModelName():                                                    schema_pkg_apis_meta_v1_LabelSelector(ref),
+		v1.LabelSelectorRequirement{}.OpenAPIModelName():                                         schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
+		v1.List{}.OpenAPIModelName():                                                             schema_pkg_apis_meta_v1_List(ref),
+		v1.ListMeta{}.OpenAPIModelName():                                                         schema_pkg_apis_meta_v1_ListMeta(ref),
+		v1.ListOptions{}.OpenAPIModelName():                                                      schema_pkg_apis_meta_v1_ListOptions(ref),
+		v1.ManagedFieldsEntry{}.OpenAPIModelName():                                               schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
+		v1.MicroTime{}.OpenAPIModelName():                                                        schema_pkg_apis_meta_v1_MicroTime(ref),
+		v1.ObjectMeta{}.OpenAPIModelName():                                                       schema_pkg_apis_meta_v1_ObjectMeta(ref),
+		v1.OwnerReference{}.OpenAPIModelName():                                                   schema_pkg_apis_meta_v1_OwnerReference(ref),
+		v1.PartialObjectMetadata{}.OpenAPIModelName():                                            schema_pkg_apis_meta_v1_PartialObjectMetadata(ref),
+		v1.PartialObjectMetadataList{}.OpenAPIModelName():                                        schema_pkg_apis_meta_v1_PartialObjectMetadataList(ref),
+		v1.Patch{}.OpenAPIModelName():                                                            schema_pkg_apis_meta_v1_Patch(ref),
+		v1.PatchOptions{}.OpenAPIModelName():                                                     schema_pkg_apis_meta_v1_PatchOptions(ref),
+		v1.Preconditions{}.OpenAPIModelName():                                                    schema_pkg_apis_meta_v1_Preconditions(ref),
+		v1.RootPaths{}.OpenAPIModelName():                                                        schema_pkg_apis_meta_v1_RootPaths(ref),
+		v1.ServerAddressByClientCIDR{}.OpenAPIModelName():                                        schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
+		v1.Status{}.OpenAPIModelName():                                                           schema_pkg_apis_meta_v1_Status(ref),
+		v1.StatusCause{}.OpenAPIModelName():                                                      schema_pkg_apis_meta_v1_StatusCause(ref),
+		v1.StatusDetails{}.OpenAPIModelName():                                                    schema_pkg_apis_meta_v1_StatusDetails(ref),
+		v1.Table{}.OpenAPIModelName():                                                            schema_pkg_apis_meta_v1_Table(ref),
+		v1.TableColumnDefinition{}.OpenAPIModelName():                                            schema_pkg_apis_meta_v1_TableColumnDefinition(ref),
+		v1.TableOptions{}.OpenAPIModelName():                                                     schema_pkg_apis_meta_v1_TableOptions(ref),
+		v1.TableRow{}.OpenAPIModelName():                                                         schema_pkg_apis_meta_v1_TableRow(ref),
+		v1.TableRowCondition{}.OpenAPIModelName():                                                schema_pkg_apis_meta_v1_TableRowCondition(ref),
+		v1.Time{}.OpenAPIModelName():                                                             schema_pkg_apis_meta_v1_Time(ref),
+		v1.Timestamp{}.OpenAPIModelName():                                                        schema_pkg_apis_meta_v1_Timestamp(ref),
+		v1.TypeMeta{}.OpenAPIModelName():                                                         schema_pkg_apis_meta_v1_TypeMeta(ref),
+		v1.UpdateOptions{}.OpenAPIModelName():                                                    schema_pkg_apis_meta_v1_UpdateOptions(ref),
+		v1.WatchEvent{}.OpenAPIModelName():                                                       schema_pkg_apis_meta_v1_WatchEvent(ref),
+		version.Info{}.OpenAPIModelName():                                                        schema_k8sio_apimachinery_pkg_version_Info(ref),
 	}
 }
 
@@ -170,55 +187,1121 @@ func schema_pkg_apis_eas_v1alpha1_CIDRUsage(ref common.ReferenceCallback) common
 	}
 }
 
+func schema_pkg_apis_eas_v1alpha1_ConnectivityCheck(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConnectivityCheck simulates a flow between two workloads offline with the NSX rules generated for the SecurityPolicy CRs and NetworkPolicies in the namespaces of the workloads. It is create-only and the result is returned in the status of the response, nothing is persisted.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(v1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.ConnectivityCheckSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.ConnectivityCheckStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.ConnectivityCheckSpec", "github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.ConnectivityCheckStatus", v1.ObjectMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_eas_v1alpha1_ConnectivityCheckEndpoint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConnectivityCheckEndpoint is an endpoint of the checked flow. Exactly one of Pod, SubnetPort and IP must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace of the Pod or SubnetPort. Defaults to the namespace of the ConnectivityCheck. The user must be allowed to create ConnectivityCheck in the namespace.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the Pod.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"subnetPort": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the SubnetPort, e.g. the SubnetPort of a VM.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ip": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IP address of an endpoint outside of the VPC, on which no rule is enforced.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_eas_v1alpha1_ConnectivityCheckList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConnectivityCheckList contains a list of ConnectivityCheck.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(v1.ListMeta{}.OpenAPIModelName()),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.ConnectivityCheck"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.ConnectivityCheck", v1.ListMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_eas_v1alpha1_ConnectivityCheckRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConnectivityCheckRule is an NSX rule evaluated for the flow.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"stage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Stage in which the rule is evaluated, Egress on the source or Ingress on the destination.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"category": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Distributed firewall category of the security policy, e.g. Environment or Application.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"policyPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NSX policy path of the security policy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rulePath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NSX policy path of the rule.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ruleName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NSX display name of the rule.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action of the rule, e.g. ALLOW, DROP, REJECT or JUMP_TO_APPLICATION.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sequenceNumber": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sequence number of the rule within the security policy.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"matched": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether the flow is matched by the rule.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Why the flow is not matched by the rule.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"stage", "matched"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_eas_v1alpha1_ConnectivityCheckSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConnectivityCheckSpec is the flow to check.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"source": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.ConnectivityCheckEndpoint"),
+						},
+					},
+					"destination": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.ConnectivityCheckEndpoint"),
+						},
+					},
+					"protocol": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Protocol of the flow, TCP, UDP, SCTP, ICMP or ICMPv6.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Destination port of the flow for TCP, UDP and SCTP.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"icmpType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ICMP type of the flow for ICMP and ICMPv6.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"icmpCode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ICMP code of the flow for ICMP and ICMPv6.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"source", "destination"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.ConnectivityCheckEndpoint"},
+	}
+}
+
+func schema_pkg_apis_eas_v1alpha1_ConnectivityCheckStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConnectivityCheckStatus is the result of the check.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"verdict": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Verdict of the flow, ALLOW, DROP, REJECT, or NO_MATCHING_OPERATOR_RULE if no rule created by the operator is matched. The VPC default rule and the policies not created by the operator are not evaluated.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"decidingRule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The rule deciding the verdict; omitted when no rule is matched.",
+							Ref:         ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.ConnectivityCheckRule"),
+						},
+					},
+					"trace": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Rules evaluated for the flow, in the evaluation order.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.ConnectivityCheckRule"),
+									},
+								},
+							},
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Details of the evaluation, e.g. the limitations applied.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.ConnectivityCheckRule"},
+	}
+}
+
 func schema_pkg_apis_eas_v1alpha1_DHCPIPPoolUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DHCPIPPoolUsage represents DHCP IP pool usage statistics.",
+				Description: "DHCPIPPoolUsage represents DHCP IP pool usage statistics.",
+				Type:        []string{"object"},
+				Required:    []string{"allocatedNumber", "allocatedPercentage", "consumedNumber", "poolSize"},
+				Properties: map[string]spec.Schema{
+					"allocatedNumber": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Allocated number.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"allocatedPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Allocated percentage.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"consumedNumber": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Total number of IP addresses consumed by DHCP clients.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"poolSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pool size.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_eas_v1alpha1_DNSRecordOwner(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DNSRecordOwner identifies a Kubernetes resource that owns or contributes to a DNS record.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind of the owner resource, e.g. Gateway, HTTPRoute, Service or Ingress.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace of the owner resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the owner resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"kind", "namespace", "name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_eas_v1alpha1_DNSRecordStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DNSRecordStatus exposes a DNS record published to NSX by nsx-operator. The DNSRecordStatus name is the NSX DNS record ID, and the namespace is the namespace of the primary owner or of a contributing owner. A DNS request that nsx-operator rejected, e.g. for a hostname outside the allowed DNS zones or conflicting with another owner, is listed in the namespace of its owner with the name rejected-<kind>-<name> and a Reason.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(v1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"fqdn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Fully qualified domain name of the record.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"recordType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNS record type, e.g. A, AAAA or CNAME.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"values": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Record values, e.g. IP addresses for A/AAAA records.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"zone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NSX policy path of the DNS zone holding the record.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ttl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time to live of the record in seconds.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"owner": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Primary owner of the record.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.DNSRecordOwner"),
+						},
+					},
+					"contributingOwners": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Other resources sharing the same FQDN with the primary owner.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.DNSRecordOwner"),
+									},
+								},
+							},
+						},
+					},
+					"realizationState": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NSX realization state of the record.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason why the DNS request of the owner was rejected, e.g. DNSRecordFailed. It is empty for published records.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Realization error details reported by NSX, or the rejection details reported by the owner.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"owner"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.DNSRecordOwner", v1.ObjectMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_eas_v1alpha1_DNSRecordStatusList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DNSRecordStatusList contains a list of DNSRecordStatus.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(v1.ListMeta{}.OpenAPIModelName()),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.DNSRecordStatus"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.DNSRecordStatus", v1.ListMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_eas_v1alpha1_IPBlockUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPBlockUsage is the usage information of an IPBlock. It contains used IP ranges and available IP ranges statistics of an IPBlock.",
+				Type:        []string{"object"},
+				Required:    []string{"usedIPsCount", "availableIPsCount", "overallIPsCount"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(v1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"usedIPRanges": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Used IP ranges in an IPBlock.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"availableIPRanges": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Available IP ranges in an IPBlock.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"usedIPsCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Used IPs count in an IPBlock.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"availableIPsCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Available IP count in an IPBlock.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"overallIPsCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Total count of IPs which are present in this block.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cidrUsages": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "CIDR usage details for each CIDR in an IPBlock.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.CIDRUsage"),
+									},
+								},
+							},
+						},
+					},
+					"rangeUsages": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Range usage details for each IP range in an IPBlock.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.RangeUsage"),
+									},
+								},
+							},
+						},
+					},
+					"visibility": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Visibility of IPBlock. Must be External or Private.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.CIDRUsage", "github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.RangeUsage", v1.ObjectMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_eas_v1alpha1_IPBlockUsageList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPBlockUsageList contains a list of IPBlockUsage.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(v1.ListMeta{}.OpenAPIModelName()),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.IPBlockUsage"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.IPBlockUsage", v1.ListMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_eas_v1alpha1_IPPoolRange(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "A set of IPv4 or IPv6 addresses defined by a start and end address.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The start IP Address of the IP range. format: IP.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The end IP Address of the IP range. format: IP.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"start", "end"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_eas_v1alpha1_PoolUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PoolUsage represents the IP usage statistics of an IP pool.",
+				Type:        []string{"object"},
+				Required:    []string{"totalIPs", "availableIPs", "allocatedIPAllocations", "requestedIPAllocations"},
+				Properties: map[string]spec.Schema{
+					"totalIPs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Total number of IPs in the pool.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"availableIPs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of available IPs.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"allocatedIPAllocations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of allocated IP allocations.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"requestedIPAllocations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of requested IP allocations.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_eas_v1alpha1_RangeUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Represents used and available IP statistics for IP ranges in an IPBlock.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"range": {
+						SchemaProps: spec.SchemaProps{
+							Description: "One range in an IPBlock range list.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"usageDetails": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.UsageDetails"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.UsageDetails"},
+	}
+}
+
+func schema_pkg_apis_eas_v1alpha1_RealizedRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RealizedRule is an NSX rule generated for a SecurityPolicy or NetworkPolicy rule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NSX ID of the rule.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"displayName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NSX display name of the rule.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NSX policy path of the rule.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action of the rule, e.g. ALLOW, DROP or REJECT.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"direction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Direction of the rule, IN or OUT.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sequenceNumber": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sequence number of the rule within the security policy.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"sourceGroups": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Source groups of the rule.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.RealizedRuleGroup"),
+									},
+								},
+							},
+						},
+					},
+					"destinationGroups": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Destination groups of the rule.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.RealizedRuleGroup"),
+									},
+								},
+							},
+						},
+					},
+					"appliedTo": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Groups the rule is applied to.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.RealizedRuleGroup"),
+									},
+								},
+							},
+						},
+					},
+					"serviceEntries": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Service entries of the rule, e.g. TCP/80 or ANY.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"realizationState": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NSX realization state of the rule.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Realization error details reported by NSX.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"statistics": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Traffic statistics of the rule; omitted when NSX does not report them.",
+							Ref:         ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.RealizedRuleStatistics"),
+						},
+					},
+				},
+				Required: []string{"id"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.RealizedRuleGroup", "github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.RealizedRuleStatistics"},
+	}
+}
+
+func schema_pkg_apis_eas_v1alpha1_RealizedRuleGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RealizedRuleGroup is an NSX group referenced by a realized rule.",
 				Type:        []string{"object"},
-				Required:    []string{"allocatedNumber", "allocatedPercentage", "consumedNumber", "poolSize"},
 				Properties: map[string]spec.Schema{
-					"allocatedNumber": {
+					"path": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Allocated number.",
+							Description: "NSX policy path of the group.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"memberCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of effective IP address members of the group. Only reported for VPC groups; omitted when not available.",
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
 					},
-					"allocatedPercentage": {
+				},
+				Required: []string{"path"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_eas_v1alpha1_RealizedRuleStatistics(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RealizedRuleStatistics is the traffic statistics of a realized rule reported by NSX.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"hitCount": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Allocated percentage.",
+							Description: "Number of flows which hit the rule.",
+							Default:     0,
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
 					},
-					"consumedNumber": {
+					"packetCount": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Total number of IP addresses consumed by DHCP clients.",
+							Description: "Number of packets processed by the rule.",
+							Default:     0,
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
 					},
-					"poolSize": {
+					"byteCount": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Pool size.",
+							Description: "Number of bytes processed by the rule.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"sessionCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of sessions handled by the rule.",
+							Default:     0,
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
 					},
 				},
+				Required: []string{"hitCount", "packetCount", "byteCount", "sessionCount"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_eas_v1alpha1_IPBlockUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_eas_v1alpha1_SecurityPolicyRuleStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "IPBlockUsage is the usage information of an IPBlock. It contains used IP ranges and available IP ranges statistics of an IPBlock.",
+				Description: "SecurityPolicyRuleStats exposes the NSX rules generated for a SecurityPolicy CR or a NetworkPolicy, together with their realization state and traffic statistics. The SecurityPolicyRuleStats name is the NSX security policy ID. A NetworkPolicy may map to two NSX security policies, one for the allow rules and one for the isolation rules.",
 				Type:        []string{"object"},
-				Required:    []string{"usedIPsCount", "availableIPsCount", "overallIPsCount"},
 				Properties: map[string]spec.Schema{
 					"kind": {
 						SchemaProps: spec.SchemaProps{
@@ -240,125 +1323,176 @@ func schema_pkg_apis_eas_v1alpha1_IPBlockUsage(ref common.ReferenceCallback) com
 							Ref:     ref(v1.ObjectMeta{}.OpenAPIModelName()),
 						},
 					},
-					"usedIPRanges": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "atomic",
-							},
+					"ownerKind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind of the resource owning the NSX security policy, SecurityPolicy or NetworkPolicy.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
+					},
+					"ownerName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Used IP ranges in an IPBlock.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Name of the resource owning the NSX security policy.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"availableIPRanges": {
+					"policyPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NSX policy path of the security policy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"realizationState": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NSX realization state of the security policy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Realization error details reported by NSX.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rules": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-type": "atomic",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Available IP ranges in an IPBlock.",
+							Description: "NSX rules of the security policy, ordered by sequence number.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.RealizedRule"),
 									},
 								},
 							},
 						},
 					},
-					"usedIPsCount": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.RealizedRule", v1.ObjectMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_eas_v1alpha1_SecurityPolicyRuleStatsList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecurityPolicyRuleStatsList contains a list of SecurityPolicyRuleStats.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Used IPs count in an IPBlock.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"availableIPsCount": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Available IP count in an IPBlock.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"overallIPsCount": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Total count of IPs which are present in this block.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref(v1.ListMeta{}.OpenAPIModelName()),
 						},
 					},
-					"cidrUsages": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "atomic",
-							},
-						},
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CIDR usage details for each CIDR in an IPBlock.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.CIDRUsage"),
+										Ref:     ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.SecurityPolicyRuleStats"),
 									},
 								},
 							},
 						},
 					},
-					"rangeUsages": {
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.SecurityPolicyRuleStats", v1.ListMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_eas_v1alpha1_SubnetDHCPServerStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SubnetDHCPServerStats exposes the Subnet's DHCP server statistics. The SubnetDHCPServerStats name should be the same as the Subnet CR name.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(v1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"ipPoolStats": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-type": "atomic",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Range usage details for each IP range in an IPBlock.",
+							Description: "DHCP IP pool usage statistics.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.RangeUsage"),
+										Ref:     ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.DHCPIPPoolUsage"),
 									},
 								},
 							},
 						},
 					},
-					"visibility": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Visibility of IPBlock. Must be External or Private.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.CIDRUsage", "github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.RangeUsage", v1.ObjectMeta{}.OpenAPIModelName()},
+			"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.DHCPIPPoolUsage", v1.ObjectMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_pkg_apis_eas_v1alpha1_IPBlockUsageList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_eas_v1alpha1_SubnetDHCPServerStatsList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "IPBlockUsageList contains a list of IPBlockUsage.",
+				Description: "SubnetDHCPServerStatsList contains a list of SubnetDHCPServerStats.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -388,7 +1522,7 @@ func schema_pkg_apis_eas_v1alpha1_IPBlockUsageList(ref common.ReferenceCallback)
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.IPBlockUsage"),
+										Ref:     ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.SubnetDHCPServerStats"),
 									},
 								},
 							},
@@ -399,115 +1533,63 @@ func schema_pkg_apis_eas_v1alpha1_IPBlockUsageList(ref common.ReferenceCallback)
 			},
 		},
 		Dependencies: []string{
-			"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.IPBlockUsage", v1.ListMeta{}.OpenAPIModelName()},
+			"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.SubnetDHCPServerStats", v1.ListMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_pkg_apis_eas_v1alpha1_IPPoolRange(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_eas_v1alpha1_SubnetIPPools(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "A set of IPv4 or IPv6 addresses defined by a start and end address.",
+				Description: "SubnetIPPools describes IP pools of a Subnet. The SubnetIPPools name is the same as subnet CR name.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"start": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The start IP Address of the IP range. format: IP.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"end": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The end IP Address of the IP range. format: IP.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"start", "end"},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_eas_v1alpha1_PoolUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "PoolUsage represents the IP usage statistics of an IP pool.",
-				Type:        []string{"object"},
-				Required:    []string{"totalIPs", "availableIPs", "allocatedIPAllocations", "requestedIPAllocations"},
-				Properties: map[string]spec.Schema{
-					"totalIPs": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Total number of IPs in the pool.",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"availableIPs": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Number of available IPs.",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"allocatedIPAllocations": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Number of allocated IP allocations.",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"requestedIPAllocations": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Number of requested IP allocations.",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Default: map[string]interface{}{},
+							Ref:     ref(v1.ObjectMeta{}.OpenAPIModelName()),
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_eas_v1alpha1_RangeUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Represents used and available IP statistics for IP ranges in an IPBlock.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"range": {
+					"ipAddressType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "One range in an IPBlock range list.",
+							Description: "Type of IP address. Must be IPv4 or IPv6.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"usageDetails": {
+					"poolUsage": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.UsageDetails"),
+							Description: "IP pool usage statistics.",
+							Ref:         ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.PoolUsage"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.UsageDetails"},
+			"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.PoolUsage", v1.ObjectMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_pkg_apis_eas_v1alpha1_SubnetDHCPServerStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_eas_v1alpha1_SubnetIPPoolsList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SubnetDHCPServerStats exposes the Subnet's DHCP server statistics. The SubnetDHCPServerStats name should be the same as the Subnet CR name.",
+				Description: "SubnetIPPoolsList contains a list of SubnetIPPools.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -527,90 +1609,70 @@ func schema_pkg_apis_eas_v1alpha1_SubnetDHCPServerStats(ref common.ReferenceCall
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref(v1.ObjectMeta{}.OpenAPIModelName()),
+							Ref:     ref(v1.ListMeta{}.OpenAPIModelName()),
 						},
 					},
-					"ipPoolStats": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "atomic",
-							},
-						},
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DHCP IP pool usage statistics.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.DHCPIPPoolUsage"),
+										Ref:     ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.SubnetIPPools"),
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.DHCPIPPoolUsage", v1.ObjectMeta{}.OpenAPIModelName()},
+			"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.SubnetIPPools", v1.ListMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_pkg_apis_eas_v1alpha1_SubnetDHCPServerStatsList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_eas_v1alpha1_SubnetPortAddressBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SubnetDHCPServerStatsList contains a list of SubnetDHCPServerStats.",
+				Description: "SubnetPortAddressBinding is an IP/MAC binding realized on the NSX subnet port.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"ipAddress": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "IP address of the binding.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"macAddress": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "MAC address of the binding.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref(v1.ListMeta{}.OpenAPIModelName()),
-						},
-					},
-					"items": {
+					"vlanID": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.SubnetDHCPServerStats"),
-									},
-								},
-							},
+							Description: "VLAN ID of the binding.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.SubnetDHCPServerStats", v1.ListMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_pkg_apis_eas_v1alpha1_SubnetIPPools(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_eas_v1alpha1_SubnetPortState(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SubnetIPPools describes IP pools of a Subnet. The SubnetIPPools name is the same as subnet CR name.",
+				Description: "SubnetPortState exposes the runtime state of the NSX subnet port and VIF backing a SubnetPort or Pod. The SubnetPortState name should be the same as the SubnetPort CR name or the Pod name.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -633,32 +1695,133 @@ func schema_pkg_apis_eas_v1alpha1_SubnetIPPools(ref common.ReferenceCallback) co
 							Ref:     ref(v1.ObjectMeta{}.OpenAPIModelName()),
 						},
 					},
-					"ipAddressType": {
+					"ownerKind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type of IP address. Must be IPv4 or IPv6.",
+							Description: "Kind of the resource owning the port, SubnetPort or Pod.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"poolUsage": {
+					"portPath": {
 						SchemaProps: spec.SchemaProps{
-							Description: "IP pool usage statistics.",
-							Ref:         ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.PoolUsage"),
+							Description: "NSX policy path of the subnet port.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"subnetPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NSX policy path of the Subnet the port is attached to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"attachmentID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID of the VIF attachment.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"adminState": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Admin state of the port, UP or DOWN.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"operationalState": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operational state of the port reported by NSX runtime, e.g. UP, DOWN or UNKNOWN.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"realizationState": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NSX realization state of the port.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Realization error details reported by NSX.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"transportNodeIDs": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "IDs of the transport nodes hosting the VIF.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"realizedBindings": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Address bindings realized on the port, including DHCP bindings.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.SubnetPortAddressBinding"),
+									},
+								},
+							},
+						},
+					},
+					"duplicateBindings": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Address bindings that NSX detected as duplicates of other ports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.SubnetPortAddressBinding"),
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.PoolUsage", v1.ObjectMeta{}.OpenAPIModelName()},
+			"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.SubnetPortAddressBinding", v1.ObjectMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_pkg_apis_eas_v1alpha1_SubnetIPPoolsList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_eas_v1alpha1_SubnetPortStateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SubnetIPPoolsList contains a list of SubnetIPPools.",
+				Description: "SubnetPortStateList contains a list of SubnetPortState.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -688,7 +1851,7 @@ func schema_pkg_apis_eas_v1alpha1_SubnetIPPoolsList(ref common.ReferenceCallback
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.SubnetIPPools"),
+										Ref:     ref("github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.SubnetPortState"),
 									},
 								},
 							},
@@ -699,7 +1862,7 @@ func schema_pkg_apis_eas_v1alpha1_SubnetIPPoolsList(ref common.ReferenceCallback
 			},
 		},
 		Dependencies: []string{
-			"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.SubnetIPPools", v1.ListMeta{}.OpenAPIModelName()},
+			"github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1.SubnetPortState", v1.ListMeta{}.OpenAPIModelName()},
 	}
 }
 
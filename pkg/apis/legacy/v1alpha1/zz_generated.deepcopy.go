@@ -317,11 +317,26 @@ func (in *SecurityPolicyPeer) DeepCopyInto(out *SecurityPolicyPeer) {
 		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ServiceAccountSelector != nil {
+		in, out := &in.ServiceAccountSelector, &out.ServiceAccountSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceRef != nil {
+		in, out := &in.ServiceRef, &out.ServiceRef
+		*out = new(ServiceReference)
+		**out = **in
+	}
 	if in.IPBlocks != nil {
 		in, out := &in.IPBlocks, &out.IPBlocks
 		*out = make([]IPBlock, len(*in))
 		copy(*out, *in)
 	}
+	if in.FQDNs != nil {
+		in, out := &in.FQDNs, &out.FQDNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityPolicyPeer.
@@ -338,6 +353,16 @@ func (in *SecurityPolicyPeer) DeepCopy() *SecurityPolicyPeer {
 func (in *SecurityPolicyPort) DeepCopyInto(out *SecurityPolicyPort) {
 	*out = *in
 	out.Port = in.Port
+	if in.ICMPType != nil {
+		in, out := &in.ICMPType, &out.ICMPType
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ICMPCode != nil {
+		in, out := &in.ICMPCode, &out.ICMPCode
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityPolicyPort.
@@ -401,7 +426,14 @@ func (in *SecurityPolicyRule) DeepCopyInto(out *SecurityPolicyRule) {
 	if in.Ports != nil {
 		in, out := &in.Ports, &out.Ports
 		*out = make([]SecurityPolicyPort, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(bool)
+		**out = **in
 	}
 }
 
@@ -479,6 +511,16 @@ func (in *SecurityPolicyTarget) DeepCopyInto(out *SecurityPolicyTarget) {
 		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ServiceAccountSelector != nil {
+		in, out := &in.ServiceAccountSelector, &out.ServiceAccountSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceRef != nil {
+		in, out := &in.ServiceRef, &out.ServiceRef
+		*out = new(ServiceReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityPolicyTarget.
@@ -490,3 +532,18 @@ func (in *SecurityPolicyTarget) DeepCopy() *SecurityPolicyTarget {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceReference) DeepCopyInto(out *ServiceReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceReference.
+func (in *ServiceReference) DeepCopy() *ServiceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceReference)
+	in.DeepCopyInto(out)
+	return out
+}
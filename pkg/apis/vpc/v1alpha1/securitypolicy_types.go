@@ -48,6 +48,13 @@ type SecurityPolicySpec struct {
 	AppliedTo []SecurityPolicyTarget `json:"appliedTo,omitempty"`
 	// Rules is a list of policy rules.
 	Rules []SecurityPolicyRule `json:"rules,omitempty"`
+	// Logging enables NSX firewall logging for all rules of the policy.
+	// Rule level 'Logging' takes precedence over policy level.
+	Logging bool `json:"logging,omitempty"`
+	// LogLabel is the label attached to the firewall logs of all rules of the policy.
+	// Rule level 'LogLabel' takes precedence over policy level.
+	// +kubebuilder:validation:MaxLength=32
+	LogLabel string `json:"logLabel,omitempty"`
 }
 
 // SecurityPolicyRule defines a rule of SecurityPolicy.
@@ -75,17 +82,45 @@ type SecurityPolicyRule struct {
 	Ports []SecurityPolicyPort `json:"ports,omitempty"`
 	// Name is the display name of this rule.
 	Name string `json:"name,omitempty"`
+	// Logging enables NSX firewall logging for the traffic matching the rule.
+	// It defaults to the policy level 'Logging' if not set.
+	Logging *bool `json:"logging,omitempty"`
+	// LogLabel is the label attached to the firewall logs of the rule.
+	// It defaults to the policy level 'LogLabel' if not set.
+	// +kubebuilder:validation:MaxLength=32
+	LogLabel string `json:"logLabel,omitempty"`
 }
 
 // SecurityPolicyTarget defines the target endpoints to apply SecurityPolicy.
+// +kubebuilder:validation:XValidation:rule="!has(self.serviceAccountSelector) || !has(self.vmSelector)",message="serviceAccountSelector cannot be set together with vmSelector"
+// +kubebuilder:validation:XValidation:rule="!has(self.serviceRef) || (!has(self.vmSelector) && !has(self.podSelector) && !has(self.serviceAccountSelector))",message="serviceRef cannot be set together with selectors"
+// +kubebuilder:validation:XValidation:rule="!has(self.serviceRef) || !has(self.serviceRef.__namespace__)",message="serviceRef namespace is not allowed in appliedTo"
 type SecurityPolicyTarget struct {
 	// VMSelector uses label selector to select VMs.
 	VMSelector *metav1.LabelSelector `json:"vmSelector,omitempty"`
 	// PodSelector uses label selector to select Pods.
 	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+	// ServiceAccountSelector uses label selector to select ServiceAccounts, the Pods running
+	// with the selected ServiceAccounts are selected. Only supported in VPC network.
+	ServiceAccountSelector *metav1.LabelSelector `json:"serviceAccountSelector,omitempty"`
+	// ServiceRef selects the Pods backing the Service in the EndpointSlices.
+	// Only supported in VPC network.
+	ServiceRef *ServiceReference `json:"serviceRef,omitempty"`
+}
+
+// ServiceReference refers to a Service.
+type ServiceReference struct {
+	// Name is the name of the Service.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Namespace is the Namespace of the Service. It defaults to the Namespace of the SecurityPolicy.
+	Namespace string `json:"namespace,omitempty"`
 }
 
 // SecurityPolicyPeer defines the source or destination of traffic.
+// +kubebuilder:validation:XValidation:rule="!has(self.fqdns) || (!has(self.vmSelector) && !has(self.podSelector) && !has(self.namespaceSelector) && !has(self.ipBlocks) && !has(self.serviceAccountSelector) && !has(self.serviceRef))",message="fqdns cannot be set together with selectors or ipBlocks in one peer"
+// +kubebuilder:validation:XValidation:rule="!has(self.serviceAccountSelector) || (!has(self.vmSelector) && !has(self.ipBlocks))",message="serviceAccountSelector cannot be set together with vmSelector or ipBlocks in one peer"
+// +kubebuilder:validation:XValidation:rule="!has(self.serviceRef) || (!has(self.vmSelector) && !has(self.podSelector) && !has(self.namespaceSelector) && !has(self.ipBlocks) && !has(self.serviceAccountSelector))",message="serviceRef cannot be set together with selectors or ipBlocks in one peer"
 type SecurityPolicyPeer struct {
 	// VMSelector uses label selector to select VMs.
 	VMSelector *metav1.LabelSelector `json:"vmSelector,omitempty"`
@@ -93,8 +128,21 @@ type SecurityPolicyPeer struct {
 	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
 	// NamespaceSelector uses label selector to select Namespaces.
 	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// ServiceAccountSelector uses label selector to select ServiceAccounts, the Pods running
+	// with the selected ServiceAccounts are selected. It can be used together with PodSelector
+	// and NamespaceSelector. Only supported in VPC network.
+	ServiceAccountSelector *metav1.LabelSelector `json:"serviceAccountSelector,omitempty"`
+	// ServiceRef selects the Pods backing the Service in the EndpointSlices.
+	// Only supported in VPC network.
+	ServiceRef *ServiceReference `json:"serviceRef,omitempty"`
 	// IPBlocks is a list of IP CIDRs.
 	IPBlocks []IPBlock `json:"ipBlocks,omitempty"`
+	// FQDNs is a list of fully qualified domain names. For egress rule destinations only.
+	// A name may start with the wildcard "*." to match all its subdomains, e.g. "*.example.com".
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:MaxLength=253
+	// +kubebuilder:validation:items:Pattern=`^(\*\.)?([a-zA-Z0-9]([-a-zA-Z0-9_]*[a-zA-Z0-9])?\.)+[a-zA-Z0-9]([-a-zA-Z0-9_]*[a-zA-Z0-9])?\.?$`
+	FQDNs []string `json:"fqdns,omitempty"`
 }
 
 // IPBlock describes a particular CIDR that is allowed or denied to/from the workloads matched by an AppliedTo.
@@ -105,9 +153,16 @@ type IPBlock struct {
 	CIDR string `json:"cidr"`
 }
 
+const (
+	// ProtocolICMP is the ICMP protocol for IPv4.
+	ProtocolICMP corev1.Protocol = "ICMP"
+	// ProtocolICMPv6 is the ICMP protocol for IPv6.
+	ProtocolICMPv6 corev1.Protocol = "ICMPv6"
+)
+
 // SecurityPolicyPort describes protocol and ports for traffic.
 type SecurityPolicyPort struct {
-	// Protocol(TCP, UDP) is the protocol to match traffic.
+	// Protocol(TCP, UDP, ICMP, ICMPv6) is the protocol to match traffic.
 	// It is TCP by default.
 	// +kubebuilder:default=TCP
 	Protocol corev1.Protocol `json:"protocol,omitempty"`
@@ -115,6 +170,18 @@ type SecurityPolicyPort struct {
 	Port intstr.IntOrString `json:"port,omitempty"`
 	// EndPort defines the end of port range.
 	EndPort int `json:"endPort,omitempty"`
+	// ICMPType is the ICMP type to match, for ICMP and ICMPv6 protocol only.
+	// All the ICMP types are matched if it is not set.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=255
+	// +optional
+	ICMPType *int32 `json:"icmpType,omitempty"`
+	// ICMPCode is the ICMP code to match, for ICMP and ICMPv6 protocol only.
+	// It requires ICMPType, all the ICMP codes of the type are matched if it is not set.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=255
+	// +optional
+	ICMPCode *int32 `json:"icmpCode,omitempty"`
 }
 
 // SecurityPolicyStatus defines the observed state of SecurityPolicy.
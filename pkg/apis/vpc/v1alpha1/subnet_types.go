@@ -94,6 +94,10 @@ type SubnetStatus struct {
 	GatewayAddresses []string `json:"gatewayAddresses,omitempty"`
 	// DHCP server IP address.
 	DHCPServerAddresses []string `json:"DHCPServerAddresses,omitempty"`
+	// Effective DHCP server configuration of the Subnet.
+	DHCPServer *DHCPServerStatus `json:"dhcpServer,omitempty"`
+	// Effective DHCPv6 server configuration of the Subnet.
+	DHCPv6Server *DHCPServerStatus `json:"dhcpv6Server,omitempty"`
 	// VLAN extension configured for VPC Subnet.
 	VLANExtension VLANExtension `json:"vlanExtension,omitempty"`
 	// Whether this is a pre-created Subnet shared with the Namespace.
@@ -164,6 +168,25 @@ type StaticIPAllocation struct {
 	PoolRanges []string `json:"poolRanges,omitempty"`
 }
 
+// DHCPClasslessStaticRoute is a classless static route sent to DHCP clients by option 121.
+type DHCPClasslessStaticRoute struct {
+	// Destination network in CIDR format.
+	Network string `json:"network"`
+	// Next hop IP address of the route.
+	NextHop string `json:"nextHop"`
+}
+
+// DHCPStaticBinding is a fixed MAC address to IP address binding on the DHCP server.
+type DHCPStaticBinding struct {
+	// MAC address of the DHCP client.
+	// +kubebuilder:validation:Pattern=`^([0-9a-fA-F]{2}:){5}[0-9a-fA-F]{2}$`
+	MACAddress string `json:"macAddress"`
+	// IP address assigned to the DHCP client. It must be in the Subnet CIDRs.
+	IPAddress string `json:"ipAddress"`
+	// Host name sent to the DHCP client.
+	HostName string `json:"hostName,omitempty"`
+}
+
 // Additional DHCP server config for a VPC Subnet.
 // The additional configuration must not be set when the Subnet has DHCP relay enabled or DHCP is deactivated.
 type DHCPServerAdditionalConfig struct {
@@ -171,10 +194,28 @@ type DHCPServerAdditionalConfig struct {
 	// Supported formats include: ["192.168.1.1", "192.168.1.3-192.168.1.100"]
 	// +kubebuilder:validation::MaxItems=10
 	ReservedIPRanges []string `json:"reservedIPRanges,omitempty"`
+	// DHCP lease time in seconds. NSX default 86400 is used if it is not set.
+	// +kubebuilder:validation:Minimum=60
+	// +kubebuilder:validation:Maximum=4294967295
+	LeaseTime int64 `json:"leaseTime,omitempty"`
+	// DNS server IP addresses sent to the DHCP clients.
+	// +kubebuilder:validation:MaxItems=2
+	DNSServers []string `json:"dnsServers,omitempty"`
+	// Domain search list sent to the DHCP clients (option 119).
+	DomainNames []string `json:"domainNames,omitempty"`
+	// NTP server IP addresses sent to the DHCP clients (option 42).
+	NTPServers []string `json:"ntpServers,omitempty"`
+	// Classless static routes sent to the DHCP clients (option 121).
+	ClasslessStaticRoutes []DHCPClasslessStaticRoute `json:"classlessStaticRoutes,omitempty"`
+	// Fixed MAC address to IP address bindings.
+	// +listType=map
+	// +listMapKey=macAddress
+	StaticBindings []DHCPStaticBinding `json:"staticBindings,omitempty"`
 }
 
 // SubnetDHCPConfig is a DHCP configuration for Subnet.
 // +kubebuilder:validation:XValidation:rule="(!has(self.mode)|| self.mode=='DHCPDeactivated' || self.mode=='DHCPRelay' ) && (!has(self.dhcpServerAdditionalConfig) || !has(self.dhcpServerAdditionalConfig.reservedIPRanges) || size(self.dhcpServerAdditionalConfig.reservedIPRanges)==0) || has(self.mode) && self.mode=='DHCPServer'", message="DHCPServerAdditionalConfig must be cleared when Subnet has DHCP relay enabled or DHCP is deactivated."
+// +kubebuilder:validation:XValidation:rule="!has(self.dhcpServerAdditionalConfig) || (!has(self.dhcpServerAdditionalConfig.leaseTime) && !has(self.dhcpServerAdditionalConfig.dnsServers) && !has(self.dhcpServerAdditionalConfig.domainNames) && !has(self.dhcpServerAdditionalConfig.ntpServers) && !has(self.dhcpServerAdditionalConfig.classlessStaticRoutes) && !has(self.dhcpServerAdditionalConfig.staticBindings)) || has(self.mode) && self.mode=='DHCPServer'", message="DHCP options and static bindings can only be set when Subnet DHCP mode is DHCPServer."
 type SubnetDHCPConfig struct {
 	// DHCP Mode. DHCPDeactivated will be used if it is not defined.
 	// +kubebuilder:validation:Enum=DHCPServer;DHCPRelay;DHCPDeactivated
@@ -190,10 +231,27 @@ type DHCPv6ServerAdditionalConfig struct {
 	// Supported formats include: ["2001:db8::1", "2001:db8::1-2001:db8::ff"]
 	// +kubebuilder:validation::MaxItems=10
 	ReservedIPRanges []string `json:"reservedIPRanges,omitempty"`
+	// DHCPv6 lease time in seconds. NSX default 86400 is used if it is not set.
+	// +kubebuilder:validation:Minimum=60
+	// +kubebuilder:validation:Maximum=4294967295
+	LeaseTime int64 `json:"leaseTime,omitempty"`
+	// DNS server IPv6 addresses sent to the DHCPv6 clients.
+	// +kubebuilder:validation:MaxItems=2
+	DNSServers []string `json:"dnsServers,omitempty"`
+	// Domain search list sent to the DHCPv6 clients.
+	DomainNames []string `json:"domainNames,omitempty"`
+	// SNTP server IPv6 addresses sent to the DHCPv6 clients.
+	NTPServers []string `json:"ntpServers,omitempty"`
+	// Fixed MAC address to IPv6 address bindings.
+	// +listType=map
+	// +listMapKey=macAddress
+	StaticBindings []DHCPStaticBinding `json:"staticBindings,omitempty"`
 }
 
 // SubnetDHCPv6Config is a DHCPv6 configuration for Subnet.
 // +kubebuilder:validation:XValidation:rule="(!has(self.dhcpv6ServerAdditionalConfig) || !has(self.dhcpv6ServerAdditionalConfig.reservedIPRanges) || size(self.dhcpv6ServerAdditionalConfig.reservedIPRanges)==0) || has(self.mode) && self.mode=='DHCPServer'", message="DHCPv6ServerAdditionalConfig must be cleared when Subnet has DHCP relay enabled or DHCP is deactivated."
+// +kubebuilder:validation:XValidation:rule="!has(self.dhcpv6ServerAdditionalConfig) || (!has(self.dhcpv6ServerAdditionalConfig.leaseTime) && !has(self.dhcpv6ServerAdditionalConfig.staticBindings)) || has(self.mode) && self.mode=='DHCPServer'", message="DHCPv6 lease time and static bindings can only be set when Subnet DHCPv6 mode is DHCPServer."
+// +kubebuilder:validation:XValidation:rule="!has(self.dhcpv6ServerAdditionalConfig) || (!has(self.dhcpv6ServerAdditionalConfig.dnsServers) && !has(self.dhcpv6ServerAdditionalConfig.domainNames) && !has(self.dhcpv6ServerAdditionalConfig.ntpServers)) || has(self.mode) && (self.mode=='DHCPServer' || self.mode=='DHCPServerStateless')", message="DHCPv6 options can only be set when Subnet DHCPv6 mode is DHCPServer or DHCPServerStateless."
 type SubnetDHCPv6Config struct {
 	// DHCPv6 Mode. DHCPDeactivated will be used if it is not defined.
 	// +kubebuilder:validation:Enum=DHCPServer;DHCPRelay;DHCPDeactivated;DHCPServerStateless
@@ -202,6 +260,22 @@ type SubnetDHCPv6Config struct {
 	DHCPv6ServerAdditionalConfig DHCPv6ServerAdditionalConfig `json:"dhcpv6ServerAdditionalConfig,omitempty"`
 }
 
+// DHCPServerStatus is the effective DHCP server configuration realized on NSX for the Subnet.
+type DHCPServerStatus struct {
+	// DHCP lease time in seconds.
+	LeaseTime int64 `json:"leaseTime,omitempty"`
+	// DNS server IP addresses sent to the DHCP clients.
+	DNSServers []string `json:"dnsServers,omitempty"`
+	// Domain search list sent to the DHCP clients.
+	DomainNames []string `json:"domainNames,omitempty"`
+	// NTP server IP addresses sent to the DHCP clients.
+	NTPServers []string `json:"ntpServers,omitempty"`
+	// Classless static routes sent to the DHCP clients.
+	ClasslessStaticRoutes []DHCPClasslessStaticRoute `json:"classlessStaticRoutes,omitempty"`
+	// Fixed MAC address to IP address bindings.
+	StaticBindings []DHCPStaticBinding `json:"staticBindings,omitempty"`
+}
+
 // VLANExtension describes VLAN extension configuration for the VPC Subnet.
 type VLANExtension struct {
 	// Flag to control whether the VLAN extension Subnet connects to the VPC gateway.
@@ -13,6 +13,8 @@ import (
 // +kubebuilder:validation:XValidation:rule="!has(oldSelf.ipv6PrefixLength) || has(self.ipv6PrefixLength)", message="ipv6PrefixLength is required once set"
 // +kubebuilder:validation:XValidation:rule="!has(self.subnetDHCPConfig) || has(self.subnetDHCPConfig) && !has(self.subnetDHCPConfig.dhcpServerAdditionalConfig) || has(self.subnetDHCPConfig) && has(self.subnetDHCPConfig.dhcpServerAdditionalConfig) && !has(self.subnetDHCPConfig.dhcpServerAdditionalConfig.reservedIPRanges)", message="reservedIPRanges is not supported in SubnetSet"
 // +kubebuilder:validation:XValidation:rule="!has(self.subnetDHCPv6Config) || has(self.subnetDHCPv6Config) && !has(self.subnetDHCPv6Config.dhcpv6ServerAdditionalConfig) || has(self.subnetDHCPv6Config) && has(self.subnetDHCPv6Config.dhcpv6ServerAdditionalConfig) && !has(self.subnetDHCPv6Config.dhcpv6ServerAdditionalConfig.reservedIPRanges)", message="reservedIPRanges is not supported in SubnetSet"
+// +kubebuilder:validation:XValidation:rule="!has(self.subnetDHCPConfig) || !has(self.subnetDHCPConfig.dhcpServerAdditionalConfig) || !has(self.subnetDHCPConfig.dhcpServerAdditionalConfig.staticBindings)", message="staticBindings is not supported in SubnetSet"
+// +kubebuilder:validation:XValidation:rule="!has(self.subnetDHCPv6Config) || !has(self.subnetDHCPv6Config.dhcpv6ServerAdditionalConfig) || !has(self.subnetDHCPv6Config.dhcpv6ServerAdditionalConfig.staticBindings)", message="staticBindings is not supported in SubnetSet"
 // +kubebuilder:validation:XValidation:rule="!has(self.subnetDHCPConfig) || !has(self.subnetDHCPConfig.mode) || self.subnetDHCPConfig.mode!='DHCPRelay'", message="DHCPRelay is not supported in SubnetSet"
 // +kubebuilder:validation:XValidation:rule="!has(self.subnetDHCPv6Config) || !has(self.subnetDHCPv6Config.mode) || self.subnetDHCPv6Config.mode!='DHCPRelay' && self.subnetDHCPv6Config.mode!='DHCPServerStateless'", message="DHCPRelay or DHCPServerStateless is not supported in SubnetSet"
 type SubnetSetSpec struct {
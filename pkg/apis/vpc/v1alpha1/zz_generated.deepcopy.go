@@ -124,6 +124,21 @@ func (in *Condition) DeepCopy() *Condition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPClasslessStaticRoute) DeepCopyInto(out *DHCPClasslessStaticRoute) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPClasslessStaticRoute.
+func (in *DHCPClasslessStaticRoute) DeepCopy() *DHCPClasslessStaticRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPClasslessStaticRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DHCPServerAdditionalConfig) DeepCopyInto(out *DHCPServerAdditionalConfig) {
 	*out = *in
@@ -132,6 +147,31 @@ func (in *DHCPServerAdditionalConfig) DeepCopyInto(out *DHCPServerAdditionalConf
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.DNSServers != nil {
+		in, out := &in.DNSServers, &out.DNSServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DomainNames != nil {
+		in, out := &in.DomainNames, &out.DomainNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NTPServers != nil {
+		in, out := &in.NTPServers, &out.NTPServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClasslessStaticRoutes != nil {
+		in, out := &in.ClasslessStaticRoutes, &out.ClasslessStaticRoutes
+		*out = make([]DHCPClasslessStaticRoute, len(*in))
+		copy(*out, *in)
+	}
+	if in.StaticBindings != nil {
+		in, out := &in.StaticBindings, &out.StaticBindings
+		*out = make([]DHCPStaticBinding, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPServerAdditionalConfig.
@@ -144,6 +184,61 @@ func (in *DHCPServerAdditionalConfig) DeepCopy() *DHCPServerAdditionalConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPServerStatus) DeepCopyInto(out *DHCPServerStatus) {
+	*out = *in
+	if in.DNSServers != nil {
+		in, out := &in.DNSServers, &out.DNSServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DomainNames != nil {
+		in, out := &in.DomainNames, &out.DomainNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NTPServers != nil {
+		in, out := &in.NTPServers, &out.NTPServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClasslessStaticRoutes != nil {
+		in, out := &in.ClasslessStaticRoutes, &out.ClasslessStaticRoutes
+		*out = make([]DHCPClasslessStaticRoute, len(*in))
+		copy(*out, *in)
+	}
+	if in.StaticBindings != nil {
+		in, out := &in.StaticBindings, &out.StaticBindings
+		*out = make([]DHCPStaticBinding, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPServerStatus.
+func (in *DHCPServerStatus) DeepCopy() *DHCPServerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPServerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPStaticBinding) DeepCopyInto(out *DHCPStaticBinding) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPStaticBinding.
+func (in *DHCPStaticBinding) DeepCopy() *DHCPStaticBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPStaticBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DHCPv6ServerAdditionalConfig) DeepCopyInto(out *DHCPv6ServerAdditionalConfig) {
 	*out = *in
@@ -152,6 +247,26 @@ func (in *DHCPv6ServerAdditionalConfig) DeepCopyInto(out *DHCPv6ServerAdditional
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.DNSServers != nil {
+		in, out := &in.DNSServers, &out.DNSServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DomainNames != nil {
+		in, out := &in.DomainNames, &out.DomainNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NTPServers != nil {
+		in, out := &in.NTPServers, &out.NTPServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StaticBindings != nil {
+		in, out := &in.StaticBindings, &out.StaticBindings
+		*out = make([]DHCPStaticBinding, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPv6ServerAdditionalConfig.
@@ -593,11 +708,26 @@ func (in *SecurityPolicyPeer) DeepCopyInto(out *SecurityPolicyPeer) {
 		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ServiceAccountSelector != nil {
+		in, out := &in.ServiceAccountSelector, &out.ServiceAccountSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceRef != nil {
+		in, out := &in.ServiceRef, &out.ServiceRef
+		*out = new(ServiceReference)
+		**out = **in
+	}
 	if in.IPBlocks != nil {
 		in, out := &in.IPBlocks, &out.IPBlocks
 		*out = make([]IPBlock, len(*in))
 		copy(*out, *in)
 	}
+	if in.FQDNs != nil {
+		in, out := &in.FQDNs, &out.FQDNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityPolicyPeer.
@@ -614,6 +744,16 @@ func (in *SecurityPolicyPeer) DeepCopy() *SecurityPolicyPeer {
 func (in *SecurityPolicyPort) DeepCopyInto(out *SecurityPolicyPort) {
 	*out = *in
 	out.Port = in.Port
+	if in.ICMPType != nil {
+		in, out := &in.ICMPType, &out.ICMPType
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ICMPCode != nil {
+		in, out := &in.ICMPCode, &out.ICMPCode
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityPolicyPort.
@@ -677,7 +817,14 @@ func (in *SecurityPolicyRule) DeepCopyInto(out *SecurityPolicyRule) {
 	if in.Ports != nil {
 		in, out := &in.Ports, &out.Ports
 		*out = make([]SecurityPolicyPort, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(bool)
+		**out = **in
 	}
 }
 
@@ -755,6 +902,16 @@ func (in *SecurityPolicyTarget) DeepCopyInto(out *SecurityPolicyTarget) {
 		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ServiceAccountSelector != nil {
+		in, out := &in.ServiceAccountSelector, &out.ServiceAccountSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceRef != nil {
+		in, out := &in.ServiceRef, &out.ServiceRef
+		*out = new(ServiceReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityPolicyTarget.
@@ -863,6 +1020,21 @@ func (in *ServiceEndpointStatus) DeepCopy() *ServiceEndpointStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceReference) DeepCopyInto(out *ServiceReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceReference.
+func (in *ServiceReference) DeepCopy() *ServiceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SharedSubnet) DeepCopyInto(out *SharedSubnet) {
 	*out = *in
@@ -1733,6 +1905,16 @@ func (in *SubnetStatus) DeepCopyInto(out *SubnetStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.DHCPServer != nil {
+		in, out := &in.DHCPServer, &out.DHCPServer
+		*out = new(DHCPServerStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DHCPv6Server != nil {
+		in, out := &in.DHCPv6Server, &out.DHCPv6Server
+		*out = new(DHCPServerStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	out.VLANExtension = in.VLANExtension
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
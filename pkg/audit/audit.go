@@ -0,0 +1,296 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+)
+
+const (
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+
+	// VerbChainStart is the verb of the entry starting a hash chain, only the first one of an audit log has no previous
+	// hash.
+	VerbChainStart = "CHAIN_START"
+
+	// tailSize is the size read from the end of the existing audit file to continue its hash chain.
+	tailSize = 64 * 1024
+	// newAuditFileReason is the reason of the chain start entry of a new audit file.
+	newAuditFileReason = "new audit file"
+)
+
+var log = logger.Log
+
+// auditLogger is the Logger set by Initialize, the NSX requests are not audited if it is nil.
+var auditLogger atomic.Pointer[Logger]
+
+// Owner is the Kubernetes object on behalf of which the operator changed the NSX resource.
+type Owner struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,omitempty"`
+	UID       string `json:"uid,omitempty"`
+}
+
+// Entry is a line of the audit log. Hash is the SHA-256 of the previous hash and the entry without Hash, so removing
+// or changing a line breaks the chain of the following lines, which is detected by Verify. A chain starts with a
+// VerbChainStart entry whose Diff is the reason of the new chain. The chain start entry of a chain restarted after an
+// unreadable line has the hash of the last readable entry as previous hash. Changes has the new values of the fields
+// set by the request.
+type Entry struct {
+	Time       time.Time                  `json:"time"`
+	Cluster    string                     `json:"cluster,omitempty"`
+	Verb       string                     `json:"verb"`
+	Path       string                     `json:"path"`
+	URLPath    string                     `json:"url_path"`
+	Diff       string                     `json:"diff"`
+	Changes    map[string]json.RawMessage `json:"changes,omitempty"`
+	Owner      *Owner                     `json:"owner,omitempty"`
+	Result     string                     `json:"result"`
+	StatusCode int                        `json:"status_code,omitempty"`
+	Error      string                     `json:"error,omitempty"`
+	PrevHash   string                     `json:"prev_hash"`
+	Hash       string                     `json:"hash"`
+}
+
+// Logger writes the entries as JSON lines and chains their hashes.
+type Logger struct {
+	lock     sync.Mutex
+	writer   io.Writer
+	cluster  string
+	prevHash string
+}
+
+func NewLogger(writer io.Writer, cluster string, prevHash string) *Logger {
+	return &Logger{writer: writer, cluster: cluster, prevHash: prevHash}
+}
+
+// Initialize sets the audit logger from the [audit] section. The returned function closes the audit file, it is a
+// no-op if the audit log is disabled or written to stdout.
+func Initialize(cf *config.NSXOperatorConfig) (func() error, error) {
+	if cf.AuditConfig == nil || !cf.EnableAudit {
+		return func() error { return nil }, nil
+	}
+	if cf.AuditFile == "" {
+		auditLogger.Store(NewLogger(os.Stdout, cf.Cluster, ""))
+		log.Info("Initialized audit log to stdout")
+		return func() error { return nil }, nil
+	}
+	prevHash, chainStartReason, err := lastHash(cf.AuditFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read audit file %s: %w", cf.AuditFile, err)
+	}
+	writer := &lumberjack.Logger{
+		Filename:   cf.AuditFile,
+		MaxSize:    cf.AuditMaxSize,
+		MaxBackups: cf.AuditMaxBackups,
+		MaxAge:     cf.AuditMaxAge,
+		Compress:   cf.AuditCompress,
+	}
+	l := NewLogger(writer, cf.Cluster, prevHash)
+	if chainStartReason != "" {
+		if chainStartReason != newAuditFileReason {
+			// Terminate the incomplete last line, so the chain start entry is a line of its own.
+			_, err = writer.Write([]byte("\n"))
+		}
+		if err == nil {
+			// The chain start entry of a restarted chain records the hash of the last readable entry.
+			err = l.Log(Entry{Verb: VerbChainStart, Diff: chainStartReason, Result: ResultSuccess})
+		}
+		if err != nil {
+			writer.Close()
+			return nil, fmt.Errorf("failed to write audit file %s: %w", cf.AuditFile, err)
+		}
+	}
+	auditLogger.Store(l)
+	log.Info("Initialized audit log", "file", cf.AuditFile, "maxSize", cf.AuditMaxSize, "maxBackups", cf.AuditMaxBackups, "maxAge", cf.AuditMaxAge)
+	return func() error {
+		auditLogger.Store(nil)
+		return writer.Close()
+	}, nil
+}
+
+// Enabled returns whether the NSX requests are audited.
+func Enabled() bool {
+	return auditLogger.Load() != nil
+}
+
+// Log sets the time, the cluster and the hashes of the entry, and writes it. A chain start entry is written first if
+// the logger has no previous hash.
+func (l *Logger) Log(entry Entry) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.prevHash == "" && entry.Verb != VerbChainStart {
+		if err := l.write(Entry{Time: entry.Time, Verb: VerbChainStart, Diff: "new audit log", Result: ResultSuccess}); err != nil {
+			return err
+		}
+	}
+	return l.write(entry)
+}
+
+func (l *Logger) write(entry Entry) error {
+	if entry.Time.IsZero() {
+		entry.Time = time.Now()
+	}
+	entry.Time = entry.Time.UTC()
+	entry.Cluster = l.cluster
+	entry.PrevHash = l.prevHash
+	hash, err := entry.hash()
+	if err != nil {
+		return err
+	}
+	entry.Hash = hash
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if _, err := l.writer.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	l.prevHash = hash
+	return nil
+}
+
+func (entry Entry) hash() (string, error) {
+	entry.Hash = ""
+	content, err := json.Marshal(entry)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(append([]byte(entry.PrevHash), content...))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// VerifyResult is the result of Verify.
+type VerifyResult struct {
+	// LastHash is the hash of the last entry, the first entry of the next audit file follows it.
+	LastHash string
+	// Restarts are the chain start entries following other entries. They are written after an unreadable last line
+	// at startup, but the entries between a restart and the entry before it may also have been removed.
+	Restarts []ChainRestart
+}
+
+// ChainRestart is a chain start entry following other entries in the audit log.
+type ChainRestart struct {
+	// Line is the line of the chain start entry.
+	Line int
+	// UnreadableLines is the number of unreadable lines before the chain start entry.
+	UnreadableLines int
+	// Reason is the reason of the new chain.
+	Reason string
+}
+
+// Verify checks the hash chain of the audit log read from reader. prevHash is the hash of the last entry of the
+// previous audit file, which is rotated, and the first entry must follow it. If prevHash is empty, the first entry
+// must start a chain. A chain start entry may also follow the unreadable last line written before a crash, it must
+// have the hash of the last readable entry as previous hash, and it is reported in the Restarts of the result.
+func Verify(reader io.Reader, prevHash string) (VerifyResult, error) {
+	result := VerifyResult{LastHash: prevHash}
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	unreadable, firstUnreadable := 0, 0
+	var unreadableErr error
+	for line := 1; scanner.Scan(); line++ {
+		entry := Entry{}
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			// The unreadable lines are only accepted before a chain start entry.
+			if unreadable == 0 {
+				firstUnreadable, unreadableErr = line, err
+			}
+			unreadable++
+			continue
+		}
+		if entry.Verb == VerbChainStart {
+			if entry.PrevHash != result.LastHash {
+				return result, fmt.Errorf("line %d starts a chain without the hash of the last readable entry", line)
+			}
+			if line > 1 || entry.PrevHash != "" {
+				result.Restarts = append(result.Restarts, ChainRestart{Line: line, UnreadableLines: unreadable, Reason: entry.Diff})
+			}
+		} else if unreadable > 0 {
+			return result, fmt.Errorf("line %d is not an audit entry: %w", firstUnreadable, unreadableErr)
+		} else if result.LastHash == "" || entry.PrevHash != result.LastHash {
+			return result, fmt.Errorf("line %d does not follow the previous line", line)
+		}
+		hash, err := entry.hash()
+		if err != nil {
+			return result, err
+		}
+		if hash != entry.Hash {
+			return result, fmt.Errorf("line %d has been modified", line)
+		}
+		result.LastHash = entry.Hash
+		unreadable = 0
+	}
+	if unreadable > 0 {
+		return result, fmt.Errorf("line %d is not an audit entry: %w", firstUnreadable, unreadableErr)
+	}
+	return result, scanner.Err()
+}
+
+// lastHash returns the hash of the last entry in the audit file, so the chain continues after a restart. If the chain
+// cannot be continued, the reason of starting a new chain is returned, with the hash of the last readable entry if the
+// last line of the file is unreadable.
+func lastHash(filename string) (string, string, error) {
+	file, err := os.Open(filename)
+	if os.IsNotExist(err) {
+		return "", newAuditFileReason, nil
+	} else if err != nil {
+		return "", "", err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return "", "", err
+	}
+	if info.Size() == 0 {
+		return "", newAuditFileReason, nil
+	}
+	offset := max(info.Size()-tailSize, 0)
+	tail := make([]byte, info.Size()-offset)
+	if _, err := file.ReadAt(tail, offset); err != nil {
+		return "", "", err
+	}
+	lines := bytes.Split(bytes.TrimRight(tail, "\n"), []byte("\n"))
+	entry := Entry{}
+	if err = json.Unmarshal(lines[len(lines)-1], &entry); err == nil {
+		return entry.Hash, "", nil
+	}
+	// The last line is incomplete if the operator crashed while writing it, start a new chain after the last readable
+	// entry.
+	log.Info("Failed to read the last audit entry, starting a new hash chain", "file", filename, "error", err.Error())
+	reason := fmt.Sprintf("last audit entry is unreadable: %v", err)
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return "", "", err
+	}
+	hash, err := lastReadableHash(file)
+	return hash, reason, err
+}
+
+// lastReadableHash returns the hash of the last entry of the audit log read from reader which can be read.
+func lastReadableHash(reader io.Reader) (string, error) {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	hash := ""
+	for scanner.Scan() {
+		entry := Entry{}
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err == nil {
+			hash = entry.Hash
+		}
+	}
+	return hash, scanner.Err()
+}
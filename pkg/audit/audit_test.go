@@ -0,0 +1,173 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+)
+
+func readEntries(t *testing.T, content []byte) []Entry {
+	var entries []Entry
+	for _, line := range bytes.Split(bytes.TrimSpace(content), []byte("\n")) {
+		entry := Entry{}
+		require.NoError(t, json.Unmarshal(line, &entry))
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestLogger(t *testing.T) {
+	buf := &bytes.Buffer{}
+	l := NewLogger(buf, "cluster-1", "")
+	require.NoError(t, l.Log(Entry{Verb: http.MethodPatch, Path: "/orgs/default/projects/p1/vpcs/v1/subnets/s1", Diff: "set tags", Result: ResultSuccess}))
+	require.NoError(t, l.Log(Entry{Verb: http.MethodDelete, Path: "/orgs/default/projects/p1/vpcs/v1/subnets/s1", Diff: "delete", Result: ResultSuccess}))
+	result, err := Verify(bytes.NewReader(buf.Bytes()), "")
+	require.NoError(t, err)
+	assert.Empty(t, result.Restarts)
+	lastHash := result.LastHash
+
+	// The chain starts with a chain start entry.
+	entries := readEntries(t, buf.Bytes())
+	require.Len(t, entries, 3)
+	assert.Equal(t, VerbChainStart, entries[0].Verb)
+	assert.Equal(t, "", entries[0].PrevHash)
+	assert.Equal(t, "cluster-1", entries[1].Cluster)
+	assert.Equal(t, entries[0].Hash, entries[1].PrevHash)
+	assert.Equal(t, entries[1].Hash, entries[2].PrevHash)
+	assert.Equal(t, entries[2].Hash, lastHash)
+
+	// Changing a line breaks the chain.
+	modified := strings.Replace(buf.String(), `"verb":"DELETE"`, `"verb":"PATCH"`, 1)
+	_, err = Verify(strings.NewReader(modified), "")
+	assert.ErrorContains(t, err, "line 3 has been modified")
+	// Removing a line breaks the chain.
+	lines := strings.SplitAfter(buf.String(), "\n")
+	_, err = Verify(strings.NewReader(lines[0]+lines[2]), "")
+	assert.ErrorContains(t, err, "line 2 does not follow the previous line")
+	// Removing the first lines breaks the chain.
+	_, err = Verify(strings.NewReader(lines[1]+lines[2]), "")
+	assert.ErrorContains(t, err, "line 1 does not follow the previous line")
+
+	// A rotated file continues the chain of the previous file.
+	rotated := &bytes.Buffer{}
+	l = NewLogger(rotated, "cluster-1", lastHash)
+	require.NoError(t, l.Log(Entry{Verb: http.MethodPut, Path: "/infra/domains/default/groups/g1", Diff: "set tags", Result: ResultSuccess}))
+	require.Len(t, readEntries(t, rotated.Bytes()), 1)
+	_, err = Verify(bytes.NewReader(rotated.Bytes()), lastHash)
+	assert.NoError(t, err)
+	_, err = Verify(bytes.NewReader(rotated.Bytes()), "")
+	assert.ErrorContains(t, err, "line 1 does not follow the previous line")
+	_, err = Verify(bytes.NewReader(rotated.Bytes()), entries[1].Hash)
+	assert.ErrorContains(t, err, "line 1 does not follow the previous line")
+}
+
+func TestInitialize(t *testing.T) {
+	defer auditLogger.Store(nil)
+
+	closeAudit, err := Initialize(&config.NSXOperatorConfig{AuditConfig: &config.AuditConfig{}})
+	require.NoError(t, err)
+	assert.False(t, Enabled())
+	assert.NoError(t, closeAudit())
+
+	file := filepath.Join(t.TempDir(), "audit.log")
+	cf := &config.NSXOperatorConfig{
+		CoeConfig:   &config.CoeConfig{Cluster: "cluster-1"},
+		AuditConfig: &config.AuditConfig{EnableAudit: true, AuditFile: file, AuditMaxSize: 1},
+	}
+	u, _ := url.Parse("https://nsx/policy/api/v1/orgs/default/projects/p1/vpcs/v1/subnets/s1")
+	for i := 0; i < 2; i++ {
+		// The hash chain continues after a restart.
+		closeAudit, err = Initialize(cf)
+		require.NoError(t, err)
+		assert.True(t, Enabled())
+		RecordRequest(context.Background(), http.MethodDelete, u, nil, http.StatusOK, nil)
+		require.NoError(t, closeAudit())
+	}
+	assert.False(t, Enabled())
+
+	content, err := os.ReadFile(file)
+	require.NoError(t, err)
+	_, err = Verify(bytes.NewReader(content), "")
+	require.NoError(t, err)
+	entries := readEntries(t, content)
+	require.Len(t, entries, 3)
+	assert.Equal(t, VerbChainStart, entries[0].Verb)
+	assert.Equal(t, newAuditFileReason, entries[0].Diff)
+	assert.Equal(t, entries[0].Hash, entries[1].PrevHash)
+	assert.Equal(t, entries[1].Hash, entries[2].PrevHash)
+
+	// A new chain is started with the reason if the last entry is incomplete.
+	f, err := os.OpenFile(file, os.O_APPEND|os.O_WRONLY, 0644)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"time":`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	closeAudit, err = Initialize(cf)
+	require.NoError(t, err)
+	RecordRequest(context.Background(), http.MethodDelete, u, nil, http.StatusOK, nil)
+	require.NoError(t, closeAudit())
+
+	content, err = os.ReadFile(file)
+	require.NoError(t, err)
+	// The new chain follows the last readable entry, and the restart is reported.
+	result, err := Verify(bytes.NewReader(content), "")
+	require.NoError(t, err)
+	require.Len(t, result.Restarts, 1)
+	assert.Equal(t, 5, result.Restarts[0].Line)
+	assert.Equal(t, 1, result.Restarts[0].UnreadableLines)
+	assert.Contains(t, result.Restarts[0].Reason, "last audit entry is unreadable")
+	lines := bytes.SplitAfter(content, []byte("\n"))
+	require.Len(t, lines, 7)
+	newChain := readEntries(t, bytes.Join(lines[4:], nil))
+	require.Len(t, newChain, 2)
+	assert.Equal(t, VerbChainStart, newChain[0].Verb)
+	assert.Equal(t, entries[2].Hash, newChain[0].PrevHash)
+	assert.Equal(t, newChain[1].Hash, result.LastHash)
+}
+
+func TestVerifyRestart(t *testing.T) {
+	buf := &bytes.Buffer{}
+	l := NewLogger(buf, "cluster-1", "")
+	for i := 0; i < 3; i++ {
+		require.NoError(t, l.Log(Entry{Verb: http.MethodDelete, Path: "/orgs/default/projects/p1/vpcs/v1/subnets/s1", Diff: "delete", Result: ResultSuccess}))
+	}
+	lines := strings.SplitAfter(buf.String(), "\n")
+	require.Len(t, lines, 5)
+	entries := readEntries(t, buf.Bytes())
+	chainStart := func(prevHash string) string {
+		out := &bytes.Buffer{}
+		require.NoError(t, NewLogger(out, "cluster-1", prevHash).Log(Entry{Verb: VerbChainStart, Diff: "restart", Result: ResultSuccess}))
+		return out.String()
+	}
+
+	// Removing the tail and restarting the chain without a previous hash is detected.
+	_, err := Verify(strings.NewReader(lines[0]+lines[1]+chainStart("")), "")
+	assert.ErrorContains(t, err, "line 3 starts a chain without the hash of the last readable entry")
+	_, err = Verify(strings.NewReader(lines[0]+lines[1]+`{"time":`+"\n"+chainStart(entries[3].Hash)), "")
+	assert.ErrorContains(t, err, "line 4 starts a chain without the hash of the last readable entry")
+
+	// A restart following the last readable entry is accepted and reported.
+	result, err := Verify(strings.NewReader(lines[0]+lines[1]+`{"time":`+"\n"+chainStart(entries[1].Hash)), "")
+	require.NoError(t, err)
+	assert.Equal(t, []ChainRestart{{Line: 4, UnreadableLines: 1, Reason: "restart"}}, result.Restarts)
+	result, err = Verify(strings.NewReader(buf.String()+chainStart(entries[3].Hash)), "")
+	require.NoError(t, err)
+	assert.Equal(t, []ChainRestart{{Line: 5, Reason: "restart"}}, result.Restarts)
+
+	// The unreadable lines are only accepted before a restart.
+	_, err = Verify(strings.NewReader(lines[0]+lines[1]+`{"time":`+"\n"+lines[2]), "")
+	assert.ErrorContains(t, err, "line 3 is not an audit entry")
+	_, err = Verify(strings.NewReader(lines[0]+lines[1]+`{"time":`), "")
+	assert.ErrorContains(t, err, "line 3 is not an audit entry")
+}
@@ -0,0 +1,241 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	policyAPIPrefix   = "/policy/api/v1"
+	sessionCreatePath = "/api/session/create"
+
+	resourceTypeOrgRoot = "OrgRoot"
+	resourceTypeInfra   = "Infra"
+	resourceTypeRef     = "ChildResourceReference"
+	childPrefix         = "Child"
+
+	// redactedValue replaces the values of the sensitive fields in the changes.
+	redactedValue = "[redacted]"
+	// maxValueSize is the size of the JSON value of a field above which the value is not written in the changes.
+	maxValueSize = 1024
+)
+
+var (
+	registryLock sync.RWMutex
+	pathSegments = map[string]string{}
+)
+
+// ignoredFields are not listed in the diff summary, they are set by the operator on every request or by NSX.
+var ignoredFields = map[string]bool{
+	"resource_type":     true,
+	"id":                true,
+	"path":              true,
+	"parent_path":       true,
+	"relative_path":     true,
+	"children":          true,
+	"marked_for_delete": true,
+}
+
+// sensitiveFields are the fragments of the field names whose values are redacted in the changes, e.g. the private key
+// of a TLS certificate.
+var sensitiveFields = []string{"password", "passphrase", "secret", "private_key", "token"}
+
+// RegisterPathSegment registers the segment of the NSX path of resourceType, e.g. "subnets" for VpcSubnet. It is used
+// to build the path of the resources in an H-API request which have no path.
+func RegisterPathSegment(resourceType, segment string) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	if _, ok := pathSegments[resourceType]; !ok {
+		pathSegments[resourceType] = segment
+	}
+}
+
+// IsMutation returns whether a request with method changes NSX.
+func IsMutation(method string) bool {
+	switch method {
+	case http.MethodPatch, http.MethodPut, http.MethodPost, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// RecordRequest writes the entries of an NSX request if the audit log is enabled. An H-API request is recorded as an
+// entry for each resource in the tree. The owner of the entries is the owner in ctx, set by the reconcile sending the
+// request, see Reconciler. The error is only logged, the audit log never fails the request.
+func RecordRequest(ctx context.Context, method string, u *url.URL, body []byte, statusCode int, requestErr error) {
+	l := auditLogger.Load()
+	if l == nil || !IsMutation(method) || strings.HasSuffix(u.Path, sessionCreatePath) {
+		return
+	}
+	result, errMsg := ResultSuccess, ""
+	if requestErr != nil {
+		result, errMsg = ResultFailure, requestErr.Error()
+	} else if statusCode >= http.StatusBadRequest {
+		result = ResultFailure
+	}
+	owner := OwnerFrom(ctx)
+	for _, entry := range buildEntries(method, u, body) {
+		entry.Verb = method
+		entry.Owner = owner
+		entry.URLPath = u.Path
+		entry.Result = result
+		entry.StatusCode = statusCode
+		entry.Error = errMsg
+		if err := l.Log(entry); err != nil {
+			log.Error(err, "Failed to write the audit log", "verb", method, "path", entry.Path)
+		}
+	}
+}
+
+func buildEntries(method string, u *url.URL, body []byte) []Entry {
+	path := strings.TrimPrefix(u.Path, policyAPIPrefix)
+	if method == http.MethodDelete {
+		return []Entry{{Path: path, Diff: "delete"}}
+	}
+	if action := u.Query().Get("action"); action != "" {
+		return []Entry{{Path: path, Diff: "action " + action}}
+	}
+	if len(body) == 0 {
+		return []Entry{{Path: path, Diff: "no body"}}
+	}
+	obj := map[string]interface{}{}
+	// The numbers are kept as written in the request, e.g. the large integers are not converted to floats.
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&obj); err != nil {
+		return []Entry{{Path: path, Diff: fmt.Sprintf("unparsed body of %d bytes", len(body))}}
+	}
+
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+	var entries []Entry
+	resourceType, _ := obj["resource_type"].(string)
+	switch resourceType {
+	case resourceTypeOrgRoot:
+		// The paths of the children of the org root start with /orgs.
+		entries = walkChildren(obj, "", entries)
+	case resourceTypeInfra:
+		entries = walkChildren(obj, path, entries)
+	default:
+		entries = walkObject(obj, path, false, entries)
+	}
+	if len(entries) == 0 {
+		entries = append(entries, Entry{Path: path, Diff: "no change"})
+	}
+	return entries
+}
+
+// walkObject records obj, whose path is path, and walks its children.
+func walkObject(obj map[string]interface{}, path string, markedForDelete bool, entries []Entry) []Entry {
+	if deleted, ok := obj["marked_for_delete"].(bool); ok && deleted {
+		markedForDelete = true
+	}
+	diff, changes := diffSummary(obj, markedForDelete)
+	entries = append(entries, Entry{Path: path, Diff: diff, Changes: changes})
+	return walkChildren(obj, path, entries)
+}
+
+// walkChildren walks the H-API children of obj. A ChildResourceReference only references its target to build the
+// path, the other children wrap the resource in the field named by the resource type, e.g. ChildVpcSubnet wraps
+// VpcSubnet.
+func walkChildren(obj map[string]interface{}, path string, entries []Entry) []Entry {
+	children, _ := obj["children"].([]interface{})
+	for _, c := range children {
+		child, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		childType, _ := child["resource_type"].(string)
+		if childType == resourceTypeRef {
+			targetType, _ := child["target_type"].(string)
+			entries = walkChildren(child, childPath(path, targetType, child), entries)
+			continue
+		}
+		resourceType := strings.TrimPrefix(childType, childPrefix)
+		resource, ok := child[resourceType].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		markedForDelete, _ := child["marked_for_delete"].(bool)
+		resourcePath, _ := resource["path"].(string)
+		if resourcePath == "" {
+			resourcePath = childPath(path, resourceType, resource)
+		}
+		entries = walkObject(resource, resourcePath, markedForDelete, entries)
+	}
+	return entries
+}
+
+func childPath(parentPath, resourceType string, obj map[string]interface{}) string {
+	id, _ := obj["id"].(string)
+	segment, ok := pathSegments[resourceType]
+	if !ok {
+		segment = resourceType
+	}
+	return parentPath + "/" + segment + "/" + id
+}
+
+// diffSummary lists the fields set by the request and returns their new values. The previous state of the resource is
+// not known in the transport, so the changes only have the new values. The values of the sensitive fields are redacted,
+// and the values larger than maxValueSize are replaced by their size.
+func diffSummary(obj map[string]interface{}, markedForDelete bool) (string, map[string]json.RawMessage) {
+	if markedForDelete {
+		return "delete", nil
+	}
+	var fields []string
+	changes := map[string]json.RawMessage{}
+	for field, value := range obj {
+		if ignoredFields[field] || strings.HasPrefix(field, "_") {
+			continue
+		}
+		fields = append(fields, field)
+		changes[field] = changeValue(field, value)
+	}
+	if len(fields) == 0 {
+		return "no field", nil
+	}
+	sort.Strings(fields)
+	return "set " + strings.Join(fields, ","), changes
+}
+
+func changeValue(field string, value interface{}) json.RawMessage {
+	raw, err := json.Marshal(redact(field, value))
+	if err != nil {
+		raw, _ = json.Marshal(fmt.Sprintf("[unmarshalable value: %v]", err))
+	} else if len(raw) > maxValueSize {
+		raw, _ = json.Marshal(fmt.Sprintf("[value of %d bytes]", len(raw)))
+	}
+	return raw
+}
+
+// redact returns value with the values of the sensitive fields, at any depth, replaced by redactedValue.
+func redact(field string, value interface{}) interface{} {
+	lowerField := strings.ToLower(field)
+	for _, sensitive := range sensitiveFields {
+		if strings.Contains(lowerField, sensitive) {
+			return redactedValue
+		}
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, child := range v {
+			out[k] = redact(k, child)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, child := range v {
+			out[i] = redact("", child)
+		}
+		return out
+	}
+	return value
+}
@@ -0,0 +1,44 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+type ownerKey struct{}
+
+// WithOwner returns a context carrying owner, the NSX requests sent with the context are audited on behalf of owner.
+// The requests carry the context if they are sent by a client of nsx.Client.WithContext.
+func WithOwner(ctx context.Context, owner *Owner) context.Context {
+	return context.WithValue(ctx, ownerKey{}, owner)
+}
+
+// OwnerFrom returns the owner set by WithOwner, or nil if the context is not the one of a reconcile.
+func OwnerFrom(ctx context.Context) *Owner {
+	owner, _ := ctx.Value(ownerKey{}).(*Owner)
+	return owner
+}
+
+// Reconciler wraps a reconciler to set the reconciled object as the owner of the NSX requests of each reconcile. obj is
+// the type of the reconciled objects, the reconciled object is read from the cache of c to get its UID, which is empty
+// once the object is deleted. The owner is only set if the audit log is enabled.
+func Reconciler(c client.Client, obj client.Object, r reconcile.Reconciler) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		if !Enabled() {
+			return r.Reconcile(ctx, req)
+		}
+		owner := &Owner{Kind: fmt.Sprintf("%T", obj), Namespace: req.Namespace, Name: req.Name}
+		if gvk, err := apiutil.GVKForObject(obj, c.Scheme()); err == nil {
+			owner.Kind = gvk.Kind
+		}
+		current := obj.DeepCopyObject().(client.Object)
+		if err := c.Get(ctx, req.NamespacedName, current); err == nil {
+			owner.UID = string(current.GetUID())
+		}
+		return r.Reconcile(WithOwner(ctx, owner), req)
+	})
+}
@@ -29,10 +29,12 @@ const (
 	LicenseInterval = 86400
 	// LicenseIntervalForDFW is the timeout for checking license status while no DFW license enabled
 	LicenseIntervalForDFW = 1800
-	defaultWebhookPort    = 9981
-	WebhookCertDir        = "/etc/nsx-operator/webhook-certs"
-	EASCertFile           = "eas.crt"
-	EASKeyFile            = "eas.key"
+	// LicenseIntervalForFailure is the timeout for checking license status again after the check failed
+	LicenseIntervalForFailure = 60
+	defaultWebhookPort        = 9981
+	WebhookCertDir            = "/etc/nsx-operator/webhook-certs"
+	EASCertFile               = "eas.crt"
+	EASKeyFile                = "eas.key"
 )
 
 var (
@@ -52,6 +54,11 @@ type NSXOperatorConfig struct {
 	*K8sConfig
 	*VCConfig
 	*HAConfig
+	*TracingConfig
+	*AuditConfig
+	*CertConfig
+	// Controllers is loaded from the [controllers] section.
+	Controllers *ControllersConfig `ini:"-"`
 	configCache configCache
 	LibMode     bool
 }
@@ -129,7 +136,10 @@ type NsxConfig struct {
 	NSXLBSize                 string   `ini:"service_size"`
 	InventoryBatchPeriod      int      `ini:"inventory_batch_period"`
 	InventoryBatchSize        int      `ini:"inventory_batch_size"`
-	EnableInventory           bool     `ini:"enable_inventory"`
+	// InventoryResyncPeriod is the interval in seconds to compare the inventory objects with NSX and push the
+	// changed ones again, 0 disables the full resync.
+	InventoryResyncPeriod int  `ini:"inventory_resync_period"`
+	EnableInventory       bool `ini:"enable_inventory"`
 	// VpcWcpEnhance controls StatefulSet pod SubnetPort behavior together with NSX version.
 	// When omitted (nil), treated as false; only an explicit true enables the enhancement path.
 	VpcWcpEnhance *bool `ini:"vpc_wcp_enhance"`
@@ -137,6 +147,12 @@ type NsxConfig struct {
 	RestoreVif *bool `ini:"restore_vif"`
 	// TnIdCheckInterval is the interval in seconds to check TN ID for node.
 	TnIdCheckInterval int `ini:"tn_id_check_interval"`
+	// DriftScanInterval is the interval in seconds to compare the operator-owned NSX resources with NSX, the drift
+	// detection is disabled by default with 0.
+	DriftScanInterval int `ini:"drift_scan_interval"`
+	// DriftRepairResourceTypes lists the NSX resource types, e.g. VpcSubnet, whose drift is repaired by reconciling
+	// the owning CRs again. The drift of the other resource types is only reported.
+	DriftRepairResourceTypes []string `ini:"drift_repair_resource_types"`
 }
 
 type K8sConfig struct {
@@ -187,6 +203,68 @@ type VCConfig struct {
 
 type HAConfig struct {
 	EnableHA *bool `ini:"enable"`
+	// StandbyStoreRefreshInterval is the interval in seconds to query NSX again for the stores built on a standby
+	// replica, 0 disables the refresh.
+	StandbyStoreRefreshInterval int `ini:"standby_store_refresh_interval"`
+	// ShardCount is the number of shards the namespaces of the sharded controllers are distributed in, each shard is
+	// processed by the replica holding its Lease. 0 disables the sharding, and all the controllers run on the leader.
+	ShardCount int `ini:"shard_count"`
+	// ShardLeaseDuration is the duration in seconds of the shard Leases.
+	ShardLeaseDuration int `ini:"shard_lease_duration"`
+	// ShardStoreUpdateInterval is the interval in seconds to update the NSX stores with the changes made by the other
+	// replicas in the sharding mode.
+	ShardStoreUpdateInterval int `ini:"shard_store_update_interval"`
+	// ShardStoreSyncInterval is the interval in seconds to query NSX again for the NSX stores in the sharding mode, to
+	// remove the resources deleted by the other replicas, which are not found by the updates.
+	ShardStoreSyncInterval int `ini:"shard_store_sync_interval"`
+}
+
+// ShardingEnabled returns true if the namespaced controllers are sharded across the replicas.
+func (operatorConfig *NSXOperatorConfig) ShardingEnabled() bool {
+	return operatorConfig.HAConfig != nil && operatorConfig.ShardCount > 0 && operatorConfig.HAEnabled()
+}
+
+const (
+	TracingExporterOTLP = "otlp"
+	TracingExporterFile = "file"
+)
+
+// TracingConfig is loaded from the [tracing] section. The spans are exported with OTLP over gRPC to TracingEndpoint,
+// or written as JSON to TracingFile, which works without a collector.
+type TracingConfig struct {
+	EnableTracing      bool    `ini:"enable"`
+	TracingExporter    string  `ini:"exporter"`
+	TracingEndpoint    string  `ini:"endpoint"`
+	TracingInsecure    bool    `ini:"insecure"`
+	TracingFile        string  `ini:"file"`
+	TracingSampleRatio float64 `ini:"sample_ratio"`
+}
+
+// AuditConfig is loaded from the [audit] section. The audit log is written to AuditFile, which is rotated when it
+// reaches AuditMaxSize megabytes, or to stdout if AuditFile is empty.
+type AuditConfig struct {
+	EnableAudit     bool   `ini:"enable"`
+	AuditFile       string `ini:"file"`
+	AuditMaxSize    int    `ini:"max_size"`
+	AuditMaxBackups int    `ini:"max_backups"`
+	AuditMaxAge     int    `ini:"max_age"`
+	AuditCompress   bool   `ini:"compress"`
+}
+
+const (
+	CertProviderSelfSigned = "self-signed"
+	CertProviderSecret     = "secret"
+	CertProviderCSR        = "csr"
+)
+
+// CertConfig is loaded from the [cert] section. It selects how the serving certificates of the webhook and EAS are
+// issued: generated and signed by a self-signed CA, read from the Secrets written by e.g. cert-manager, or signed by
+// CSRSignerName through the Kubernetes CSR API. The certificates are renewed CertRenewBefore hours before they expire.
+type CertConfig struct {
+	CertProvider    string `ini:"provider"`
+	CSRSignerName   string `ini:"csr_signer_name"`
+	CABundleFile    string `ini:"ca_bundle_file"`
+	CertRenewBefore int    `ini:"renew_before"`
 }
 
 type Validate interface {
@@ -243,6 +321,22 @@ func LoadConfigFromFile() (*NSXOperatorConfig, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = cfg.Section("tracing").MapTo(nsxOperatorConfig.TracingConfig)
+	if err != nil {
+		return nil, err
+	}
+	err = cfg.Section("audit").MapTo(nsxOperatorConfig.AuditConfig)
+	if err != nil {
+		return nil, err
+	}
+	err = cfg.Section("cert").MapTo(nsxOperatorConfig.CertConfig)
+	if err != nil {
+		return nil, err
+	}
+	err = nsxOperatorConfig.Controllers.load(cfg.Section("controllers"))
+	if err != nil {
+		return nil, err
+	}
 
 	if err := nsxOperatorConfig.validate(); err != nil {
 		return nil, err
@@ -265,13 +359,18 @@ func NewNSXOpertorConfig() *NSXOperatorConfig {
 		&DefaultConfig{},
 		&CoeConfig{EnableSha: true},
 		&NsxConfig{
-			InventoryBatchPeriod: 5,
-			InventoryBatchSize:   50,
-			TnIdCheckInterval:    300,
+			InventoryBatchPeriod:  5,
+			InventoryBatchSize:    50,
+			InventoryResyncPeriod: 3600,
+			TnIdCheckInterval:     300,
 		},
 		&K8sConfig{},
 		&VCConfig{},
-		&HAConfig{},
+		&HAConfig{StandbyStoreRefreshInterval: 600, ShardLeaseDuration: 15, ShardStoreUpdateInterval: 60, ShardStoreSyncInterval: 600},
+		&TracingConfig{TracingExporter: TracingExporterOTLP, TracingSampleRatio: 1},
+		&AuditConfig{AuditMaxSize: 100, AuditMaxBackups: 10},
+		&CertConfig{CertProvider: CertProviderSelfSigned, CertRenewBefore: 720},
+		NewControllersConfig(),
 		configCache{},
 		false,
 	}
@@ -285,6 +384,21 @@ func (operatorConfig *NSXOperatorConfig) validate() error {
 	if err := operatorConfig.NsxConfig.validate(operatorConfig.CoeConfig.EnableVPCNetwork); err != nil {
 		return err
 	}
+	if err := operatorConfig.TracingConfig.validate(); err != nil {
+		return err
+	}
+	if err := operatorConfig.AuditConfig.validate(); err != nil {
+		return err
+	}
+	if err := operatorConfig.CertConfig.validate(); err != nil {
+		return err
+	}
+	if err := operatorConfig.HAConfig.validate(); err != nil {
+		return err
+	}
+	if err := operatorConfig.Controllers.validate(); err != nil {
+		return err
+	}
 	// TODO, verify if user&pwd, cert, jwt has any of them provided
 	return nil
 }
@@ -452,6 +566,16 @@ func (nsxConfig *NsxConfig) RestoreVifEnabled() bool {
 	return *nsxConfig.RestoreVif
 }
 
+// DriftRepairEnabled reports whether the drift of the NSX resource type is repaired, it is only reported by default.
+func (nsxConfig *NsxConfig) DriftRepairEnabled(resourceType string) bool {
+	for _, t := range nsxConfig.DriftRepairResourceTypes {
+		if strings.EqualFold(strings.TrimSpace(t), resourceType) {
+			return true
+		}
+	}
+	return false
+}
+
 func (nsxConfig *NsxConfig) validate(enableVPC bool) error {
 	nsxConfig.NsxApiManagers = removeEmptyItem(nsxConfig.NsxApiManagers)
 	mCount := len(nsxConfig.NsxApiManagers)
@@ -466,6 +590,83 @@ func (nsxConfig *NsxConfig) validate(enableVPC bool) error {
 	return nil
 }
 
+func (tracingConfig *TracingConfig) validate() error {
+	if tracingConfig == nil || !tracingConfig.EnableTracing {
+		return nil
+	}
+	switch tracingConfig.TracingExporter {
+	case TracingExporterOTLP:
+		if tracingConfig.TracingEndpoint == "" {
+			return errors.New("endpoint is required in section [tracing] for the otlp exporter")
+		}
+	case TracingExporterFile:
+		if tracingConfig.TracingFile == "" {
+			return errors.New("file is required in section [tracing] for the file exporter")
+		}
+	default:
+		return fmt.Errorf("invalid exporter %q in section [tracing], it should be %s or %s", tracingConfig.TracingExporter, TracingExporterOTLP, TracingExporterFile)
+	}
+	if tracingConfig.TracingSampleRatio <= 0 || tracingConfig.TracingSampleRatio > 1 {
+		return fmt.Errorf("invalid sample_ratio %v in section [tracing], it should be greater than 0 and not greater than 1", tracingConfig.TracingSampleRatio)
+	}
+	return nil
+}
+
+func (auditConfig *AuditConfig) validate() error {
+	if auditConfig == nil || !auditConfig.EnableAudit {
+		return nil
+	}
+	if auditConfig.AuditMaxSize <= 0 {
+		return fmt.Errorf("invalid max_size %d in section [audit], it should be greater than 0", auditConfig.AuditMaxSize)
+	}
+	if auditConfig.AuditMaxBackups < 0 || auditConfig.AuditMaxAge < 0 {
+		return fmt.Errorf("invalid max_backups %d or max_age %d in section [audit], they should not be negative", auditConfig.AuditMaxBackups, auditConfig.AuditMaxAge)
+	}
+	return nil
+}
+
+func (certConfig *CertConfig) validate() error {
+	if certConfig == nil {
+		return nil
+	}
+	switch certConfig.CertProvider {
+	case CertProviderSelfSigned, CertProviderSecret:
+	case CertProviderCSR:
+		if certConfig.CSRSignerName == "" {
+			return errors.New("csr_signer_name is required in section [cert] for the csr provider")
+		}
+	default:
+		return fmt.Errorf("invalid provider %q in section [cert], it should be %s, %s or %s", certConfig.CertProvider, CertProviderSelfSigned, CertProviderSecret, CertProviderCSR)
+	}
+	if certConfig.CertRenewBefore <= 0 {
+		return fmt.Errorf("invalid renew_before %d in section [cert], it should be greater than 0", certConfig.CertRenewBefore)
+	}
+	return nil
+}
+
+func (haConfig *HAConfig) validate() error {
+	if haConfig == nil || haConfig.ShardCount == 0 {
+		return nil
+	}
+	if haConfig.ShardCount < 0 {
+		return fmt.Errorf("invalid shard_count %d in section [ha], it should not be negative", haConfig.ShardCount)
+	}
+	if haConfig.EnableHA != nil && !*haConfig.EnableHA {
+		return errors.New("shard_count in section [ha] requires HA to be enabled")
+	}
+	// The shard Leases are renewed every 2 seconds, and must be renewed within 2/3 of the lease duration.
+	if haConfig.ShardLeaseDuration < 6 {
+		return fmt.Errorf("invalid shard_lease_duration %d in section [ha], it should be at least 6", haConfig.ShardLeaseDuration)
+	}
+	if haConfig.ShardStoreUpdateInterval <= 0 {
+		return fmt.Errorf("invalid shard_store_update_interval %d in section [ha], it should be greater than 0", haConfig.ShardStoreUpdateInterval)
+	}
+	if haConfig.ShardStoreSyncInterval < haConfig.ShardStoreUpdateInterval {
+		return fmt.Errorf("invalid shard_store_sync_interval %d in section [ha], it should not be less than shard_store_update_interval", haConfig.ShardStoreSyncInterval)
+	}
+	return nil
+}
+
 func (coeConfig *CoeConfig) validate() error {
 	if len(coeConfig.Cluster) == 0 {
 		err := errors.New("invalid field " + "Cluster")
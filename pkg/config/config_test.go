@@ -57,6 +57,86 @@ func TestConfig_CoeConfig(t *testing.T) {
 
 }
 
+func TestConfig_TracingConfig(t *testing.T) {
+	tracingConfig := &TracingConfig{TracingExporter: TracingExporterOTLP, TracingSampleRatio: 1}
+	assert.NoError(t, tracingConfig.validate())
+
+	tracingConfig.EnableTracing = true
+	assert.ErrorContains(t, tracingConfig.validate(), "endpoint is required")
+	tracingConfig.TracingEndpoint = "otel-collector:4317"
+	assert.NoError(t, tracingConfig.validate())
+
+	tracingConfig.TracingExporter = TracingExporterFile
+	assert.ErrorContains(t, tracingConfig.validate(), "file is required")
+	tracingConfig.TracingFile = "/var/log/nsx-operator/traces.json"
+	assert.NoError(t, tracingConfig.validate())
+
+	tracingConfig.TracingSampleRatio = 0
+	assert.ErrorContains(t, tracingConfig.validate(), "invalid sample_ratio")
+
+	tracingConfig.TracingExporter = "jaeger"
+	assert.ErrorContains(t, tracingConfig.validate(), "invalid exporter")
+}
+
+func TestConfig_AuditConfig(t *testing.T) {
+	auditConfig := &AuditConfig{}
+	assert.NoError(t, auditConfig.validate())
+
+	auditConfig.EnableAudit = true
+	assert.ErrorContains(t, auditConfig.validate(), "invalid max_size")
+	auditConfig.AuditMaxSize = 100
+	assert.NoError(t, auditConfig.validate())
+
+	auditConfig.AuditMaxBackups = -1
+	assert.ErrorContains(t, auditConfig.validate(), "invalid max_backups")
+}
+
+func TestConfig_CertConfig(t *testing.T) {
+	certConfig := &CertConfig{CertProvider: CertProviderSelfSigned, CertRenewBefore: 720}
+	assert.NoError(t, certConfig.validate())
+
+	certConfig.CertProvider = CertProviderCSR
+	assert.ErrorContains(t, certConfig.validate(), "csr_signer_name is required")
+	certConfig.CSRSignerName = "example.com/corp-ca"
+	assert.NoError(t, certConfig.validate())
+
+	certConfig.CertRenewBefore = 0
+	assert.ErrorContains(t, certConfig.validate(), "invalid renew_before")
+
+	certConfig.CertProvider = "vault"
+	assert.ErrorContains(t, certConfig.validate(), "invalid provider")
+}
+
+func TestConfig_HAConfig(t *testing.T) {
+	haConfig := &HAConfig{ShardLeaseDuration: 15, ShardStoreUpdateInterval: 60, ShardStoreSyncInterval: 600}
+	assert.NoError(t, haConfig.validate())
+
+	haConfig.ShardCount = -1
+	assert.ErrorContains(t, haConfig.validate(), "invalid shard_count")
+	haConfig.ShardCount = 8
+	assert.NoError(t, haConfig.validate())
+
+	haConfig.ShardLeaseDuration = 1
+	assert.ErrorContains(t, haConfig.validate(), "invalid shard_lease_duration")
+	haConfig.ShardLeaseDuration = 15
+
+	haConfig.ShardStoreUpdateInterval = 0
+	assert.ErrorContains(t, haConfig.validate(), "invalid shard_store_update_interval")
+	haConfig.ShardStoreUpdateInterval = 60
+
+	haConfig.ShardStoreSyncInterval = 30
+	assert.ErrorContains(t, haConfig.validate(), "invalid shard_store_sync_interval")
+	haConfig.ShardStoreSyncInterval = 600
+
+	enableHA := false
+	haConfig.EnableHA = &enableHA
+	assert.ErrorContains(t, haConfig.validate(), "requires HA to be enabled")
+	operatorConfig := &NSXOperatorConfig{HAConfig: haConfig}
+	assert.False(t, operatorConfig.ShardingEnabled())
+	enableHA = true
+	assert.True(t, operatorConfig.ShardingEnabled())
+}
+
 func TestConfig_NsxConfig(t *testing.T) {
 	nsxConfig := &NsxConfig{}
 	expect := errors.New("invalid field " + "NsxApiManagers")
@@ -207,6 +287,14 @@ func TestNsxConfig_RestoreVifEnabled(t *testing.T) {
 	assert.False(t, (&NsxConfig{RestoreVif: &f}).RestoreVifEnabled())
 }
 
+func TestNsxConfig_DriftRepairEnabled(t *testing.T) {
+	assert.False(t, (&NsxConfig{}).DriftRepairEnabled("VpcSubnet"))
+	nsxConfig := &NsxConfig{DriftRepairResourceTypes: []string{"VpcSubnet", " Rule"}}
+	assert.True(t, nsxConfig.DriftRepairEnabled("VpcSubnet"))
+	assert.True(t, nsxConfig.DriftRepairEnabled("Rule"))
+	assert.False(t, nsxConfig.DriftRepairEnabled("Group"))
+}
+
 func TestNsxConfig_GetServiceSize(t *testing.T) {
 	type fields struct {
 		ServiceSize string
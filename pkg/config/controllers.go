@@ -0,0 +1,184 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"gopkg.in/ini.v1"
+)
+
+const (
+	settingMaxConcurrentReconciles = "max_concurrent_reconciles"
+	settingBaseBackoff             = "base_backoff"
+	settingMaxBackoff              = "max_backoff"
+	settingQPS                     = "qps"
+	settingBurst                   = "burst"
+	settingLowPriorityMaxWait      = "low_priority_max_wait"
+)
+
+// minLowPriorityMaxWait is the minimum of low_priority_max_wait, the low priority requests are checked for promotion
+// every quarter of it.
+const minLowPriorityMaxWait = time.Second
+
+// ControllerNames are the names of the controllers which can be configured in the [controllers] section, they are
+// the same as the res_type label of the controller metrics.
+var ControllerNames = []string{
+	"adminnetworkpolicy", "baselineadminnetworkpolicy", "gateway", "ingress", "ipaddressallocation", "namespace",
+	"networkinfo", "networkpolicy", "nsxserviceaccount", "pod", "securitypolicy", "servicelb", "statefulset",
+	"staticroute", "subnet", "subnetconnectionbindingmap", "subnetipreservation", "subnetport", "subnetset",
+}
+
+// DefaultControllerConfig is the same as the defaults of the controller-runtime workqueue rate limiter, with 8
+// concurrent reconciles.
+var DefaultControllerConfig = ControllerConfig{
+	MaxConcurrentReconciles: 8,
+	BaseBackoff:             5 * time.Millisecond,
+	MaxBackoff:              1000 * time.Second,
+	QPS:                     10,
+	Burst:                   100,
+	LowPriorityMaxWait:      time.Minute,
+}
+
+// ControllerConfig is the concurrency and the workqueue rate limiter of a controller. The requeue delay of a failed
+// request grows exponentially from BaseBackoff to MaxBackoff, and the overall requeue rate is limited by a token
+// bucket with QPS and Burst. A low priority request, e.g. from the initial list or a periodic resync, is raised to
+// the high priority once it has been ready for LowPriorityMaxWait, so it is not starved by the user changes.
+type ControllerConfig struct {
+	MaxConcurrentReconciles int
+	BaseBackoff             time.Duration
+	MaxBackoff              time.Duration
+	QPS                     float64
+	Burst                   int
+	LowPriorityMaxWait      time.Duration
+}
+
+// ControllersConfig is loaded from the [controllers] section. The keys without a prefix, e.g. max_concurrent_reconciles,
+// apply to all the controllers, and the keys prefixed with the controller name, e.g. pod.max_concurrent_reconciles,
+// apply to that controller only.
+type ControllersConfig struct {
+	Default   ControllerConfig
+	overrides map[string]ControllerConfig
+}
+
+func NewControllersConfig() *ControllersConfig {
+	return &ControllersConfig{Default: DefaultControllerConfig, overrides: map[string]ControllerConfig{}}
+}
+
+// Get returns the configuration of the controller.
+func (c *ControllersConfig) Get(name string) ControllerConfig {
+	if c == nil {
+		return DefaultControllerConfig
+	}
+	if controllerConfig, ok := c.overrides[name]; ok {
+		return controllerConfig
+	}
+	return c.Default
+}
+
+// ControllerConfig returns the configuration of the controller, or the default one if the [controllers] section is
+// not loaded.
+func (operatorConfig *NSXOperatorConfig) ControllerConfig(name string) ControllerConfig {
+	if operatorConfig == nil {
+		return DefaultControllerConfig
+	}
+	return operatorConfig.Controllers.Get(name)
+}
+
+func (c *ControllersConfig) load(section *ini.Section) error {
+	// Load the keys applying to all the controllers first, they are inherited by the controller keys.
+	for _, key := range section.Keys() {
+		if !strings.Contains(key.Name(), ".") {
+			if err := setControllerConfig(&c.Default, key); err != nil {
+				return err
+			}
+		}
+	}
+	for _, key := range section.Keys() {
+		name, _, found := strings.Cut(key.Name(), ".")
+		if !found {
+			continue
+		}
+		if !isControllerName(name) {
+			return fmt.Errorf("unknown controller %q in key %s of section [controllers], valid controllers: %s", name, key.Name(), strings.Join(ControllerNames, ", "))
+		}
+		controllerConfig, ok := c.overrides[name]
+		if !ok {
+			controllerConfig = c.Default
+		}
+		if err := setControllerConfig(&controllerConfig, key); err != nil {
+			return err
+		}
+		c.overrides[name] = controllerConfig
+	}
+	return nil
+}
+
+func setControllerConfig(controllerConfig *ControllerConfig, key *ini.Key) error {
+	var err error
+	setting := key.Name()
+	if _, s, found := strings.Cut(setting, "."); found {
+		setting = s
+	}
+	switch setting {
+	case settingMaxConcurrentReconciles:
+		controllerConfig.MaxConcurrentReconciles, err = key.Int()
+	case settingBaseBackoff:
+		controllerConfig.BaseBackoff, err = key.Duration()
+	case settingMaxBackoff:
+		controllerConfig.MaxBackoff, err = key.Duration()
+	case settingQPS:
+		controllerConfig.QPS, err = key.Float64()
+	case settingBurst:
+		controllerConfig.Burst, err = key.Int()
+	case settingLowPriorityMaxWait:
+		controllerConfig.LowPriorityMaxWait, err = key.Duration()
+	default:
+		return fmt.Errorf("unknown key %s in section [controllers]", key.Name())
+	}
+	if err != nil {
+		return fmt.Errorf("invalid value %q of key %s in section [controllers]: %w", key.Value(), key.Name(), err)
+	}
+	return nil
+}
+
+func isControllerName(name string) bool {
+	for _, controllerName := range ControllerNames {
+		if name == controllerName {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *ControllersConfig) validate() error {
+	if c == nil {
+		return nil
+	}
+	if err := c.Default.validate("controllers"); err != nil {
+		return err
+	}
+	for name, controllerConfig := range c.overrides {
+		if err := controllerConfig.validate(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *ControllerConfig) validate(name string) error {
+	if c.MaxConcurrentReconciles <= 0 {
+		return fmt.Errorf("invalid %s of %s: %d, it should be greater than 0", settingMaxConcurrentReconciles, name, c.MaxConcurrentReconciles)
+	}
+	if c.BaseBackoff <= 0 || c.MaxBackoff < c.BaseBackoff {
+		return fmt.Errorf("invalid %s %s and %s %s of %s, %s should be greater than 0 and not greater than %s",
+			settingBaseBackoff, c.BaseBackoff, settingMaxBackoff, c.MaxBackoff, name, settingBaseBackoff, settingMaxBackoff)
+	}
+	if c.QPS <= 0 || c.Burst <= 0 {
+		return fmt.Errorf("invalid %s %v and %s %d of %s, they should be greater than 0", settingQPS, c.QPS, settingBurst, c.Burst, name)
+	}
+	if c.LowPriorityMaxWait < minLowPriorityMaxWait {
+		return fmt.Errorf("invalid %s of %s: %s, it should not be less than %s", settingLowPriorityMaxWait, name, c.LowPriorityMaxWait, minLowPriorityMaxWait)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/ini.v1"
+)
+
+func TestControllersConfig_Load(t *testing.T) {
+	loadSection := func(content string) (*ControllersConfig, error) {
+		cfg, err := ini.Load([]byte(content))
+		require.NoError(t, err)
+		controllersConfig := NewControllersConfig()
+		if err := controllersConfig.load(cfg.Section("controllers")); err != nil {
+			return nil, err
+		}
+		return controllersConfig, controllersConfig.validate()
+	}
+
+	controllersConfig, err := loadSection(`
+[controllers]
+max_backoff = 300s
+pod.max_concurrent_reconciles = 32
+pod.qps = 50
+pod.low_priority_max_wait = 30s
+namespace.max_concurrent_reconciles = 2
+`)
+	require.NoError(t, err)
+	podConfig := DefaultControllerConfig
+	podConfig.MaxConcurrentReconciles, podConfig.QPS, podConfig.MaxBackoff = 32, 50, 300*time.Second
+	podConfig.LowPriorityMaxWait = 30 * time.Second
+	assert.Equal(t, podConfig, controllersConfig.Get("pod"))
+	assert.Equal(t, 2, controllersConfig.Get("namespace").MaxConcurrentReconciles)
+	assert.Equal(t, 300*time.Second, controllersConfig.Get("namespace").MaxBackoff)
+	assert.Equal(t, 8, controllersConfig.Get("subnet").MaxConcurrentReconciles)
+
+	// The default is returned if the section is not loaded.
+	assert.Equal(t, DefaultControllerConfig, (&NSXOperatorConfig{}).ControllerConfig("pod"))
+
+	for name, content := range map[string]string{
+		"UnknownController": "[controllers]\nfoo.max_concurrent_reconciles = 1",
+		"UnknownKey":        "[controllers]\npod.workers = 1",
+		"InvalidValue":      "[controllers]\nbase_backoff = 5",
+		"ZeroConcurrency":   "[controllers]\nsubnet.max_concurrent_reconciles = 0",
+		"BackoffOrder":      "[controllers]\nbase_backoff = 10s\nmax_backoff = 1s",
+		"ZeroBurst":         "[controllers]\nburst = 0",
+		"ZeroMaxWait":       "[controllers]\nlow_priority_max_wait = 0s",
+		"TinyMaxWait":       "[controllers]\npod.low_priority_max_wait = 1ns",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := loadSection(content)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,416 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package adminnetworkpolicy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	policyv1alpha1 "sigs.k8s.io/network-policy-api/apis/v1alpha1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/audit"
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/securitypolicy"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
+)
+
+const (
+	conditionTypeReady = "Ready"
+
+	reasonRealized         = "Realized"
+	reasonRealizationError = "RealizationError"
+	reasonValidationError  = "ValidationError"
+	reasonNoDFWLicense     = "NoDFWLicense"
+	reasonVPCNotReady      = "VPCNotReady"
+)
+
+var (
+	log           = logger.Log
+	ResultNormal  = common.ResultNormal
+	ResultRequeue = common.ResultRequeue
+)
+
+// AdminNetworkPolicyReconciler reconciles AdminNetworkPolicy or BaselineAdminNetworkPolicy objects, the kind is
+// decided by the constructor.
+type AdminNetworkPolicyReconciler struct {
+	Client        client.Client
+	Scheme        *apimachineryruntime.Scheme
+	Service       *securitypolicy.SecurityPolicyService
+	Recorder      record.EventRecorder
+	StatusUpdater common.StatusUpdater
+
+	// createdFor is either ResourceTypeAdminNetworkPolicy or ResourceTypeBaselineAdminNetworkPolicy.
+	createdFor      string
+	discoveryClient discovery.DiscoveryInterface
+}
+
+func (r *AdminNetworkPolicyReconciler) newObject() client.Object {
+	if r.createdFor == servicecommon.ResourceTypeBaselineAdminNetworkPolicy {
+		return &policyv1alpha1.BaselineAdminNetworkPolicy{}
+	}
+	return &policyv1alpha1.AdminNetworkPolicy{}
+}
+
+// listObjects lists all the AdminNetworkPolicies or BaselineAdminNetworkPolicies.
+func (r *AdminNetworkPolicyReconciler) listObjects(ctx context.Context) ([]client.Object, error) {
+	var objs []client.Object
+	if r.createdFor == servicecommon.ResourceTypeBaselineAdminNetworkPolicy {
+		list := &policyv1alpha1.BaselineAdminNetworkPolicyList{}
+		if err := r.Client.List(ctx, list); err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			objs = append(objs, &list.Items[i])
+		}
+		return objs, nil
+	}
+	list := &policyv1alpha1.AdminNetworkPolicyList{}
+	if err := r.Client.List(ctx, list); err != nil {
+		return nil, err
+	}
+	for i := range list.Items {
+		objs = append(objs, &list.Items[i])
+	}
+	return objs, nil
+}
+
+func getSubject(obj client.Object) policyv1alpha1.AdminNetworkPolicySubject {
+	switch obj := obj.(type) {
+	case *policyv1alpha1.AdminNetworkPolicy:
+		return obj.Spec.Subject
+	case *policyv1alpha1.BaselineAdminNetworkPolicy:
+		return obj.Spec.Subject
+	}
+	return policyv1alpha1.AdminNetworkPolicySubject{}
+}
+
+func getConditions(obj client.Object) *[]metav1.Condition {
+	switch obj := obj.(type) {
+	case *policyv1alpha1.AdminNetworkPolicy:
+		return &obj.Status.Conditions
+	case *policyv1alpha1.BaselineAdminNetworkPolicy:
+		return &obj.Status.Conditions
+	}
+	return nil
+}
+
+// listSubjectNamespaces lists the Namespaces selected by the subject of the AdminNetworkPolicy or
+// BaselineAdminNetworkPolicy, the terminating Namespaces are excluded.
+func (r *AdminNetworkPolicyReconciler) listSubjectNamespaces(ctx context.Context, obj client.Object) ([]string, error) {
+	subject := getSubject(obj)
+	nsSelector := subject.Namespaces
+	if subject.Pods != nil {
+		nsSelector = &subject.Pods.NamespaceSelector
+	}
+	if nsSelector == nil {
+		return nil, &nsxutil.ValidationError{Desc: "subject must set either namespaces or pods"}
+	}
+	selector, err := metav1.LabelSelectorAsSelector(nsSelector)
+	if err != nil {
+		return nil, &nsxutil.ValidationError{Desc: fmt.Sprintf("invalid subject namespace selector: %v", err)}
+	}
+	nsList := &v1.NamespaceList{}
+	if err := r.Client.List(ctx, nsList, &client.ListOptions{LabelSelector: selector}); err != nil {
+		return nil, err
+	}
+	var namespaces []string
+	for _, ns := range nsList.Items {
+		if !ns.DeletionTimestamp.IsZero() {
+			continue
+		}
+		namespaces = append(namespaces, ns.Name)
+	}
+	return namespaces, nil
+}
+
+// +kubebuilder:rbac:groups=policy.networking.k8s.io,resources=adminnetworkpolicies;baselineadminnetworkpolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=policy.networking.k8s.io,resources=adminnetworkpolicies/status;baselineadminnetworkpolicies/status,verbs=get;update;patch
+func (r *AdminNetworkPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	obj := r.newObject()
+	log.Info(fmt.Sprintf("Reconciling %s", r.createdFor), "name", req.Name)
+	startTime := time.Now()
+	defer func() {
+		log.Info(fmt.Sprintf("Finished reconciling %s", r.createdFor), "name", req.Name, "duration(ms)", time.Since(startTime).Milliseconds())
+	}()
+
+	r.StatusUpdater.IncreaseSyncTotal()
+
+	if err := r.Client.Get(ctx, req.NamespacedName, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			// The UID of the deleted object is unknown, so the NSX resources are deleted by comparing with the existing objects.
+			if err := r.CollectGarbage(ctx); err != nil {
+				r.StatusUpdater.DeleteFail(req.NamespacedName, nil, err)
+				return ResultRequeue, err
+			}
+			r.StatusUpdater.DeleteSuccess(req.NamespacedName, nil)
+			return ResultNormal, nil
+		}
+		log.Error(err, fmt.Sprintf("Failed to fetch %s", r.createdFor), "req", req.NamespacedName)
+		return ResultRequeue, err
+	}
+
+	if !obj.GetDeletionTimestamp().IsZero() {
+		r.StatusUpdater.IncreaseDeleteTotal()
+		if err := r.Service.DeleteAdminNetworkPolicy(obj.GetUID(), false, r.createdFor); err != nil {
+			r.StatusUpdater.DeleteFail(req.NamespacedName, obj, err)
+			return ResultRequeue, err
+		}
+		r.StatusUpdater.DeleteSuccess(req.NamespacedName, obj)
+		return ResultNormal, nil
+	}
+
+	r.StatusUpdater.IncreaseUpdateTotal()
+	namespaces, err := r.listSubjectNamespaces(ctx, obj)
+	if err == nil {
+		err = r.Service.CreateOrUpdateAdminNetworkPolicy(obj, namespaces)
+	}
+	if err != nil {
+		// Without the DFW license, the policy is paused until the license is restored.
+		if errors.As(err, &nsxutil.RestrictionError{}) || nsxutil.IsInvalidLicense(err) {
+			r.StatusUpdater.UpdateFail(ctx, obj, err, "", setReadyConditionFalse, reasonNoDFWLicense)
+			return ResultNormal, nil
+		}
+		var validationErr *nsxutil.ValidationError
+		if errors.As(err, &validationErr) {
+			// Validation errors can only be fixed by updating the object, so there is no need to requeue.
+			r.StatusUpdater.UpdateFail(ctx, obj, err, "", setReadyConditionFalse, reasonValidationError)
+			return ResultNormal, nil
+		}
+		var vpcNotReadyErr *securitypolicy.NamespaceVPCNotReadyError
+		if errors.As(err, &vpcNotReadyErr) {
+			// The VPCs are created by the NetworkInfo controller without Namespace events, so retry until they are ready.
+			r.StatusUpdater.UpdateFail(ctx, obj, err, "", setReadyConditionFalse, reasonVPCNotReady)
+			return common.ResultRequeueAfter10sec, nil
+		}
+		r.StatusUpdater.UpdateFail(ctx, obj, err, "", setReadyConditionFalse, reasonRealizationError)
+		return ResultRequeue, err
+	}
+	r.StatusUpdater.UpdateSuccess(ctx, obj, setReadyConditionTrue, len(namespaces))
+	return ResultNormal, nil
+}
+
+func setReadyConditionTrue(c client.Client, ctx context.Context, obj client.Object, _ metav1.Time, args ...interface{}) {
+	message := "Realized in NSX"
+	if len(args) == 1 {
+		message = fmt.Sprintf("Realized in %d Namespaces in NSX", args[0])
+	}
+	updateReadyCondition(ctx, c, obj, metav1.Condition{
+		Type:    conditionTypeReady,
+		Status:  metav1.ConditionTrue,
+		Reason:  reasonRealized,
+		Message: message,
+	})
+}
+
+func setReadyConditionFalse(c client.Client, ctx context.Context, obj client.Object, _ metav1.Time, err error, args ...interface{}) {
+	reason := reasonRealizationError
+	if len(args) == 1 {
+		reason = args[0].(string)
+	}
+	updateReadyCondition(ctx, c, obj, metav1.Condition{
+		Type:    conditionTypeReady,
+		Status:  metav1.ConditionFalse,
+		Reason:  reason,
+		Message: err.Error(),
+	})
+}
+
+// updateReadyCondition sets the Ready condition in the status of the AdminNetworkPolicy or BaselineAdminNetworkPolicy,
+// the status is not updated if the condition is not changed.
+func updateReadyCondition(ctx context.Context, c client.Client, obj client.Object, cond metav1.Condition) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := obj.DeepCopyObject().(client.Object)
+		if err := c.Get(ctx, client.ObjectKeyFromObject(obj), latest); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		patch := client.MergeFromWithOptions(latest.DeepCopyObject().(client.Object), client.MergeFromWithOptimisticLock{})
+		cond.ObservedGeneration = latest.GetGeneration()
+		if !meta.SetStatusCondition(getConditions(latest), cond) {
+			return nil
+		}
+		return c.Status().Patch(ctx, latest, patch)
+	})
+	if err != nil {
+		log.Error(err, "Failed to update Ready condition", "name", obj.GetName())
+	}
+}
+
+// requeueAllPolicies enqueues all the AdminNetworkPolicies or BaselineAdminNetworkPolicies when a Namespace is
+// created, deleted or its labels are changed, as the subject Namespaces may be changed.
+func (r *AdminNetworkPolicyReconciler) requeueAllPolicies(ctx context.Context, _ client.Object) []reconcile.Request {
+	objs, err := r.listObjects(ctx)
+	if err != nil {
+		log.Error(err, fmt.Sprintf("Failed to list %s", r.createdFor))
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(objs))
+	for _, obj := range objs {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: obj.GetName()}})
+	}
+	return requests
+}
+
+// PredicateFuncsNs filters the Namespace events which may change the subject Namespaces.
+var PredicateFuncsNs = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool {
+		return true
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		return !labels.Equals(e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels())
+	},
+	DeleteFunc: func(e event.DeleteEvent) bool {
+		return true
+	},
+	GenericFunc: func(e event.GenericEvent) bool {
+		return false
+	},
+}
+
+func (r *AdminNetworkPolicyReconciler) setupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(r.newObject()).
+		Watches(
+			&v1.Namespace{},
+			handler.EnqueueRequestsFromMapFunc(r.requeueAllPolicies),
+			builder.WithPredicates(PredicateFuncsNs),
+		).
+		WatchesRawSource(common.DFWLicenseSource(r.StatusUpdater.MetricResType, r.listObjects)).
+		WithOptions(
+			controller.Options{
+				MaxConcurrentReconciles: common.NumReconcile(r.StatusUpdater.NSXConfig, r.StatusUpdater.MetricResType),
+				RateLimiter:             common.RateLimiter(r.StatusUpdater.NSXConfig, r.StatusUpdater.MetricResType),
+				NewQueue:                common.NewQueue(r.StatusUpdater.NSXConfig, r.StatusUpdater.MetricResType),
+			}).
+		Complete(common.LicensedReconciler(r.StatusUpdater.MetricResType, tracing.Reconciler(r.StatusUpdater.MetricResType, audit.Reconciler(r.Client, r.newObject(), r))))
+}
+
+// isCRDInstalled checks whether the AdminNetworkPolicy or BaselineAdminNetworkPolicy CRD is installed, the upstream
+// network-policy-api CRDs are not installed by default.
+func (r *AdminNetworkPolicyReconciler) isCRDInstalled(mgr ctrl.Manager) (bool, error) {
+	if r.discoveryClient == nil {
+		var err error
+		r.discoveryClient, err = discovery.NewDiscoveryClientForConfig(mgr.GetConfig())
+		if err != nil {
+			return false, err
+		}
+	}
+	resourceList, err := r.discoveryClient.ServerResourcesForGroupVersion(policyv1alpha1.GroupVersion.String())
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	resource := "adminnetworkpolicies"
+	if r.createdFor == servicecommon.ResourceTypeBaselineAdminNetworkPolicy {
+		resource = "baselineadminnetworkpolicies"
+	}
+	for _, res := range resourceList.APIResources {
+		if res.Name == resource {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *AdminNetworkPolicyReconciler) StartController(mgr ctrl.Manager, _ webhook.Server) error {
+	installed, err := r.isCRDInstalled(mgr)
+	if err != nil {
+		log.Error(err, "Failed to check CRD", "controller", r.createdFor)
+		return err
+	}
+	if !installed {
+		log.Info("CRD is not installed in the cluster, skipping controller start", "controller", r.createdFor)
+		return nil
+	}
+	if err := r.setupWithManager(mgr); err != nil {
+		log.Error(err, "Failed to create controller", "controller", r.createdFor)
+		return err
+	}
+	go common.GenericGarbageCollector(make(chan bool), servicecommon.GCInterval, r.CollectGarbage)
+	return nil
+}
+
+// CollectGarbage deletes the NSX resources of the AdminNetworkPolicies or BaselineAdminNetworkPolicies which have
+// been removed from K8s, it implements the interface GarbageCollector method.
+func (r *AdminNetworkPolicyReconciler) CollectGarbage(ctx context.Context) error {
+	nsxPolicySet := r.Service.ListAdminNetworkPolicyUID(r.createdFor)
+	if len(nsxPolicySet) == 0 {
+		return nil
+	}
+	objs, err := r.listObjects(ctx)
+	if err != nil {
+		log.Error(err, fmt.Sprintf("Failed to list %s", r.createdFor))
+		return err
+	}
+	crPolicySet := sets.New[string]()
+	for _, obj := range objs {
+		crPolicySet.Insert(string(obj.GetUID()))
+	}
+
+	var errList []error
+	for elem := range nsxPolicySet.Difference(crPolicySet) {
+		log.Debug(fmt.Sprintf("GC collected %s", r.createdFor), "UID", elem)
+		r.StatusUpdater.IncreaseDeleteTotal()
+		if err := r.Service.DeleteAdminNetworkPolicy(types.UID(elem), true, r.createdFor); err != nil {
+			errList = append(errList, err)
+			r.StatusUpdater.IncreaseDeleteFailTotal()
+		} else {
+			r.StatusUpdater.IncreaseDeleteSuccessTotal()
+		}
+	}
+	if len(errList) > 0 {
+		return fmt.Errorf("errors found in %s garbage collection: %s", r.createdFor, errList)
+	}
+	return nil
+}
+
+func (r *AdminNetworkPolicyReconciler) RestoreReconcile() error {
+	return nil
+}
+
+func newReconciler(mgr ctrl.Manager, commonService servicecommon.Service, vpcService servicecommon.VPCServiceProvider, createdFor, metricResType string) *AdminNetworkPolicyReconciler {
+	r := &AdminNetworkPolicyReconciler{
+		Client:     mgr.GetClient(),
+		Scheme:     mgr.GetScheme(),
+		Recorder:   mgr.GetEventRecorderFor(fmt.Sprintf("%s-controller", metricResType)), //nolint:staticcheck // record.EventRecorder; StatusUpdater not on events.EventRecorder yet
+		createdFor: createdFor,
+	}
+	r.Service = securitypolicy.GetSecurityService(commonService, vpcService)
+	r.StatusUpdater = common.NewStatusUpdater(r.Client, r.Service.NSXConfig, r.Recorder, metricResType, "SecurityPolicy", createdFor)
+	return r
+}
+
+func NewAdminNetworkPolicyReconciler(mgr ctrl.Manager, commonService servicecommon.Service, vpcService servicecommon.VPCServiceProvider) *AdminNetworkPolicyReconciler {
+	return newReconciler(mgr, commonService, vpcService, servicecommon.ResourceTypeAdminNetworkPolicy, common.MetricResTypeAdminNetworkPolicy)
+}
+
+func NewBaselineAdminNetworkPolicyReconciler(mgr ctrl.Manager, commonService servicecommon.Service, vpcService servicecommon.VPCServiceProvider) *AdminNetworkPolicyReconciler {
+	return newReconciler(mgr, commonService, vpcService, servicecommon.ResourceTypeBaselineAdminNetworkPolicy, common.MetricResTypeBaselineAdminNetworkPolicy)
+}
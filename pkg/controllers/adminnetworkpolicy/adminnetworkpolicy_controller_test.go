@@ -0,0 +1,292 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package adminnetworkpolicy
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	policyv1alpha1 "sigs.k8s.io/network-policy-api/apis/v1alpha1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	ctrcommon "github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/securitypolicy"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+type fakeRecorder struct{}
+
+func (recorder fakeRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+}
+
+func (recorder fakeRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+}
+
+func (recorder fakeRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+}
+
+func createFakeReconciler(createdFor string, objs ...client.Object) *AdminNetworkPolicyReconciler {
+	newScheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(newScheme))
+	utilruntime.Must(policyv1alpha1.Install(newScheme))
+	fakeClient := fake.NewClientBuilder().WithScheme(newScheme).WithObjects(objs...).
+		WithStatusSubresource(&policyv1alpha1.AdminNetworkPolicy{}, &policyv1alpha1.BaselineAdminNetworkPolicy{}).Build()
+
+	r := &AdminNetworkPolicyReconciler{
+		Client: fakeClient,
+		Scheme: newScheme,
+		Service: &securitypolicy.SecurityPolicyService{
+			Service: common.Service{
+				NSXConfig: &config.NSXOperatorConfig{
+					CoeConfig: &config.CoeConfig{
+						Cluster:          "k8scl-one:test",
+						EnableVPCNetwork: true,
+					},
+				},
+			},
+		},
+		Recorder:   fakeRecorder{},
+		createdFor: createdFor,
+	}
+	r.StatusUpdater = ctrcommon.NewStatusUpdater(r.Client, r.Service.NSXConfig, r.Recorder, ctrcommon.MetricResTypeAdminNetworkPolicy, "SecurityPolicy", createdFor)
+	return r
+}
+
+func TestAdminNetworkPolicyReconciler_Reconcile(t *testing.T) {
+	anpName := "anp1"
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: anpName}}
+	namespaces := []client.Object{
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1", Labels: map[string]string{"team": "a"}}},
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns2", Labels: map[string]string{"team": "a"}}},
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns3", Labels: map[string]string{"team": "b"}}},
+	}
+	newANP := func(deleting bool) *policyv1alpha1.AdminNetworkPolicy {
+		anp := &policyv1alpha1.AdminNetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: anpName, UID: "uidANP", Generation: 2},
+			Spec: policyv1alpha1.AdminNetworkPolicySpec{
+				Priority: 10,
+				Subject: policyv1alpha1.AdminNetworkPolicySubject{
+					Namespaces: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+				},
+			},
+		}
+		if deleting {
+			anp.Finalizers = []string{"test-Finalizers"}
+			anp.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		}
+		return anp
+	}
+
+	testCases := []struct {
+		name            string
+		existingANP     *policyv1alpha1.AdminNetworkPolicy
+		patches         func(r *AdminNetworkPolicyReconciler) *gomonkey.Patches
+		expectRes       ctrl.Result
+		expectErrStr    string
+		expectCondition *metav1.Condition
+	}{
+		{
+			name: "AdminNetworkPolicy not found",
+			patches: func(r *AdminNetworkPolicyReconciler) *gomonkey.Patches {
+				patches := gomonkey.ApplyMethod(reflect.TypeOf(r.Service), "ListAdminNetworkPolicyUID", func(_ *securitypolicy.SecurityPolicyService, _ string) sets.Set[string] {
+					return sets.New[string]("uidANP")
+				})
+				patches.ApplyMethod(reflect.TypeOf(r.Service), "DeleteAdminNetworkPolicy", func(_ *securitypolicy.SecurityPolicyService, uid types.UID, isGC bool, createdFor string) error {
+					assert.Equal(t, types.UID("uidANP"), uid)
+					return nil
+				})
+				return patches
+			},
+			expectRes: ResultNormal,
+		},
+		{
+			name:        "AdminNetworkPolicy deleting",
+			existingANP: newANP(true),
+			patches: func(r *AdminNetworkPolicyReconciler) *gomonkey.Patches {
+				return gomonkey.ApplyMethod(reflect.TypeOf(r.Service), "DeleteAdminNetworkPolicy", func(_ *securitypolicy.SecurityPolicyService, uid types.UID, isGC bool, createdFor string) error {
+					return errors.New("delete failed")
+				})
+			},
+			expectRes:    ResultRequeue,
+			expectErrStr: "delete failed",
+		},
+		{
+			name:        "AdminNetworkPolicy realized",
+			existingANP: newANP(false),
+			patches: func(r *AdminNetworkPolicyReconciler) *gomonkey.Patches {
+				return gomonkey.ApplyMethod(reflect.TypeOf(r.Service), "CreateOrUpdateAdminNetworkPolicy", func(_ *securitypolicy.SecurityPolicyService, obj client.Object, namespaces []string) error {
+					assert.ElementsMatch(t, []string{"ns1", "ns2"}, namespaces)
+					return nil
+				})
+			},
+			expectRes: ResultNormal,
+			expectCondition: &metav1.Condition{
+				Type:               conditionTypeReady,
+				Status:             metav1.ConditionTrue,
+				Reason:             reasonRealized,
+				ObservedGeneration: 2,
+			},
+		},
+		{
+			name:        "AdminNetworkPolicy validation error",
+			existingANP: newANP(false),
+			patches: func(r *AdminNetworkPolicyReconciler) *gomonkey.Patches {
+				return gomonkey.ApplyMethod(reflect.TypeOf(r.Service), "CreateOrUpdateAdminNetworkPolicy", func(_ *securitypolicy.SecurityPolicyService, obj client.Object, namespaces []string) error {
+					return &nsxutil.ValidationError{Desc: "unsupported peer"}
+				})
+			},
+			expectRes: ResultNormal,
+			expectCondition: &metav1.Condition{
+				Type:               conditionTypeReady,
+				Status:             metav1.ConditionFalse,
+				Reason:             reasonValidationError,
+				ObservedGeneration: 2,
+			},
+		},
+		{
+			name:        "AdminNetworkPolicy Namespace VPC not ready",
+			existingANP: newANP(false),
+			patches: func(r *AdminNetworkPolicyReconciler) *gomonkey.Patches {
+				return gomonkey.ApplyMethod(reflect.TypeOf(r.Service), "CreateOrUpdateAdminNetworkPolicy", func(_ *securitypolicy.SecurityPolicyService, obj client.Object, namespaces []string) error {
+					return &securitypolicy.NamespaceVPCNotReadyError{Namespaces: []string{"ns2"}}
+				})
+			},
+			expectRes: ctrcommon.ResultRequeueAfter10sec,
+			expectCondition: &metav1.Condition{
+				Type:               conditionTypeReady,
+				Status:             metav1.ConditionFalse,
+				Reason:             reasonVPCNotReady,
+				ObservedGeneration: 2,
+			},
+		},
+		{
+			name:        "AdminNetworkPolicy realization error",
+			existingANP: newANP(false),
+			patches: func(r *AdminNetworkPolicyReconciler) *gomonkey.Patches {
+				return gomonkey.ApplyMethod(reflect.TypeOf(r.Service), "CreateOrUpdateAdminNetworkPolicy", func(_ *securitypolicy.SecurityPolicyService, obj client.Object, namespaces []string) error {
+					return errors.New("nsx error")
+				})
+			},
+			expectRes:    ResultRequeue,
+			expectErrStr: "nsx error",
+			expectCondition: &metav1.Condition{
+				Type:               conditionTypeReady,
+				Status:             metav1.ConditionFalse,
+				Reason:             reasonRealizationError,
+				ObservedGeneration: 2,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			objs := append([]client.Object{}, namespaces...)
+			if tc.existingANP != nil {
+				objs = append(objs, tc.existingANP)
+			}
+			r := createFakeReconciler(common.ResourceTypeAdminNetworkPolicy, objs...)
+			patches := tc.patches(r)
+			defer patches.Reset()
+
+			ctx := context.Background()
+			res, err := r.Reconcile(ctx, req)
+			if tc.expectErrStr != "" {
+				assert.ErrorContains(t, err, tc.expectErrStr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectRes, res)
+
+			if tc.expectCondition != nil {
+				anp := &policyv1alpha1.AdminNetworkPolicy{}
+				assert.NoError(t, r.Client.Get(ctx, req.NamespacedName, anp))
+				cond := meta.FindStatusCondition(anp.Status.Conditions, conditionTypeReady)
+				assert.NotNil(t, cond)
+				assert.Equal(t, tc.expectCondition.Status, cond.Status)
+				assert.Equal(t, tc.expectCondition.Reason, cond.Reason)
+				assert.Equal(t, tc.expectCondition.ObservedGeneration, cond.ObservedGeneration)
+			}
+		})
+	}
+}
+
+func TestAdminNetworkPolicyReconciler_listSubjectNamespaces(t *testing.T) {
+	r := createFakeReconciler(common.ResourceTypeBaselineAdminNetworkPolicy,
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1", Labels: map[string]string{"team": "a"}}},
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns2", Labels: map[string]string{"team": "b"}}},
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns3", Labels: map[string]string{"team": "b"}, Finalizers: []string{"test"}, DeletionTimestamp: &metav1.Time{Time: time.Now()}}},
+	)
+	ctx := context.Background()
+
+	banp := &policyv1alpha1.BaselineAdminNetworkPolicy{
+		Spec: policyv1alpha1.BaselineAdminNetworkPolicySpec{
+			Subject: policyv1alpha1.AdminNetworkPolicySubject{
+				Pods: &policyv1alpha1.NamespacedPod{
+					NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"team": "b"}},
+				},
+			},
+		},
+	}
+	namespaces, err := r.listSubjectNamespaces(ctx, banp)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ns2"}, namespaces)
+
+	banp.Spec.Subject = policyv1alpha1.AdminNetworkPolicySubject{Namespaces: &metav1.LabelSelector{}}
+	namespaces, err = r.listSubjectNamespaces(ctx, banp)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"ns1", "ns2"}, namespaces)
+
+	banp.Spec.Subject = policyv1alpha1.AdminNetworkPolicySubject{}
+	_, err = r.listSubjectNamespaces(ctx, banp)
+	assert.Error(t, err)
+}
+
+func TestAdminNetworkPolicyReconciler_CollectGarbage(t *testing.T) {
+	r := createFakeReconciler(common.ResourceTypeBaselineAdminNetworkPolicy,
+		&policyv1alpha1.BaselineAdminNetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "default", UID: "uidBANP"}},
+	)
+	var deleted []string
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(r.Service), "ListAdminNetworkPolicyUID", func(_ *securitypolicy.SecurityPolicyService, createdFor string) sets.Set[string] {
+		assert.Equal(t, common.ResourceTypeBaselineAdminNetworkPolicy, createdFor)
+		return sets.New[string]("uidBANP", "uidStale")
+	})
+	patches.ApplyMethod(reflect.TypeOf(r.Service), "DeleteAdminNetworkPolicy", func(_ *securitypolicy.SecurityPolicyService, uid types.UID, isGC bool, createdFor string) error {
+		assert.True(t, isGC)
+		deleted = append(deleted, string(uid))
+		return nil
+	})
+	defer patches.Reset()
+
+	assert.NoError(t, r.CollectGarbage(context.Background()))
+	assert.Equal(t, []string{"uidStale"}, deleted)
+}
+
+func TestAdminNetworkPolicyReconciler_requeueAllPolicies(t *testing.T) {
+	r := createFakeReconciler(common.ResourceTypeAdminNetworkPolicy,
+		&policyv1alpha1.AdminNetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "anp1"}},
+		&policyv1alpha1.AdminNetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "anp2"}},
+		&policyv1alpha1.BaselineAdminNetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+	)
+	requests := r.requeueAllPolicies(context.Background(), &v1.Namespace{})
+	assert.ElementsMatch(t, []ctrl.Request{
+		{NamespacedName: types.NamespacedName{Name: "anp1"}},
+		{NamespacedName: types.NamespacedName{Name: "anp2"}},
+	}, requests)
+}
@@ -0,0 +1,133 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"golang.org/x/time/rate"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+const (
+	driftModeReport = "report"
+	driftModeRepair = "repair"
+)
+
+// driftScanQueryInterval limits the rate of the NSX queries sent by a drift scanner, so the scan does not compete
+// with the reconcilers for the NSX API.
+var driftScanQueryInterval = 5 * time.Second
+
+// DriftScanner periodically compares the operator-owned NSX resources in the service stores with NSX. The drift is
+// recorded as Events on the owning CRs and in metrics, and is repaired by reconciling the owning CRs again if the
+// repair is enabled for the resource type in drift_repair_resource_types.
+type DriftScanner struct {
+	Client   k8sclient.Client
+	Service  *servicecommon.Service
+	Recorder record.EventRecorder
+	Sources  []servicecommon.DriftSource
+	// Owner returns the owning CR of the NSX resource with the name and namespace set, or nil if the resource is not
+	// owned by a CR of the controller.
+	Owner func(obj interface{}) k8sclient.Object
+	// Enqueue adds the owning CR to the queue of the controller.
+	Enqueue func(req reconcile.Request)
+	limiter *rate.Limiter
+}
+
+// Start runs the drift scan every drift_scan_interval seconds, it should be called only if drift_scan_interval > 0.
+func (s *DriftScanner) Start() {
+	metrics.InitializeDriftMetrics()
+	go runPeriodically(make(chan bool), time.Duration(s.Service.NSXConfig.DriftScanInterval)*time.Second, s.Scan)
+}
+
+// Scan detects the drift of all the sources, and reports or repairs it.
+func (s *DriftScanner) Scan(ctx context.Context) error {
+	if s.limiter == nil {
+		s.limiter = rate.NewLimiter(rate.Every(driftScanQueryInterval), 1)
+	}
+	var errs []error
+	for i := range s.Sources {
+		source := &s.Sources[i]
+		if err := s.limiter.Wait(ctx); err != nil {
+			return err
+		}
+		drifts, err := s.Service.DetectDrift(ctx, source)
+		if err != nil {
+			log.Error(err, "Failed to scan NSX drift", "resourceType", source.ResourceType)
+			metrics.NSXDriftScanFailTotal.WithLabelValues(source.ResourceType).Inc()
+			errs = append(errs, err)
+			continue
+		}
+		repair := s.Service.NSXConfig.DriftRepairEnabled(source.ResourceType)
+		for _, drift := range drifts {
+			s.handleDrift(ctx, source, drift, repair)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *DriftScanner) handleDrift(ctx context.Context, source *servicecommon.DriftSource, drift servicecommon.Drift, repair bool) {
+	owner := s.Owner(drift.Object)
+	// In sharding mode all the replicas scan, the drift is handled by the replica processing the namespace of the
+	// owner, or by the leader if it has no owner.
+	if (owner == nil && !util.IsLeader()) || (owner != nil && !util.OwnsNamespace(owner.GetNamespace())) {
+		return
+	}
+	mode := driftModeReport
+	if repair {
+		mode = driftModeRepair
+	}
+	metrics.NSXDriftTotal.WithLabelValues(drift.ResourceType, string(drift.Type), mode).Inc()
+	id := source.ToComparable(drift.Object).Key()
+	log.Info("Found NSX drift", "resourceType", drift.ResourceType, "id", id, "driftType", drift.Type, "mode", mode)
+
+	if owner == nil {
+		return
+	}
+	if err := s.Client.Get(ctx, k8sclient.ObjectKeyFromObject(owner), owner); err != nil {
+		// The NSX resources of the deleted CRs are cleaned up by the garbage collector.
+		log.Debug("Skip the NSX drift as the owner is not found", "resourceType", drift.ResourceType, "id", id, "error", err)
+		return
+	}
+	msg := fmt.Sprintf("NSX %s %s is %s outside of NSX Operator", drift.ResourceType, id, strings.ToLower(string(drift.Type)))
+	if repair {
+		if err := source.RepairDrift(drift); err != nil {
+			log.Error(err, "Failed to repair NSX drift", "resourceType", drift.ResourceType, "id", id)
+			return
+		}
+		msg += ", reconciling to repair it"
+		s.Enqueue(reconcile.Request{NamespacedName: k8sclient.ObjectKeyFromObject(owner)})
+	}
+	s.Recorder.Event(owner, v1.EventTypeWarning, ReasonNSXDrift, msg)
+}
+
+// DriftOwnerKey returns the name and namespace of the owning CR from the tags of the NSX resource, the name is got
+// by the tag nameScope and the namespace is got by the first tag matching any of namespaceScopes.
+func DriftOwnerKey(tags []model.Tag, nameScope string, namespaceScopes ...string) (types.NamespacedName, bool) {
+	key := types.NamespacedName{}
+	for _, tag := range tags {
+		if tag.Scope == nil || tag.Tag == nil {
+			continue
+		}
+		if *tag.Scope == nameScope {
+			key.Name = *tag.Tag
+		}
+		for _, scope := range namespaceScopes {
+			if *tag.Scope == scope && key.Namespace == "" {
+				key.Namespace = *tag.Tag
+			}
+		}
+	}
+	return key, key.Name != "" && key.Namespace != ""
+}
@@ -0,0 +1,142 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	gomonkey "github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+type comparableSubnet model.VpcSubnet
+
+func (s *comparableSubnet) Key() string {
+	return *s.Id
+}
+
+func (s *comparableSubnet) Value() data.DataValue {
+	dataValue, _ := (*model.VpcSubnet)(s).GetDataValue__()
+	return dataValue
+}
+
+func TestDriftScanner_Scan(t *testing.T) {
+	origInterval := driftScanQueryInterval
+	defer func() {
+		driftScanQueryInterval = origInterval
+	}()
+	driftScanQueryInterval = 0
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&v1alpha1.Subnet{
+		ObjectMeta: metav1.ObjectMeta{Name: "subnet-1", Namespace: "ns-1"},
+	}).Build()
+
+	subnetStore := &servicecommon.ResourceStore{
+		Indexer: cache.NewIndexer(func(obj interface{}) (string, error) {
+			return *obj.(*model.VpcSubnet).Id, nil
+		}, cache.Indexers{}),
+		BindingType: model.VpcSubnetBindingType(),
+	}
+	newSubnet := func(id string, name string) *model.VpcSubnet {
+		return &model.VpcSubnet{Id: servicecommon.String(id), DisplayName: servicecommon.String(name)}
+	}
+	modified, deleted, deletedOwner := newSubnet("id-1", "subnet-1"), newSubnet("id-2", "subnet-1"), newSubnet("id-3", "subnet-3")
+	for _, subnet := range []*model.VpcSubnet{modified, deleted, deletedOwner} {
+		require.NoError(t, subnetStore.Add(subnet))
+	}
+	nsxModified := newSubnet("id-1", "subnet-1-changed")
+
+	service := &servicecommon.Service{NSXConfig: &config.NSXOperatorConfig{NsxConfig: &config.NsxConfig{}}}
+	recorder := record.NewFakeRecorder(10)
+	var requests []reconcile.Request
+	scanner := &DriftScanner{
+		Client:   k8sClient,
+		Service:  service,
+		Recorder: recorder,
+		Sources: []servicecommon.DriftSource{{
+			ResourceType: servicecommon.ResourceTypeSubnet,
+			Store:        subnetStore,
+			ToComparable: func(obj interface{}) servicecommon.Comparable {
+				return (*comparableSubnet)(obj.(*model.VpcSubnet))
+			},
+		}},
+		Owner: func(obj interface{}) client.Object {
+			// The owner is found by the DisplayName for testing.
+			return &v1alpha1.Subnet{ObjectMeta: metav1.ObjectMeta{Name: *obj.(*model.VpcSubnet).DisplayName, Namespace: "ns-1"}}
+		},
+		Enqueue: func(req reconcile.Request) {
+			requests = append(requests, req)
+		},
+	}
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(service), "DetectDrift", func(_ *servicecommon.Service, source *servicecommon.DriftSource) ([]servicecommon.Drift, error) {
+		return []servicecommon.Drift{
+			{ResourceType: source.ResourceType, Type: servicecommon.DriftTypeModified, Object: modified, NSXObject: nsxModified},
+			{ResourceType: source.ResourceType, Type: servicecommon.DriftTypeDeleted, Object: deleted},
+			{ResourceType: source.ResourceType, Type: servicecommon.DriftTypeDeleted, Object: deletedOwner},
+		}, nil
+	})
+	defer patches.Reset()
+
+	t.Run("ReportOnly", func(t *testing.T) {
+		require.NoError(t, scanner.Scan(context.TODO()))
+		assert.Empty(t, requests)
+		assert.Len(t, recorder.Events, 2)
+		assert.Equal(t, "Warning NSXDriftDetected NSX VpcSubnet id-1 is modified outside of NSX Operator", <-recorder.Events)
+		assert.Equal(t, "Warning NSXDriftDetected NSX VpcSubnet id-2 is deleted outside of NSX Operator", <-recorder.Events)
+		assert.Len(t, subnetStore.List(), 3)
+	})
+
+	t.Run("Repair", func(t *testing.T) {
+		service.NSXConfig.DriftRepairResourceTypes = []string{servicecommon.ResourceTypeSubnet}
+		require.NoError(t, scanner.Scan(context.TODO()))
+		assert.Equal(t, []reconcile.Request{
+			{NamespacedName: client.ObjectKey{Namespace: "ns-1", Name: "subnet-1"}},
+			{NamespacedName: client.ObjectKey{Namespace: "ns-1", Name: "subnet-1"}},
+		}, requests)
+		assert.Len(t, recorder.Events, 2)
+		assert.Equal(t, "Warning NSXDriftDetected NSX VpcSubnet id-1 is modified outside of NSX Operator, reconciling to repair it", <-recorder.Events)
+		<-recorder.Events
+		assert.Same(t, nsxModified, subnetStore.GetByKey("id-1"))
+		assert.Nil(t, subnetStore.GetByKey("id-2"))
+		// The drift of the resources without the owner CR is left to the garbage collector.
+		assert.NotNil(t, subnetStore.GetByKey("id-3"))
+	})
+
+	t.Run("ScanFailure", func(t *testing.T) {
+		patches.ApplyMethod(reflect.TypeOf(service), "DetectDrift", func(_ *servicecommon.Service, _ *servicecommon.DriftSource) ([]servicecommon.Drift, error) {
+			return nil, errors.New("query error")
+		})
+		assert.ErrorContains(t, scanner.Scan(context.TODO()), "query error")
+	})
+}
+
+func TestDriftOwnerKey(t *testing.T) {
+	tags := []model.Tag{
+		{Scope: servicecommon.String(servicecommon.TagScopeCluster), Tag: servicecommon.String("cluster-1")},
+		{Scope: servicecommon.String(servicecommon.TagScopeVMNamespace), Tag: servicecommon.String("ns-1")},
+		{Scope: servicecommon.String(servicecommon.TagScopeSubnetCRName), Tag: servicecommon.String("subnet-1")},
+	}
+	key, ok := DriftOwnerKey(tags, servicecommon.TagScopeSubnetCRName, servicecommon.TagScopeVMNamespace, servicecommon.TagScopeNamespace)
+	assert.True(t, ok)
+	assert.Equal(t, client.ObjectKey{Namespace: "ns-1", Name: "subnet-1"}, key)
+
+	_, ok = DriftOwnerKey(tags, servicecommon.TagScopeSubnetSetCRName, servicecommon.TagScopeVMNamespace)
+	assert.False(t, ok)
+}
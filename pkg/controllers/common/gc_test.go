@@ -19,6 +19,7 @@ func TestGenericGarbageCollector(t *testing.T) {
 
 	// Set startup delay to 0 for testing immediate execution
 	GCStartupDelay = 0
+	setNetworkingLicense(t)
 
 	var wg sync.WaitGroup
 	wg.Add(1)
@@ -61,6 +62,7 @@ func TestGenericGarbageCollector_WithDelay(t *testing.T) {
 
 	// Set a short startup delay of 50ms for testing
 	GCStartupDelay = 50 * time.Millisecond
+	setNetworkingLicense(t)
 
 	var calledCount int32
 	f := func(ctx context.Context) error {
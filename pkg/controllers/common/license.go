@@ -0,0 +1,50 @@
+package common
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+// DFWLicenseSource returns a source which enqueues all the objects returned by list when the DFW license is restored.
+// The DFW policies are paused with a failed condition while the license is missing, so they are realized again
+// without restarting the operator. The objects are enqueued with the low priority to not delay the user changes.
+func DFWLicenseSource(resourceType string, list func(ctx context.Context) ([]client.Object, error)) source.Source {
+	return source.Func(func(ctx context.Context, q workqueue.TypedRateLimitingInterface[reconcile.Request]) error {
+		nsxutil.AddDFWLicenseHandler(func(isLicensed bool) {
+			if !isLicensed || ctx.Err() != nil {
+				return
+			}
+			objs, err := list(ctx)
+			if err != nil {
+				log.Error(err, "Failed to list the objects to resume after the DFW license is restored", "resourceType", resourceType)
+				return
+			}
+			log.Info("DFW license is restored, resuming the objects", "resourceType", resourceType, "count", len(objs))
+			for _, obj := range objs {
+				AddLowPriority(q, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(obj)})
+			}
+		})
+		return nil
+	})
+}
+
+// LicensedReconciler returns a reconciler which pauses the requests while the container or VPC license is missing.
+// The paused requests are retried after the interval of checking a failed license, so they are realized again once
+// the license is restored without restarting the operator.
+func LicensedReconciler(resourceType string, r reconcile.Reconciler) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		if !nsxutil.GetNetworkingLicense() {
+			log.Debug("Pausing request while the NSX license is missing", "resourceType", resourceType, "req", req.NamespacedName)
+			return reconcile.Result{RequeueAfter: time.Duration(config.LicenseIntervalForFailure) * time.Second}, nil
+		}
+		return r.Reconcile(ctx, req)
+	})
+}
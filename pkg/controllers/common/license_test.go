@@ -0,0 +1,85 @@
+package common
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+func TestDFWLicenseSource(t *testing.T) {
+	defer nsxutil.UpdateLicense(nsxutil.LicenseDFW, false)
+	listCalls := 0
+	list := func(ctx context.Context) ([]client.Object, error) {
+		listCalls++
+		return []client.Object{
+			&v1alpha1.SecurityPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "sp-1"}},
+			&v1alpha1.SecurityPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "sp-2"}},
+		}, nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	q := NewPriorityQueue("test-dfw-license", workqueue.DefaultTypedControllerRateLimiter[reconcile.Request](), time.Hour)
+	defer q.ShutDown()
+	require.NoError(t, DFWLicenseSource("securitypolicy", list).Start(ctx, q))
+
+	// The objects are not enqueued when the license is lost.
+	nsxutil.UpdateLicense(nsxutil.LicenseDFW, false)
+	nsxutil.NotifyDFWLicense()
+	assert.Equal(t, 0, listCalls)
+	assert.Equal(t, 0, q.Len())
+
+	nsxutil.UpdateLicense(nsxutil.LicenseDFW, true)
+	nsxutil.NotifyDFWLicense()
+	assert.Equal(t, 1, listCalls)
+	assert.Equal(t, 2, q.Len())
+	// The resumed objects do not compete with the user changes.
+	assert.Equal(t, float64(2), testutil.ToFloat64(metrics.ControllerQueueDepth.WithLabelValues("test-dfw-license", priorityLabelLow)))
+
+	// The handler is a no-op after the controller is stopped.
+	cancel()
+	nsxutil.NotifyDFWLicense()
+	assert.Equal(t, 1, listCalls)
+}
+
+// setNetworkingLicense sets the container license for the test, the VPC license is not required without VPC
+// namespaces.
+func setNetworkingLicense(t *testing.T) {
+	nsxutil.UpdateLicense(nsxutil.FeatureContainer, true)
+	t.Cleanup(func() {
+		nsxutil.UpdateLicense(nsxutil.FeatureContainer, false)
+	})
+}
+
+func TestLicensedReconciler(t *testing.T) {
+	var reconciled int32
+	r := LicensedReconciler("subnet", reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		atomic.AddInt32(&reconciled, 1)
+		return ResultNormal, nil
+	}))
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "ns-1", Name: "subnet-1"}}
+
+	// The request is paused and retried later without the container license.
+	result, err := r.Reconcile(context.TODO(), req)
+	require.NoError(t, err)
+	assert.Equal(t, 60*time.Second, result.RequeueAfter)
+	assert.Zero(t, atomic.LoadInt32(&reconciled))
+
+	setNetworkingLicense(t)
+	result, err = r.Reconcile(context.TODO(), req)
+	require.NoError(t, err)
+	assert.Equal(t, ResultNormal, result)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&reconciled))
+}
@@ -0,0 +1,209 @@
+package common
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+const (
+	// PriorityHigh is the priority of the requests from the create and update events, which are usually changes made
+	// by the users. It is the default priority of controller-runtime.
+	PriorityHigh = 0
+	// PriorityLow is the priority of the background requests, e.g. the initial list and the periodic resync of the
+	// informers, the polling and the drift scanners. It is the same as the priority set by controller-runtime for the
+	// initial list and the resync.
+	PriorityLow = handler.LowPriority
+
+	priorityLabelHigh = "high"
+	priorityLabelLow  = "low"
+)
+
+type queuedRequest struct {
+	priority  int
+	readyTime time.Time
+}
+
+// PriorityQueue wraps the priority queue of controller-runtime. The high priority requests are always processed
+// before the low priority ones, so a request from a user change does not wait behind the requests of the initial list
+// after a restart. To avoid starving the low priority requests, a request which has been ready for more than maxWait is
+// raised to the high priority. The queue depth by priority is exposed as a metric.
+type PriorityQueue struct {
+	priorityqueue.PriorityQueue[reconcile.Request]
+	name        string
+	rateLimiter workqueue.TypedRateLimiter[reconcile.Request]
+	maxWait     time.Duration
+
+	lock     sync.Mutex
+	queued   map[reconcile.Request]*queuedRequest
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewQueue returns the function creating the workqueue of the controller, it is set as the NewQueue of the controller
+// options. name is the name of the controller in the [controllers] section.
+func NewQueue(cf *config.NSXOperatorConfig, name string) func(string, workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+	return func(controllerName string, rateLimiter workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+		return NewPriorityQueue(controllerName, rateLimiter, cf.ControllerConfig(name).LowPriorityMaxWait)
+	}
+}
+
+func NewPriorityQueue(controllerName string, rateLimiter workqueue.TypedRateLimiter[reconcile.Request], maxWait time.Duration) *PriorityQueue {
+	q := &PriorityQueue{
+		PriorityQueue: priorityqueue.New(controllerName, func(o *priorityqueue.Opts[reconcile.Request]) {
+			o.Log = log.WithValues("controller", controllerName)
+			o.RateLimiter = rateLimiter
+		}),
+		name:        controllerName,
+		rateLimiter: rateLimiter,
+		maxWait:     maxWait,
+		queued:      map[reconcile.Request]*queuedRequest{},
+		stop:        make(chan struct{}),
+	}
+	metrics.ControllerQueueDepth.WithLabelValues(controllerName, priorityLabelHigh).Set(0)
+	metrics.ControllerQueueDepth.WithLabelValues(controllerName, priorityLabelLow).Set(0)
+	go q.promotePeriodically()
+	return q
+}
+
+// AddLowPriority adds a background request to the queue of a controller, with the low priority if the queue is a
+// priority queue.
+func AddLowPriority(q workqueue.TypedRateLimitingInterface[reconcile.Request], req reconcile.Request) {
+	if pq, ok := q.(priorityqueue.PriorityQueue[reconcile.Request]); ok {
+		pq.AddWithOpts(priorityqueue.AddOpts{Priority: util.Ptr(PriorityLow)}, req)
+		return
+	}
+	q.Add(req)
+}
+
+func priorityLabel(priority int) string {
+	if priority < PriorityHigh {
+		return priorityLabelLow
+	}
+	return priorityLabelHigh
+}
+
+// AddWithOpts follows the priority queue of controller-runtime: the queued request keeps the max of the priorities and
+// the earliest ready time. The rate limiter is called here instead of in the wrapped queue to know when the request is
+// ready.
+func (q *PriorityQueue) AddWithOpts(o priorityqueue.AddOpts, items ...reconcile.Request) {
+	priority := PriorityHigh
+	if o.Priority != nil {
+		priority = *o.Priority
+	}
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	now := time.Now()
+	for _, item := range items {
+		after := o.After
+		if o.RateLimited {
+			if rateLimitedAfter := q.rateLimiter.When(item); after == 0 || rateLimitedAfter < after {
+				after = rateLimitedAfter
+			}
+		}
+		readyTime := now.Add(after)
+		if queued, ok := q.queued[item]; ok {
+			if readyTime.Before(queued.readyTime) {
+				queued.readyTime = readyTime
+			}
+			if priority > queued.priority {
+				q.updateDepth(queued.priority, -1)
+				q.updateDepth(priority, 1)
+				queued.priority = priority
+			}
+		} else {
+			q.queued[item] = &queuedRequest{priority: priority, readyTime: readyTime}
+			q.updateDepth(priority, 1)
+		}
+		q.PriorityQueue.AddWithOpts(priorityqueue.AddOpts{After: after, Priority: util.Ptr(priority)}, item)
+	}
+}
+
+func (q *PriorityQueue) Add(item reconcile.Request) {
+	q.AddWithOpts(priorityqueue.AddOpts{}, item)
+}
+
+func (q *PriorityQueue) AddAfter(item reconcile.Request, after time.Duration) {
+	q.AddWithOpts(priorityqueue.AddOpts{After: after}, item)
+}
+
+func (q *PriorityQueue) AddRateLimited(item reconcile.Request) {
+	q.AddWithOpts(priorityqueue.AddOpts{RateLimited: true}, item)
+}
+
+func (q *PriorityQueue) GetWithPriority() (reconcile.Request, int, bool) {
+	item, priority, shutdown := q.PriorityQueue.GetWithPriority()
+	if shutdown {
+		return item, priority, shutdown
+	}
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if queued, ok := q.queued[item]; ok {
+		q.updateDepth(queued.priority, -1)
+		delete(q.queued, item)
+	}
+	return item, priority, shutdown
+}
+
+func (q *PriorityQueue) Get() (reconcile.Request, bool) {
+	item, _, shutdown := q.GetWithPriority()
+	return item, shutdown
+}
+
+func (q *PriorityQueue) ShutDown() {
+	q.stopOnce.Do(func() {
+		close(q.stop)
+		q.PriorityQueue.ShutDown()
+	})
+}
+
+func (q *PriorityQueue) ShutDownWithDrain() {
+	q.ShutDown()
+}
+
+func (q *PriorityQueue) updateDepth(priority int, delta float64) {
+	metrics.ControllerQueueDepth.WithLabelValues(q.name, priorityLabel(priority)).Add(delta)
+}
+
+func (q *PriorityQueue) promotePeriodically() {
+	ticker := time.NewTicker(q.maxWait / 4)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-q.stop:
+			return
+		case <-ticker.C:
+			q.promote()
+		}
+	}
+}
+
+// promote raises the low priority requests which have been ready for more than maxWait to the high priority.
+func (q *PriorityQueue) promote() {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	deadline := time.Now().Add(-q.maxWait)
+	var promoted []reconcile.Request
+	for item, queued := range q.queued {
+		if queued.priority < PriorityHigh && queued.readyTime.Before(deadline) {
+			q.updateDepth(queued.priority, -1)
+			q.updateDepth(PriorityHigh, 1)
+			queued.priority = PriorityHigh
+			promoted = append(promoted, item)
+		}
+	}
+	if len(promoted) == 0 {
+		return
+	}
+	q.PriorityQueue.AddWithOpts(priorityqueue.AddOpts{Priority: util.Ptr(PriorityHigh)}, promoted...)
+	metrics.ControllerQueuePromotedTotal.WithLabelValues(q.name).Add(float64(len(promoted)))
+	log.Info("Raised the priority of the requests waiting too long in the queue", "controller", q.name, "count", len(promoted), "maxWait", q.maxWait)
+}
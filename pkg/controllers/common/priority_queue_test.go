@@ -0,0 +1,99 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	newRequest := func(name string) reconcile.Request {
+		return reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "ns-1", Name: name}}
+	}
+	depth := func(name, priority string) float64 {
+		return testutil.ToFloat64(metrics.ControllerQueueDepth.WithLabelValues(name, priority))
+	}
+	getWithPriority := func(q *PriorityQueue) (string, int) {
+		item, priority, shutdown := q.GetWithPriority()
+		require.False(t, shutdown)
+		q.Done(item)
+		return item.Name, priority
+	}
+
+	t.Run("HighPriorityFirst", func(t *testing.T) {
+		q := NewPriorityQueue("test-high-first", workqueue.DefaultTypedControllerRateLimiter[reconcile.Request](), time.Hour)
+		defer q.ShutDown()
+		AddLowPriority(q, newRequest("resync-1"))
+		AddLowPriority(q, newRequest("resync-2"))
+		q.Add(newRequest("user-change"))
+		// The priority of a queued request is raised by a high priority add.
+		q.Add(newRequest("resync-2"))
+		assert.Equal(t, float64(2), depth("test-high-first", priorityLabelHigh))
+		assert.Equal(t, float64(1), depth("test-high-first", priorityLabelLow))
+
+		name, priority := getWithPriority(q)
+		assert.Equal(t, "user-change", name)
+		assert.Equal(t, PriorityHigh, priority)
+		name, _ = getWithPriority(q)
+		assert.Equal(t, "resync-2", name)
+		name, priority = getWithPriority(q)
+		assert.Equal(t, "resync-1", name)
+		assert.Equal(t, PriorityLow, priority)
+		assert.Equal(t, float64(0), depth("test-high-first", priorityLabelHigh))
+		assert.Equal(t, float64(0), depth("test-high-first", priorityLabelLow))
+	})
+
+	t.Run("StarvationProtection", func(t *testing.T) {
+		maxWait := 40 * time.Millisecond
+		q := NewPriorityQueue("test-starvation", workqueue.DefaultTypedControllerRateLimiter[reconcile.Request](), maxWait)
+		defer q.ShutDown()
+		promotedTotal := metrics.ControllerQueuePromotedTotal.WithLabelValues("test-starvation")
+		initialPromoted := testutil.ToFloat64(promotedTotal)
+		AddLowPriority(q, newRequest("resync"))
+		// The delayed request is not promoted before it is ready.
+		q.AddWithOpts(priorityqueue.AddOpts{After: time.Hour, Priority: util.Ptr(PriorityLow)}, newRequest("delayed"))
+		assert.Eventually(t, func() bool {
+			return testutil.ToFloat64(promotedTotal) == initialPromoted+1
+		}, time.Second, 10*time.Millisecond)
+		assert.Equal(t, float64(1), depth("test-starvation", priorityLabelHigh))
+		assert.Equal(t, float64(1), depth("test-starvation", priorityLabelLow))
+
+		// The promoted request is processed before the high priority requests added after it.
+		q.Add(newRequest("user-change"))
+		name, priority := getWithPriority(q)
+		assert.Equal(t, "resync", name)
+		assert.Equal(t, PriorityHigh, priority)
+		name, _ = getWithPriority(q)
+		assert.Equal(t, "user-change", name)
+	})
+
+	t.Run("RateLimited", func(t *testing.T) {
+		rateLimiter := workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](time.Hour, time.Hour)
+		q := NewPriorityQueue("test-rate-limited", rateLimiter, time.Millisecond)
+		defer q.ShutDown()
+		q.AddRateLimited(newRequest("failed"))
+		assert.Equal(t, 1, q.NumRequeues(newRequest("failed")))
+		q.promote()
+		assert.Equal(t, 0, q.Len())
+		q.Forget(newRequest("failed"))
+		assert.Equal(t, 0, q.NumRequeues(newRequest("failed")))
+	})
+
+	t.Run("ShutDown", func(t *testing.T) {
+		q := NewPriorityQueue("test-shutdown", workqueue.DefaultTypedControllerRateLimiter[reconcile.Request](), time.Hour)
+		q.ShutDown()
+		q.ShutDownWithDrain()
+		_, shutdown := q.Get()
+		assert.True(t, shutdown)
+	})
+}
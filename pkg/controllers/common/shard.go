@@ -0,0 +1,65 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package common
+
+import (
+	"context"
+
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+// ShardedReconciler wraps the reconciler of a namespaced controller which runs on all the replicas in sharding mode.
+// The requests of a namespace are skipped unless this replica processes its shard, the replica processing the shard
+// enqueues all its objects when it acquires the shard.
+func ShardedReconciler(resourceType string, r reconcile.Reconciler) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		done, ok := util.BeginShardReconcile(req.Namespace)
+		if !ok {
+			log.Trace("Skipping request of a namespace processed by another replica", "resourceType", resourceType, "req", req.NamespacedName)
+			return ResultNormal, nil
+		}
+		defer done()
+		return r.Reconcile(ctx, req)
+	})
+}
+
+// ShardSource returns a source which enqueues the objects returned by list in the namespaces of a shard when this
+// replica starts processing it. The objects are enqueued with the low priority to not delay the user changes.
+func ShardSource(resourceType string, list func(ctx context.Context) ([]client.Object, error)) source.Source {
+	return source.Func(func(ctx context.Context, q workqueue.TypedRateLimitingInterface[reconcile.Request]) error {
+		util.AddShardAcquireHandler(func(shard int) {
+			if ctx.Err() != nil {
+				return
+			}
+			objs, err := list(ctx)
+			if err != nil {
+				log.Error(err, "Failed to list the objects of the acquired shard", "resourceType", resourceType, "shard", shard)
+				return
+			}
+			count := 0
+			for _, obj := range objs {
+				if util.NamespaceInShard(obj.GetNamespace(), shard) {
+					AddLowPriority(q, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(obj)})
+					count++
+				}
+			}
+			log.Info("Enqueued the objects of the acquired shard", "resourceType", resourceType, "shard", shard, "count", count)
+		})
+		return nil
+	})
+}
+
+// NeedLeaderElection returns false for the sharded controllers in sharding mode so they run on all the replicas, and
+// nil to keep the default of the manager otherwise.
+func NeedLeaderElection() *bool {
+	if !util.ShardingEnabled() {
+		return nil
+	}
+	return util.Ptr(false)
+}
@@ -0,0 +1,91 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package common
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+func enableSharding(t *testing.T) {
+	m, err := util.NewShardManager(nil, "vmware-system-nsx", 4, 15*time.Second, nil)
+	require.NoError(t, err)
+	util.SetShardManager(m)
+	t.Cleanup(func() {
+		util.SetShardManager(nil)
+		util.SetLeader(false)
+	})
+}
+
+func TestShardedReconciler(t *testing.T) {
+	var reconciled int32
+	r := ShardedReconciler("test", reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+		atomic.AddInt32(&reconciled, 1)
+		return ResultRequeue, nil
+	}))
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "ns-1", Name: "obj"}}
+
+	// All the requests are processed without sharding.
+	result, err := r.Reconcile(context.TODO(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, ResultRequeue, result)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&reconciled))
+	assert.Nil(t, NeedLeaderElection())
+
+	// The requests of the shards not acquired by this replica are skipped.
+	enableSharding(t)
+	result, err = r.Reconcile(context.TODO(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, ResultNormal, result)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&reconciled))
+	require.NotNil(t, NeedLeaderElection())
+	assert.False(t, *NeedLeaderElection())
+
+	// The cluster scoped objects are processed by the leader.
+	clusterReq := reconcile.Request{NamespacedName: types.NamespacedName{Name: "obj"}}
+	_, _ = r.Reconcile(context.TODO(), clusterReq)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&reconciled))
+	util.SetLeader(true)
+	_, _ = r.Reconcile(context.TODO(), clusterReq)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&reconciled))
+}
+
+func TestGenericGarbageCollector_Sharding(t *testing.T) {
+	origDelay := GCStartupDelay
+	defer func() {
+		GCStartupDelay = origDelay
+	}()
+	GCStartupDelay = 0
+	enableSharding(t)
+	setNetworkingLicense(t)
+
+	var calledCount int32
+	cancel := make(chan bool)
+	done := make(chan struct{})
+	go func() {
+		GenericGarbageCollector(cancel, 5*time.Millisecond, func(ctx context.Context) error {
+			atomic.AddInt32(&calledCount, 1)
+			return nil
+		})
+		close(done)
+	}()
+
+	// Only the leader collects the garbage.
+	time.Sleep(30 * time.Millisecond)
+	assert.Zero(t, atomic.LoadInt32(&calledCount))
+	util.SetLeader(true)
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&calledCount) > 0 }, time.Second, 5*time.Millisecond)
+
+	close(cancel)
+	<-done
+}
@@ -26,8 +26,11 @@ const (
 	MetricResTypeServiceLb                  = "servicelb"
 	MetricResTypeStatefulSet                = "statefulset"
 	MetricResTypeGateway                    = "gateway"
-	MaxConcurrentReconciles                 = 8
+	MetricResTypeIngress                    = "ingress"
+	MetricResTypeAdminNetworkPolicy         = "adminnetworkpolicy"
+	MetricResTypeBaselineAdminNetworkPolicy = "baselineadminnetworkpolicy"
 	NSXOperatorError                        = "nsx-op/error"
+	NSXOperatorRealization                  = "nsx-op/realization"
 	//sync the error with NCP side
 	ErrorNoDFWLicense                  = "NO_DFW_LICENSE"
 	ErrorNetworkPolicyValidationFailed = "NETWORK_POLICY_VALIDATION_FAILED"
@@ -57,6 +60,9 @@ const (
 	ReasonSuccessfulUpdate = "SuccessfulUpdate"
 	ReasonFailDelete       = "FailDelete"
 	ReasonFailUpdate       = "FailUpdate"
+	ReasonPolicyWarning    = "PolicyWarning"
+	ReasonUnresolvedPort   = "NamedPortUnresolved"
+	ReasonNSXDrift         = "NSXDriftDetected"
 )
 
 // GarbageCollector interface with collectGarbage method
@@ -10,18 +10,22 @@ import (
 	"time"
 
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"golang.org/x/time/rate"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
 	"github.com/vmware-tanzu/nsx-operator/pkg/config"
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
 	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
 	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
@@ -226,7 +230,7 @@ func AllocateSubnetFromSubnetSet(client k8sclient.Client, apiReader k8sclient.Re
 		log.Error(err, "Failed to allocate Subnet")
 		return "", nil, nil, err
 	}
-	nsxSubnet, err := subnetService.CreateOrUpdateSubnet(subnetSet, vpcInfoList[0], tags)
+	nsxSubnet, err := subnetService.CreateOrUpdateSubnet(context.TODO(), subnetSet, vpcInfoList[0], tags)
 	if err != nil {
 		return "", nil, nil, err
 	}
@@ -293,12 +297,39 @@ func GetVirtualMachineNameForSubnetPort(subnetPort *v1alpha1.SubnetPort) (string
 	return array[1], array[2], nil
 }
 
-// NumReconcile now uses the fix number of concurrency
-func NumReconcile() int {
-	return MaxConcurrentReconciles
+// NumReconcile returns the max concurrent reconciles of the controller configured in the [controllers] section, name
+// is the res_type label of the controller metrics.
+func NumReconcile(cf *config.NSXOperatorConfig, name string) int {
+	return cf.ControllerConfig(name).MaxConcurrentReconciles
+}
+
+// RateLimiter returns the workqueue rate limiter of the controller configured in the [controllers] section. It is the
+// same as the default rate limiter of controller-runtime with the configured backoff and bucket.
+func RateLimiter(cf *config.NSXOperatorConfig, name string) workqueue.TypedRateLimiter[reconcile.Request] {
+	controllerConfig := cf.ControllerConfig(name)
+	return workqueue.NewTypedMaxOfRateLimiter(
+		workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](controllerConfig.BaseBackoff, controllerConfig.MaxBackoff),
+		&workqueue.TypedBucketRateLimiter[reconcile.Request]{Limiter: rate.NewLimiter(rate.Limit(controllerConfig.QPS), controllerConfig.Burst)},
+	)
 }
 
 func GenericGarbageCollector(cancel chan bool, timeout time.Duration, f func(ctx context.Context) error) {
+	runPeriodically(cancel, timeout, func(ctx context.Context) error {
+		// In sharding mode the controllers run on all the replicas, and only the leader collects the garbage.
+		if !util.IsLeader() {
+			return nil
+		}
+		// The garbage is collected again once the license is restored.
+		if !nsxutil.GetNetworkingLicense() {
+			log.Info("Skipping garbage collection while the NSX license is missing")
+			return nil
+		}
+		return f(ctx)
+	})
+}
+
+// runPeriodically runs f after GCStartupDelay and then every timeout until cancel is closed.
+func runPeriodically(cancel chan bool, timeout time.Duration, f func(ctx context.Context) error) {
 	ctx := context.Background()
 
 	if GCStartupDelay > 0 {
@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	gomonkey "github.com/agiledragon/gomonkey/v2"
 	"github.com/stretchr/testify/assert"
@@ -23,6 +24,7 @@ import (
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
 	"github.com/vmware-tanzu/nsx-operator/pkg/config"
@@ -1491,3 +1493,21 @@ func TestValidateAccessModeTransition(t *testing.T) {
 		})
 	}
 }
+
+func TestControllerConcurrencyAndRateLimiter(t *testing.T) {
+	assert.Equal(t, 8, NumReconcile(nil, MetricResTypePod))
+
+	controllerConfig := config.DefaultControllerConfig
+	controllerConfig.MaxConcurrentReconciles = 16
+	controllerConfig.BaseBackoff = time.Second
+	controllerConfig.MaxBackoff = 4 * time.Second
+	cf := &config.NSXOperatorConfig{Controllers: &config.ControllersConfig{Default: controllerConfig}}
+	assert.Equal(t, 16, NumReconcile(cf, MetricResTypePod))
+
+	rateLimiter := RateLimiter(cf, MetricResTypePod)
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "ns-1", Name: "pod-1"}}
+	assert.Equal(t, time.Second, rateLimiter.When(req))
+	assert.Equal(t, 2*time.Second, rateLimiter.When(req))
+	assert.Equal(t, 4*time.Second, rateLimiter.When(req))
+	assert.Equal(t, 4*time.Second, rateLimiter.When(req))
+}
@@ -33,12 +33,15 @@ import (
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/audit"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/dns"
 	extdns "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/endpoint"
 	extdnssrc "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/source"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 )
 
 const (
@@ -69,6 +72,7 @@ type GatewayReconciler struct {
 	Client        client.Client
 	Scheme        *apimachineryruntime.Scheme
 	Recorder      record.EventRecorder
+	NSXConfig     *config.NSXOperatorConfig
 	DNS           dns.DNSRecordProvider
 	StatusUpdater StatusUpdater
 
@@ -417,16 +421,17 @@ func (r *GatewayReconciler) StartController(mgr ctrl.Manager, _ webhook.Server)
 	return nil
 }
 
-func NewGatewayReconciler(mgr ctrl.Manager, dnsProv dns.DNSRecordProvider) *GatewayReconciler {
+func NewGatewayReconciler(mgr ctrl.Manager, cf *config.NSXOperatorConfig, dnsProv dns.DNSRecordProvider) *GatewayReconciler {
 	r := &GatewayReconciler{
-		Client:   mgr.GetClient(),
-		Scheme:   mgr.GetScheme(),
-		Recorder: mgr.GetEventRecorderFor("gateway-controller"), //nolint:staticcheck // record.EventRecorder; StatusUpdater not on events.EventRecorder yet
-		DNS:      dnsProv,
-		ipCache:  NewGatewayIPCache(),
-	}
-	if svc, ok := dnsProv.(*dns.DNSRecordService); ok && svc != nil && svc.NSXConfig != nil {
-		updater := common.NewStatusUpdater(r.Client, svc.NSXConfig, r.Recorder, common.MetricResTypeGateway, "ProjectDnsRecord", "Gateway")
+		Client:    mgr.GetClient(),
+		Scheme:    mgr.GetScheme(),
+		Recorder:  mgr.GetEventRecorderFor("gateway-controller"), //nolint:staticcheck // record.EventRecorder; StatusUpdater not on events.EventRecorder yet
+		NSXConfig: cf,
+		DNS:       dnsProv,
+		ipCache:   NewGatewayIPCache(),
+	}
+	if cf != nil {
+		updater := common.NewStatusUpdater(r.Client, cf, r.Recorder, common.MetricResTypeGateway, "ProjectDnsRecord", "Gateway")
 		r.StatusUpdater = &updater
 	}
 	return r
@@ -472,7 +477,16 @@ func (r *GatewayReconciler) setupWithManager(mgr ctrl.Manager) error {
 		return err
 	}
 
-	return b.WithOptions(controller.Options{MaxConcurrentReconciles: common.NumReconcile()}).Complete(r)
+	return b.WithOptions(r.controllerOptions()).Complete(common.LicensedReconciler(common.MetricResTypeGateway, tracing.Reconciler(common.MetricResTypeGateway, audit.Reconciler(r.Client, &gatewayv1.Gateway{}, r))))
+}
+
+// controllerOptions returns the options shared by the Gateway and the Route controllers.
+func (r *GatewayReconciler) controllerOptions() controller.Options {
+	return controller.Options{
+		MaxConcurrentReconciles: common.NumReconcile(r.NSXConfig, common.MetricResTypeGateway),
+		RateLimiter:             common.RateLimiter(r.NSXConfig, common.MetricResTypeGateway),
+		NewQueue:                common.NewQueue(r.NSXConfig, common.MetricResTypeGateway),
+	}
 }
 
 // warmGatewayIPCacheOnStartup loads ipCache from API, sets ipCacheWarmedOnStartup, enqueues Route resyncs; returns err.
@@ -532,9 +546,9 @@ func (r *GatewayReconciler) RestoreReconcile() error {
 
 // registerRouteWatchers registers Route DNS reconcilers for installed Route CRDs.
 func (r *GatewayReconciler) registerRouteWatchers(mgr ctrl.Manager) error {
-	opts := controller.Options{MaxConcurrentReconciles: common.NumReconcile()}
 	for _, rr := range r.apiResources.routeReconcilers {
-		if err := rr.registerWatcher(mgr, opts, r.apiResources.listenerSetEnabled); err != nil {
+		// Each controller needs its own rate limiter.
+		if err := rr.registerWatcher(mgr, r.controllerOptions(), r.apiResources.listenerSetEnabled); err != nil {
 			return err
 		}
 	}
@@ -31,11 +31,13 @@ import (
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/audit"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/dns"
 	extdns "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/endpoint"
 	extdnssrc "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/source"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 )
 
 const (
@@ -311,10 +313,10 @@ func newRouteReconciler[PT RouteObject[T], T any, PI Object[T]](
 		resyncCh:               make(chan event.TypedGenericEvent[*T], 256),
 		newList:                newList,
 	}
-	if svc, ok := r.DNS.(*dns.DNSRecordService); ok && svc != nil && svc.NSXConfig != nil {
+	if r.NSXConfig != nil {
 		updater := common.NewStatusUpdater(
 			r.Client,
-			svc.NSXConfig,
+			r.NSXConfig,
 			r.Recorder,
 			common.MetricResTypeGateway,
 			"ProjectDnsRecord",
@@ -642,7 +644,7 @@ func (r *genericRouteReconciler[PT, T, PI]) registerWatcher(mgr ctrl.Manager, op
 		builder.WithPredicates(predicateNetworkInfoAllowedDNSDomainsChanged()),
 	)
 
-	return b.Complete(r)
+	return b.Complete(common.LicensedReconciler(strings.ToLower(r.kind), tracing.Reconciler(strings.ToLower(r.kind), audit.Reconciler(r.client, creator.GetObject(), r))))
 }
 
 func (r *genericRouteReconciler[PT, T, PI]) getKind() string {
@@ -358,7 +358,7 @@ func TestGenericRouteReconciler_newRouteReconciler(t *testing.T) {
 	env := setupTestEnv(t)
 	r := env.reconciler
 	r.StatusUpdater = setupMockStatusUpdater(env.ctrl)
-	r.DNS = &dns.DNSRecordService{Service: servicecommon.Service{NSXConfig: &config.NSXOperatorConfig{}}}
+	r.NSXConfig = &config.NSXOperatorConfig{}
 
 	gr := newRouteReconciler[*HTTPRoute, gatewayv1.HTTPRoute, *gatewayv1.HTTPRoute](r, "HTTPRoute", newHTTPRoute, func() client.ObjectList { return &gatewayv1.HTTPRouteList{} })
 	assert.NotNil(t, gr)
@@ -366,7 +366,7 @@ func TestGenericRouteReconciler_newRouteReconciler(t *testing.T) {
 	assert.NotNil(t, gr.statusUpdater)
 	assert.NotEqual(t, r.StatusUpdater, gr.statusUpdater)
 
-	// test without DNSRecordService (uses default status updater)
+	// test without NSXConfig (uses default status updater)
 	r2 := &GatewayReconciler{Client: env.client, DNS: mockdns.NewMockDNSRecordProvider(env.ctrl), StatusUpdater: setupMockStatusUpdater(env.ctrl)}
 	gr2 := newRouteReconciler[*HTTPRoute, gatewayv1.HTTPRoute, *gatewayv1.HTTPRoute](r2, "HTTPRoute", newHTTPRoute, func() client.ObjectList { return &gatewayv1.HTTPRouteList{} })
 	assert.Equal(t, r2.StatusUpdater, gr2.statusUpdater)
@@ -374,7 +374,7 @@ func TestGenericRouteReconciler_newRouteReconciler(t *testing.T) {
 
 func TestGatewayReconciler_NewGatewayReconciler(t *testing.T) {
 	c := mockclient.NewMockClient(gomock.NewController(t))
-	r := NewGatewayReconciler(setupMockManager(gomock.NewController(t), c), &dns.DNSRecordService{Service: servicecommon.Service{NSXConfig: &config.NSXOperatorConfig{}}})
+	r := NewGatewayReconciler(setupMockManager(gomock.NewController(t), c), &config.NSXOperatorConfig{}, &dns.DNSRecordService{Service: servicecommon.Service{NSXConfig: &config.NSXOperatorConfig{}}})
 	assert.NotNil(t, r)
 	assert.NotNil(t, r.StatusUpdater)
 }
@@ -0,0 +1,154 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package ingress
+
+import (
+	"context"
+	"time"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/audit"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/dns"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
+)
+
+var (
+	log           = logger.Log
+	ResultNormal  = common.ResultNormal
+	MetricResType = common.MetricResTypeIngress
+)
+
+// IngressReconciler publishes NSX DNS records for networking.k8s.io/v1 Ingress rule hosts.
+type IngressReconciler struct {
+	Client   client.Client
+	Scheme   *apimachineryruntime.Scheme
+	Service  *servicecommon.Service
+	DNS      dns.DNSRecordProvider
+	Recorder record.EventRecorder
+}
+
+func (r *IngressReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ing := &networkingv1.Ingress{}
+	startTime := time.Now()
+	defer func() {
+		log.Info("Finished reconciling Ingress", "Ingress", req.NamespacedName, "duration(ms)", time.Since(startTime).Milliseconds())
+	}()
+
+	if err := r.Client.Get(ctx, req.NamespacedName, ing); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("Not found Ingress", "req", req.NamespacedName)
+			if err := r.deleteDNSForIngress(ctx, req.NamespacedName, "deleted Ingress"); err != nil {
+				return common.ResultRequeueAfter10sec, nil
+			}
+			return ResultNormal, nil
+		}
+		log.Error(err, "Failed to fetch Ingress", "req", req.NamespacedName)
+		return common.ResultRequeueAfter10sec, nil
+	}
+
+	if !ing.ObjectMeta.DeletionTimestamp.IsZero() {
+		metrics.CounterInc(r.Service.NSXConfig, metrics.ControllerDeleteTotal, MetricResType)
+		if err := r.deleteDNSForIngress(ctx, req.NamespacedName, "terminating Ingress"); err != nil {
+			metrics.CounterInc(r.Service.NSXConfig, metrics.ControllerDeleteFailTotal, MetricResType)
+			return common.ResultRequeueAfter10sec, nil
+		}
+		metrics.CounterInc(r.Service.NSXConfig, metrics.ControllerDeleteSuccessTotal, MetricResType)
+		return ResultNormal, nil
+	}
+
+	log.Info("Reconciling Ingress", "Ingress", req.NamespacedName)
+	metrics.CounterInc(r.Service.NSXConfig, metrics.ControllerSyncTotal, MetricResType)
+	metrics.CounterInc(r.Service.NSXConfig, metrics.ControllerUpdateTotal, MetricResType)
+	if err := r.reconcileIngressDNS(ctx, ing); err != nil {
+		log.Error(err, "Failed to reconcile DNS for Ingress", "Name", ing.Name, "Namespace", ing.Namespace)
+		metrics.CounterInc(r.Service.NSXConfig, metrics.ControllerUpdateFailTotal, MetricResType)
+		return common.ResultRequeueAfter10sec, nil
+	}
+	metrics.CounterInc(r.Service.NSXConfig, metrics.ControllerUpdateSuccessTotal, MetricResType)
+	return ResultNormal, nil
+}
+
+// nsxConfig returns nil if the service is not set, the default controller configuration is used in that case.
+func (r *IngressReconciler) nsxConfig() *config.NSXOperatorConfig {
+	if r.Service == nil {
+		return nil
+	}
+	return r.Service.NSXConfig
+}
+
+func (r *IngressReconciler) setupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&networkingv1.Ingress{}).
+		Watches(
+			&v1alpha1.NetworkInfo{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueIngressRequestsFromNetworkInfo),
+			builder.WithPredicates(predicateNetworkInfoAllowedDNSDomainsChanged()),
+		).
+		WithEventFilter(common.VPCNamespacePredicate(r.Client)).
+		WithOptions(
+			controller.Options{
+				MaxConcurrentReconciles: common.NumReconcile(r.nsxConfig(), MetricResType),
+				RateLimiter:             common.RateLimiter(r.nsxConfig(), MetricResType),
+				NewQueue:                common.NewQueue(r.nsxConfig(), MetricResType),
+			}).
+		Complete(common.LicensedReconciler(MetricResType, tracing.Reconciler(MetricResType, audit.Reconciler(r.Client, &networkingv1.Ingress{}, r))))
+}
+
+func (r *IngressReconciler) RestoreReconcile() error {
+	return nil
+}
+
+func (r *IngressReconciler) StartController(mgr ctrl.Manager, _ webhook.Server) error {
+	if err := r.setupWithManager(mgr); err != nil {
+		log.Error(err, "Failed to create controller", "controller", "Ingress")
+		return err
+	}
+	err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		stop := make(chan bool)
+		go func() {
+			<-ctx.Done()
+			close(stop)
+		}()
+		common.GenericGarbageCollector(stop, servicecommon.GCInterval, r.CollectGarbage)
+		return nil
+	}))
+	if err != nil {
+		log.Error(err, "Failed to add Ingress GC to manager")
+		return err
+	}
+	return nil
+}
+
+// NewIngressReconciler returns nil when the DNS record service is not available, since DNS is the only
+// NSX resource realized for an Ingress.
+func NewIngressReconciler(mgr ctrl.Manager, commonService servicecommon.Service, dnsRecordService *dns.DNSRecordService) *IngressReconciler {
+	if dnsRecordService == nil {
+		log.Info("Ingress controller isn't started since the DNS record service is not initialized")
+		return nil
+	}
+	return &IngressReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Service:  &commonService,
+		DNS:      dnsRecordService,
+		Recorder: mgr.GetEventRecorderFor("ingress-controller"), //nolint:staticcheck // record.EventRecorder; StatusUpdater not on events.EventRecorder yet
+	}
+}
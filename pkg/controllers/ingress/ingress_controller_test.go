@@ -0,0 +1,146 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	mockdns "github.com/vmware-tanzu/nsx-operator/pkg/mock/dnsrecordprovider"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/dns"
+)
+
+func ingressTestScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	s := runtime.NewScheme()
+	require.NoError(t, networkingv1.AddToScheme(s))
+	require.NoError(t, v1alpha1.AddToScheme(s))
+	return s
+}
+
+func newFakeIngressReconciler(t *testing.T, provider dns.DNSRecordProvider, objs ...client.Object) *IngressReconciler {
+	scheme := ingressTestScheme(t)
+	r := &IngressReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build(),
+		Scheme: scheme,
+		Service: &servicecommon.Service{
+			NSXConfig: &config.NSXOperatorConfig{
+				CoeConfig: &config.CoeConfig{EnableVPCNetwork: true},
+				NsxConfig: &config.NsxConfig{},
+			},
+		},
+		Recorder: record.NewFakeRecorder(10),
+	}
+	if provider != nil {
+		r.DNS = provider
+	}
+	return r
+}
+
+func newNetworkInfo(ns string, domains []string) *v1alpha1.NetworkInfo {
+	return &v1alpha1.NetworkInfo{
+		ObjectMeta:        metav1.ObjectMeta{Namespace: ns, Name: ns},
+		AllowedDNSDomains: domains,
+	}
+}
+
+func TestIngressReconciler_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns1", Name: "ing"}}
+
+	tests := []struct {
+		name       string
+		objs       []client.Object
+		setupMock  func(m *mockdns.MockDNSRecordProvider)
+		wantResult ctrl.Result
+	}{
+		{
+			name: "not_found_deletes_records",
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindIngress, "ns1", "ing").Return(true, nil).Times(1)
+			},
+			wantResult: ResultNormal,
+		},
+		{
+			name: "not_found_delete_error_requeues",
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindIngress, "ns1", "ing").Return(false, fmt.Errorf("nsx error")).Times(1)
+			},
+			wantResult: common.ResultRequeueAfter10sec,
+		},
+		{
+			name: "terminating_deletes_records",
+			objs: []client.Object{func() client.Object {
+				ing := makeIngress("ns1", "ing", []string{"app.example.com"}, "203.0.113.5")
+				now := metav1.Now()
+				ing.DeletionTimestamp = &now
+				ing.Finalizers = []string{"test"}
+				return ing
+			}()},
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindIngress, "ns1", "ing").Return(true, nil).Times(1)
+			},
+			wantResult: ResultNormal,
+		},
+		{
+			name: "dns_build_error_requeues",
+			objs: []client.Object{makeIngress("ns1", "ing", []string{"app.example.com"}, "203.0.113.5")},
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().ValidateEndpointsByZone(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil, fmt.Errorf("vpc not ready")).Times(1)
+			},
+			wantResult: common.ResultRequeueAfter10sec,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtl := gomock.NewController(t)
+			m := mockdns.NewMockDNSRecordProvider(mockCtl)
+			tt.setupMock(m)
+			r := newFakeIngressReconciler(t, m, tt.objs...)
+			result, err := r.Reconcile(ctx, req)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantResult, result)
+		})
+	}
+}
+
+func TestPredicateNetworkInfoAllowedDNSDomainsChanged(t *testing.T) {
+	p := predicateNetworkInfoAllowedDNSDomainsChanged()
+
+	assert.False(t, p.Create(event.CreateEvent{}))
+	assert.False(t, p.Delete(event.DeleteEvent{}))
+	assert.False(t, p.Generic(event.GenericEvent{}))
+
+	assert.False(t, p.Update(event.UpdateEvent{ObjectOld: &networkingv1.Ingress{}, ObjectNew: &networkingv1.Ingress{}}))
+	assert.False(t, p.Update(event.UpdateEvent{ObjectOld: newNetworkInfo("ns", []string{"a"}), ObjectNew: newNetworkInfo("ns", []string{"a"})}))
+	assert.True(t, p.Update(event.UpdateEvent{ObjectOld: newNetworkInfo("ns", []string{"a"}), ObjectNew: newNetworkInfo("ns", []string{"b"})}))
+}
+
+func TestIngressReconciler_RestoreReconcile(t *testing.T) {
+	r := &IngressReconciler{}
+	assert.NoError(t, r.RestoreReconcile())
+}
+
+func TestNewIngressReconciler_nilDNS(t *testing.T) {
+	assert.Nil(t, NewIngressReconciler(nil, servicecommon.Service{}, nil))
+}
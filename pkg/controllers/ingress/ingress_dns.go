@@ -0,0 +1,245 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package ingress
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/dns"
+	extdns "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/endpoint"
+)
+
+const (
+	reasonIngressDNSRecordConfigured = "DNSRecordConfigured"
+	reasonIngressDNSRecordFailed     = "DNSRecordFailed"
+)
+
+// hostnamesFromIngressRules returns the unique, lower-cased hosts from Ingress spec.rules[].host.
+// Ingresses with the dns.nsx.vmware.com/skip annotation publish no DNS records.
+func hostnamesFromIngressRules(ing *networkingv1.Ingress) []string {
+	if _, ok := ing.GetAnnotations()[servicecommon.AnnotationsDNSSkip]; ok {
+		return nil
+	}
+	seen := sets.New[string]()
+	for i := range ing.Spec.Rules {
+		h := strings.ToLower(strings.TrimSpace(ing.Spec.Rules[i].Host))
+		if h == "" {
+			continue
+		}
+		seen.Insert(h)
+	}
+	return sets.List(seen)
+}
+
+// targetsFromIngressLoadBalancer collects IP and Hostname values from Ingress.Status.LoadBalancer.Ingress.
+func targetsFromIngressLoadBalancer(ingress []networkingv1.IngressLoadBalancerIngress) extdns.Targets {
+	vals := make([]string, 0, len(ingress)*2)
+	for i := range ingress {
+		ing := ingress[i]
+		if ip := strings.TrimSpace(ing.IP); ip != "" {
+			vals = append(vals, ip)
+		}
+		if hn := strings.TrimSpace(ing.Hostname); hn != "" {
+			vals = append(vals, hn)
+		}
+	}
+	return extdns.NewTargets(vals...)
+}
+
+// buildIngressDNSBatch builds owner-scoped DNS rows for an Ingress: rule hosts, targets from the Ingress
+// load balancer status, then ValidateEndpointsByZone for namespace VPC policy.
+func buildIngressDNSBatch(ing *networkingv1.Ingress, w dns.DNSRecordProvider) (*dns.AggregatedDNSEndpoints, error) {
+	hostnames := hostnamesFromIngressRules(ing)
+	if len(hostnames) == 0 {
+		return nil, nil
+	}
+	targets := targetsFromIngressLoadBalancer(ing.Status.LoadBalancer.Ingress)
+	if len(targets) == 0 {
+		log.Debug("Ingress has rule hosts but no load balancer targets yet", "namespace", ing.Namespace, "name", ing.Name)
+		return nil, nil
+	}
+	log.Debug("Building DNS batch for Ingress", "namespace", ing.Namespace, "name", ing.Name,
+		"hostnames", len(hostnames), "targets", len(targets))
+	ttl := extdns.TTL(0)
+	var eps []*extdns.Endpoint
+	for _, h := range hostnames {
+		for _, ep := range extdns.EndpointsForHostname(h, targets, ttl) {
+			if ep == nil {
+				log.Info("Skipping invalid DNS hostname", "hostname", h, "namespace", ing.Namespace, "name", ing.Name)
+				continue
+			}
+			eps = append(eps, ep)
+		}
+	}
+	if len(eps) == 0 {
+		return nil, nil
+	}
+	owner := &dns.ResourceRef{Kind: dns.ResourceKindIngress, Object: ing.GetObjectMeta()}
+	rows, _, err := w.ValidateEndpointsByZone(ing.Namespace, owner, eps)
+	if len(rows) == 0 {
+		return nil, err
+	}
+	log.Info("DNS batch built for Ingress", "namespace", ing.Namespace, "name", ing.Name, "rows", len(rows))
+	return dns.NewOwnerScopedAggregatedRouteDNS(owner, rows), err
+}
+
+// reconcileIngressDNS applies DNS rows for the Ingress. Ingress has no status conditions, so the outcome is
+// reported with Events on the Ingress object.
+func (r *IngressReconciler) reconcileIngressDNS(ctx context.Context, ing *networkingv1.Ingress) error {
+	ingNN := types.NamespacedName{Namespace: ing.Namespace, Name: ing.Name}
+	log.Info("Reconciling DNS for Ingress", "Ingress", ingNN)
+	batch, err := buildIngressDNSBatch(ing, r.DNS)
+	if err != nil {
+		var zoneValErr *dns.DNSZoneValidationError
+		if errors.As(err, &zoneValErr) {
+			log.Error(err, "Failed to validate DNS records for Ingress with the allowed DNS zones", "Ingress", ingNN)
+			r.Recorder.Event(ing, v1.EventTypeWarning, reasonIngressDNSRecordFailed, err.Error())
+			// If there are valid rows, we should still apply them
+			if batch != nil && len(batch.Rows) > 0 {
+				if _, uErr := r.DNS.CreateOrUpdateRecords(ctx, batch); uErr != nil {
+					log.Error(uErr, "Failed to reconcile valid DNS records despite validation errors", "Ingress", ingNN)
+					return uErr
+				}
+			} else if err := r.deleteDNSForIngress(ctx, ingNN, "invalid DNS zone"); err != nil {
+				return err
+			}
+			// For validation errors, we do not automatically requeue. We wait for the user to update the Ingress.
+			return nil
+		}
+		log.Error(err, "Failed to build DNS endpoints for Ingress", "Ingress", ingNN)
+		r.Recorder.Event(ing, v1.EventTypeWarning, reasonIngressDNSRecordFailed, err.Error())
+		return err
+	}
+
+	if batch == nil || len(batch.Rows) == 0 {
+		return r.deleteDNSForIngress(ctx, ingNN, "stale DNS records")
+	}
+
+	updated, uErr := r.DNS.CreateOrUpdateRecords(ctx, batch)
+	if uErr != nil {
+		log.Error(uErr, "Failed to reconcile DNS records", "Ingress", ingNN)
+		r.Recorder.Event(ing, v1.EventTypeWarning, reasonIngressDNSRecordFailed, uErr.Error())
+		return uErr
+	}
+	if updated {
+		r.Recorder.Event(ing, v1.EventTypeNormal, reasonIngressDNSRecordConfigured, "DNS records for Ingress have been successfully configured")
+	}
+	return nil
+}
+
+func (r *IngressReconciler) deleteDNSForIngress(ctx context.Context, ingNN types.NamespacedName, op string) error {
+	if _, err := r.DNS.DeleteRecordByOwnerNN(ctx, dns.ResourceKindIngress, ingNN.Namespace, ingNN.Name); err != nil {
+		log.Error(err, "Failed to delete DNS records for Ingress", "Namespace", ingNN.Namespace, "Name", ingNN.Name, "Operation", op)
+		return fmt.Errorf("deleting DNS records for %s: %w", op, err)
+	}
+	return nil
+}
+
+// getIngressesWithDNS returns Ingresses that should have DNS records.
+func getIngressesWithDNS(ctx context.Context, c client.Client, listOpts ...client.ListOption) ([]networkingv1.Ingress, error) {
+	ingList := &networkingv1.IngressList{}
+	if err := c.List(ctx, ingList, listOpts...); err != nil {
+		return nil, err
+	}
+	var filtered []networkingv1.Ingress
+	for i := range ingList.Items {
+		ing := ingList.Items[i]
+		if !ing.ObjectMeta.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if len(hostnamesFromIngressRules(&ing)) == 0 {
+			continue
+		}
+		filtered = append(filtered, ing)
+	}
+	return filtered, nil
+}
+
+// enqueueIngressRequestsFromNetworkInfo requeues Ingresses that publish DNS when namespace AllowedDNSDomains change.
+func (r *IngressReconciler) enqueueIngressRequestsFromNetworkInfo(ctx context.Context, obj client.Object) []reconcile.Request {
+	ni, ok := obj.(*v1alpha1.NetworkInfo)
+	if !ok || ni == nil {
+		return nil
+	}
+	ings, err := getIngressesWithDNS(ctx, r.Client, client.InNamespace(ni.Namespace))
+	if err != nil {
+		log.Error(err, "Failed to list Ingresses for NetworkInfo DNS domain change", "Namespace", ni.Namespace)
+		return nil
+	}
+	var reqs []reconcile.Request
+	for _, ing := range ings {
+		reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: ing.Namespace, Name: ing.Name}})
+	}
+	return reqs
+}
+
+func predicateNetworkInfoAllowedDNSDomainsChanged() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(event.DeleteEvent) bool {
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldNI, ok1 := e.ObjectOld.(*v1alpha1.NetworkInfo)
+			newNI, ok2 := e.ObjectNew.(*v1alpha1.NetworkInfo)
+			if !ok1 || !ok2 {
+				return false
+			}
+			return !slices.Equal(oldNI.AllowedDNSDomains, newNI.AllowedDNSDomains)
+		},
+		GenericFunc: func(event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
+// CollectGarbage deletes DNS records whose owning Ingress is gone or no longer publishes DNS.
+func (r *IngressReconciler) CollectGarbage(ctx context.Context) error {
+	if r.DNS == nil {
+		return nil
+	}
+	apiSet := sets.New[types.NamespacedName]()
+	ings, err := getIngressesWithDNS(ctx, r.Client)
+	if err != nil {
+		log.Error(err, "Ingress GC: failed to list Ingresses")
+		return err
+	}
+	for _, ing := range ings {
+		apiSet.Insert(types.NamespacedName{Namespace: ing.Namespace, Name: ing.Name})
+	}
+	ownersByKind := r.DNS.ListRecordOwnerResource()
+	var errs []error
+	for nn := range ownersByKind[dns.ResourceKindIngress] {
+		if apiSet.Has(nn) {
+			continue
+		}
+		if err := r.deleteDNSForIngress(ctx, nn, "GC: missing or ineligible Ingress owner"); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("ingress garbage collection encountered %d error(s): %w", len(errs), errors.Join(errs...))
+	}
+	return nil
+}
+
+var _ common.GarbageCollector = (*IngressReconciler)(nil)
@@ -0,0 +1,244 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	mockdns "github.com/vmware-tanzu/nsx-operator/pkg/mock/dnsrecordprovider"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/dns"
+	extdns "github.com/vmware-tanzu/nsx-operator/pkg/third_party/externaldns/endpoint"
+)
+
+// stubValidatedRows mimics ValidateEndpointsByZone for *.example.com under /zones/t.
+func stubValidatedRows(eps []*extdns.Endpoint) ([]dns.EndpointRow, map[string]string, error) {
+	const zpath = "/orgs/org1/projects/proj1/dns-services/dns1/zones/t"
+	var rows []dns.EndpointRow
+	for _, ep := range eps {
+		if ep == nil {
+			continue
+		}
+		dn := strings.ToLower(strings.TrimSpace(ep.DNSName))
+		if !strings.HasSuffix(dn, ".example.com") {
+			return nil, nil, fmt.Errorf("hostname %q does not match stub allowed domain", ep.DNSName)
+		}
+		rel := strings.TrimPrefix(strings.TrimSuffix(dn, ".example.com"), ".")
+		rows = append(rows, *dns.NewEndpointRow(ep, zpath, rel))
+	}
+	return rows, map[string]string{zpath: "example.com"}, nil
+}
+
+func makeIngress(ns, name string, hosts []string, ips ...string) *networkingv1.Ingress {
+	ing := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: name, UID: types.UID(name + "-uid")},
+	}
+	for _, h := range hosts {
+		ing.Spec.Rules = append(ing.Spec.Rules, networkingv1.IngressRule{Host: h})
+	}
+	for _, ip := range ips {
+		ing.Status.LoadBalancer.Ingress = append(ing.Status.LoadBalancer.Ingress, networkingv1.IngressLoadBalancerIngress{IP: ip})
+	}
+	return ing
+}
+
+func TestHostnamesFromIngressRules(t *testing.T) {
+	tests := []struct {
+		name string
+		ing  *networkingv1.Ingress
+		want []string
+	}{
+		{
+			name: "dedupe_and_lowercase",
+			ing:  makeIngress("ns", "ing", []string{"App.example.com", "app.example.com", "", "api.example.com"}),
+			want: []string{"api.example.com", "app.example.com"},
+		},
+		{
+			name: "no_rules",
+			ing:  makeIngress("ns", "ing", nil),
+			want: nil,
+		},
+		{
+			name: "skip_annotation",
+			ing: func() *networkingv1.Ingress {
+				ing := makeIngress("ns", "ing", []string{"app.example.com"})
+				ing.Annotations = map[string]string{servicecommon.AnnotationsDNSSkip: "true"}
+				return ing
+			}(),
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := hostnamesFromIngressRules(tt.ing)
+			if tt.want == nil {
+				require.Empty(t, got)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTargetsFromIngressLoadBalancer(t *testing.T) {
+	got := targetsFromIngressLoadBalancer([]networkingv1.IngressLoadBalancerIngress{
+		{IP: "10.0.0.1"},
+		{Hostname: "lb.vendor.example"},
+		{IP: " "},
+	})
+	assert.ElementsMatch(t, []string{"10.0.0.1", "lb.vendor.example"}, []string(got))
+	require.Empty(t, targetsFromIngressLoadBalancer(nil))
+}
+
+func TestReconcileIngressDNS(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		ing       *networkingv1.Ingress
+		setupMock func(m *mockdns.MockDNSRecordProvider)
+		wantErr   bool
+	}{
+		{
+			name: "hosts_with_ip_publishes",
+			ing:  makeIngress("ns1", "ing", []string{"app.example.com"}, "203.0.113.5"),
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().ValidateEndpointsByZone("ns1", gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ string, owner *dns.ResourceRef, eps []*extdns.Endpoint) ([]dns.EndpointRow, map[string]string, error) {
+						assert.Equal(t, dns.ResourceKindIngress, owner.Kind)
+						return stubValidatedRows(eps)
+					}).Times(1)
+				m.EXPECT().CreateOrUpdateRecords(gomock.Any(), gomock.Any()).Return(true, nil).Times(1)
+			},
+		},
+		{
+			name: "no_lb_address_deletes_stale",
+			ing:  makeIngress("ns1", "ing", []string{"app.example.com"}),
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindIngress, "ns1", "ing").Return(false, nil).Times(1)
+			},
+		},
+		{
+			name: "zone_validation_error_deletes_and_does_not_fail",
+			ing:  makeIngress("ns1", "ing", []string{"app.other.com"}, "203.0.113.5"),
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().ValidateEndpointsByZone(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+					nil, nil, &dns.DNSZoneValidationError{Msg: "zone mismatch"}).Times(1)
+				m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindIngress, "ns1", "ing").Return(true, nil).Times(1)
+			},
+		},
+		{
+			name: "non_zone_validation_error_returned",
+			ing:  makeIngress("ns1", "ing", []string{"app.example.com"}, "203.0.113.5"),
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().ValidateEndpointsByZone(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+					nil, nil, fmt.Errorf("vpc not ready")).Times(1)
+			},
+			wantErr: true,
+		},
+		{
+			name: "create_records_error_returned",
+			ing:  makeIngress("ns1", "ing", []string{"app.example.com"}, "203.0.113.5"),
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().ValidateEndpointsByZone(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ string, _ *dns.ResourceRef, eps []*extdns.Endpoint) ([]dns.EndpointRow, map[string]string, error) {
+						return stubValidatedRows(eps)
+					}).Times(1)
+				m.EXPECT().CreateOrUpdateRecords(gomock.Any(), gomock.Any()).Return(false, fmt.Errorf("nsx error")).Times(1)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtl := gomock.NewController(t)
+			m := mockdns.NewMockDNSRecordProvider(mockCtl)
+			tt.setupMock(m)
+			r := newFakeIngressReconciler(t, m)
+			err := r.reconcileIngressDNS(ctx, tt.ing)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIngressReconciler_CollectGarbage(t *testing.T) {
+	ctx := context.Background()
+	keepNN := types.NamespacedName{Namespace: "ns", Name: "keep"}
+	staleNN := types.NamespacedName{Namespace: "ns", Name: "stale"}
+
+	tests := []struct {
+		name      string
+		setupMock func(m *mockdns.MockDNSRecordProvider)
+		wantErr   bool
+	}{
+		{
+			name: "prunes_stale_ingress_owners",
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().ListRecordOwnerResource().Return(map[string]sets.Set[types.NamespacedName]{
+					dns.ResourceKindIngress: sets.New(keepNN, staleNN),
+					dns.ResourceKindService: sets.New(staleNN),
+				}).Times(1)
+				m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindIngress, staleNN.Namespace, staleNN.Name).Return(true, nil).Times(1)
+			},
+		},
+		{
+			name: "delete_error_returned",
+			setupMock: func(m *mockdns.MockDNSRecordProvider) {
+				m.EXPECT().ListRecordOwnerResource().Return(map[string]sets.Set[types.NamespacedName]{
+					dns.ResourceKindIngress: sets.New(staleNN),
+				}).Times(1)
+				m.EXPECT().DeleteRecordByOwnerNN(gomock.Any(), dns.ResourceKindIngress, staleNN.Namespace, staleNN.Name).Return(false, fmt.Errorf("mock error")).Times(1)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtl := gomock.NewController(t)
+			m := mockdns.NewMockDNSRecordProvider(mockCtl)
+			tt.setupMock(m)
+			r := newFakeIngressReconciler(t, m, makeIngress("ns", "keep", []string{"app.example.com"}, "203.0.113.5"))
+			err := r.CollectGarbage(ctx)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("nil_dns_is_noop", func(t *testing.T) {
+		r := &IngressReconciler{}
+		require.NoError(t, r.CollectGarbage(ctx))
+	})
+}
+
+func TestEnqueueIngressRequestsFromNetworkInfo(t *testing.T) {
+	r := newFakeIngressReconciler(t, nil,
+		makeIngress("ns1", "with-host", []string{"app.example.com"}),
+		makeIngress("ns1", "no-host", nil),
+		makeIngress("ns2", "other-ns", []string{"app.example.com"}),
+	)
+	reqs := r.enqueueIngressRequestsFromNetworkInfo(context.Background(), newNetworkInfo("ns1", nil))
+	require.Len(t, reqs, 1)
+	assert.Equal(t, types.NamespacedName{Namespace: "ns1", Name: "with-host"}, reqs[0].NamespacedName)
+
+	require.Nil(t, r.enqueueIngressRequestsFromNetworkInfo(context.Background(), &networkingv1.Ingress{}))
+}
@@ -0,0 +1,118 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/tools/cache"
+	ctrl "sigs.k8s.io/controller-runtime"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/inventory"
+)
+
+func watchGateway(c *InventoryController, mgr ctrl.Manager) error {
+	gatewayInformer, err := mgr.GetCache().GetInformer(context.Background(), &gatewayv1.Gateway{})
+	// The Gateway API CRDs are optional.
+	if meta.IsNoMatchError(err) {
+		log.Info("Gateway CRD is not installed, skip Gateway inventory")
+		return nil
+	}
+	if err != nil {
+		log.Error(err, "Failed to create Gateway informer")
+		return err
+	}
+
+	_, err = gatewayInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			// Handle Gateway add event
+			c.handleGateway(obj)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			// Handle Gateway update event
+			c.handleGateway(newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			// Handle Gateway delete event
+			c.handleGateway(obj)
+		},
+	})
+	if err != nil {
+		log.Error(err, "Failed to add Gateway event handler")
+		return err
+	}
+	return nil
+}
+
+func watchHTTPRoute(c *InventoryController, mgr ctrl.Manager) error {
+	routeInformer, err := mgr.GetCache().GetInformer(context.Background(), &gatewayv1.HTTPRoute{})
+	if meta.IsNoMatchError(err) {
+		log.Info("HTTPRoute CRD is not installed, skip HTTPRoute inventory")
+		return nil
+	}
+	if err != nil {
+		log.Error(err, "Failed to create HTTPRoute informer")
+		return err
+	}
+
+	_, err = routeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			// Handle HTTPRoute add event
+			c.handleHTTPRoute(obj)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			// Handle HTTPRoute update event
+			c.handleHTTPRoute(newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			// Handle HTTPRoute delete event
+			c.handleHTTPRoute(obj)
+		},
+	})
+	if err != nil {
+		log.Error(err, "Failed to add HTTPRoute event handler")
+		return err
+	}
+	return nil
+}
+
+func (c *InventoryController) handleGateway(obj interface{}) {
+	var gateway *gatewayv1.Gateway
+	ok := false
+	switch obj1 := obj.(type) {
+	case *gatewayv1.Gateway:
+		gateway = obj1
+	case cache.DeletedFinalStateUnknown:
+		gateway, ok = obj1.Obj.(*gatewayv1.Gateway)
+		if !ok {
+			err := fmt.Errorf("obj is not valid *gatewayv1.Gateway")
+			log.Error(err, "DeletedFinalStateUnknown Obj is not *gatewayv1.Gateway")
+			return
+		}
+	}
+	log.Debug("Inventory processing Gateway", "Namespace", gateway.Namespace, "Name", gateway.Name)
+	key, _ := keyFunc(gateway)
+	log.Debug("Adding Gateway key to inventory object queue", "Gateway key", key)
+	c.inventoryObjectQueue.Add(inventory.InventoryKey{InventoryType: inventory.ContainerGateway, ExternalId: string(gateway.UID), Key: key})
+}
+
+func (c *InventoryController) handleHTTPRoute(obj interface{}) {
+	var route *gatewayv1.HTTPRoute
+	ok := false
+	switch obj1 := obj.(type) {
+	case *gatewayv1.HTTPRoute:
+		route = obj1
+	case cache.DeletedFinalStateUnknown:
+		route, ok = obj1.Obj.(*gatewayv1.HTTPRoute)
+		if !ok {
+			err := fmt.Errorf("obj is not valid *gatewayv1.HTTPRoute")
+			log.Error(err, "DeletedFinalStateUnknown Obj is not *gatewayv1.HTTPRoute")
+			return
+		}
+	}
+	log.Debug("Inventory processing HTTPRoute", "Namespace", route.Namespace, "Name", route.Name)
+	key, _ := keyFunc(route)
+	log.Debug("Adding HTTPRoute key to inventory object queue", "HTTPRoute key", key)
+	c.inventoryObjectQueue.Add(inventory.InventoryKey{InventoryType: inventory.ContainerHTTPRoute, ExternalId: string(route.UID), Key: key})
+}
@@ -0,0 +1,85 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/inventory"
+)
+
+func TestWatchGateway(t *testing.T) {
+	t.Run("SuccessfullyCreateInformer", func(t *testing.T) {
+		controller := &InventoryController{}
+		mockCache := new(MockCache)
+		mockCache.On("GetInformer", context.Background(), &gatewayv1.Gateway{}).Return(&MockInformer{}, nil)
+		mockCache.On("GetInformer", context.Background(), &gatewayv1.HTTPRoute{}).Return(&MockInformer{}, nil)
+		mgr := new(MockMgr)
+		mgr.On("GetCache").Return(mockCache)
+		assert.Nil(t, watchGateway(controller, mgr))
+		assert.Nil(t, watchHTTPRoute(controller, mgr))
+	})
+
+	t.Run("CRDNotInstalled", func(t *testing.T) {
+		controller := &InventoryController{}
+		mockCache := new(MockCache)
+		mockCache.On("GetInformer", context.Background(), &gatewayv1.Gateway{}).Return(nil, &meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: gatewayv1.GroupName, Kind: "Gateway"}})
+		mockCache.On("GetInformer", context.Background(), &gatewayv1.HTTPRoute{}).Return(nil, &meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: gatewayv1.GroupName, Kind: "HTTPRoute"}})
+		mgr := new(MockMgr)
+		mgr.On("GetCache").Return(mockCache)
+		assert.Nil(t, watchGateway(controller, mgr))
+		assert.Nil(t, watchHTTPRoute(controller, mgr))
+	})
+
+	t.Run("CreateInformerFailure", func(t *testing.T) {
+		controller := &InventoryController{}
+		mockCache := new(MockCache)
+		mockCache.On("GetInformer", context.Background(), &gatewayv1.Gateway{}).Return(nil, errors.New("connection timeout"))
+		mockCache.On("GetInformer", context.Background(), &gatewayv1.HTTPRoute{}).Return(nil, errors.New("connection timeout"))
+		mgr := new(MockMgr)
+		mgr.On("GetCache").Return(mockCache)
+		assert.ErrorContains(t, watchGateway(controller, mgr), "connection timeout")
+		assert.ErrorContains(t, watchHTTPRoute(controller, mgr), "connection timeout")
+	})
+}
+
+func TestHandleGateway(t *testing.T) {
+	cfg := &config.NSXOperatorConfig{NsxConfig: &config.NsxConfig{}}
+	queue := MockObjectQueue[any]{}
+	controller := &InventoryController{
+		service:              &inventory.InventoryService{},
+		keyBuffer:            sets.New[inventory.InventoryKey](),
+		cf:                   cfg,
+		inventoryObjectQueue: &queue}
+
+	t.Run("NormalGateway", func(t *testing.T) {
+		gateway := &gatewayv1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "gw1", UID: "gw-uid"}}
+		queue.On("Add", inventory.InventoryKey{InventoryType: inventory.ContainerGateway, ExternalId: "gw-uid", Key: "ns1/gw1"}).Return().Once()
+		controller.handleGateway(gateway)
+		queue.AssertExpectations(t)
+	})
+
+	t.Run("DeletedHTTPRoute", func(t *testing.T) {
+		route := &gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "route1", UID: "route-uid"}}
+		queue.On("Add", inventory.InventoryKey{InventoryType: inventory.ContainerHTTPRoute, ExternalId: "route-uid", Key: "ns1/route1"}).Return().Once()
+		controller.handleHTTPRoute(cache.DeletedFinalStateUnknown{Obj: route})
+		queue.AssertExpectations(t)
+	})
+
+	t.Run("DeletedStateWithInvalidObject", func(t *testing.T) {
+		queue = MockObjectQueue[any]{}
+		controller.inventoryObjectQueue = &queue
+		controller.handleGateway(cache.DeletedFinalStateUnknown{Obj: "deleted Gateway"})
+		controller.handleHTTPRoute(cache.DeletedFinalStateUnknown{Obj: "deleted HTTPRoute"})
+		queue.AssertExpectations(t)
+	})
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/config"
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
 	commonservice "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/inventory"
 )
@@ -45,6 +46,9 @@ var (
 		watchIngress,
 		watchNode,
 		watchNetworkPolicy,
+		watchVirtualMachine,
+		watchGateway,
+		watchHTTPRoute,
 	}
 )
 
@@ -55,6 +59,9 @@ type InventoryController struct {
 	keyBuffer            sets.Set[inventory.InventoryKey]
 	inventoryMutex       sync.Mutex
 	cf                   *config.NSXOperatorConfig
+	// batchSize is the current batch size, it is halved on NSX errors and doubled on success up to
+	// InventoryBatchSize. 0 means InventoryBatchSize.
+	batchSize int
 }
 
 func NewInventoryController(Client client.Client, service *inventory.InventoryService, cf *config.NSXOperatorConfig) *InventoryController {
@@ -107,6 +114,9 @@ func (c *InventoryController) Run(stopCh <-chan struct{}) {
 	go wait.Until(c.inventoryWorker, time.Second, stopCh)
 	go wait.Until(c.inventoryTimeWorker, time.Second*time.Duration(c.cf.InventoryBatchPeriod), stopCh)
 	go wait.JitterUntil(c.inventoryGCWorker, commonservice.GCInterval, inventoryGCJitterFactor, true, stopCh)
+	if c.cf.InventoryResyncPeriod > 0 {
+		go wait.JitterUntil(c.inventoryResyncWorker, time.Second*time.Duration(c.cf.InventoryResyncPeriod), inventoryGCJitterFactor, true, stopCh)
+	}
 
 	<-stopCh
 }
@@ -128,6 +138,24 @@ func (c *InventoryController) inventoryGCWorker() {
 	}
 }
 
+func (c *InventoryController) inventoryResyncWorker() {
+	// Listing all the inventory objects from NSX takes long, it is done without the lock to not block the incremental
+	// inventory updates.
+	nsxService, err := c.service.ListNSXInventoryObjects()
+	if err != nil {
+		log.Error(err, "Failed to list inventory objects from NSX for resync")
+		return
+	}
+	defer c.inventoryMutex.Unlock()
+	c.inventoryMutex.Lock()
+	resynced, err := c.service.ResyncInventoryObjects(context.Background(), nsxService)
+	if err != nil {
+		log.Error(err, "Failed to resync inventory objects with NSX")
+		return
+	}
+	log.Info("Resynced inventory objects with NSX", "resynced", resynced)
+}
+
 func (c *InventoryController) inventoryWorker() {
 	for c.processNextInventoryWorkItem() {
 	}
@@ -142,7 +170,8 @@ func (c *InventoryController) processNextInventoryWorkItem() bool {
 	defer c.inventoryMutex.Unlock()
 	c.inventoryMutex.Lock()
 	c.keyBuffer.Insert(key.(inventory.InventoryKey))
-	if len(c.keyBuffer) >= c.cf.InventoryBatchSize {
+	metrics.InventoryQueueDepth.Set(float64(c.inventoryObjectQueue.Len()))
+	if len(c.keyBuffer) >= c.currentBatchSize() {
 		c.syncInventoryKeys()
 	}
 	return true
@@ -155,18 +184,22 @@ func (c *InventoryController) syncInventoryKeys() {
 			c.inventoryObjectQueue.Done(key)
 		}
 		c.keyBuffer = sets.New[inventory.InventoryKey]()
+		metrics.InventoryQueueDepth.Set(float64(c.inventoryObjectQueue.Len()))
 	}()
 
 	if len(c.keyBuffer) >= 0 {
+		metrics.InventoryBatchSize.Observe(float64(len(c.keyBuffer)))
 		retryKeys, err := c.service.SyncInventoryObject(c.keyBuffer)
 		if err != nil {
 			log.Error(err, "Failed to sync inventory object to NSX")
 		}
+		c.adjustBatchSize(err)
 		for key := range c.keyBuffer {
 			// For retry keys, the item is put back on the queue and attempted again after a back-off period.
 			// For others, forget here stop the rate limiter from tracking it.
 			if retryKeys.Has(key) {
 				c.inventoryObjectQueue.AddRateLimited(key)
+				metrics.InventoryRetryTotal.WithLabelValues(string(key.InventoryType)).Inc()
 				log.Info("Enqueue key for retrying", "key", key)
 			} else {
 				c.inventoryObjectQueue.Forget(key)
@@ -175,6 +208,29 @@ func (c *InventoryController) syncInventoryKeys() {
 	}
 }
 
+func (c *InventoryController) currentBatchSize() int {
+	if c.batchSize > 0 && c.batchSize < c.cf.InventoryBatchSize {
+		return c.batchSize
+	}
+	return c.cf.InventoryBatchSize
+}
+
+// adjustBatchSize halves the batch size when the NSX request fails to lower the load on NSX, and doubles it back
+// up to InventoryBatchSize when the request succeeds.
+func (c *InventoryController) adjustBatchSize(err error) {
+	batchSize := c.currentBatchSize()
+	if err != nil {
+		batchSize = max(batchSize/2, 1)
+	} else {
+		batchSize = min(batchSize*2, c.cf.InventoryBatchSize)
+	}
+	if batchSize != c.currentBatchSize() {
+		log.Info("Adjust inventory batch size", "batchSize", batchSize, "error", err)
+	}
+	c.batchSize = batchSize
+	metrics.InventoryBatchSizeLimit.Set(float64(batchSize))
+}
+
 func (c *InventoryController) CleanStaleInventoryObjects() error {
 	log.Info("Clean stale inventory objects")
 	err := c.service.CleanStaleInventoryApplicationInstance()
@@ -201,6 +257,14 @@ func (c *InventoryController) CleanStaleInventoryObjects() error {
 	if err != nil {
 		return err
 	}
+	err = c.service.CleanStaleInventoryVirtualMachine()
+	if err != nil {
+		return err
+	}
+	err = c.service.CleanStaleInventoryGateway()
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
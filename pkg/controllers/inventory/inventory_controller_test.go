@@ -273,6 +273,12 @@ func TestCleanStaleInventoryObjects(t *testing.T) {
 		patches.ApplyMethod(reflect.TypeOf(mockService), "CleanStaleInventoryNetworkPolicy", func(_ *inventory.InventoryService) error {
 			return nil
 		})
+		patches.ApplyMethod(reflect.TypeOf(mockService), "CleanStaleInventoryVirtualMachine", func(_ *inventory.InventoryService) error {
+			return nil
+		})
+		patches.ApplyMethod(reflect.TypeOf(mockService), "CleanStaleInventoryGateway", func(_ *inventory.InventoryService) error {
+			return nil
+		})
 
 		defer patches.Reset()
 
@@ -358,3 +364,53 @@ func TestCleanStaleInventoryObjects(t *testing.T) {
 		assert.Equal(t, "ingress policy cleanup error", err.Error())
 	})
 }
+
+func TestAdjustBatchSize(t *testing.T) {
+	cfg := &config.NSXOperatorConfig{NsxConfig: &config.NsxConfig{}}
+	cfg.InventoryBatchSize = 8
+	controller := &InventoryController{cf: cfg}
+	assert.Equal(t, 8, controller.currentBatchSize())
+
+	for _, expected := range []int{4, 2, 1, 1} {
+		controller.adjustBatchSize(errors.New("nsx error"))
+		assert.Equal(t, expected, controller.currentBatchSize())
+	}
+	for _, expected := range []int{2, 4, 8, 8} {
+		controller.adjustBatchSize(nil)
+		assert.Equal(t, expected, controller.currentBatchSize())
+	}
+
+	// The batch size follows a smaller InventoryBatchSize.
+	controller.adjustBatchSize(errors.New("nsx error"))
+	cfg.InventoryBatchSize = 2
+	assert.Equal(t, 2, controller.currentBatchSize())
+}
+
+func TestInventoryResyncWorker(t *testing.T) {
+	controller := &InventoryController{service: &inventory.InventoryService{}}
+	called := 0
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(controller.service), "ListNSXInventoryObjects", func(_ *inventory.InventoryService) (*inventory.InventoryService, error) {
+		// The incremental inventory updates are not blocked while listing from NSX.
+		assert.True(t, controller.inventoryMutex.TryLock())
+		controller.inventoryMutex.Unlock()
+		return &inventory.InventoryService{}, nil
+	})
+	defer patches.Reset()
+	patches.ApplyMethod(reflect.TypeOf(controller.service), "ResyncInventoryObjects", func(_ *inventory.InventoryService, _ context.Context, _ *inventory.InventoryService) (int, error) {
+		called++
+		assert.False(t, controller.inventoryMutex.TryLock())
+		return 0, errors.New("resync error")
+	})
+	controller.inventoryResyncWorker()
+	assert.Equal(t, 1, called)
+	// The mutex is released after the resync fails.
+	assert.True(t, controller.inventoryMutex.TryLock())
+	controller.inventoryMutex.Unlock()
+
+	// The stores are not resynced if listing from NSX fails.
+	patches.ApplyMethod(reflect.TypeOf(controller.service), "ListNSXInventoryObjects", func(_ *inventory.InventoryService) (*inventory.InventoryService, error) {
+		return nil, errors.New("list error")
+	})
+	controller.inventoryResyncWorker()
+	assert.Equal(t, 1, called)
+}
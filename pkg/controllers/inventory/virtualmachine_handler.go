@@ -0,0 +1,65 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+
+	vmv1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/tools/cache"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/inventory"
+)
+
+func watchVirtualMachine(c *InventoryController, mgr ctrl.Manager) error {
+	vmInformer, err := mgr.GetCache().GetInformer(context.Background(), &vmv1alpha1.VirtualMachine{})
+	if meta.IsNoMatchError(err) {
+		log.Info("VirtualMachine CRD is not installed, skip VirtualMachine inventory")
+		return nil
+	}
+	if err != nil {
+		log.Error(err, "Failed to create VirtualMachine informer")
+		return err
+	}
+
+	_, err = vmInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			// Handle VirtualMachine add event
+			c.handleVirtualMachine(obj)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			// Handle VirtualMachine update event
+			c.handleVirtualMachine(newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			// Handle VirtualMachine delete event
+			c.handleVirtualMachine(obj)
+		},
+	})
+	if err != nil {
+		log.Error(err, "Failed to add VirtualMachine event handler")
+		return err
+	}
+	return nil
+}
+
+func (c *InventoryController) handleVirtualMachine(obj interface{}) {
+	var vm *vmv1alpha1.VirtualMachine
+	ok := false
+	switch obj1 := obj.(type) {
+	case *vmv1alpha1.VirtualMachine:
+		vm = obj1
+	case cache.DeletedFinalStateUnknown:
+		vm, ok = obj1.Obj.(*vmv1alpha1.VirtualMachine)
+		if !ok {
+			err := fmt.Errorf("obj is not valid *vmv1alpha1.VirtualMachine")
+			log.Error(err, "DeletedFinalStateUnknown Obj is not *vmv1alpha1.VirtualMachine")
+			return
+		}
+	}
+	log.Debug("Inventory processing VirtualMachine", "Namespace", vm.Namespace, "Name", vm.Name)
+	key, _ := keyFunc(vm)
+	log.Debug("Adding VirtualMachine key to inventory object queue", "VirtualMachine key", key)
+	c.inventoryObjectQueue.Add(inventory.InventoryKey{InventoryType: inventory.ContainerVirtualMachine, ExternalId: string(vm.UID), Key: key})
+}
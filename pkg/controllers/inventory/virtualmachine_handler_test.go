@@ -0,0 +1,88 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	vmv1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/inventory"
+)
+
+func TestWatchVirtualMachine(t *testing.T) {
+	t.Run("SuccessfullyCreateInformer", func(t *testing.T) {
+		controller := &InventoryController{}
+		mockCache := new(MockCache)
+		mockInformer := &MockInformer{handlers: cache.ResourceEventHandlerFuncs{}}
+		mockCache.On("GetInformer", context.Background(), &vmv1alpha1.VirtualMachine{}).Return(mockInformer, nil)
+		mgr := new(MockMgr)
+		mgr.On("GetCache").Return(mockCache)
+		err := watchVirtualMachine(controller, mgr)
+		assert.Nil(t, err)
+	})
+
+	t.Run("CreateInformerFailure", func(t *testing.T) {
+		mockCache := new(MockCache)
+		mockCache.On("GetInformer", context.Background(), &vmv1alpha1.VirtualMachine{}).Return(nil, errors.New("connection timeout"))
+		controller := &InventoryController{}
+		mgr := new(MockMgr)
+		mgr.On("GetCache").Return(mockCache)
+		err := watchVirtualMachine(controller, mgr)
+
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "connection timeout")
+	})
+
+	t.Run("CRDNotInstalled", func(t *testing.T) {
+		mockCache := new(MockCache)
+		mockCache.On("GetInformer", context.Background(), &vmv1alpha1.VirtualMachine{}).Return(nil, &meta.NoKindMatchError{GroupKind: vmv1alpha1.GroupVersion.WithKind("VirtualMachine").GroupKind()})
+		controller := &InventoryController{}
+		mgr := new(MockMgr)
+		mgr.On("GetCache").Return(mockCache)
+		err := watchVirtualMachine(controller, mgr)
+		assert.Nil(t, err)
+	})
+}
+
+func TestHandleVirtualMachine(t *testing.T) {
+	cfg := &config.NSXOperatorConfig{NsxConfig: &config.NsxConfig{}}
+	queue := MockObjectQueue[any]{}
+	inventoryService := &inventory.InventoryService{}
+	controller := &InventoryController{
+		service:              inventoryService,
+		keyBuffer:            sets.New[inventory.InventoryKey](),
+		cf:                   cfg,
+		inventoryObjectQueue: &queue}
+	t.Run("NormalVirtualMachine", func(t *testing.T) {
+
+		testVM := &vmv1alpha1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "deleted-ns",
+				Name:      "deleted-vm",
+				UID:       "deleted-uid",
+			},
+		}
+		deletedObj := cache.DeletedFinalStateUnknown{Obj: testVM}
+		queue.On("Add", mock.Anything).Return().Once()
+		controller.handleVirtualMachine(deletedObj)
+		queue.AssertExpectations(t)
+	})
+	t.Run("DeletedStateWithVirtualMachine", func(t *testing.T) {
+		queue = MockObjectQueue[any]{}
+		controller.inventoryObjectQueue = &queue
+
+		invalidObj := "deleted VirtualMachine"
+		deletedObj := cache.DeletedFinalStateUnknown{Obj: invalidObj}
+
+		controller.handleVirtualMachine(deletedObj)
+		queue.AssertExpectations(t)
+	})
+}
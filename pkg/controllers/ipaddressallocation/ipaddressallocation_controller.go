@@ -21,10 +21,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/audit"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/ipaddressallocation"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
 var (
@@ -173,9 +176,11 @@ func (r *IPAddressAllocationReconciler) setupWithManager(mgr ctrl.Manager) error
 		WithEventFilter(common.VPCNamespacePredicate(r.Client)).
 		WithOptions(
 			controller.Options{
-				MaxConcurrentReconciles: common.NumReconcile(),
+				MaxConcurrentReconciles: common.NumReconcile(r.StatusUpdater.NSXConfig, MetricResType),
+				RateLimiter:             common.RateLimiter(r.StatusUpdater.NSXConfig, MetricResType),
+				NewQueue:                common.NewQueue(r.StatusUpdater.NSXConfig, MetricResType),
 			}).
-		Complete(r)
+		Complete(common.LicensedReconciler(MetricResType, tracing.Reconciler(MetricResType, audit.Reconciler(r.Client, &v1alpha1.IPAddressAllocation{}, r))))
 }
 
 func (r *IPAddressAllocationReconciler) CollectGarbage(ctx context.Context) error {
@@ -220,9 +225,12 @@ func (r *IPAddressAllocationReconciler) RestoreReconcile() error {
 	}
 	var errorList []error
 	r.restoreMode = true
+	util.RecordRestoreTotal(len(restoreList))
 	for _, key := range restoreList {
 		result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
-		if err != nil || common.IsReconcileResultRequeue(result) {
+		restored := err == nil && !common.IsReconcileResultRequeue(result)
+		util.RecordRestoreResult(restored)
+		if !restored {
 			errorList = append(errorList, fmt.Errorf("failed to restore IPAddressAllocation %s, error: %w", key, err))
 		}
 	}
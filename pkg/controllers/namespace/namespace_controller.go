@@ -21,6 +21,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/audit"
 	"github.com/vmware-tanzu/nsx-operator/pkg/config"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
@@ -29,6 +30,7 @@ import (
 	_ "github.com/vmware-tanzu/nsx-operator/pkg/nsx/ratelimiter"
 	types "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnet"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
@@ -342,14 +344,16 @@ func (r *NamespaceReconciler) setupWithManager(mgr ctrl.Manager) error {
 		For(&v1.Namespace{}).
 		WithOptions(
 			controller.Options{
-				MaxConcurrentReconciles: common.NumReconcile(),
+				MaxConcurrentReconciles: common.NumReconcile(r.NSXConfig, common.MetricResTypeNamespace),
+				RateLimiter:             common.RateLimiter(r.NSXConfig, common.MetricResTypeNamespace),
+				NewQueue:                common.NewQueue(r.NSXConfig, common.MetricResTypeNamespace),
 			}).
 		Watches(
 			&v1alpha1.VPCNetworkConfiguration{},
 			&EnqueueRequestForVPCNetworkConfiguration{Reconciler: r},
 			builder.WithPredicates(PredicateFuncsVPCNetworkConfig),
 		).
-		Complete(r)
+		Complete(common.LicensedReconciler(common.MetricResTypeNamespace, tracing.Reconciler(common.MetricResTypeNamespace, audit.Reconciler(r.Client, &v1.Namespace{}, r))))
 }
 
 // Start setup manager and launch GC
@@ -32,6 +32,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/audit"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
@@ -40,6 +41,7 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/ipblocksinfo"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/vpc"
 	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
@@ -632,7 +634,8 @@ func (r *NetworkInfoReconciler) setupWithManager(mgr ctrl.Manager) error {
 		WithEventFilter(common.VPCNamespacePredicate(r.Client)).
 		WithOptions(
 			controller.Options{
-				MaxConcurrentReconciles: common.NumReconcile(),
+				MaxConcurrentReconciles: common.NumReconcile(r.StatusUpdater.NSXConfig, MetricResType),
+				RateLimiter:             common.RateLimiter(r.StatusUpdater.NSXConfig, MetricResType),
 				NewQueue:                r.getQueue,
 			}).
 		Watches(
@@ -650,7 +653,7 @@ func (r *NetworkInfoReconciler) setupWithManager(mgr ctrl.Manager) error {
 			&v1alpha1.SubnetSet{},
 			&SubnetSetHandler{Client: mgr.GetClient()},
 			builder.WithPredicates(PredicateFuncsSubnetSet)).
-		Complete(r)
+		Complete(common.LicensedReconciler(MetricResType, tracing.Reconciler(MetricResType, audit.Reconciler(r.Client, &v1alpha1.NetworkInfo{}, r))))
 }
 
 // Start setup manager and launch GC
@@ -846,7 +849,7 @@ func (r *NetworkInfoReconciler) syncPreCreatedVpcs(ctx context.Context) {
 			}
 			// Always reconcile the precreated VPC to update the
 			// PrivateIP, SNAT IP and LBS path
-			r.queue.Add(req)
+			common.AddLowPriority(r.queue, req)
 		}
 		return nil
 	})
@@ -855,9 +858,7 @@ func (r *NetworkInfoReconciler) syncPreCreatedVpcs(ctx context.Context) {
 
 func (r *NetworkInfoReconciler) getQueue(controllerName string, rateLimiter workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
 	if r.queue == nil {
-		r.queue = workqueue.NewTypedRateLimitingQueueWithConfig(rateLimiter, workqueue.TypedRateLimitingQueueConfig[reconcile.Request]{
-			Name: controllerName,
-		})
+		r.queue = common.NewQueue(r.StatusUpdater.NSXConfig, MetricResType)(controllerName, rateLimiter)
 	}
 	return r.queue
 }
@@ -892,9 +893,12 @@ func (r *NetworkInfoReconciler) RestoreReconcile() error {
 	}
 	var errorList []error
 	r.restoreMode = true
+	util.RecordRestoreTotal(len(restoreList))
 	for _, key := range restoreList {
 		result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
-		if err != nil || common.IsReconcileResultRequeue(result) {
+		restored := err == nil && !common.IsReconcileResultRequeue(result)
+		util.RecordRestoreResult(restored)
+		if !restored {
 			errorList = append(errorList, fmt.Errorf("failed to restore NetworkInfo %s, error: %w", key, err))
 		}
 	}
@@ -963,9 +967,35 @@ func (r *NetworkInfoReconciler) StartController(mgr ctrl.Manager, _ webhook.Serv
 		return err
 	}
 	go common.GenericGarbageCollector(make(chan bool), commonservice.GCInterval, r.CollectGarbage)
+	// Start the drift scanner of the NSX VPCs created for the NetworkInfo CRs
+	if r.Service.NSXConfig.DriftScanInterval > 0 {
+		r.newDriftScanner().Start()
+	}
 	return nil
 }
 
+func (r *NetworkInfoReconciler) newDriftScanner() *common.DriftScanner {
+	return &common.DriftScanner{
+		Client:   r.Client,
+		Service:  &r.Service.Service,
+		Recorder: r.Recorder,
+		Sources:  r.Service.DriftSources(),
+		Owner: func(obj interface{}) client.Object {
+			// The NetworkInfo CR has the same name as its Namespace.
+			key, ok := common.DriftOwnerKey(obj.(*model.Vpc).Tags, commonservice.TagScopeNamespace, commonservice.TagScopeNamespace)
+			if !ok {
+				return nil
+			}
+			return &v1alpha1.NetworkInfo{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}}
+		},
+		Enqueue: func(req reconcile.Request) {
+			if r.queue != nil {
+				common.AddLowPriority(r.queue, req)
+			}
+		},
+	}
+}
+
 func NewNetworkInfoReconciler(mgr ctrl.Manager, vpcService *vpc.VPCService, ipblocksInfoService *ipblocksinfo.IPBlocksInfoService, dnsRecordService dnsZoneSyncer) *NetworkInfoReconciler {
 	networkInfoReconciler := &NetworkInfoReconciler{
 		Client:           mgr.GetClient(),
@@ -2903,3 +2903,19 @@ type mockDNSZoneSyncer struct {
 func (s *mockDNSZoneSyncer) SyncDNSZonesByVpcNetworkConfig(_ *v1alpha1.VPCNetworkConfiguration) (map[string]string, error) {
 	return s.dnsZoneConfigurations, s.syncErr
 }
+
+func TestNetworkInfoReconciler_newDriftScanner(t *testing.T) {
+	r := &NetworkInfoReconciler{Service: &vpc.VPCService{VpcStore: &vpc.VPCStore{}}}
+	scanner := r.newDriftScanner()
+	assert.Len(t, scanner.Sources, 1)
+	assert.Equal(t, servicecommon.ResourceTypeVpc, scanner.Sources[0].ResourceType)
+
+	owner := scanner.Owner(&model.Vpc{Tags: []model.Tag{
+		{Scope: servicecommon.String(servicecommon.TagScopeCluster), Tag: servicecommon.String("cluster-1")},
+		{Scope: servicecommon.String(servicecommon.TagScopeNamespace), Tag: servicecommon.String("ns-1")},
+	}})
+	assert.Equal(t, &v1alpha1.NetworkInfo{ObjectMeta: metav1.ObjectMeta{Name: "ns-1", Namespace: "ns-1"}}, owner)
+	assert.Nil(t, scanner.Owner(&model.Vpc{}))
+	// Enqueue does nothing before the controller queue is created.
+	scanner.Enqueue(reconcile.Request{})
+}
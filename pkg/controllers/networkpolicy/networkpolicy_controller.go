@@ -5,9 +5,9 @@ package networkpolicy
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"os"
 	"time"
 
 	stderrors "github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
@@ -25,15 +25,19 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
+	"github.com/vmware-tanzu/nsx-operator/pkg/audit"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
 	_ "github.com/vmware-tanzu/nsx-operator/pkg/nsx/ratelimiter"
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/securitypolicy"
 	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
 var (
@@ -83,6 +87,37 @@ func cleanNetworkPolicyErrorAnnotation(client client.Client, ctx context.Context
 	}
 }
 
+// updateNetworkPolicyRealization records the realization of the NetworkPolicy in the realization annotation,
+// and emits a Warning event for each warning and unresolved named port when the record is changed. The annotation
+// is written with a merge patch, the NetworkPolicy is not reconciled again as its generation is not changed.
+func (r *NetworkPolicyReconciler) updateNetworkPolicyRealization(ctx context.Context, networkPolicy *networkingv1.NetworkPolicy, realizeErr error) {
+	realization := r.Service.GetNetworkPolicyRealization(networkPolicy, realizeErr)
+	value, err := json.Marshal(realization)
+	if err != nil {
+		log.Error(err, "Failed to marshal NetworkPolicy realization", "networkPolicy", networkPolicy.Namespace+"/"+networkPolicy.Name)
+		return
+	}
+	if networkPolicy.Annotations[common.NSXOperatorRealization] == string(value) {
+		return
+	}
+	patch := client.MergeFrom(networkPolicy.DeepCopy())
+	if networkPolicy.Annotations == nil {
+		networkPolicy.Annotations = make(map[string]string)
+	}
+	networkPolicy.Annotations[common.NSXOperatorRealization] = string(value)
+	if err := r.Client.Patch(ctx, networkPolicy, patch); err != nil {
+		log.Error(err, "Failed to update NetworkPolicy with realization annotation", "networkPolicy", networkPolicy.Namespace+"/"+networkPolicy.Name)
+		return
+	}
+	log.Info("Updated NetworkPolicy with realization annotation", "networkPolicy", networkPolicy.Namespace+"/"+networkPolicy.Name, "realization", string(value))
+	for _, warning := range realization.Warnings {
+		r.Recorder.Event(networkPolicy, v1.EventTypeWarning, common.ReasonPolicyWarning, warning)
+	}
+	for _, port := range realization.UnresolvedNamedPorts {
+		r.Recorder.Eventf(networkPolicy, v1.EventTypeWarning, common.ReasonUnresolvedPort, "Named port %s is not resolved to any Pod port, no NSX rule is generated for it", port)
+	}
+}
+
 func (r *NetworkPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	networkPolicy := &networkingv1.NetworkPolicy{}
 	log.Info("Reconciling NetworkPolicy", "networkpolicy", req.NamespacedName)
@@ -95,7 +130,7 @@ func (r *NetworkPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 
 	if err := r.Client.Get(ctx, req.NamespacedName, networkPolicy); err != nil {
 		if apierrors.IsNotFound(err) {
-			if err := r.deleteNetworkPolicyByName(req.Namespace, req.Name); err != nil {
+			if err := r.deleteNetworkPolicyByName(ctx, req.Namespace, req.Name); err != nil {
 				r.StatusUpdater.DeleteFail(req.NamespacedName, nil, err)
 				return ResultRequeue, err
 			}
@@ -111,25 +146,24 @@ func (r *NetworkPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		r.StatusUpdater.IncreaseUpdateTotal()
 		log.Info("Reconciling CR to create or update networkPolicy", "networkPolicy", req.NamespacedName)
 
-		if err := r.Service.CreateOrUpdateSecurityPolicy(networkPolicy); err != nil {
-			if errors.As(err, &nsxutil.RestrictionError{}) {
+		if err := r.Service.CreateOrUpdateSecurityPolicy(ctx, networkPolicy); err != nil {
+			// Without the DFW license, the NetworkPolicy is paused until the license is restored.
+			if errors.As(err, &nsxutil.RestrictionError{}) || nsxutil.IsInvalidLicense(err) {
 				setNetworkPolicyErrorAnnotation(ctx, networkPolicy, r.Client, common.ErrorNoDFWLicense)
 				r.StatusUpdater.UpdateFail(ctx, networkPolicy, err, "", nil)
+				r.updateNetworkPolicyRealization(ctx, networkPolicy, err)
 				return ResultNormal, nil
 			}
-			if nsxutil.IsInvalidLicense(err) {
-				log.Error(err, err.Error(), "networkpolicy", req.NamespacedName)
-				setNetworkPolicyErrorAnnotation(ctx, networkPolicy, r.Client, common.ErrorNoDFWLicense)
-				os.Exit(1)
-			}
 			r.StatusUpdater.UpdateFail(ctx, networkPolicy, err, "", clarifyAndSetNetworkPolicyErrorAnnotation)
+			r.updateNetworkPolicyRealization(ctx, networkPolicy, err)
 			return ResultRequeue, err
 		}
 		r.StatusUpdater.UpdateSuccess(ctx, networkPolicy, cleanNetworkPolicyErrorAnnotation)
+		r.updateNetworkPolicyRealization(ctx, networkPolicy, nil)
 	} else {
 		log.Info("Reconciling CR to delete networkPolicy", "networkPolicy", req.NamespacedName)
 		r.StatusUpdater.IncreaseDeleteTotal()
-		if err := r.deleteNetworkPolicyByName(req.Namespace, req.Name); err != nil {
+		if err := r.deleteNetworkPolicyByName(ctx, req.Namespace, req.Name); err != nil {
 			r.StatusUpdater.DeleteFail(req.NamespacedName, nil, err)
 			return ResultRequeue, err
 		}
@@ -141,7 +175,8 @@ func (r *NetworkPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 
 func (r *NetworkPolicyReconciler) setupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&networkingv1.NetworkPolicy{}).
+		// The annotations written by the controller do not change the generation, and are not reconciled.
+		For(&networkingv1.NetworkPolicy{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		WithEventFilter(common.VPCNamespacePredicate(r.Client)).
 		Watches(
 			&v1.Pod{},
@@ -159,11 +194,16 @@ func (r *NetworkPolicyReconciler) setupWithManager(mgr ctrl.Manager) error {
 			},
 			builder.WithPredicates(PredicateFuncsNs),
 		).
+		WatchesRawSource(common.DFWLicenseSource(MetricResType, r.listNetworkPolicies)).
+		WatchesRawSource(common.ShardSource(MetricResType, r.listNetworkPolicies)).
 		WithOptions(
 			controller.Options{
-				MaxConcurrentReconciles: common.NumReconcile(),
+				MaxConcurrentReconciles: common.NumReconcile(r.StatusUpdater.NSXConfig, MetricResType),
+				RateLimiter:             common.RateLimiter(r.StatusUpdater.NSXConfig, MetricResType),
+				NewQueue:                common.NewQueue(r.StatusUpdater.NSXConfig, MetricResType),
+				NeedLeaderElection:      common.NeedLeaderElection(),
 			}).
-		Complete(r)
+		Complete(common.LicensedReconciler(MetricResType, common.ShardedReconciler(MetricResType, tracing.Reconciler(MetricResType, audit.Reconciler(r.Client, &networkingv1.NetworkPolicy{}, r)))))
 }
 
 // Start setup manager and launch GC
@@ -194,7 +234,7 @@ func (r *NetworkPolicyReconciler) CollectGarbage(ctx context.Context) error {
 	for elem := range diffSet {
 		log.Debug("GC collected NetworkPolicy", "ID", elem)
 		r.StatusUpdater.IncreaseDeleteTotal()
-		err = r.Service.DeleteSecurityPolicy(types.UID(elem), true, servicecommon.ResourceTypeNetworkPolicy)
+		err = r.Service.DeleteSecurityPolicy(ctx, types.UID(elem), true, servicecommon.ResourceTypeNetworkPolicy)
 		if err != nil {
 			errList = append(errList, err)
 			r.StatusUpdater.IncreaseDeleteFailTotal()
@@ -208,12 +248,12 @@ func (r *NetworkPolicyReconciler) CollectGarbage(ctx context.Context) error {
 	return nil
 }
 
-func (r *NetworkPolicyReconciler) deleteNetworkPolicyByName(ns, name string) error {
+func (r *NetworkPolicyReconciler) deleteNetworkPolicyByName(ctx context.Context, ns, name string) error {
 	nsxSecurityPolicies := r.Service.ListNetworkPolicyByName(ns, name)
 	for _, item := range nsxSecurityPolicies {
 		uid := nsxutil.FindTag(item.Tags, servicecommon.TagScopeNetworkPolicyUID)
 		log.Info("Deleting NetworkPolicy", "networkPolicyUID", uid, "nsxSecurityPolicyId", *item.Id)
-		if err := r.Service.DeleteSecurityPolicy(types.UID(uid), false, servicecommon.ResourceTypeNetworkPolicy); err != nil {
+		if err := r.Service.DeleteSecurityPolicy(ctx, types.UID(uid), false, servicecommon.ResourceTypeNetworkPolicy); err != nil {
 			log.Error(err, "Failed to delete NetworkPolicy", "networkPolicyUID", uid, "nsxSecurityPolicyId", *item.Id)
 			return err
 		}
@@ -222,6 +262,19 @@ func (r *NetworkPolicyReconciler) deleteNetworkPolicyByName(ns, name string) err
 	return nil
 }
 
+// listNetworkPolicies lists all the NetworkPolicies.
+func (r *NetworkPolicyReconciler) listNetworkPolicies(ctx context.Context) ([]client.Object, error) {
+	networkPolicyList := &networkingv1.NetworkPolicyList{}
+	if err := r.Client.List(ctx, networkPolicyList); err != nil {
+		return nil, err
+	}
+	objs := make([]client.Object, 0, len(networkPolicyList.Items))
+	for i := range networkPolicyList.Items {
+		objs = append(objs, &networkPolicyList.Items[i])
+	}
+	return objs, nil
+}
+
 func (r *NetworkPolicyReconciler) listNetworkPolicyCRIDs() (sets.Set[string], error) {
 	networkPolicyList := &networkingv1.NetworkPolicyList{}
 	err := r.Client.List(context.Background(), networkPolicyList)
@@ -251,6 +304,11 @@ func (r *NetworkPolicyReconciler) StartController(mgr ctrl.Manager, _ webhook.Se
 	return nil
 }
 
+// RegisterWebhooks implements ShardedReconcilerProvider, the NetworkPolicy controller has no webhook.
+func (r *NetworkPolicyReconciler) RegisterWebhooks(_ ctrl.Manager, _ webhook.Server) {}
+
+var _ util.ShardedReconcilerProvider = (*NetworkPolicyReconciler)(nil)
+
 // reconcileNetworkPolicy is triggered by Pod events or Namespace events to reconcile NetworkPolicies with named ports
 func reconcileNetworkPolicy(pkgClient client.Client, q workqueue.TypedRateLimitingInterface[reconcile.Request]) error {
 	npList := &networkingv1.NetworkPolicyList{}
@@ -286,7 +286,7 @@ func TestNetworkPolicyReconciler_Reconcile(t *testing.T) {
 			name: "NetworkPolicy CR not found",
 			req:  ctrl.Request{NamespacedName: types.NamespacedName{Namespace: ns, Name: npName}},
 			patches: func(r *NetworkPolicyReconciler) *gomonkey.Patches {
-				return gomonkey.ApplyPrivateMethod(reflect.TypeOf(r), "deleteNetworkPolicyByName", func(_ *NetworkPolicyReconciler, ns, name string) error {
+				return gomonkey.ApplyPrivateMethod(reflect.TypeOf(r), "deleteNetworkPolicyByName", func(_ *NetworkPolicyReconciler, _ context.Context, ns, name string) error {
 					return nil
 				})
 			},
@@ -300,7 +300,7 @@ func TestNetworkPolicyReconciler_Reconcile(t *testing.T) {
 				patches := gomonkey.ApplyMethod(reflect.TypeOf(r.Client), "Get", func(_ client.Client, _ context.Context, _ client.ObjectKey, _ client.Object, _ ...client.GetOption) error {
 					return errors.New("get NetworkPolicy CR error")
 				})
-				patches.ApplyPrivateMethod(reflect.TypeOf(r), "deleteNetworkPolicyByName", func(_ *NetworkPolicyReconciler, ns, name string) error {
+				patches.ApplyPrivateMethod(reflect.TypeOf(r), "deleteNetworkPolicyByName", func(_ *NetworkPolicyReconciler, _ context.Context, ns, name string) error {
 					return nil
 				})
 				return patches
@@ -313,7 +313,7 @@ func TestNetworkPolicyReconciler_Reconcile(t *testing.T) {
 			name: "NetworkPolicy with DeletionTimestamp not zero and delete success",
 			req:  ctrl.Request{NamespacedName: types.NamespacedName{Namespace: ns, Name: npName}},
 			patches: func(r *NetworkPolicyReconciler) *gomonkey.Patches {
-				patches := gomonkey.ApplyPrivateMethod(reflect.TypeOf(r), "deleteNetworkPolicyByName", func(_ *NetworkPolicyReconciler, ns, name string) error {
+				patches := gomonkey.ApplyPrivateMethod(reflect.TypeOf(r), "deleteNetworkPolicyByName", func(_ *NetworkPolicyReconciler, _ context.Context, ns, name string) error {
 					return nil
 				})
 				return patches
@@ -325,7 +325,7 @@ func TestNetworkPolicyReconciler_Reconcile(t *testing.T) {
 			name: "NetworkPolicy with DeletionTimestamp not zero and delete fail",
 			req:  ctrl.Request{NamespacedName: types.NamespacedName{Namespace: ns, Name: npName}},
 			patches: func(r *NetworkPolicyReconciler) *gomonkey.Patches {
-				patches := gomonkey.ApplyPrivateMethod(reflect.TypeOf(r), "deleteNetworkPolicyByName", func(_ *NetworkPolicyReconciler, ns, name string) error {
+				patches := gomonkey.ApplyPrivateMethod(reflect.TypeOf(r), "deleteNetworkPolicyByName", func(_ *NetworkPolicyReconciler, _ context.Context, ns, name string) error {
 					return errors.New("delete networkpolicy failed")
 				})
 				return patches
@@ -338,9 +338,12 @@ func TestNetworkPolicyReconciler_Reconcile(t *testing.T) {
 			name: "NetworkPolicy with DeletionTimestamp zero and create/update success",
 			req:  ctrl.Request{NamespacedName: types.NamespacedName{Namespace: ns, Name: npName}},
 			patches: func(r *NetworkPolicyReconciler) *gomonkey.Patches {
-				patches := gomonkey.ApplyMethod(reflect.TypeOf(r.Service), "CreateOrUpdateSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, obj interface{}) error {
+				patches := gomonkey.ApplyMethod(reflect.TypeOf(r.Service), "CreateOrUpdateSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, obj interface{}) error {
 					return nil
 				})
+				patches.ApplyMethod(reflect.TypeOf(r.Service), "GetNetworkPolicyRealization", func(_ *securitypolicy.SecurityPolicyService, _ *networkingv1.NetworkPolicy, _ error) *securitypolicy.NetworkPolicyRealization {
+					return &securitypolicy.NetworkPolicyRealization{}
+				})
 				return patches
 			},
 			expectRes:               ResultNormal,
@@ -350,9 +353,12 @@ func TestNetworkPolicyReconciler_Reconcile(t *testing.T) {
 			name: "NetworkPolicy with DeletionTimestamp zero and create/update fail",
 			req:  ctrl.Request{NamespacedName: types.NamespacedName{Namespace: ns, Name: npName}},
 			patches: func(r *NetworkPolicyReconciler) *gomonkey.Patches {
-				patches := gomonkey.ApplyMethod(reflect.TypeOf(r.Service), "CreateOrUpdateSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, obj interface{}) error {
+				patches := gomonkey.ApplyMethod(reflect.TypeOf(r.Service), "CreateOrUpdateSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, obj interface{}) error {
 					return errors.New("create or update networkpolicy failed")
 				})
+				patches.ApplyMethod(reflect.TypeOf(r.Service), "GetNetworkPolicyRealization", func(_ *securitypolicy.SecurityPolicyService, _ *networkingv1.NetworkPolicy, _ error) *securitypolicy.NetworkPolicyRealization {
+					return &securitypolicy.NetworkPolicyRealization{}
+				})
 				return patches
 			},
 			expectErrStr:            "create or update networkpolicy failed",
@@ -403,7 +409,7 @@ func TestNetworkPolicyReconciler_GarbageCollector(t *testing.T) {
 					res := sets.New[string]("1234_ingress", "1234_isolation")
 					return res
 				})
-				patch.ApplyMethod(reflect.TypeOf(r.Service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, obj interface{}, isGc bool, createdFor string) error {
+				patch.ApplyMethod(reflect.TypeOf(r.Service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, obj interface{}, isGc bool, createdFor string) error {
 					return nil
 				})
 				return patch
@@ -417,7 +423,7 @@ func TestNetworkPolicyReconciler_GarbageCollector(t *testing.T) {
 					res := sets.New[string]("1234_allow", "1234_isolation")
 					return res
 				})
-				patch.ApplyMethod(reflect.TypeOf(r.Service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, obj interface{}, isGc bool, createdFor string) error {
+				patch.ApplyMethod(reflect.TypeOf(r.Service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, obj interface{}, isGc bool, createdFor string) error {
 					assert.FailNow(t, "should not be called")
 					return nil
 				})
@@ -438,7 +444,7 @@ func TestNetworkPolicyReconciler_GarbageCollector(t *testing.T) {
 					res := sets.New[string]("1234_allow", "1234_isolation")
 					return res
 				})
-				patch.ApplyMethod(reflect.TypeOf(r.Service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, obj interface{}, isGc bool, createdFor string) error {
+				patch.ApplyMethod(reflect.TypeOf(r.Service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, obj interface{}, isGc bool, createdFor string) error {
 					return errors.New("delete failed")
 				})
 				return patch
@@ -521,14 +527,14 @@ func TestNetworkPolicyReconciler_deleteNetworkPolicyByName(t *testing.T) {
 		}
 	})
 
-	patch.ApplyMethod(reflect.TypeOf(r.Service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, obj types.UID, isGc bool, createdFor string) error {
+	patch.ApplyMethod(reflect.TypeOf(r.Service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, obj types.UID, isGc bool, createdFor string) error {
 		if obj == "uid2" {
 			return errors.New("delete failed")
 		}
 		return nil
 	})
 
-	err := r.deleteNetworkPolicyByName("dummy-ns", "dummy-name")
+	err := r.deleteNetworkPolicyByName(context.TODO(), "dummy-ns", "dummy-name")
 	assert.Error(t, err)
 	patch.Reset()
 }
@@ -787,3 +793,38 @@ func TestReconcileNetworkPolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestNetworkPolicyReconciler_updateNetworkPolicyRealization(t *testing.T) {
+	networkPolicy := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "np1", UID: "uid1"},
+	}
+	r := createFakeNetworkPolicyReconciler([]client.Object{networkPolicy.DeepCopy()})
+	recorder := record.NewFakeRecorder(10)
+	r.Recorder = recorder
+	realization := &securitypolicy.NetworkPolicyRealization{
+		State:                securitypolicy.NetworkPolicyRealizationStateApplied,
+		RuleCount:            3,
+		UnresolvedNamedPorts: []string{"ingress/http"},
+		Warnings:             []string{"ingress[0]: ipBlock except 10.1.0.0/28 is not within cidr 10.0.0.0/24 and is ignored"},
+	}
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(r.Service), "GetNetworkPolicyRealization", func(_ *securitypolicy.SecurityPolicyService, _ *networkingv1.NetworkPolicy, _ error) *securitypolicy.NetworkPolicyRealization {
+		return realization
+	})
+	defer patches.Reset()
+
+	ctx := context.TODO()
+	actual := &networkingv1.NetworkPolicy{}
+	require.NoError(t, r.Client.Get(ctx, types.NamespacedName{Namespace: "ns1", Name: "np1"}, actual))
+	r.updateNetworkPolicyRealization(ctx, actual, nil)
+
+	require.NoError(t, r.Client.Get(ctx, types.NamespacedName{Namespace: "ns1", Name: "np1"}, actual))
+	assert.JSONEq(t, `{"state":"Applied","ruleCount":3,"unresolvedNamedPorts":["ingress/http"],"warnings":["ingress[0]: ipBlock except 10.1.0.0/28 is not within cidr 10.0.0.0/24 and is ignored"]}`,
+		actual.Annotations[ctrcommon.NSXOperatorRealization])
+	require.Len(t, recorder.Events, 2)
+	assert.Contains(t, <-recorder.Events, ctrcommon.ReasonPolicyWarning)
+	assert.Contains(t, <-recorder.Events, ctrcommon.ReasonUnresolvedPort)
+
+	// The unchanged realization is not updated again, and no event is emitted.
+	r.updateNetworkPolicyRealization(ctx, actual, nil)
+	assert.Len(t, recorder.Events, 0)
+}
@@ -19,10 +19,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
+	"github.com/vmware-tanzu/nsx-operator/pkg/audit"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
 	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/node"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
 var (
@@ -79,7 +82,7 @@ func (r *NodeReconciler) setupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1.Node{}).
 		WithEventFilter(PredicateFuncsNode).
-		Complete(r)
+		Complete(common.LicensedReconciler(MetricResTypeNode, tracing.Reconciler(MetricResTypeNode, audit.Reconciler(r.Client, &v1.Node{}, r))))
 }
 
 func StartNodeController(mgr ctrl.Manager, nodeService *node.NodeService) {
@@ -105,9 +108,12 @@ func (r *NodeReconciler) RestoreReconcile() error {
 		return fmt.Errorf("failed to reconcile Nodes: %w", err)
 	}
 	var errorList []error
+	util.RecordRestoreTotal(len(nodeList.Items))
 	for _, node := range nodeList.Items {
 		result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: node.Namespace, Name: node.Name}})
-		if err != nil || common.IsReconcileResultRequeue(result) {
+		restored := err == nil && !common.IsReconcileResultRequeue(result)
+		util.RecordRestoreResult(restored)
+		if !restored {
 			errorList = append(errorList, fmt.Errorf("failed to reconcile Node %v, error: %w", node, err))
 		}
 	}
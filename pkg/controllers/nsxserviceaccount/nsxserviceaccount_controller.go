@@ -29,11 +29,13 @@ import (
 
 	nsxvmwarecomv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
 
+	"github.com/vmware-tanzu/nsx-operator/pkg/audit"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/nsxserviceaccount"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 )
 
 const proxyLabelKey = "mgmt-proxy.antrea-nsx.vmware.com"
@@ -229,14 +231,16 @@ func (r *NSXServiceAccountReconciler) setupWithManager(mgr ctrl.Manager) error {
 		}).
 		WithOptions(
 			controller.Options{
-				MaxConcurrentReconciles: common.NumReconcile(),
+				MaxConcurrentReconciles: common.NumReconcile(r.StatusUpdater.NSXConfig, MetricResType),
+				RateLimiter:             common.RateLimiter(r.StatusUpdater.NSXConfig, MetricResType),
+				NewQueue:                common.NewQueue(r.StatusUpdater.NSXConfig, MetricResType),
 			}).
 		Watches(
 			&corev1.Service{},
 			handler.EnqueueRequestsFromMapFunc(r.serviceMapFunc),
 			builder.WithPredicates(proxyServicePred),
 		).
-		Complete(r)
+		Complete(common.LicensedReconciler(MetricResType, tracing.Reconciler(MetricResType, audit.Reconciler(r.Client, &nsxvmwarecomv1alpha1.NSXServiceAccount{}, r))))
 }
 
 func (r *NSXServiceAccountReconciler) serviceMapFunc(ctx context.Context, _ client.Object) []reconcile.Request {
@@ -0,0 +1,346 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package pod
+
+import (
+	"context"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+const (
+	defaultServiceAccountName = "default"
+
+	// endpointSlicePodIndex indexes the EndpointSlices owned by Services by the names of the Pods in the endpoints.
+	endpointSlicePodIndex = "endpointSlicePod"
+	// securityPolicyServiceAccountLabelIndex indexes the SecurityPolicies by the ServiceAccount label keys used in
+	// the serviceAccountSelectors.
+	securityPolicyServiceAccountLabelIndex = "securityPolicyServiceAccountLabel"
+	// securityPolicyServiceRefIndex indexes the SecurityPolicies by the "namespace/name" of the referred Services.
+	securityPolicyServiceRefIndex = "securityPolicyServiceRef"
+)
+
+// buildPortLabelTags returns the tags synced onto the Pod port from the Pod labels, the labels of the Pod
+// ServiceAccount and the Services backed by the Pod. The ServiceAccount label and Service member tags are only
+// built for the label keys and Services referred by the SecurityPolicy serviceAccountSelector and serviceRef.
+func (r *PodReconciler) buildPortLabelTags(ctx context.Context, pod *v1.Pod) (map[string]string, error) {
+	labelTags := make(map[string]string, len(pod.Labels))
+	for k, v := range pod.Labels {
+		labelTags[k] = v
+	}
+
+	saName := getPodServiceAccountName(pod)
+	sa := &v1.ServiceAccount{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Namespace: pod.Namespace, Name: saName}, sa); err != nil {
+		if !apierrors.IsNotFound(err) {
+			log.Error(err, "Failed to get ServiceAccount for Pod", "Pod", pod.Name, "ServiceAccount", saName)
+			return nil, err
+		}
+	}
+	saLabels := make(map[string]string, len(sa.Labels)+1)
+	for k, v := range sa.Labels {
+		saLabels[k] = v
+	}
+	saLabels[v1.LabelMetadataName] = saName
+	for k, v := range saLabels {
+		referred, err := r.isReferredBySecurityPolicy(ctx, securityPolicyServiceAccountLabelIndex, k)
+		if err != nil {
+			return nil, err
+		}
+		if referred {
+			labelTags[servicecommon.TagScopeServiceAccountLabelPrefix+k] = v
+		}
+	}
+
+	endpointSliceList := &discoveryv1.EndpointSliceList{}
+	if err := r.Client.List(ctx, endpointSliceList, client.InNamespace(pod.Namespace), client.MatchingFields{endpointSlicePodIndex: pod.Name}); err != nil {
+		log.Error(err, "Failed to list EndpointSlices for Pod", "Pod", pod.Name)
+		return nil, err
+	}
+	for i := range endpointSliceList.Items {
+		serviceName := endpointSliceList.Items[i].Labels[discoveryv1.LabelServiceName]
+		referred, err := r.isReferredBySecurityPolicy(ctx, securityPolicyServiceRefIndex, types.NamespacedName{Namespace: pod.Namespace, Name: serviceName}.String())
+		if err != nil {
+			return nil, err
+		}
+		if referred {
+			labelTags[servicecommon.TagScopeServiceMemberPrefix+serviceName] = servicecommon.TagValueServiceMember
+		}
+	}
+	return labelTags, nil
+}
+
+func (r *PodReconciler) isReferredBySecurityPolicy(ctx context.Context, index, value string) (bool, error) {
+	spList := &v1alpha1.SecurityPolicyList{}
+	if err := r.Client.List(ctx, spList, client.MatchingFields{index: value}); err != nil {
+		log.Error(err, "Failed to list SecurityPolicies", "index", index, "value", value)
+		return false, err
+	}
+	return len(spList.Items) > 0, nil
+}
+
+// setupIndexFields registers the cache indexers used to find the workload references of the Pod.
+func setupIndexFields(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.TODO(), &discoveryv1.EndpointSlice{}, endpointSlicePodIndex, endpointSlicePodIndexFunc); err != nil {
+		log.Error(err, "Failed to register EndpointSlice cache indexer", "index", endpointSlicePodIndex)
+		return err
+	}
+	if err := mgr.GetFieldIndexer().IndexField(context.TODO(), &v1alpha1.SecurityPolicy{}, securityPolicyServiceAccountLabelIndex, securityPolicyServiceAccountLabelIndexFunc); err != nil {
+		log.Error(err, "Failed to register SecurityPolicy cache indexer", "index", securityPolicyServiceAccountLabelIndex)
+		return err
+	}
+	if err := mgr.GetFieldIndexer().IndexField(context.TODO(), &v1alpha1.SecurityPolicy{}, securityPolicyServiceRefIndex, securityPolicyServiceRefIndexFunc); err != nil {
+		log.Error(err, "Failed to register SecurityPolicy cache indexer", "index", securityPolicyServiceRefIndex)
+		return err
+	}
+	return nil
+}
+
+func endpointSlicePodIndexFunc(obj client.Object) []string {
+	slice, ok := obj.(*discoveryv1.EndpointSlice)
+	if !ok || slice.Labels[discoveryv1.LabelServiceName] == "" {
+		return nil
+	}
+	return sets.List(getEndpointSlicePodNames(slice))
+}
+
+func securityPolicyServiceAccountLabelIndexFunc(obj client.Object) []string {
+	sp, ok := obj.(*v1alpha1.SecurityPolicy)
+	if !ok {
+		return nil
+	}
+	labelKeys, _ := getSecurityPolicyWorkloadRefs(sp)
+	return sets.List(labelKeys)
+}
+
+func securityPolicyServiceRefIndexFunc(obj client.Object) []string {
+	sp, ok := obj.(*v1alpha1.SecurityPolicy)
+	if !ok {
+		return nil
+	}
+	_, services := getSecurityPolicyWorkloadRefs(sp)
+	return sets.List(services)
+}
+
+// getSecurityPolicyWorkloadRefs returns the ServiceAccount label keys used in the serviceAccountSelectors and the
+// "namespace/name" of the Services referred by the serviceRefs of the SecurityPolicy.
+func getSecurityPolicyWorkloadRefs(sp *v1alpha1.SecurityPolicy) (sets.Set[string], sets.Set[string]) {
+	labelKeys, services := sets.New[string](), sets.New[string]()
+	addRefs := func(saSelector *metav1.LabelSelector, serviceRef *v1alpha1.ServiceReference) {
+		if saSelector != nil {
+			for k := range saSelector.MatchLabels {
+				labelKeys.Insert(k)
+			}
+			for _, expr := range saSelector.MatchExpressions {
+				labelKeys.Insert(expr.Key)
+			}
+		}
+		if serviceRef != nil {
+			namespace := serviceRef.Namespace
+			if namespace == "" {
+				namespace = sp.Namespace
+			}
+			services.Insert(types.NamespacedName{Namespace: namespace, Name: serviceRef.Name}.String())
+		}
+	}
+	addTargets := func(targets []v1alpha1.SecurityPolicyTarget) {
+		for i := range targets {
+			addRefs(targets[i].ServiceAccountSelector, targets[i].ServiceRef)
+		}
+	}
+	addPeers := func(peers []v1alpha1.SecurityPolicyPeer) {
+		for i := range peers {
+			addRefs(peers[i].ServiceAccountSelector, peers[i].ServiceRef)
+		}
+	}
+	addTargets(sp.Spec.AppliedTo)
+	for i := range sp.Spec.Rules {
+		rule := &sp.Spec.Rules[i]
+		addTargets(rule.AppliedTo)
+		addPeers(rule.Sources)
+		addPeers(rule.Destinations)
+		addPeers(rule.From)
+		addPeers(rule.To)
+	}
+	return labelKeys, services
+}
+
+func getPodServiceAccountName(pod *v1.Pod) string {
+	if pod.Spec.ServiceAccountName == "" {
+		return defaultServiceAccountName
+	}
+	return pod.Spec.ServiceAccountName
+}
+
+func getEndpointSlicePodNames(slice *discoveryv1.EndpointSlice) sets.Set[string] {
+	podNames := sets.New[string]()
+	for _, endpoint := range slice.Endpoints {
+		if endpoint.TargetRef != nil && endpoint.TargetRef.Kind == "Pod" && endpoint.TargetRef.Namespace == slice.Namespace {
+			podNames.Insert(endpoint.TargetRef.Name)
+		}
+	}
+	return podNames
+}
+
+// serviceAccountMapFunc enqueues the Pods running with the ServiceAccount to sync its labels onto the Pod ports.
+func (r *PodReconciler) serviceAccountMapFunc(ctx context.Context, obj client.Object) []reconcile.Request {
+	sa, ok := obj.(*v1.ServiceAccount)
+	if !ok {
+		return nil
+	}
+	podList := &v1.PodList{}
+	if err := r.Client.List(ctx, podList, client.InNamespace(sa.Namespace)); err != nil {
+		log.Error(err, "Failed to list Pods for ServiceAccount", "Namespace", sa.Namespace, "ServiceAccount", sa.Name)
+		return nil
+	}
+	var requests []reconcile.Request
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.Spec.HostNetwork || getPodServiceAccountName(pod) != sa.Name {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}})
+	}
+	return requests
+}
+
+// EnqueueRequestForEndpointSlice enqueues the Pods added to or removed from the EndpointSlice to sync the Service
+// member tags onto the Pod ports.
+type EnqueueRequestForEndpointSlice struct{}
+
+func (e *EnqueueRequestForEndpointSlice) Create(_ context.Context, createEvent event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	e.enqueue(q, createEvent.Object.(*discoveryv1.EndpointSlice), nil)
+}
+
+func (e *EnqueueRequestForEndpointSlice) Update(_ context.Context, updateEvent event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	e.enqueue(q, updateEvent.ObjectNew.(*discoveryv1.EndpointSlice), updateEvent.ObjectOld.(*discoveryv1.EndpointSlice))
+}
+
+func (e *EnqueueRequestForEndpointSlice) Delete(_ context.Context, deleteEvent event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	e.enqueue(q, deleteEvent.Object.(*discoveryv1.EndpointSlice), nil)
+}
+
+func (e *EnqueueRequestForEndpointSlice) Generic(_ context.Context, _ event.GenericEvent, _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	log.Debug("EndpointSlice generic event, do nothing")
+}
+
+func (e *EnqueueRequestForEndpointSlice) enqueue(q workqueue.TypedRateLimitingInterface[reconcile.Request], slice, oldSlice *discoveryv1.EndpointSlice) {
+	if slice.Labels[discoveryv1.LabelServiceName] == "" {
+		return
+	}
+	podNames := getEndpointSlicePodNames(slice)
+	if oldSlice != nil {
+		oldPodNames := getEndpointSlicePodNames(oldSlice)
+		// Only the Pods whose membership is changed need to be synced.
+		if slice.Labels[discoveryv1.LabelServiceName] == oldSlice.Labels[discoveryv1.LabelServiceName] {
+			podNames = podNames.SymmetricDifference(oldPodNames)
+		} else {
+			podNames = podNames.Union(oldPodNames)
+		}
+	}
+	for podName := range podNames {
+		log.Debug("Enqueue Pod for EndpointSlice change", "Namespace", slice.Namespace, "Pod", podName, "EndpointSlice", slice.Name)
+		q.Add(reconcile.Request{NamespacedName: types.NamespacedName{Namespace: slice.Namespace, Name: podName}})
+	}
+}
+
+// EnqueueRequestForSecurityPolicy enqueues the Pods whose ServiceAccount labels or Services start or stop being
+// referred by the SecurityPolicy, to add or remove the corresponding tags on the Pod ports.
+type EnqueueRequestForSecurityPolicy struct {
+	Reconciler *PodReconciler
+}
+
+func (e *EnqueueRequestForSecurityPolicy) Create(ctx context.Context, createEvent event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	e.enqueue(ctx, q, createEvent.Object.(*v1alpha1.SecurityPolicy), nil)
+}
+
+func (e *EnqueueRequestForSecurityPolicy) Update(ctx context.Context, updateEvent event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	e.enqueue(ctx, q, updateEvent.ObjectNew.(*v1alpha1.SecurityPolicy), updateEvent.ObjectOld.(*v1alpha1.SecurityPolicy))
+}
+
+func (e *EnqueueRequestForSecurityPolicy) Delete(ctx context.Context, deleteEvent event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	e.enqueue(ctx, q, deleteEvent.Object.(*v1alpha1.SecurityPolicy), nil)
+}
+
+func (e *EnqueueRequestForSecurityPolicy) Generic(_ context.Context, _ event.GenericEvent, _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	log.Debug("SecurityPolicy generic event, do nothing")
+}
+
+func (e *EnqueueRequestForSecurityPolicy) enqueue(ctx context.Context, q workqueue.TypedRateLimitingInterface[reconcile.Request], sp, oldSP *v1alpha1.SecurityPolicy) {
+	labelKeys, services := getSecurityPolicyWorkloadRefs(sp)
+	if oldSP != nil {
+		// Only the references added or removed need the Pod ports to be synced.
+		oldLabelKeys, oldServices := getSecurityPolicyWorkloadRefs(oldSP)
+		labelKeys = labelKeys.SymmetricDifference(oldLabelKeys)
+		services = services.SymmetricDifference(oldServices)
+	}
+	if labelKeys.Len() == 0 && services.Len() == 0 {
+		return
+	}
+	requests := sets.New[reconcile.Request]()
+	if labelKeys.Len() > 0 {
+		requests.Insert(e.Reconciler.serviceAccountLabelRequests(ctx, labelKeys)...)
+	}
+	for service := range services {
+		requests.Insert(e.Reconciler.serviceRequests(ctx, service)...)
+	}
+	for req := range requests {
+		log.Debug("Enqueue Pod for SecurityPolicy workload reference change", "Pod", req.NamespacedName, "SecurityPolicy", sp.Namespace+"/"+sp.Name)
+		// The Pods are synced in bulk, do not compete with the Pod changes.
+		common.AddLowPriority(q, req)
+	}
+}
+
+// serviceAccountLabelRequests returns the Pods running with the ServiceAccounts having any of the label keys.
+func (r *PodReconciler) serviceAccountLabelRequests(ctx context.Context, labelKeys sets.Set[string]) []reconcile.Request {
+	saList := &v1.ServiceAccountList{}
+	if err := r.Client.List(ctx, saList); err != nil {
+		log.Error(err, "Failed to list ServiceAccounts for SecurityPolicy")
+		return nil
+	}
+	var requests []reconcile.Request
+	for i := range saList.Items {
+		sa := &saList.Items[i]
+		matched := labelKeys.Has(v1.LabelMetadataName)
+		for k := range sa.Labels {
+			matched = matched || labelKeys.Has(k)
+		}
+		if matched {
+			requests = append(requests, r.serviceAccountMapFunc(ctx, sa)...)
+		}
+	}
+	return requests
+}
+
+// serviceRequests returns the Pods backing the Service in the EndpointSlices.
+func (r *PodReconciler) serviceRequests(ctx context.Context, service string) []reconcile.Request {
+	namespace, name, _ := strings.Cut(service, "/")
+	endpointSliceList := &discoveryv1.EndpointSliceList{}
+	if err := r.Client.List(ctx, endpointSliceList, client.InNamespace(namespace), client.MatchingLabels{discoveryv1.LabelServiceName: name}); err != nil {
+		log.Error(err, "Failed to list EndpointSlices for Service", "Service", service)
+		return nil
+	}
+	var requests []reconcile.Request
+	for i := range endpointSliceList.Items {
+		for podName := range getEndpointSlicePodNames(&endpointSliceList.Items[i]) {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: podName}})
+		}
+	}
+	return requests
+}
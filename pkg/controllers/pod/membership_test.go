@@ -0,0 +1,198 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package pod
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+func newEndpointSlice(name, serviceName string, podNames ...string) *discoveryv1.EndpointSlice {
+	slice := &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: name, Labels: map[string]string{discoveryv1.LabelServiceName: serviceName}},
+	}
+	for _, podName := range podNames {
+		slice.Endpoints = append(slice.Endpoints, discoveryv1.Endpoint{
+			TargetRef: &v1.ObjectReference{Kind: "Pod", Namespace: "ns1", Name: podName},
+		})
+	}
+	return slice
+}
+
+func newMembershipFakeClient(objs ...client.Object) client.Client {
+	scheme := clientgoscheme.Scheme
+	utilruntime.Must(v1alpha1.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).
+		WithIndex(&discoveryv1.EndpointSlice{}, endpointSlicePodIndex, endpointSlicePodIndexFunc).
+		WithIndex(&v1alpha1.SecurityPolicy{}, securityPolicyServiceAccountLabelIndex, securityPolicyServiceAccountLabelIndexFunc).
+		WithIndex(&v1alpha1.SecurityPolicy{}, securityPolicyServiceRefIndex, securityPolicyServiceRefIndexFunc).
+		Build()
+}
+
+func newWorkloadRefSecurityPolicy(saLabelKey, serviceName string) *v1alpha1.SecurityPolicy {
+	return &v1alpha1.SecurityPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "sp1"},
+		Spec: v1alpha1.SecurityPolicySpec{
+			AppliedTo: []v1alpha1.SecurityPolicyTarget{{
+				ServiceAccountSelector: &metav1.LabelSelector{MatchLabels: map[string]string{saLabelKey: "a"}},
+			}},
+			Rules: []v1alpha1.SecurityPolicyRule{{
+				From: []v1alpha1.SecurityPolicyPeer{{ServiceRef: &v1alpha1.ServiceReference{Name: serviceName}}},
+			}},
+		},
+	}
+}
+
+func TestPodReconciler_buildPortLabelTags(t *testing.T) {
+	sa := &v1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "sa1", Labels: map[string]string{"team": "a", "tier": "b"}}}
+	fakeClient := newMembershipFakeClient(
+		sa,
+		newEndpointSlice("web-abc", "web", "pod1", "pod2"),
+		newEndpointSlice("db-abc", "db", "pod2"),
+		newEndpointSlice("api-abc", "api", "pod1"),
+		newWorkloadRefSecurityPolicy("team", "web"),
+	)
+	r := &PodReconciler{Client: fakeClient}
+
+	// Only the ServiceAccount labels and Services referred by the SecurityPolicies are synced.
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "pod1", Labels: map[string]string{"app": "web"}},
+		Spec:       v1.PodSpec{ServiceAccountName: "sa1"},
+	}
+	labelTags, err := r.buildPortLabelTags(context.TODO(), pod)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"app": "web",
+		servicecommon.TagScopeServiceAccountLabelPrefix + "team": "a",
+		servicecommon.TagScopeServiceMemberPrefix + "web":        servicecommon.TagValueServiceMember,
+	}, labelTags)
+	// The Pod labels are not changed.
+	assert.Equal(t, map[string]string{"app": "web"}, pod.Labels)
+
+	// The default ServiceAccount is not found.
+	fakeClient = newMembershipFakeClient(newWorkloadRefSecurityPolicy(v1.LabelMetadataName, "web"))
+	r = &PodReconciler{Client: fakeClient}
+	pod = &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "pod3"}}
+	labelTags, err = r.buildPortLabelTags(context.TODO(), pod)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		servicecommon.TagScopeServiceAccountLabelPrefix + v1.LabelMetadataName: defaultServiceAccountName,
+	}, labelTags)
+
+	// No tags are synced without SecurityPolicies.
+	r = &PodReconciler{Client: newMembershipFakeClient(sa, newEndpointSlice("web-abc", "web", "pod1"))}
+	labelTags, err = r.buildPortLabelTags(context.TODO(), &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "pod1"},
+		Spec:       v1.PodSpec{ServiceAccountName: "sa1"},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, labelTags)
+}
+
+func TestEnqueueRequestForSecurityPolicy(t *testing.T) {
+	fakeClient := newMembershipFakeClient(
+		&v1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "sa1", Labels: map[string]string{"team": "a"}}},
+		&v1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "sa2"}},
+		&v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "pod1"}, Spec: v1.PodSpec{ServiceAccountName: "sa1"}},
+		&v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "pod2"}, Spec: v1.PodSpec{ServiceAccountName: "sa2"}},
+		newEndpointSlice("web-abc", "web", "pod3"),
+		newEndpointSlice("db-abc", "db", "pod4"),
+	)
+	handler := &EnqueueRequestForSecurityPolicy{Reconciler: &PodReconciler{Client: fakeClient}}
+	queue := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+	defer queue.ShutDown()
+	dequeue := func() map[string]bool {
+		enqueued := map[string]bool{}
+		for queue.Len() > 0 {
+			item, _ := queue.Get()
+			enqueued[item.Name] = true
+			queue.Done(item)
+			queue.Forget(item)
+		}
+		return enqueued
+	}
+
+	handler.Create(context.TODO(), event.CreateEvent{Object: newWorkloadRefSecurityPolicy("team", "web")}, queue)
+	assert.Equal(t, map[string]bool{"pod1": true, "pod3": true}, dequeue())
+
+	// Only the Pods of the changed references are enqueued.
+	handler.Update(context.TODO(), event.UpdateEvent{
+		ObjectOld: newWorkloadRefSecurityPolicy("team", "web"),
+		ObjectNew: newWorkloadRefSecurityPolicy("team", "db"),
+	}, queue)
+	assert.Equal(t, map[string]bool{"pod3": true, "pod4": true}, dequeue())
+
+	// The SecurityPolicy update without reference changes is ignored.
+	handler.Update(context.TODO(), event.UpdateEvent{
+		ObjectOld: newWorkloadRefSecurityPolicy("team", "db"),
+		ObjectNew: newWorkloadRefSecurityPolicy("team", "db"),
+	}, queue)
+	assert.Equal(t, 0, queue.Len())
+
+	// The ServiceAccount name key selects all the ServiceAccounts.
+	handler.Delete(context.TODO(), event.DeleteEvent{Object: newWorkloadRefSecurityPolicy(v1.LabelMetadataName, "other")}, queue)
+	assert.Equal(t, map[string]bool{"pod1": true, "pod2": true}, dequeue())
+}
+
+func TestPodReconciler_serviceAccountMapFunc(t *testing.T) {
+	fakeClient := fake.NewClientBuilder().WithObjects(
+		&v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "pod1"}, Spec: v1.PodSpec{ServiceAccountName: "sa1"}},
+		&v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "pod2"}, Spec: v1.PodSpec{ServiceAccountName: "sa2"}},
+		&v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "pod3"}, Spec: v1.PodSpec{ServiceAccountName: "sa1", HostNetwork: true}},
+		&v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "pod4"}, Spec: v1.PodSpec{ServiceAccountName: "sa1"}},
+	).Build()
+	r := &PodReconciler{Client: fakeClient}
+
+	requests := r.serviceAccountMapFunc(context.TODO(), &v1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "sa1"}})
+	assert.Equal(t, []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: "ns1", Name: "pod1"}}}, requests)
+}
+
+func TestEnqueueRequestForEndpointSlice(t *testing.T) {
+	handler := &EnqueueRequestForEndpointSlice{}
+	queue := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+	defer queue.ShutDown()
+
+	handler.Create(context.TODO(), event.CreateEvent{Object: newEndpointSlice("web-abc", "web", "pod1", "pod2")}, queue)
+	assert.Equal(t, 2, queue.Len())
+	for queue.Len() > 0 {
+		item, _ := queue.Get()
+		queue.Done(item)
+		queue.Forget(item)
+	}
+
+	// Only the Pods added or removed are enqueued.
+	handler.Update(context.TODO(), event.UpdateEvent{
+		ObjectOld: newEndpointSlice("web-abc", "web", "pod1", "pod2"),
+		ObjectNew: newEndpointSlice("web-abc", "web", "pod2", "pod3"),
+	}, queue)
+	assert.Equal(t, 2, queue.Len())
+	enqueued := map[string]bool{}
+	for queue.Len() > 0 {
+		item, _ := queue.Get()
+		enqueued[item.Name] = true
+		queue.Done(item)
+	}
+	assert.Equal(t, map[string]bool{"pod1": true, "pod3": true}, enqueued)
+
+	// The EndpointSlice not owned by a Service is ignored.
+	handler.Delete(context.TODO(), event.DeleteEvent{Object: newEndpointSlice("other", "", "pod1")}, queue)
+	assert.Equal(t, 0, queue.Len())
+}
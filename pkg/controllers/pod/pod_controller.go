@@ -13,6 +13,7 @@ import (
 
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
@@ -21,19 +22,23 @@ import (
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/audit"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetport"
 	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
@@ -115,7 +120,12 @@ func (r *PodReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 			r.StatusUpdater.UpdateFail(ctx, pod, err, "", nil)
 			return common.ResultRequeue, err
 		}
-		nsxSubnetPortState, err := r.SubnetPortService.CreateOrUpdateSubnetPort(pod, nsxSubnet, contextID, &pod.ObjectMeta.Labels, false, r.restoreMode, interfaceIPType)
+		labelTags, err := r.buildPortLabelTags(ctx, pod)
+		if err != nil {
+			r.StatusUpdater.UpdateFail(ctx, pod, err, "", nil)
+			return common.ResultRequeue, err
+		}
+		nsxSubnetPortState, err := r.SubnetPortService.CreateOrUpdateSubnetPort(pod, nsxSubnet, contextID, &labelTags, false, r.restoreMode, interfaceIPType)
 		if err != nil {
 			r.StatusUpdater.UpdateFail(ctx, pod, err, "", nil)
 			return common.ResultRequeue, err
@@ -265,15 +275,47 @@ func (r *PodReconciler) GetNodeByName(nodeName string) (*model.HostTransportNode
 
 // setupWithManager sets up the controller with the Manager.
 func (r *PodReconciler) setupWithManager(mgr ctrl.Manager) error {
+	if err := setupIndexFields(mgr); err != nil {
+		return err
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1.Pod{}).
 		WithEventFilter(PredicateFuncsPod).
 		WithEventFilter(common.VPCNamespacePredicate(r.Client)).
 		WithOptions(
 			controller.Options{
-				MaxConcurrentReconciles: common.NumReconcile(),
+				MaxConcurrentReconciles: common.NumReconcile(r.StatusUpdater.NSXConfig, MetricResTypePod),
+				RateLimiter:             common.RateLimiter(r.StatusUpdater.NSXConfig, MetricResTypePod),
+				NewQueue:                common.NewQueue(r.StatusUpdater.NSXConfig, MetricResTypePod),
+				NeedLeaderElection:      common.NeedLeaderElection(),
 			}).
-		Complete(r)
+		Watches(
+			&v1.ServiceAccount{},
+			handler.EnqueueRequestsFromMapFunc(r.serviceAccountMapFunc),
+			builder.WithPredicates(predicate.LabelChangedPredicate{}),
+		).
+		Watches(
+			&discoveryv1.EndpointSlice{},
+			&EnqueueRequestForEndpointSlice{},
+		).
+		Watches(
+			&v1alpha1.SecurityPolicy{},
+			&EnqueueRequestForSecurityPolicy{Reconciler: r},
+		).
+		WatchesRawSource(common.ShardSource(MetricResTypePod, r.listPods)).
+		Complete(common.LicensedReconciler(MetricResTypePod, common.ShardedReconciler(MetricResTypePod, tracing.Reconciler(MetricResTypePod, audit.Reconciler(r.Client, &v1.Pod{}, r)))))
+}
+
+func (r *PodReconciler) listPods(ctx context.Context) ([]client.Object, error) {
+	podList := &v1.PodList{}
+	if err := r.Client.List(ctx, podList); err != nil {
+		return nil, err
+	}
+	objs := make([]client.Object, 0, len(podList.Items))
+	for i := range podList.Items {
+		objs = append(objs, &podList.Items[i])
+	}
+	return objs, nil
 }
 
 func (r *PodReconciler) RestoreReconcile() error {
@@ -284,9 +326,12 @@ func (r *PodReconciler) RestoreReconcile() error {
 	}
 	var errorList []error
 	r.restoreMode = true
+	util.RecordRestoreTotal(len(restoreList))
 	for _, key := range restoreList {
 		result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
-		if err != nil || common.IsReconcileResultRequeue(result) {
+		restored := err == nil && !common.IsReconcileResultRequeue(result)
+		util.RecordRestoreResult(restored)
+		if !restored {
 			errorList = append(errorList, fmt.Errorf("failed to restore Pod %s, error: %w", key, err))
 		}
 	}
@@ -322,6 +367,11 @@ func (r *PodReconciler) StartController(mgr ctrl.Manager, _ webhook.Server) erro
 	return nil
 }
 
+// RegisterWebhooks implements ShardedReconcilerProvider, the Pod controller has no webhook.
+func (r *PodReconciler) RegisterWebhooks(_ ctrl.Manager, _ webhook.Server) {}
+
+var _ util.ShardedReconcilerProvider = (*PodReconciler)(nil)
+
 func NewPodReconciler(mgr ctrl.Manager, subnetPortService *subnetport.SubnetPortService, subnetService servicecommon.SubnetServiceProvider, vpcService servicecommon.VPCServiceProvider, nodeService servicecommon.NodeServiceReader) *PodReconciler {
 	podPortReconciler := &PodReconciler{
 		Client:            mgr.GetClient(),
@@ -222,6 +222,10 @@ func TestPodReconciler_Reconcile(t *testing.T) {
 					func(r *subnet.SubnetService, path string, sharedSubnet bool) (*model.VpcSubnet, error) {
 						return &model.VpcSubnet{}, nil
 					})
+				patches.ApplyFunc((*PodReconciler).buildPortLabelTags,
+					func(r *PodReconciler, ctx context.Context, pod *v1.Pod) (map[string]string, error) {
+						return pod.Labels, nil
+					})
 				patches.ApplyFunc((*subnetport.SubnetPortService).CreateOrUpdateSubnetPort,
 					func(r *subnetport.SubnetPortService, obj interface{}, nsxSubnet *model.VpcSubnet, contextID string, tags *map[string]string, isVmSubnetPort bool, restoreMode bool, interfaceIPType v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
 						return nil, errors.New("failed to create subnetport")
@@ -255,6 +259,10 @@ func TestPodReconciler_Reconcile(t *testing.T) {
 					func(s *subnet.SubnetService, path string, sharedSubnet bool) (*model.VpcSubnet, error) {
 						return &model.VpcSubnet{}, nil
 					})
+				patches.ApplyFunc((*PodReconciler).buildPortLabelTags,
+					func(r *PodReconciler, ctx context.Context, pod *v1.Pod) (map[string]string, error) {
+						return pod.Labels, nil
+					})
 				patches.ApplyFunc((*subnetport.SubnetPortService).CreateOrUpdateSubnetPort,
 					func(s *subnetport.SubnetPortService, obj interface{}, nsxSubnet *model.VpcSubnet, contextID string, tags *map[string]string, isVmSubnetPort bool, restoreMode bool, interfaceIPType v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
 						return &model.SegmentPortState{
@@ -305,6 +313,10 @@ func TestPodReconciler_Reconcile(t *testing.T) {
 					func(s *subnet.SubnetService, path string, sharedSubnet bool) (*model.VpcSubnet, error) {
 						return &model.VpcSubnet{}, nil
 					})
+				patches.ApplyFunc((*PodReconciler).buildPortLabelTags,
+					func(r *PodReconciler, ctx context.Context, pod *v1.Pod) (map[string]string, error) {
+						return pod.Labels, nil
+					})
 				patches.ApplyFunc((*subnetport.SubnetPortService).CreateOrUpdateSubnetPort,
 					func(s *subnetport.SubnetPortService, obj interface{}, nsxSubnet *model.VpcSubnet, contextID string, tags *map[string]string, isVmSubnetPort bool, restoreMode bool, interfaceIPType v1alpha1.IPAddressType) (*model.SegmentPortState, error) {
 						return &model.SegmentPortState{
@@ -7,9 +7,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"os"
 	"time"
 
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -26,9 +26,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
 	crdv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/audit"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
@@ -37,6 +39,7 @@ import (
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/securitypolicy"
 	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
@@ -55,6 +58,7 @@ type SecurityPolicyReconciler struct {
 	Service       *securitypolicy.SecurityPolicyService
 	Recorder      record.EventRecorder
 	StatusUpdater common.StatusUpdater
+	queue         workqueue.TypedRateLimitingInterface[reconcile.Request]
 }
 
 func k8sClient(mgr ctrl.Manager) client.Client {
@@ -107,6 +111,7 @@ func cleanSecurityPolicyErrorAnnotation(ctx context.Context, securityPolicy *v1a
 }
 
 func (r *SecurityPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logger.FromContext(ctx)
 	var obj client.Object
 	if securitypolicy.IsVPCEnabled(r.Service) {
 		obj = &crdv1alpha1.SecurityPolicy{}
@@ -124,7 +129,10 @@ func (r *SecurityPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 
 	if err := r.Client.Get(ctx, req.NamespacedName, obj); err != nil {
 		if apierrors.IsNotFound(err) {
-			if err := r.deleteSecurityPolicyByName(req.Namespace, req.Name); err != nil {
+			delCtx, span := tracing.Start(ctx, "SecurityPolicyService.DeleteSecurityPolicy")
+			err := r.deleteSecurityPolicyByName(delCtx, req.Namespace, req.Name)
+			tracing.End(span, err)
+			if err != nil {
 				r.StatusUpdater.DeleteFail(req.NamespacedName, nil, err)
 				return ResultRequeue, err
 			}
@@ -172,17 +180,16 @@ func (r *SecurityPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		}
 
 		log.Info("Reconciling CR to create or update securitypolicy", "securitypolicy", req.NamespacedName)
-		if err := r.Service.CreateOrUpdateSecurityPolicy(realObj); err != nil {
-			if errors.As(err, &nsxutil.RestrictionError{}) {
+		spCtx, span := tracing.Start(ctx, "SecurityPolicyService.CreateOrUpdateSecurityPolicy")
+		err := r.Service.CreateOrUpdateSecurityPolicy(spCtx, realObj)
+		tracing.End(span, err)
+		if err != nil {
+			// Without the DFW license, the SecurityPolicy is paused until the license is restored.
+			if errors.As(err, &nsxutil.RestrictionError{}) || nsxutil.IsInvalidLicense(err) {
 				setSecurityPolicyErrorAnnotation(ctx, realObj, securitypolicy.IsVPCEnabled(r.Service), r.Client, common.ErrorNoDFWLicense)
 				r.StatusUpdater.UpdateFail(ctx, realObj, err, "", setSecurityPolicyReadyStatusFalse, r.Service)
 				return ResultNormal, nil
 			}
-			if nsxutil.IsInvalidLicense(err) {
-				log.Error(err, err.Error(), "securitypolicy", req.NamespacedName)
-				setSecurityPolicyErrorAnnotation(ctx, realObj, securitypolicy.IsVPCEnabled(r.Service), r.Client, common.ErrorNoDFWLicense)
-				os.Exit(1)
-			}
 			r.StatusUpdater.UpdateFail(ctx, realObj, err, "", setSecurityPolicyReadyStatusFalse, r.Service)
 			return ResultRequeue, err
 		}
@@ -202,7 +209,10 @@ func (r *SecurityPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 			}
 			log.Debug("Removed finalizer", "securitypolicy", req.NamespacedName)
 		}
-		if err := r.Service.DeleteSecurityPolicy(realObj.UID, false, servicecommon.ResourceTypeSecurityPolicy); err != nil {
+		delCtx, span := tracing.Start(ctx, "SecurityPolicyService.DeleteSecurityPolicy")
+		err := r.Service.DeleteSecurityPolicy(delCtx, realObj.UID, false, servicecommon.ResourceTypeSecurityPolicy)
+		tracing.End(span, err)
+		if err != nil {
 			r.StatusUpdater.DeleteFail(req.NamespacedName, realObj, err)
 			return ResultRequeue, err
 		}
@@ -312,16 +322,17 @@ func isConditionSemanticEqual(matchedCondition, newCondition *v1alpha1.Condition
 }
 
 func (r *SecurityPolicyReconciler) setupWithManager(mgr ctrl.Manager) error {
-	var blr *builder.Builder
+	var obj client.Object = &v1alpha1.SecurityPolicy{}
 	if securitypolicy.IsVPCEnabled(r.Service) {
-		blr = ctrl.NewControllerManagedBy(mgr).For(&crdv1alpha1.SecurityPolicy{})
-	} else {
-		blr = ctrl.NewControllerManagedBy(mgr).For(&v1alpha1.SecurityPolicy{})
+		obj = &crdv1alpha1.SecurityPolicy{}
 	}
-	return blr.
+	return ctrl.NewControllerManagedBy(mgr).For(obj).
 		WithOptions(
 			controller.Options{
-				MaxConcurrentReconciles: common.NumReconcile(),
+				MaxConcurrentReconciles: common.NumReconcile(r.StatusUpdater.NSXConfig, MetricResTypeSecurityPolicy),
+				RateLimiter:             common.RateLimiter(r.StatusUpdater.NSXConfig, MetricResTypeSecurityPolicy),
+				NewQueue:                r.getQueue,
+				NeedLeaderElection:      common.NeedLeaderElection(),
 			}).
 		Watches(
 			&v1.Namespace{},
@@ -333,7 +344,51 @@ func (r *SecurityPolicyReconciler) setupWithManager(mgr ctrl.Manager) error {
 			&EnqueueRequestForPod{Client: k8sClient(mgr), SecurityPolicyReconciler: r},
 			builder.WithPredicates(PredicateFuncsPod),
 		).
-		Complete(r)
+		WatchesRawSource(common.DFWLicenseSource(MetricResTypeSecurityPolicy, r.listSecurityPolicies)).
+		WatchesRawSource(common.ShardSource(MetricResTypeSecurityPolicy, r.listSecurityPolicies)).
+		Complete(common.LicensedReconciler(MetricResTypeSecurityPolicy, common.ShardedReconciler(MetricResTypeSecurityPolicy, tracing.Reconciler(MetricResTypeSecurityPolicy, audit.Reconciler(r.Client, obj, r)))))
+}
+
+func (r *SecurityPolicyReconciler) getQueue(controllerName string, rateLimiter workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+	if r.queue == nil {
+		r.queue = common.NewQueue(r.StatusUpdater.NSXConfig, MetricResTypeSecurityPolicy)(controllerName, rateLimiter)
+	}
+	return r.queue
+}
+
+// newDriftScanner returns the scanner of the drift of the NSX resources owned by the SecurityPolicy CRs, the NSX
+// resources created for NetworkPolicies are skipped.
+func (r *SecurityPolicyReconciler) newDriftScanner() *common.DriftScanner {
+	return &common.DriftScanner{
+		Client:   r.Client,
+		Service:  &r.Service.Service,
+		Recorder: r.Recorder,
+		Sources:  r.Service.DriftSources(),
+		Owner: func(obj interface{}) client.Object {
+			var tags []model.Tag
+			switch o := obj.(type) {
+			case *model.SecurityPolicy:
+				tags = o.Tags
+			case *model.Rule:
+				tags = o.Tags
+			case *model.Group:
+				tags = o.Tags
+			}
+			key, ok := common.DriftOwnerKey(tags, servicecommon.TagValueScopeSecurityPolicyName, servicecommon.TagScopeNamespace)
+			if !ok {
+				return nil
+			}
+			if securitypolicy.IsVPCEnabled(r.Service) {
+				return &crdv1alpha1.SecurityPolicy{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}}
+			}
+			return &v1alpha1.SecurityPolicy{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}}
+		},
+		Enqueue: func(req reconcile.Request) {
+			if r.queue != nil {
+				common.AddLowPriority(r.queue, req)
+			}
+		},
+	}
 }
 
 // Start setup manager and launch GC
@@ -364,7 +419,7 @@ func (r *SecurityPolicyReconciler) CollectGarbage(ctx context.Context) error {
 	for elem := range diffSet {
 		log.Debug("GC collected SecurityPolicy CR", "securityPolicyUID", elem)
 		r.StatusUpdater.IncreaseDeleteTotal()
-		err = r.Service.DeleteSecurityPolicy(types.UID(elem), true, servicecommon.ResourceTypeSecurityPolicy)
+		err = r.Service.DeleteSecurityPolicy(ctx, types.UID(elem), true, servicecommon.ResourceTypeSecurityPolicy)
 		if err != nil {
 			errList = append(errList, err)
 			r.StatusUpdater.IncreaseDeleteFailTotal()
@@ -378,12 +433,12 @@ func (r *SecurityPolicyReconciler) CollectGarbage(ctx context.Context) error {
 	return nil
 }
 
-func (r *SecurityPolicyReconciler) deleteSecurityPolicyByName(ns, name string) error {
+func (r *SecurityPolicyReconciler) deleteSecurityPolicyByName(ctx context.Context, ns, name string) error {
 	nsxSecurityPolicies := r.Service.ListSecurityPolicyByName(ns, name)
 	for _, item := range nsxSecurityPolicies {
 		uid := nsxutil.FindTag(item.Tags, servicecommon.TagValueScopeSecurityPolicyUID)
 		log.Info("Deleting SecurityPolicy", "securityPolicyUID", uid, "nsxSecurityPolicyId", *item.Id)
-		if err := r.Service.DeleteSecurityPolicy(types.UID(uid), false, servicecommon.ResourceTypeSecurityPolicy); err != nil {
+		if err := r.Service.DeleteSecurityPolicy(ctx, types.UID(uid), false, servicecommon.ResourceTypeSecurityPolicy); err != nil {
 			log.Error(err, "Failed to delete SecurityPolicy", "securityPolicyUID", uid, "nsxSecurityPolicyId", *item.Id)
 			return err
 		}
@@ -392,6 +447,29 @@ func (r *SecurityPolicyReconciler) deleteSecurityPolicyByName(ns, name string) e
 	return nil
 }
 
+// listSecurityPolicies lists all the SecurityPolicy CRs of the mode of the operator.
+func (r *SecurityPolicyReconciler) listSecurityPolicies(ctx context.Context) ([]client.Object, error) {
+	var objs []client.Object
+	if securitypolicy.IsVPCEnabled(r.Service) {
+		list := &crdv1alpha1.SecurityPolicyList{}
+		if err := r.Client.List(ctx, list); err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			objs = append(objs, &list.Items[i])
+		}
+		return objs, nil
+	}
+	list := &v1alpha1.SecurityPolicyList{}
+	if err := r.Client.List(ctx, list); err != nil {
+		return nil, err
+	}
+	for i := range list.Items {
+		objs = append(objs, &list.Items[i])
+	}
+	return objs, nil
+}
+
 func (r *SecurityPolicyReconciler) listSecurityPolicyCRIDs() (sets.Set[string], error) {
 	var objectList client.ObjectList
 	if securitypolicy.IsVPCEnabled(r.Service) {
@@ -482,15 +560,34 @@ func (r *SecurityPolicyReconciler) RestoreReconcile() error {
 	return nil
 }
 
-func (r *SecurityPolicyReconciler) StartController(mgr ctrl.Manager, _ webhook.Server) error {
+func (r *SecurityPolicyReconciler) StartController(mgr ctrl.Manager, hookServer webhook.Server) error {
 	if err := r.Start(mgr); err != nil {
 		log.Error(err, "Failed to create controller", "controller", "SecurityPolicy")
 		return err
 	}
+	if hookServer != nil {
+		r.RegisterWebhooks(mgr, hookServer)
+	}
 	go common.GenericGarbageCollector(make(chan bool), servicecommon.GCInterval, r.CollectGarbage)
+	if r.Service.NSXConfig.DriftScanInterval > 0 {
+		r.newDriftScanner().Start()
+	}
 	return nil
 }
 
+// RegisterWebhooks registers the SecurityPolicy validating webhook.
+func (r *SecurityPolicyReconciler) RegisterWebhooks(mgr ctrl.Manager, hookServer webhook.Server) {
+	hookServer.Register("/validate-crd-nsx-vmware-com-v1alpha1-securitypolicy",
+		&webhook.Admission{
+			Handler: &SecurityPolicyValidator{
+				Client:  mgr.GetClient(),
+				decoder: admission.NewDecoder(mgr.GetScheme()),
+			},
+		})
+}
+
+var _ util.ShardedReconcilerProvider = (*SecurityPolicyReconciler)(nil)
+
 func NewSecurityPolicyReconciler(mgr ctrl.Manager, commonService servicecommon.Service, vpcService servicecommon.VPCServiceProvider) *SecurityPolicyReconciler {
 	securityPolicyReconcile := &SecurityPolicyReconciler{
 		Client:   mgr.GetClient(),
@@ -310,7 +310,7 @@ func TestSecurityPolicyReconciler_Reconcile(t *testing.T) {
 	// not found and deletion success
 	errNotFound := apierrors.NewNotFound(v1alpha1.Resource("SecurityPolicy"), "")
 	k8sClient.EXPECT().Get(ctx, gomock.Any(), gomock.Any()).Return(errNotFound)
-	deleteSecurityPolicyByNamePatch := gomonkey.ApplyPrivateMethod(reflect.TypeOf(r), "deleteSecurityPolicyByName", func(_ *SecurityPolicyReconciler, name, ns string) error {
+	deleteSecurityPolicyByNamePatch := gomonkey.ApplyPrivateMethod(reflect.TypeOf(r), "deleteSecurityPolicyByName", func(_ *SecurityPolicyReconciler, _ context.Context, name, ns string) error {
 		return nil
 	})
 	defer deleteSecurityPolicyByNamePatch.Reset()
@@ -356,7 +356,7 @@ func TestSecurityPolicyReconciler_Reconcile(t *testing.T) {
 		return false, nil
 	})
 	err = errors.New("create or update security policy failed")
-	patch := gomonkey.ApplyMethod(reflect.TypeOf(service), "CreateOrUpdateSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, obj interface{}) error {
+	patch := gomonkey.ApplyMethod(reflect.TypeOf(service), "CreateOrUpdateSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, obj interface{}) error {
 		return errors.New("create or update security policy failed")
 	})
 	k8sClient.EXPECT().Status().Times(1).Return(fakewriter)
@@ -367,7 +367,7 @@ func TestSecurityPolicyReconciler_Reconcile(t *testing.T) {
 
 	// DeletionTimestamp.IsZero = true, Finalizers include util.SecurityPolicyFinalizerName and update success
 	k8sClient.EXPECT().Get(ctx, gomock.Any(), sp).Return(nil)
-	patch = gomonkey.ApplyMethod(reflect.TypeOf(service), "CreateOrUpdateSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, obj interface{}) error {
+	patch = gomonkey.ApplyMethod(reflect.TypeOf(service), "CreateOrUpdateSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, obj interface{}) error {
 		return nil
 	})
 	k8sClient.EXPECT().Status().Times(1).Return(fakewriter)
@@ -385,7 +385,7 @@ func TestSecurityPolicyReconciler_Reconcile(t *testing.T) {
 		v1sp.Finalizers = []string{common.T1SecurityPolicyFinalizerName}
 		return nil
 	})
-	patch = gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, obj interface{}, isGc bool, createdFor string) error {
+	patch = gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, obj interface{}, isGc bool, createdFor string) error {
 		assert.FailNow(t, "should not be called")
 		return nil
 	})
@@ -403,7 +403,7 @@ func TestSecurityPolicyReconciler_Reconcile(t *testing.T) {
 		v1sp.ObjectMeta.DeletionTimestamp = &time
 		return nil
 	})
-	patch = gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, obj interface{}, isGc bool, createdFor string) error {
+	patch = gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, obj interface{}, isGc bool, createdFor string) error {
 		return nil
 	})
 	result, retErr = r.Reconcile(ctx, req)
@@ -420,7 +420,7 @@ func TestSecurityPolicyReconciler_Reconcile(t *testing.T) {
 		return nil
 	})
 	err = errors.New("delete security policy failed")
-	patch = gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, UID interface{}, isGc bool, createdFor string) error {
+	patch = gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, UID interface{}, isGc bool, createdFor string) error {
 		return errors.New("delete security policy failed")
 	})
 	k8sClient.EXPECT().Update(ctx, gomock.Any(), gomock.Any()).Return(nil)
@@ -437,7 +437,7 @@ func TestSecurityPolicyReconciler_Reconcile(t *testing.T) {
 		v1sp.Finalizers = []string{common.T1SecurityPolicyFinalizerName}
 		return nil
 	})
-	patch = gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, obj interface{}, isGc bool, createdFor string) error {
+	patch = gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, obj interface{}, isGc bool, createdFor string) error {
 		return nil
 	})
 	k8sClient.EXPECT().Update(ctx, gomock.Any(), gomock.Any()).Return(nil)
@@ -473,7 +473,7 @@ func TestSecurityPolicyReconciler_GarbageCollector(t *testing.T) {
 	policyList := &v1alpha1.SecurityPolicyList{}
 
 	// gc collect item "2345", local store has more item than k8s cache
-	patch := gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, obj interface{}, isGc bool, createdFor string) error {
+	patch := gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, obj interface{}, isGc bool, createdFor string) error {
 		return nil
 	})
 	patch.ApplyMethod(reflect.TypeOf(service), "ListSecurityPolicyID", func(_ *securitypolicy.SecurityPolicyService) sets.Set[string] {
@@ -498,7 +498,7 @@ func TestSecurityPolicyReconciler_GarbageCollector(t *testing.T) {
 		a.Insert("1234")
 		return a
 	})
-	patch.ApplyMethod(reflect.TypeOf(r.Service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, obj interface{}, isGc bool, createdFor string) error {
+	patch.ApplyMethod(reflect.TypeOf(r.Service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, obj interface{}, isGc bool, createdFor string) error {
 		assert.FailNow(t, "should not be called")
 		return nil
 	})
@@ -517,7 +517,7 @@ func TestSecurityPolicyReconciler_GarbageCollector(t *testing.T) {
 		a := sets.New[string]()
 		return a
 	})
-	patch.ApplyMethod(reflect.TypeOf(service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, obj types.UID, isGc bool, createdFor string) error {
+	patch.ApplyMethod(reflect.TypeOf(service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, obj types.UID, isGc bool, createdFor string) error {
 		assert.FailNow(t, "should not be called")
 		return nil
 	})
@@ -709,14 +709,14 @@ func TestSecurityPolicyReconciler_deleteSecuritypolicyByName(t *testing.T) {
 		}
 	})
 
-	patch.ApplyMethod(reflect.TypeOf(service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, obj types.UID, isGc bool, createdFor string) error {
+	patch.ApplyMethod(reflect.TypeOf(service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ context.Context, obj types.UID, isGc bool, createdFor string) error {
 		if obj == "uid2" {
 			return errors.New("delete failed")
 		}
 		return nil
 	})
 
-	err := r.deleteSecurityPolicyByName("dummy-ns", "dummy-name")
+	err := r.deleteSecurityPolicyByName(context.TODO(), "dummy-ns", "dummy-name")
 	assert.Error(t, err)
 	patch.Reset()
 }
@@ -760,7 +760,7 @@ func TestSecurityPolicyReconcilerForVPC_Reconcile(t *testing.T) {
 	errNotFound := apierrors.NewNotFound(crdv1alpha1.Resource("SecurityPolicy"), "")
 	k8sClient.EXPECT().Get(ctx, gomock.Any(), gomock.Any()).Return(errNotFound)
 	err := errors.New("delete security policy failed")
-	deleteSecurityPolicyByNamePatch := gomonkey.ApplyPrivateMethod(reflect.TypeOf(r), "deleteSecurityPolicyByName", func(_ *SecurityPolicyReconciler, name, ns string) error {
+	deleteSecurityPolicyByNamePatch := gomonkey.ApplyPrivateMethod(reflect.TypeOf(r), "deleteSecurityPolicyByName", func(_ *SecurityPolicyReconciler, _ context.Context, name, ns string) error {
 		return errors.New("delete security policy failed")
 	})
 	result, retErr = r.Reconcile(ctx, req)
@@ -770,7 +770,7 @@ func TestSecurityPolicyReconcilerForVPC_Reconcile(t *testing.T) {
 	// not found and deletion success
 	deleteSecurityPolicyByNamePatch.Reset()
 	k8sClient.EXPECT().Get(ctx, gomock.Any(), gomock.Any()).Return(errNotFound)
-	deleteSecurityPolicyByNamePatch = gomonkey.ApplyPrivateMethod(reflect.TypeOf(r), "deleteSecurityPolicyByName", func(_ *SecurityPolicyReconciler, name, ns string) error {
+	deleteSecurityPolicyByNamePatch = gomonkey.ApplyPrivateMethod(reflect.TypeOf(r), "deleteSecurityPolicyByName", func(_ *SecurityPolicyReconciler, _ context.Context, name, ns string) error {
 		return nil
 	})
 	defer deleteSecurityPolicyByNamePatch.Reset()
@@ -0,0 +1,54 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package securitypolicy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	crdv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/securitypolicy"
+)
+
+// Create validator instead of using the existing one in controller-runtime because the existing one can't
+// inspect admission.Request in Handle function.
+
+//+kubebuilder:webhook:path=/validate-crd-nsx-vmware-com-v1alpha1-securitypolicy,mutating=false,failurePolicy=fail,sideEffects=None,groups=crd.nsx.vmware.com,resources=securitypolicies,verbs=create;update,versions=v1alpha1,name=securitypolicy.validating.crd.nsx.vmware.com,admissionReviewVersions=v1
+
+type SecurityPolicyValidator struct {
+	Client  client.Client
+	decoder admission.Decoder
+}
+
+// Handle handles admission requests.
+func (v *SecurityPolicyValidator) Handle(_ context.Context, req admission.Request) admission.Response {
+	if req.Operation == admissionv1.Delete {
+		return admission.Allowed("")
+	}
+
+	securityPolicy := &crdv1alpha1.SecurityPolicy{}
+	if err := v.decoder.Decode(req, securityPolicy); err != nil {
+		log.Error(err, "Error while decoding SecurityPolicy", "SecurityPolicy", req.Namespace+"/"+req.Name)
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	rules := securitypolicy.VPCToT1(securityPolicy).Spec.Rules
+	for i := range rules {
+		rule := &rules[i]
+		if err := securitypolicy.ValidateSecurityPolicyPorts(rule.Ports); err != nil {
+			log.Info("SecurityPolicy validation failed", "SecurityPolicy", req.Namespace+"/"+req.Name, "rule", rule.Name, "error", err)
+			return admission.Denied(fmt.Sprintf("SecurityPolicy %s/%s rule %q has invalid ports: %v", req.Namespace, req.Name, rule.Name, err))
+		}
+		if err := securitypolicy.ValidateRuleFQDNPeers(rule); err != nil {
+			log.Info("SecurityPolicy validation failed", "SecurityPolicy", req.Namespace+"/"+req.Name, "rule", rule.Name, "error", err)
+			return admission.Denied(fmt.Sprintf("SecurityPolicy %s/%s rule %q has invalid fqdns: %v", req.Namespace, req.Name, rule.Name, err))
+		}
+	}
+	return admission.Allowed("")
+}
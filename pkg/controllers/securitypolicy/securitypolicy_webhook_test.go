@@ -0,0 +1,126 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package securitypolicy
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	crdv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+)
+
+func TestSecurityPolicyValidator_Handle(t *testing.T) {
+	tests := []struct {
+		name      string
+		operation admissionv1.Operation
+		ports     []crdv1alpha1.SecurityPolicyPort
+		direction crdv1alpha1.RuleDirection
+		from      []crdv1alpha1.SecurityPolicyPeer
+		to        []crdv1alpha1.SecurityPolicyPeer
+		allowed   bool
+	}{
+		{
+			name:      "delete operation allowed",
+			operation: admissionv1.Delete,
+			allowed:   true,
+		},
+		{
+			name:      "create with ICMP echo",
+			operation: admissionv1.Create,
+			ports:     []crdv1alpha1.SecurityPolicyPort{{Protocol: crdv1alpha1.ProtocolICMP, ICMPType: ptr.To[int32](8), ICMPCode: ptr.To[int32](0)}},
+			allowed:   true,
+		},
+		{
+			name:      "create with TCP port",
+			operation: admissionv1.Create,
+			ports:     []crdv1alpha1.SecurityPolicyPort{{Protocol: "TCP", Port: intstr.FromInt32(80)}},
+			allowed:   true,
+		},
+		{
+			name:      "update with ICMP type on UDP denied",
+			operation: admissionv1.Update,
+			ports:     []crdv1alpha1.SecurityPolicyPort{{Protocol: "UDP", ICMPType: ptr.To[int32](8)}},
+			allowed:   false,
+		},
+		{
+			name:      "create with ICMPv6 code without type denied",
+			operation: admissionv1.Create,
+			ports:     []crdv1alpha1.SecurityPolicyPort{{Protocol: crdv1alpha1.ProtocolICMPv6, ICMPCode: ptr.To[int32](0)}},
+			allowed:   false,
+		},
+		{
+			name:      "create with fqdns in egress destinations",
+			operation: admissionv1.Create,
+			direction: crdv1alpha1.RuleDirectionEgress,
+			to:        []crdv1alpha1.SecurityPolicyPeer{{FQDNs: []string{"*.example.com"}}},
+			allowed:   true,
+		},
+		{
+			name:      "create with fqdns in ingress rule denied",
+			operation: admissionv1.Create,
+			direction: crdv1alpha1.RuleDirectionIngress,
+			to:        []crdv1alpha1.SecurityPolicyPeer{{FQDNs: []string{"*.example.com"}}},
+			allowed:   false,
+		},
+		{
+			name:      "update with fqdns in sources denied",
+			operation: admissionv1.Update,
+			direction: crdv1alpha1.RuleDirectionOut,
+			from:      []crdv1alpha1.SecurityPolicyPeer{{FQDNs: []string{"www.example.com"}}},
+			allowed:   false,
+		},
+		{
+			name:      "create with fqdns mixed with other peers denied",
+			operation: admissionv1.Create,
+			direction: crdv1alpha1.RuleDirectionOut,
+			to: []crdv1alpha1.SecurityPolicyPeer{
+				{FQDNs: []string{"www.example.com"}},
+				{IPBlocks: []crdv1alpha1.IPBlock{{CIDR: "10.0.0.0/24"}}},
+			},
+			allowed: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			crdv1alpha1.AddToScheme(scheme)
+			validator := &SecurityPolicyValidator{
+				Client:  fake.NewClientBuilder().WithScheme(scheme).Build(),
+				decoder: admission.NewDecoder(scheme),
+			}
+			rule := crdv1alpha1.SecurityPolicyRule{Name: "rule1", Ports: tt.ports, From: tt.from, To: tt.to}
+			if tt.direction != "" {
+				rule.Direction = &tt.direction
+			}
+			sp, _ := json.Marshal(&crdv1alpha1.SecurityPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "sp1"},
+				Spec: crdv1alpha1.SecurityPolicySpec{
+					Rules: []crdv1alpha1.SecurityPolicyRule{rule},
+				},
+			})
+			req := admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: tt.operation,
+					Namespace: "ns1",
+					Name:      "sp1",
+					Object:    runtime.RawExtension{Raw: sp},
+				},
+			}
+
+			response := validator.Handle(context.TODO(), req)
+			assert.Equal(t, tt.allowed, response.Allowed)
+		})
+	}
+}
@@ -27,12 +27,15 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/audit"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
 	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
 	_ "github.com/vmware-tanzu/nsx-operator/pkg/nsx/ratelimiter"
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/dns"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 )
 
 var (
@@ -163,6 +166,14 @@ func (r *ServiceLbReconciler) setServiceLbStatus(ctx context.Context, lbService
 	})
 }
 
+// nsxConfig returns nil if the service is not set, the default controller configuration is used in that case.
+func (r *ServiceLbReconciler) nsxConfig() *config.NSXOperatorConfig {
+	if r.Service == nil {
+		return nil
+	}
+	return r.Service.NSXConfig
+}
+
 func (r *ServiceLbReconciler) setupWithManager(mgr ctrl.Manager) error {
 	b := ctrl.NewControllerManagedBy(mgr).
 		For(&v1.Service{}).
@@ -174,9 +185,11 @@ func (r *ServiceLbReconciler) setupWithManager(mgr ctrl.Manager) error {
 		WithEventFilter(common.VPCNamespacePredicate(r.Client)).
 		WithOptions(
 			controller.Options{
-				MaxConcurrentReconciles: common.NumReconcile(),
+				MaxConcurrentReconciles: common.NumReconcile(r.nsxConfig(), MetricResType),
+				RateLimiter:             common.RateLimiter(r.nsxConfig(), MetricResType),
+				NewQueue:                common.NewQueue(r.nsxConfig(), MetricResType),
 			})
-	return b.Complete(r)
+	return b.Complete(common.LicensedReconciler(MetricResType, tracing.Reconciler(MetricResType, audit.Reconciler(r.Client, &v1.Service{}, r))))
 }
 
 // Start setup manager
@@ -25,12 +25,14 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
+	"github.com/vmware-tanzu/nsx-operator/pkg/audit"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	subnetportservice "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetport"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 )
 
 var (
@@ -449,9 +451,11 @@ func (r *StatefulSetReconciler) setupWithManager(mgr ctrl.Manager) error {
 		For(&appsv1.StatefulSet{}).
 		WithEventFilter(PredicateFuncsForStatefulSet).
 		WithOptions(controller.Options{
-			MaxConcurrentReconciles: common.NumReconcile(),
+			MaxConcurrentReconciles: common.NumReconcile(r.StatusUpdater.NSXConfig, MetricResTypeStatefulSet),
+			RateLimiter:             common.RateLimiter(r.StatusUpdater.NSXConfig, MetricResTypeStatefulSet),
+			NewQueue:                common.NewQueue(r.StatusUpdater.NSXConfig, MetricResTypeStatefulSet),
 		}).
-		Complete(r)
+		Complete(common.LicensedReconciler(MetricResTypeStatefulSet, tracing.Reconciler(MetricResTypeStatefulSet, audit.Reconciler(r.Client, &appsv1.StatefulSet{}, r))))
 }
 
 func (r *StatefulSetReconciler) StartController(mgr ctrl.Manager, _ webhook.Server) error {
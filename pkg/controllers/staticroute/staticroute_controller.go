@@ -22,6 +22,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/audit"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
@@ -29,6 +30,7 @@ import (
 	commonservice "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/staticroute"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 	pkgUtil "github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
@@ -183,9 +185,11 @@ func (r *StaticRouteReconciler) setupWithManager(mgr ctrl.Manager) error {
 		WithEventFilter(common.VPCNamespacePredicate(r.Client)).
 		WithOptions(
 			controller.Options{
-				MaxConcurrentReconciles: common.NumReconcile(),
+				MaxConcurrentReconciles: common.NumReconcile(r.StatusUpdater.NSXConfig, MetricResTypeStaticRoute),
+				RateLimiter:             common.RateLimiter(r.StatusUpdater.NSXConfig, MetricResTypeStaticRoute),
+				NewQueue:                common.NewQueue(r.StatusUpdater.NSXConfig, MetricResTypeStaticRoute),
 			}).
-		Complete(r)
+		Complete(common.LicensedReconciler(MetricResTypeStaticRoute, tracing.Reconciler(MetricResTypeStaticRoute, audit.Reconciler(r.Client, &v1alpha1.StaticRoute{}, r))))
 }
 
 // Start setup manager and launch GC
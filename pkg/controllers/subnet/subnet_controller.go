@@ -27,12 +27,14 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/audit"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnet"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetbinding"
 	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
@@ -58,6 +60,7 @@ type SubnetReconciler struct {
 }
 
 func (r *SubnetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logger.FromContext(ctx)
 	startTime := time.Now()
 	defer func() {
 		log.Info("Finished reconciling Subnet", "Subnet", req.NamespacedName, "duration(ms)", time.Since(startTime).Milliseconds())
@@ -68,7 +71,10 @@ func (r *SubnetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 
 	if err := r.Client.Get(ctx, req.NamespacedName, subnetCR); err != nil {
 		if apierrors.IsNotFound(err) {
-			if err := r.deleteSubnetByName(req.Name, req.Namespace); err != nil {
+			delCtx, span := tracing.Start(ctx, "SubnetService.DeleteSubnet")
+			err := r.deleteSubnetByName(delCtx, req.Name, req.Namespace)
+			tracing.End(span, err)
+			if err != nil {
 				r.StatusUpdater.DeleteFail(req.NamespacedName, nil, err)
 				return ResultRequeue, err
 			}
@@ -114,7 +120,10 @@ func (r *SubnetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 			return ResultRequeue, err
 		}
 
-		if err := r.deleteSubnetByID(string(subnetCR.GetUID())); err != nil {
+		delCtx, span := tracing.Start(ctx, "SubnetService.DeleteSubnet")
+		err := r.deleteSubnetByID(delCtx, string(subnetCR.GetUID()))
+		tracing.End(span, err)
+		if err != nil {
 			r.StatusUpdater.DeleteFail(req.NamespacedName, nil, err)
 			return ResultRequeue, err
 		}
@@ -197,7 +206,10 @@ func (r *SubnetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	}
 
 	// Create or update the subnet in NSX
-	if _, err := r.SubnetService.CreateOrUpdateSubnet(subnetCR, vpcInfoList[0], tags); err != nil {
+	subnetCtx, span := tracing.Start(ctx, "SubnetService.CreateOrUpdateSubnet")
+	nsxSubnet, err := r.SubnetService.CreateOrUpdateSubnet(subnetCtx, subnetCR, vpcInfoList[0], tags)
+	tracing.End(span, err)
+	if err != nil {
 		if errors.As(err, &nsxutil.ExceedTagsError{}) {
 			r.StatusUpdater.UpdateFail(ctx, subnetCR, err, "Tags limit exceeded", setSubnetReadyStatusFalse)
 			return ResultNormal, nil
@@ -221,8 +233,20 @@ func (r *SubnetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		r.StatusUpdater.UpdateFail(ctx, subnetCR, err, "Failed to create/update Subnet", setSubnetReadyStatusFalse)
 		return ResultRequeue, err
 	}
+	// DHCP static bindings are child resources of the NSX Subnet, sync them even if the Subnet is not changed.
+	var staticBindings *subnet.RealizedDHCPStaticBindings
+	if nsxSubnet != nil && needDHCPStaticBindingSync(subnetCR) {
+		staticBindings, err = r.SubnetService.SyncDHCPStaticBindings(subnetCR, nsxSubnet)
+		if err != nil {
+			r.StatusUpdater.UpdateFail(ctx, subnetCR, err, "Failed to sync DHCP static bindings", setSubnetReadyStatusFalse)
+			if errors.Is(err, subnet.ErrInvalidDHCPStaticBinding) {
+				return ResultNormal, nil
+			}
+			return ResultRequeue, err
+		}
+	}
 	// Update status
-	updated, err := r.updateSubnetStatus(subnetCR)
+	updated, err := r.updateSubnetStatus(subnetCR, staticBindings)
 	if err != nil {
 		r.StatusUpdater.UpdateFail(ctx, subnetCR, err, "Failed to update Subnet status", setSubnetReadyStatusFalse)
 		return ResultRequeue, err
@@ -263,12 +287,12 @@ func (r *SubnetReconciler) setDefaultIPv6PrefixLengthValue(ctx context.Context,
 	return nil
 }
 
-func (r *SubnetReconciler) deleteSubnetByID(subnetID string) error {
+func (r *SubnetReconciler) deleteSubnetByID(ctx context.Context, subnetID string) error {
 	nsxSubnets := r.SubnetService.SubnetStore.GetByIndex(servicecommon.TagScopeSubnetCRUID, subnetID)
-	return r.deleteSubnets(nsxSubnets)
+	return r.deleteSubnets(ctx, nsxSubnets)
 }
 
-func (r *SubnetReconciler) deleteSubnets(nsxSubnets []*model.VpcSubnet) error {
+func (r *SubnetReconciler) deleteSubnets(ctx context.Context, nsxSubnets []*model.VpcSubnet) error {
 	if len(nsxSubnets) == 0 {
 		return nil
 	}
@@ -279,7 +303,7 @@ func (r *SubnetReconciler) deleteSubnets(nsxSubnets []*model.VpcSubnet) error {
 			log.Error(err, "Delete Subnet from NSX failed")
 			return err
 		}
-		if err := r.SubnetService.DeleteSubnet(*nsxSubnet); err != nil {
+		if err := r.SubnetService.DeleteSubnet(ctx, *nsxSubnet); err != nil {
 			log.Error(err, "Failed to delete Subnet", "ID", *nsxSubnet.Id)
 			return err
 		}
@@ -290,13 +314,13 @@ func (r *SubnetReconciler) deleteSubnets(nsxSubnets []*model.VpcSubnet) error {
 	return nil
 }
 
-func (r *SubnetReconciler) deleteSubnetByName(name, ns string) error {
+func (r *SubnetReconciler) deleteSubnetByName(ctx context.Context, name, ns string) error {
 	// Since shared subnets are not in the store, we can enter this function safely
 	nsxSubnets := r.SubnetService.ListSubnetByName(ns, name)
-	return r.deleteSubnets(nsxSubnets)
+	return r.deleteSubnets(ctx, nsxSubnets)
 }
 
-func (r *SubnetReconciler) updateSubnetStatus(obj *v1alpha1.Subnet) (bool, error) {
+func (r *SubnetReconciler) updateSubnetStatus(obj *v1alpha1.Subnet, staticBindings *subnet.RealizedDHCPStaticBindings) (bool, error) {
 	nsxSubnets := r.SubnetService.GetSubnetsByIndex(servicecommon.TagScopeSubnetCRUID, string(obj.GetUID()))
 	if len(nsxSubnets) == 0 {
 		return false, fmt.Errorf("failed to get NSX Subnet from store")
@@ -318,9 +342,19 @@ func (r *SubnetReconciler) updateSubnetStatus(obj *v1alpha1.Subnet) (bool, error
 			obj.Status.DHCPServerAddresses = append(obj.Status.DHCPServerAddresses, *status.DhcpServerAddress)
 		}
 	}
+	obj.Status.DHCPServer, obj.Status.DHCPv6Server = subnet.BuildDHCPServerStatus(nsxSubnet, staticBindings)
 	return r.hasStatusChanged(originalStatus, &obj.Status), nil
 }
 
+// needDHCPStaticBindingSync checks whether the Subnet has DHCP static bindings configured,
+// or had DHCP static bindings realized which may need to be removed.
+func needDHCPStaticBindingSync(obj *v1alpha1.Subnet) bool {
+	return len(obj.Spec.SubnetDHCPConfig.DHCPServerAdditionalConfig.StaticBindings) > 0 ||
+		len(obj.Spec.SubnetDHCPv6Config.DHCPv6ServerAdditionalConfig.StaticBindings) > 0 ||
+		obj.Status.DHCPServer != nil && len(obj.Status.DHCPServer.StaticBindings) > 0 ||
+		obj.Status.DHCPv6Server != nil && len(obj.Status.DHCPv6Server.StaticBindings) > 0
+}
+
 // handleSharedSubnet manages a shared subnet annotated with an associated resource.
 // It first tries to get the NSX subnet from the cache.
 // If not found, it retrieves the subnet from the NSX API.
@@ -486,9 +520,12 @@ func (r *SubnetReconciler) RestoreReconcile() error {
 		return err
 	}
 	var errorList []error
+	util.RecordRestoreTotal(len(restoreList))
 	for _, key := range restoreList {
 		result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
-		if err != nil || common.IsReconcileResultRequeue(result) {
+		restored := err == nil && !common.IsReconcileResultRequeue(result)
+		util.RecordRestoreResult(restored)
+		if !restored {
 			errorList = append(errorList, fmt.Errorf("failed to restore Subnet %s, error: %w", key, err))
 		}
 	}
@@ -541,9 +578,34 @@ func (r *SubnetReconciler) StartController(mgr ctrl.Manager, hookServer webhook.
 	}
 	// Start a garbage collector in a separate goroutine
 	go common.GenericGarbageCollector(make(chan bool), servicecommon.SubnetGCInterval, r.CollectGarbage)
+	// Start the drift scanner of the NSX Subnets owned by the Subnet CRs
+	if r.SubnetService.NSXConfig.DriftScanInterval > 0 {
+		r.newDriftScanner().Start()
+	}
 	return nil
 }
 
+func (r *SubnetReconciler) newDriftScanner() *common.DriftScanner {
+	return &common.DriftScanner{
+		Client:   r.Client,
+		Service:  &r.SubnetService.Service,
+		Recorder: r.Recorder,
+		Sources:  r.SubnetService.DriftSources(),
+		Owner: func(obj interface{}) client.Object {
+			key, ok := common.DriftOwnerKey(obj.(*model.VpcSubnet).Tags, servicecommon.TagScopeSubnetCRName, servicecommon.TagScopeVMNamespace, servicecommon.TagScopeNamespace)
+			if !ok {
+				return nil
+			}
+			return &v1alpha1.Subnet{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}}
+		},
+		Enqueue: func(req reconcile.Request) {
+			if r.queue != nil {
+				common.AddLowPriority(r.queue, req)
+			}
+		},
+	}
+}
+
 func NewSubnetReconciler(mgr ctrl.Manager, subnetService *subnet.SubnetService, subnetPortService servicecommon.SubnetPortServiceProvider, vpcService servicecommon.VPCServiceProvider, bindingService *subnetbinding.BindingService) *SubnetReconciler {
 	// Create the Subnet Reconciler with the necessary services and configuration
 	subnetReconciler := &SubnetReconciler{
@@ -589,7 +651,8 @@ func (r *SubnetReconciler) setupWithManager(mgr ctrl.Manager) error {
 		WithEventFilter(common.VPCNamespacePredicate(r.Client)).
 		WithOptions(
 			controller.Options{
-				MaxConcurrentReconciles: common.NumReconcile(),
+				MaxConcurrentReconciles: common.NumReconcile(r.StatusUpdater.NSXConfig, MetricResTypeSubnet),
+				RateLimiter:             common.RateLimiter(r.StatusUpdater.NSXConfig, MetricResTypeSubnet),
 				NewQueue:                r.getQueue,
 			}).
 		// Watches for changes in Namespaces and triggers reconciliation
@@ -609,14 +672,12 @@ func (r *SubnetReconciler) setupWithManager(mgr ctrl.Manager) error {
 			},
 			builder.WithPredicates(common.PredicateFuncsWithSubnetBindings),
 		).
-		Complete(r)
+		Complete(common.LicensedReconciler(MetricResTypeSubnet, tracing.Reconciler(MetricResTypeSubnet, audit.Reconciler(r.Client, &v1alpha1.Subnet{}, r))))
 }
 
 func (r *SubnetReconciler) getQueue(controllerName string, rateLimiter workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
 	if r.queue == nil {
-		r.queue = workqueue.NewTypedRateLimitingQueueWithConfig(rateLimiter, workqueue.TypedRateLimitingQueueConfig[reconcile.Request]{
-			Name: controllerName,
-		})
+		r.queue = common.NewQueue(r.StatusUpdater.NSXConfig, MetricResTypeSubnet)(controllerName, rateLimiter)
 	}
 	return r.queue
 }
@@ -656,7 +717,7 @@ func (r *SubnetReconciler) CollectGarbage(ctx context.Context) error {
 		r.StatusUpdater.IncreaseDeleteTotal()
 
 		log.Info("Subnet garbage collection, cleaning stale Subnets", "Count", len(nsxSubnets))
-		if err := r.deleteSubnets(nsxSubnets); err != nil {
+		if err := r.deleteSubnets(ctx, nsxSubnets); err != nil {
 			errList = append(errList, err)
 			log.Error(err, "Subnet garbage collection, failed to delete NSX subnet", "SubnetUID", subnetID)
 			r.StatusUpdater.IncreaseDeleteFailTotal()
@@ -68,7 +68,7 @@ func TestSubnetReconciler_GarbageCollector(t *testing.T) {
 				patch.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "GetPortsOfSubnet", func(_ *subnetport.SubnetPortService, _ string) (ports []*model.VpcSubnetPort) {
 					return nil
 				})
-				patch.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, subnet model.VpcSubnet) error {
+				patch.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, _ context.Context, subnet model.VpcSubnet) error {
 					return nil
 				})
 				patch.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "DeletePortCount", func(_ *subnetport.SubnetPortService, _ string) {
@@ -84,7 +84,7 @@ func TestSubnetReconciler_GarbageCollector(t *testing.T) {
 					res := sets.New[string]("fake-id2")
 					return res
 				})
-				patch.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, subnet model.VpcSubnet) error {
+				patch.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, _ context.Context, subnet model.VpcSubnet) error {
 					assert.FailNow(t, "should not be called")
 					return nil
 				})
@@ -118,7 +118,7 @@ func TestSubnetReconciler_GarbageCollector(t *testing.T) {
 				patch.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "GetPortsOfSubnet", func(_ *subnetport.SubnetPortService, _ string) (ports []*model.VpcSubnetPort) {
 					return nil
 				})
-				patch.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, subnet model.VpcSubnet) error {
+				patch.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, _ context.Context, subnet model.VpcSubnet) error {
 					return errors.New("delete failed")
 				})
 				return patch
@@ -246,7 +246,7 @@ func TestSubnetReconciler_Reconcile(t *testing.T) {
 			name: "Subnet CR not found",
 			req:  ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "test-subnet"}},
 			patches: func(r *SubnetReconciler) *gomonkey.Patches {
-				return gomonkey.ApplyPrivateMethod(reflect.TypeOf(r), "deleteSubnetByName", func(_ *SubnetReconciler, name, ns string) error {
+				return gomonkey.ApplyPrivateMethod(reflect.TypeOf(r), "deleteSubnetByName", func(_ *SubnetReconciler, _ context.Context, name, ns string) error {
 					return nil
 				})
 			},
@@ -257,7 +257,7 @@ func TestSubnetReconciler_Reconcile(t *testing.T) {
 			name: "Delete Subnet CR success",
 			req:  ctrl.Request{NamespacedName: types.NamespacedName{Namespace: ns, Name: subnetName1}},
 			patches: func(r *SubnetReconciler) *gomonkey.Patches {
-				patches := gomonkey.ApplyPrivateMethod(reflect.TypeOf(r), "deleteSubnetByID", func(_ *SubnetReconciler, id string) error {
+				patches := gomonkey.ApplyPrivateMethod(reflect.TypeOf(r), "deleteSubnetByID", func(_ *SubnetReconciler, _ context.Context, id string) error {
 					return nil
 				})
 				patches.ApplyMethod(reflect.TypeOf(r.SubnetService.SubnetStore), "GetByIndex", func(_ *subnet.SubnetStore, key string, value string) []*model.VpcSubnet {
@@ -285,7 +285,7 @@ func TestSubnetReconciler_Reconcile(t *testing.T) {
 				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "GetPortsOfSubnet", func(_ *subnetport.SubnetPortService, _ string) (ports []*model.VpcSubnetPort) {
 					return nil
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, subnet model.VpcSubnet) error {
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, _ context.Context, subnet model.VpcSubnet) error {
 					return nil
 				})
 				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "DeletePortCount", func(_ *subnetport.SubnetPortService, _ string) {
@@ -322,7 +322,7 @@ func TestSubnetReconciler_Reconcile(t *testing.T) {
 				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "GetPortsOfSubnet", func(_ *subnetport.SubnetPortService, _ string) (ports []*model.VpcSubnetPort) {
 					return nil
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, subnet model.VpcSubnet) error {
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, _ context.Context, subnet model.VpcSubnet) error {
 					return errors.New("failed to delete NSX Subnet")
 				})
 				return patches
@@ -358,7 +358,7 @@ func TestSubnetReconciler_Reconcile(t *testing.T) {
 					id := "fake-subnetport-0"
 					return []*model.VpcSubnetPort{{Id: &id}}
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, subnet model.VpcSubnet) error {
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, _ context.Context, subnet model.VpcSubnet) error {
 					return nil
 				})
 				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "DeletePortCount", func(_ *subnetport.SubnetPortService, _ string) {
@@ -375,7 +375,7 @@ func TestSubnetReconciler_Reconcile(t *testing.T) {
 				patches := gomonkey.ApplyMethod(reflect.TypeOf(r.Client), "Get", func(_ client.Client, _ context.Context, _ client.ObjectKey, _ client.Object, _ ...client.GetOption) error {
 					return errors.New("get Subnet CR error")
 				})
-				patches.ApplyPrivateMethod(reflect.TypeOf(r), "deleteSubnetByName", func(_ *SubnetReconciler, name, ns string) error {
+				patches.ApplyPrivateMethod(reflect.TypeOf(r), "deleteSubnetByName", func(_ *SubnetReconciler, _ context.Context, name, ns string) error {
 					return nil
 				})
 				return patches
@@ -388,7 +388,7 @@ func TestSubnetReconciler_Reconcile(t *testing.T) {
 			name: "Subnet CR with finalizer delete success",
 			req:  ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "test-subnet"}},
 			patches: func(r *SubnetReconciler) *gomonkey.Patches {
-				patches := gomonkey.ApplyPrivateMethod(reflect.TypeOf(r), "deleteSubnetByID", func(_ *SubnetReconciler, _ string) error {
+				patches := gomonkey.ApplyPrivateMethod(reflect.TypeOf(r), "deleteSubnetByID", func(_ *SubnetReconciler, _ context.Context, _ string) error {
 					return nil
 				})
 				patches.ApplyPrivateMethod(reflect.TypeOf(r), "getSubnetBindingCRsBySubnet", func(_ *SubnetReconciler, _ context.Context, _ *v1alpha1.Subnet) []v1alpha1.SubnetConnectionBindingMap {
@@ -435,7 +435,7 @@ func TestSubnetReconciler_Reconcile(t *testing.T) {
 				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "GetPortsOfSubnet", func(_ *subnetport.SubnetPortService, _ string) (ports []*model.VpcSubnetPort) {
 					return nil
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, subnet model.VpcSubnet) error {
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, _ context.Context, subnet model.VpcSubnet) error {
 					return nil
 				})
 				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "DeletePortCount", func(_ *subnetport.SubnetPortService, _ string) {
@@ -478,7 +478,7 @@ func TestSubnetReconciler_Reconcile(t *testing.T) {
 				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "GetPortsOfSubnet", func(_ *subnetport.SubnetPortService, _ string) (ports []*model.VpcSubnetPort) {
 					return nil
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, subnet model.VpcSubnet) error {
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, _ context.Context, subnet model.VpcSubnet) error {
 					return errors.New("delete NSX Subnet failed")
 				})
 				return patches
@@ -510,7 +510,7 @@ func TestSubnetReconciler_Reconcile(t *testing.T) {
 						{OrgID: "org-id", ProjectID: "project-id", VPCID: "vpc-id", ID: "fake-id"},
 					}
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "CreateOrUpdateSubnet", func(_ *subnet.SubnetService, obj client.Object, vpcInfo common.VPCResourceInfo, tags []model.Tag) (*model.VpcSubnet, error) {
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "CreateOrUpdateSubnet", func(_ *subnet.SubnetService, _ context.Context, obj client.Object, vpcInfo common.VPCResourceInfo, tags []model.Tag) (*model.VpcSubnet, error) {
 					return nil, errors.New("create or update failed")
 				})
 				patches.ApplyMethod(reflect.TypeOf(r.VPCService), "IsDefaultNSXProject", func(_ *vpc.VPCService, orgID, projectID string) (bool, error) {
@@ -551,7 +551,7 @@ func TestSubnetReconciler_Reconcile(t *testing.T) {
 						{OrgID: "org-id", ProjectID: "project-id", VPCID: "vpc-id", ID: "fake-id"},
 					}
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "CreateOrUpdateSubnet", func(_ *subnet.SubnetService, obj client.Object, vpcInfo common.VPCResourceInfo, tags []model.Tag) (*model.VpcSubnet, error) {
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "CreateOrUpdateSubnet", func(_ *subnet.SubnetService, _ context.Context, obj client.Object, vpcInfo common.VPCResourceInfo, tags []model.Tag) (*model.VpcSubnet, error) {
 					return nil, nil
 				})
 
@@ -620,7 +620,7 @@ func TestSubnetReconciler_Reconcile(t *testing.T) {
 					}
 				})
 
-				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "CreateOrUpdateSubnet", func(_ *subnet.SubnetService, obj client.Object, vpcInfo common.VPCResourceInfo, tags []model.Tag) (*model.VpcSubnet, error) {
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "CreateOrUpdateSubnet", func(_ *subnet.SubnetService, _ context.Context, obj client.Object, vpcInfo common.VPCResourceInfo, tags []model.Tag) (*model.VpcSubnet, error) {
 					return nil, nil
 				})
 
@@ -747,7 +747,7 @@ func TestSubnetReconciler_Reconcile(t *testing.T) {
 						{OrgID: "org-id", ProjectID: "project-id", VPCID: "vpc-id", ID: "fake-id"},
 					}
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "CreateOrUpdateSubnet", func(_ *subnet.SubnetService, obj client.Object, vpcInfo common.VPCResourceInfo, tags []model.Tag) (*model.VpcSubnet, error) {
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "CreateOrUpdateSubnet", func(_ *subnet.SubnetService, _ context.Context, obj client.Object, vpcInfo common.VPCResourceInfo, tags []model.Tag) (*model.VpcSubnet, error) {
 					return nil, nsxutil.NewNSXApiError(&model.ApiError{
 						ErrorCode:    util.Ptr(int64(508134)),
 						ErrorMessage: util.Ptr("Test error message"),
@@ -826,7 +826,7 @@ func TestSubnetReconciler_Reconcile(t *testing.T) {
 						{OrgID: "org-id", ProjectID: "project-id", VPCID: "vpc-id", ID: "fake-id"},
 					}
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "CreateOrUpdateSubnet", func(_ *subnet.SubnetService, obj client.Object, vpcInfo common.VPCResourceInfo, tags []model.Tag) (*model.VpcSubnet, error) {
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "CreateOrUpdateSubnet", func(_ *subnet.SubnetService, _ context.Context, obj client.Object, vpcInfo common.VPCResourceInfo, tags []model.Tag) (*model.VpcSubnet, error) {
 					return nil, nil
 				})
 				patches.ApplyMethod(reflect.TypeOf(r.VPCService), "IsDefaultNSXProject", func(_ *vpc.VPCService, orgID, projectID string) (bool, error) {
@@ -886,7 +886,7 @@ func TestSubnetReconciler_Reconcile(t *testing.T) {
 						{OrgID: "org-id", ProjectID: "project-id", VPCID: "vpc-id", ID: "fake-id"},
 					}
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "CreateOrUpdateSubnet", func(_ *subnet.SubnetService, obj client.Object, vpcInfo common.VPCResourceInfo, tags []model.Tag) (*model.VpcSubnet, error) {
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "CreateOrUpdateSubnet", func(_ *subnet.SubnetService, _ context.Context, obj client.Object, vpcInfo common.VPCResourceInfo, tags []model.Tag) (*model.VpcSubnet, error) {
 					return nil, nil
 				})
 				patches.ApplyMethod(reflect.TypeOf(r.VPCService), "IsDefaultNSXProject", func(_ *vpc.VPCService, orgID, projectID string) (bool, error) {
@@ -1001,7 +1001,8 @@ func TestStartSubnetController(t *testing.T) {
 	}
 	subnetService := &subnet.SubnetService{
 		Service: common.Service{
-			Client: fakeClient,
+			Client:    fakeClient,
+			NSXConfig: &config.NSXOperatorConfig{NsxConfig: &config.NsxConfig{DriftScanInterval: 1800}},
 		},
 		SubnetStore: &subnet.SubnetStore{},
 	}
@@ -1279,12 +1280,12 @@ func patchSuccessfulReconcileSubnetWorkflow(r *SubnetReconciler, patches *gomonk
 	patches.ApplyMethod(reflect.TypeOf(r.VPCService), "IsDefaultNSXProject", func(_ *vpc.VPCService, orgID, projectID string) (bool, error) {
 		return false, nil
 	})
-	patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "CreateOrUpdateSubnet", func(_ *subnet.SubnetService, _ client.Object, _ common.VPCResourceInfo, _ []model.Tag) (subnet *model.VpcSubnet, err error) {
+	patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "CreateOrUpdateSubnet", func(_ *subnet.SubnetService, _ context.Context, _ client.Object, _ common.VPCResourceInfo, _ []model.Tag) (subnet *model.VpcSubnet, err error) {
 		return &model.VpcSubnet{
 			Path: common.String("subnet-path"),
 		}, nil
 	})
-	patches.ApplyPrivateMethod(reflect.TypeOf(r), "updateSubnetStatus", func(_ *SubnetReconciler, _ *v1alpha1.Subnet) error {
+	patches.ApplyPrivateMethod(reflect.TypeOf(r), "updateSubnetStatus", func(_ *SubnetReconciler, _ *v1alpha1.Subnet, _ *subnet.RealizedDHCPStaticBindings) error {
 		return nil
 	})
 	patches.ApplyFunc(setSubnetReadyStatusTrue, func(_ client.Client, _ context.Context, _ client.Object, _ metav1.Time, _ ...interface{}) {
@@ -1735,7 +1736,7 @@ func TestSubnetReconciler_updateSubnetStatus(t *testing.T) {
 				})
 			defer patches2.Reset()
 
-			changed, err := r.updateSubnetStatus(obj)
+			changed, err := r.updateSubnetStatus(obj, nil)
 			if tt.expectErr {
 				assert.NotNil(t, err)
 			} else {
@@ -1745,3 +1746,23 @@ func TestSubnetReconciler_updateSubnetStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestSubnetReconciler_newDriftScanner(t *testing.T) {
+	r := &SubnetReconciler{SubnetService: &subnet.SubnetService{SubnetStore: &subnet.SubnetStore{}}}
+	scanner := r.newDriftScanner()
+	assert.Len(t, scanner.Sources, 1)
+	assert.Equal(t, subnet.ResourceTypeSubnet, scanner.Sources[0].ResourceType)
+
+	owner := scanner.Owner(&model.VpcSubnet{Tags: []model.Tag{
+		{Scope: common.String(common.TagScopeVMNamespace), Tag: common.String("ns-1")},
+		{Scope: common.String(common.TagScopeSubnetCRName), Tag: common.String("subnet-1")},
+	}})
+	assert.Equal(t, &v1alpha1.Subnet{ObjectMeta: metav1.ObjectMeta{Name: "subnet-1", Namespace: "ns-1"}}, owner)
+	// The NSX Subnets created for SubnetSets are not owned by Subnet CRs.
+	assert.Nil(t, scanner.Owner(&model.VpcSubnet{Tags: []model.Tag{
+		{Scope: common.String(common.TagScopeNamespace), Tag: common.String("ns-1")},
+		{Scope: common.String(common.TagScopeSubnetSetCRName), Tag: common.String("subnetset-1")},
+	}}))
+	// Enqueue does nothing before the controller queue is created.
+	scanner.Enqueue(reconcile.Request{})
+}
@@ -13,6 +13,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 )
 
@@ -110,7 +111,7 @@ func (r *SubnetReconciler) enqueueSubnetForReconciliation(ctx context.Context, n
 	req := reconcile.Request{
 		NamespacedName: namespacedName,
 	}
-	r.queue.Add(req)
+	common.AddLowPriority(r.queue, req)
 	log.Info("Successfully enqueued Subnet for reconciliation", "Subnet", namespacedName)
 }
 
@@ -177,6 +178,8 @@ func (r *SubnetReconciler) hasStatusChanged(originalStatus, newStatus *v1alpha1.
 	return !reflect.DeepEqual(originalStatus.NetworkAddresses, newStatus.NetworkAddresses) ||
 		!reflect.DeepEqual(originalStatus.GatewayAddresses, newStatus.GatewayAddresses) ||
 		!reflect.DeepEqual(originalStatus.DHCPServerAddresses, newStatus.DHCPServerAddresses) ||
+		!reflect.DeepEqual(originalStatus.DHCPServer, newStatus.DHCPServer) ||
+		!reflect.DeepEqual(originalStatus.DHCPv6Server, newStatus.DHCPv6Server) ||
 		!reflect.DeepEqual(originalStatus.VLANExtension, newStatus.VLANExtension) ||
 		originalStatus.Shared != newStatus.Shared
 }
@@ -23,6 +23,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/audit"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
@@ -30,6 +31,7 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnet"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetbinding"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/vlanpool"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 )
 
 var (
@@ -189,7 +191,9 @@ func (r *Reconciler) setupWithManager(mgr ctrl.Manager) error {
 		For(&v1alpha1.SubnetConnectionBindingMap{}, builder.WithPredicates(PredicateFuncsForBindingMaps)).
 		WithEventFilter(common.VPCNamespacePredicate(r.Client)).
 		WithOptions(controller.Options{
-			MaxConcurrentReconciles: common.NumReconcile(),
+			MaxConcurrentReconciles: common.NumReconcile(r.StatusUpdater.NSXConfig, common.MetricResTypeSubnetConnectionBindingMap),
+			RateLimiter:             common.RateLimiter(r.StatusUpdater.NSXConfig, common.MetricResTypeSubnetConnectionBindingMap),
+			NewQueue:                common.NewQueue(r.StatusUpdater.NSXConfig, common.MetricResTypeSubnetConnectionBindingMap),
 		}).
 		Watches(
 			&v1alpha1.Subnet{},
@@ -207,7 +211,7 @@ func (r *Reconciler) setupWithManager(mgr ctrl.Manager) error {
 				ResourceType:    "SubnetSet"},
 			builder.WithPredicates(PredicateFuncsForSubnetSets),
 		).
-		Complete(r)
+		Complete(common.LicensedReconciler(common.MetricResTypeSubnetConnectionBindingMap, tracing.Reconciler(common.MetricResTypeSubnetConnectionBindingMap, audit.Reconciler(r.Client, &v1alpha1.SubnetConnectionBindingMap{}, r))))
 }
 
 func (r *Reconciler) listBindingMapIDsFromCRs(ctx context.Context) (sets.Set[string], error) {
@@ -19,11 +19,14 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/audit"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetipreservation"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
 var (
@@ -58,9 +61,12 @@ func (r *Reconciler) RestoreReconcile() error {
 	}
 	var errorList []error
 	r.restoreMode = true
+	util.RecordRestoreTotal(len(restoreList))
 	for _, key := range restoreList {
 		result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
-		if err != nil || common.IsReconcileResultRequeue(result) {
+		restored := err == nil && !common.IsReconcileResultRequeue(result)
+		util.RecordRestoreResult(restored)
+		if !restored {
 			errorList = append(errorList, fmt.Errorf("failed to restore SubnetIPReservation %s, error: %w", key, err))
 		}
 	}
@@ -155,7 +161,9 @@ func (r *Reconciler) setupWithManager(mgr ctrl.Manager) error {
 		For(&v1alpha1.SubnetIPReservation{}).
 		WithEventFilter(common.VPCNamespacePredicate(r.Client)).
 		WithOptions(controller.Options{
-			MaxConcurrentReconciles: common.NumReconcile(),
+			MaxConcurrentReconciles: common.NumReconcile(r.StatusUpdater.NSXConfig, common.MetricResTypeSubnetIPReservation),
+			RateLimiter:             common.RateLimiter(r.StatusUpdater.NSXConfig, common.MetricResTypeSubnetIPReservation),
+			NewQueue:                common.NewQueue(r.StatusUpdater.NSXConfig, common.MetricResTypeSubnetIPReservation),
 		}).
 		Watches(
 			&v1alpha1.Subnet{},
@@ -166,7 +174,7 @@ func (r *Reconciler) setupWithManager(mgr ctrl.Manager) error {
 			},
 			builder.WithPredicates(PredicateFuncsForSubnets),
 		).
-		Complete(r)
+		Complete(common.LicensedReconciler(common.MetricResTypeSubnetIPReservation, tracing.Reconciler(common.MetricResTypeSubnetIPReservation, audit.Reconciler(r.Client, &v1alpha1.SubnetIPReservation{}, r))))
 }
 
 func (r *Reconciler) setNotSupported(ctx context.Context, req ctrl.Request) error {
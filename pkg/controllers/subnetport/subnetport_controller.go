@@ -26,7 +26,6 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
-	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -38,6 +37,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/audit"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/ratelimiter"
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
@@ -47,6 +47,7 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetport"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/vpc"
 	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
@@ -519,17 +520,33 @@ func (r *SubnetPortReconciler) setupWithManager(mgr ctrl.Manager) error {
 		WithEventFilter(common.VPCNamespacePredicate(r.Client)).
 		WithOptions(
 			controller.Options{
-				MaxConcurrentReconciles: common.NumReconcile(),
+				MaxConcurrentReconciles: common.NumReconcile(r.StatusUpdater.NSXConfig, MetricResTypeSubnetPort),
 				RateLimiter: &ratelimiter.LoggingRateLimiter{
-					TypedRateLimiter: workqueue.DefaultTypedControllerRateLimiter[reconcile.Request](),
+					TypedRateLimiter: common.RateLimiter(r.StatusUpdater.NSXConfig, MetricResTypeSubnetPort),
 				},
+				NewQueue:           common.NewQueue(r.StatusUpdater.NSXConfig, MetricResTypeSubnetPort),
+				NeedLeaderElection: common.NeedLeaderElection(),
 			}).
 		Watches(&vmv1alpha1.VirtualMachine{},
 			handler.EnqueueRequestsFromMapFunc(r.vmMapFunc),
 			builder.WithPredicates(predicate.LabelChangedPredicate{})).
 		Watches(&v1alpha1.AddressBinding{},
-				handler.EnqueueRequestsFromMapFunc(r.addressBindingMapFunc)).
-		Complete(r) // TODO: watch the virtualmachine event and update the labels on NSX subnet port.
+			handler.EnqueueRequestsFromMapFunc(r.addressBindingMapFunc)).
+		WatchesRawSource(common.ShardSource(MetricResTypeSubnetPort, r.listSubnetPorts)).
+		// TODO: watch the virtualmachine event and update the labels on NSX subnet port.
+		Complete(common.LicensedReconciler(MetricResTypeSubnetPort, common.ShardedReconciler(MetricResTypeSubnetPort, tracing.Reconciler(MetricResTypeSubnetPort, audit.Reconciler(r.Client, &v1alpha1.SubnetPort{}, r)))))
+}
+
+func (r *SubnetPortReconciler) listSubnetPorts(ctx context.Context) ([]client.Object, error) {
+	subnetPortList := &v1alpha1.SubnetPortList{}
+	if err := r.Client.List(ctx, subnetPortList); err != nil {
+		return nil, err
+	}
+	objs := make([]client.Object, 0, len(subnetPortList.Items))
+	for i := range subnetPortList.Items {
+		objs = append(objs, &subnetPortList.Items[i])
+	}
+	return objs, nil
 }
 
 func (r *SubnetPortReconciler) SetupFieldIndexers(mgr ctrl.Manager) error {
@@ -587,9 +604,12 @@ func (r *SubnetPortReconciler) RestoreReconcile() error {
 	}
 	var errorList []error
 	r.restoreMode = true
+	util.RecordRestoreTotal(len(restoreList))
 	for _, key := range restoreList {
 		result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
-		if err != nil || common.IsReconcileResultRequeue(result) {
+		restored := err == nil && !common.IsReconcileResultRequeue(result)
+		util.RecordRestoreResult(restored)
+		if !restored {
 			errorList = append(errorList, fmt.Errorf("failed to restore SubnetPort %s, error: %w", key, err))
 		}
 	}
@@ -667,18 +687,25 @@ func (r *SubnetPortReconciler) StartController(mgr ctrl.Manager, hookServer webh
 		return err
 	}
 	if hookServer != nil {
-		hookServer.Register("/validate-crd-nsx-vmware-com-v1alpha1-addressbinding",
-			&webhook.Admission{
-				Handler: &AddressBindingValidator{
-					Client:  mgr.GetClient(),
-					decoder: admission.NewDecoder(mgr.GetScheme()),
-				},
-			})
+		r.RegisterWebhooks(mgr, hookServer)
 	}
 	go common.GenericGarbageCollector(make(chan bool), servicecommon.SubnetPortGCInterval, r.CollectGarbage)
 	return nil
 }
 
+// RegisterWebhooks registers the AddressBinding validating webhook.
+func (r *SubnetPortReconciler) RegisterWebhooks(mgr ctrl.Manager, hookServer webhook.Server) {
+	hookServer.Register("/validate-crd-nsx-vmware-com-v1alpha1-addressbinding",
+		&webhook.Admission{
+			Handler: &AddressBindingValidator{
+				Client:  mgr.GetClient(),
+				decoder: admission.NewDecoder(mgr.GetScheme()),
+			},
+		})
+}
+
+var _ util.ShardedReconcilerProvider = (*SubnetPortReconciler)(nil)
+
 func NewSubnetPortReconciler(mgr ctrl.Manager, subnetPortService *subnetport.SubnetPortService, subnetService *subnet.SubnetService, vpcService *vpc.VPCService, ipAddressAllocationService servicecommon.IPAddressAllocationServiceProvider) *SubnetPortReconciler {
 	subnetPortReconciler := &SubnetPortReconciler{
 		Client:                     mgr.GetClient(),
@@ -25,11 +25,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/audit"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnet"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetbinding"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
@@ -201,7 +203,7 @@ func (r *SubnetSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 			return ResultRequeue, err
 		}
 
-		err := r.deleteSubnetForSubnetSet(*subnetsetCR, false, false)
+		err := r.deleteSubnetForSubnetSet(ctx, *subnetsetCR, false, false)
 		if err != nil {
 			r.StatusUpdater.DeleteFail(req.NamespacedName, nil, err)
 			return ResultRequeue, err
@@ -446,7 +448,9 @@ func (r *SubnetSetReconciler) setupWithManager(mgr ctrl.Manager) error {
 		For(&v1alpha1.SubnetSet{}).
 		WithEventFilter(common.VPCNamespacePredicate(r.Client)).
 		WithOptions(controller.Options{
-			MaxConcurrentReconciles: common.NumReconcile(),
+			MaxConcurrentReconciles: common.NumReconcile(r.StatusUpdater.NSXConfig, MetricResTypeSubnetSet),
+			RateLimiter:             common.RateLimiter(r.StatusUpdater.NSXConfig, MetricResTypeSubnetSet),
+			NewQueue:                common.NewQueue(r.StatusUpdater.NSXConfig, MetricResTypeSubnetSet),
 		}).
 		Watches(
 			&v1.Namespace{},
@@ -464,7 +468,7 @@ func (r *SubnetSetReconciler) setupWithManager(mgr ctrl.Manager) error {
 			},
 			builder.WithPredicates(common.PredicateFuncsWithSubnetBindings),
 		).
-		Complete(r)
+		Complete(common.LicensedReconciler(MetricResTypeSubnetSet, tracing.Reconciler(MetricResTypeSubnetSet, audit.Reconciler(r.Client, &v1alpha1.SubnetSet{}, r))))
 }
 
 func (r *SubnetSetReconciler) EnableRestoreMode() {
@@ -493,7 +497,7 @@ func (r *SubnetSetReconciler) CollectGarbage(ctx context.Context) error {
 			continue
 		}
 		crdSubnetSetIDsSet.Insert(string(subnetSet.UID))
-		if err := r.deleteSubnetForSubnetSet(subnetSet, true, true); err != nil {
+		if err := r.deleteSubnetForSubnetSet(ctx, subnetSet, true, true); err != nil {
 			errList = append(errList, err)
 			r.StatusUpdater.IncreaseDeleteFailTotal()
 		} else {
@@ -506,7 +510,7 @@ func (r *SubnetSetReconciler) CollectGarbage(ctx context.Context) error {
 	for subnetSetID := range subnetSetIDsToDelete {
 		nsxSubnets := r.SubnetService.ListSubnetCreatedBySubnetSet(subnetSetID)
 		log.Info("SubnetSet garbage collection, cleaning stale Subnets for SubnetSet", "Count", len(nsxSubnets))
-		if _, err := r.deleteSubnets(nsxSubnets, true); err != nil {
+		if _, err := r.deleteSubnets(ctx, nsxSubnets, true); err != nil {
 			errList = append(errList, err)
 			log.Error(err, "SubnetSet garbage collection, failed to delete NSX subnet", "SubnetSetUID", subnetSetID)
 			r.StatusUpdater.IncreaseDeleteFailTotal()
@@ -533,14 +537,14 @@ func (r *SubnetSetReconciler) CollectGarbage(ctx context.Context) error {
 func (r *SubnetSetReconciler) deleteSubnetBySubnetSetName(ctx context.Context, subnetSetName, ns string) error {
 	nsxSubnets := r.SubnetService.ListSubnetBySubnetSetName(ns, subnetSetName)
 	// We also actively delete the SubnetConnectionBindingMaps associated with the empty NSX Subnet that has no SubnetPort.
-	hasStaleSubnetPort, err := r.deleteSubnets(nsxSubnets, true)
+	hasStaleSubnetPort, err := r.deleteSubnets(ctx, nsxSubnets, true)
 	if err != nil || hasStaleSubnetPort {
 		return fmt.Errorf("failed to delete stale Subnets, error: %v, hasStaleSubnetPort: %t", err, hasStaleSubnetPort)
 	}
 	return nil
 }
 
-func (r *SubnetSetReconciler) deleteSubnetForSubnetSet(subnetSet v1alpha1.SubnetSet, updateStatus, ignoreStaleSubnetPort bool) error {
+func (r *SubnetSetReconciler) deleteSubnetForSubnetSet(ctx context.Context, subnetSet v1alpha1.SubnetSet, updateStatus, ignoreStaleSubnetPort bool) error {
 	subnetSetLock := common.WLockSubnetSet(subnetSet.GetUID())
 	nsxSubnets := r.SubnetService.SubnetStore.GetByIndex(servicecommon.TagScopeSubnetSetCRUID, string(subnetSet.GetUID()))
 
@@ -565,7 +569,7 @@ func (r *SubnetSetReconciler) deleteSubnetForSubnetSet(subnetSet v1alpha1.Subnet
 	// corresponding NSX Subnet. This happens in the GC case to scale-in the NSX Subnet if no SubnetPort exists.
 	// For SubnetSet CR deletion event, we don't delete the existing SubnetConnectionBindingMaps but let the
 	// SubnetConnectionBindingMap controller do it after the binding CR is removed.
-	hasStaleSubnetPort, deleteErr := r.deleteSubnets(nsxSubnets, ignoreStaleSubnetPort)
+	hasStaleSubnetPort, deleteErr := r.deleteSubnets(ctx, nsxSubnets, ignoreStaleSubnetPort)
 	common.WUnlockSubnetSet(subnetSet.GetUID(), subnetSetLock)
 	// Skip SubnetSet status update for restore case, as we need the stale status to restore the NSX Subnet
 	if updateStatus && !r.restoreMode {
@@ -585,7 +589,7 @@ func (r *SubnetSetReconciler) deleteSubnetForSubnetSet(subnetSet v1alpha1.Subnet
 // deleteSubnets deletes all the specified NSX Subnets.
 // If any of the Subnets have stale SubnetPorts, they are skipped. The final result returns true.
 // If there is an error while deleting any NSX Subnet, it is skipped, and the final result returns an error.
-func (r *SubnetSetReconciler) deleteSubnets(nsxSubnets []*model.VpcSubnet, deleteBindingMaps bool) (hasStalePort bool, err error) {
+func (r *SubnetSetReconciler) deleteSubnets(ctx context.Context, nsxSubnets []*model.VpcSubnet, deleteBindingMaps bool) (hasStalePort bool, err error) {
 	if len(nsxSubnets) == 0 {
 		return
 	}
@@ -607,7 +611,7 @@ func (r *SubnetSetReconciler) deleteSubnets(nsxSubnets []*model.VpcSubnet, delet
 			}
 		}
 
-		if err := r.SubnetService.DeleteSubnet(*nsxSubnet); err != nil {
+		if err := r.SubnetService.DeleteSubnet(ctx, *nsxSubnet); err != nil {
 			deleteErr := fmt.Errorf("failed to delete NSX Subnet/%s: %+v", *nsxSubnet.Id, err)
 			deleteErrs = append(deleteErrs, deleteErr)
 			log.Error(deleteErr, "Skipping to next Subnet")
@@ -632,9 +636,12 @@ func (r *SubnetSetReconciler) RestoreReconcile() error {
 		return err
 	}
 	var errorList []error
+	util.RecordRestoreTotal(len(restoreList))
 	for _, key := range restoreList {
 		result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
-		if err != nil || common.IsReconcileResultRequeue(result) {
+		restored := err == nil && !common.IsReconcileResultRequeue(result)
+		util.RecordRestoreResult(restored)
+		if !restored {
 			errorList = append(errorList, fmt.Errorf("failed to restore SubnetSet %s, error: %w", key, err))
 		}
 	}
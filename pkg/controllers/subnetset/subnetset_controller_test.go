@@ -801,7 +801,7 @@ func TestReconcile_DeleteSubnetSet(t *testing.T) {
 				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "GetPortsOfSubnet", func(_ *subnetport.SubnetPortService, _ string) (ports []*model.VpcSubnetPort) {
 					return nil
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, subnet model.VpcSubnet) error {
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, _ context.Context, subnet model.VpcSubnet) error {
 					return nil
 				})
 				return patches
@@ -840,7 +840,7 @@ func TestReconcile_DeleteSubnetSet(t *testing.T) {
 				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "IsEmptySubnet", func(_ *subnetport.SubnetPortService, _ string) bool {
 					return false
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, subnet model.VpcSubnet) error {
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, _ context.Context, subnet model.VpcSubnet) error {
 					return nil
 				})
 				return patches
@@ -879,7 +879,7 @@ func TestReconcile_DeleteSubnetSet(t *testing.T) {
 				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "GetPortsOfSubnet", func(_ *subnetport.SubnetPortService, _ string) (ports []*model.VpcSubnetPort) {
 					return nil
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, subnet model.VpcSubnet) error {
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, _ context.Context, subnet model.VpcSubnet) error {
 					return errors.New("delete NSX Subnet failed")
 				})
 				return patches
@@ -951,7 +951,7 @@ func TestReconcile_DeleteSubnetSet_WithFinalizer(t *testing.T) {
 		return nil
 	})
 
-	patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, subnet model.VpcSubnet) error {
+	patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, _ context.Context, subnet model.VpcSubnet) error {
 		return nil
 	})
 
@@ -1037,7 +1037,7 @@ func TestSubnetSetReconciler_CollectGarbage(t *testing.T) {
 	patches.ApplyMethod(reflect.TypeOf(r.BindingService), "DeleteSubnetConnectionBindingMapsByParentSubnet", func(_ *subnetbinding.BindingService, parentSubnet *model.VpcSubnet) error {
 		return nil
 	})
-	patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, subnet model.VpcSubnet) error {
+	patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, _ context.Context, subnet model.VpcSubnet) error {
 		return nil
 	})
 
@@ -1106,12 +1106,12 @@ func TestSubnetSetReconciler_deleteSubnetForSubnetSet(t *testing.T) {
 		}
 	})
 	defer patches.Reset()
-	patches.ApplyPrivateMethod(reflect.TypeOf(r), "deleteSubnets", func(_ *SubnetSetReconciler, nsxSubnets []*model.VpcSubnet, deleteBindingMaps bool) (hasStalePort bool, err error) {
+	patches.ApplyPrivateMethod(reflect.TypeOf(r), "deleteSubnets", func(_ *SubnetSetReconciler, _ context.Context, nsxSubnets []*model.VpcSubnet, deleteBindingMaps bool) (hasStalePort bool, err error) {
 		assert.Equal(t, vpcSubnet1, nsxSubnets[0])
 		assert.Equal(t, false, deleteBindingMaps)
 		return false, nil
 	})
-	err := r.deleteSubnetForSubnetSet(subnetSet, true, false)
+	err := r.deleteSubnetForSubnetSet(context.Background(), subnetSet, true, false)
 	assert.Nil(t, err)
 }
 
@@ -1297,7 +1297,7 @@ func TestDeleteSubnets(t *testing.T) {
 				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "IsEmptySubnet", func(_ *subnetport.SubnetPortService, path string) bool {
 					return path != "subnet1-path"
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, nsxSubnet model.VpcSubnet) error {
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, _ context.Context, nsxSubnet model.VpcSubnet) error {
 					if *nsxSubnet.Id == "net1" {
 						require.Fail(t, "SubnetService.DeleteSubnet should not be called if stale ports exist")
 					}
@@ -1323,7 +1323,7 @@ func TestDeleteSubnets(t *testing.T) {
 				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "IsEmptySubnet", func(_ *subnetport.SubnetPortService, path string) bool {
 					return true
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, nsxSubnet model.VpcSubnet) error {
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, _ context.Context, nsxSubnet model.VpcSubnet) error {
 					if *nsxSubnet.Id == "net1" {
 						return fmt.Errorf("net1 deletion failed")
 					}
@@ -1349,7 +1349,7 @@ func TestDeleteSubnets(t *testing.T) {
 				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "IsEmptySubnet", func(_ *subnetport.SubnetPortService, path string) bool {
 					return true
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, nsxSubnet model.VpcSubnet) error {
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, _ context.Context, nsxSubnet model.VpcSubnet) error {
 					return nil
 				})
 				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "DeletePortCount", func(_ *subnetport.SubnetPortService, _ string) {})
@@ -1378,7 +1378,7 @@ func TestDeleteSubnets(t *testing.T) {
 					}
 					return nil
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, nsxSubnet model.VpcSubnet) error {
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, _ context.Context, nsxSubnet model.VpcSubnet) error {
 					if *nsxSubnet.Id == "net1" {
 						require.Fail(t, "SubnetService.DeleteSubnet should not be called if binding maps are failed to delete")
 					}
@@ -1407,7 +1407,7 @@ func TestDeleteSubnets(t *testing.T) {
 				patches.ApplyMethod(reflect.TypeOf(r.BindingService), "DeleteSubnetConnectionBindingMapsByParentSubnet", func(_ *subnetbinding.BindingService, parentSubnet *model.VpcSubnet) error {
 					return nil
 				})
-				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, nsxSubnet model.VpcSubnet) error {
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, _ context.Context, nsxSubnet model.VpcSubnet) error {
 					return nil
 				})
 				patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "DeletePortCount", func(_ *subnetport.SubnetPortService, _ string) {})
@@ -1428,7 +1428,7 @@ func TestDeleteSubnets(t *testing.T) {
 				defer patches.Reset()
 			}
 
-			hasPorts, err := r.deleteSubnets(tc.nsxSubnets, tc.deleteBindingMaps)
+			hasPorts, err := r.deleteSubnets(context.Background(), tc.nsxSubnets, tc.deleteBindingMaps)
 			if tc.expErrStr != "" {
 				require.EqualError(t, err, tc.expErrStr)
 			} else {
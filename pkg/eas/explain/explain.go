@@ -0,0 +1,118 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+// Package explain implements the "explain" subcommand of the EAS binary, which checks the connectivity
+// between two workloads offline with the NSX rules generated for the SecurityPolicy CRs and NetworkPolicies,
+// the same as creating a ConnectivityCheck.
+package explain
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"text/tabwriter"
+
+	easv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1"
+)
+
+const (
+	Command = "explain"
+
+	subnetPortPrefix = "subnetport:"
+	podPrefix        = "pod:"
+)
+
+// Checker evaluates the ConnectivityCheck created in the namespace, it is implemented by
+// storage.ConnectivityCheckStorage.
+type Checker interface {
+	Check(ctx context.Context, namespace string, check *easv1alpha1.ConnectivityCheck) (*easv1alpha1.ConnectivityCheck, error)
+}
+
+// Run parses the subcommand arguments, checks the flow with checker and prints the result to out.
+func Run(ctx context.Context, args []string, checker Checker, out io.Writer) error {
+	fs := flag.NewFlagSet(Command, flag.ContinueOnError)
+	fs.SetOutput(out)
+	source := fs.String("source", "", "source endpoint, <namespace>/<pod>, subnetport:<namespace>/<name> or an IP")
+	destination := fs.String("destination", "", "destination endpoint, <namespace>/<pod>, subnetport:<namespace>/<name> or an IP")
+	protocol := fs.String("protocol", "TCP", "protocol of the flow, TCP, UDP, SCTP, ICMP or ICMPv6")
+	port := fs.Int("port", 0, "destination port of the flow for TCP, UDP and SCTP")
+	icmpType := fs.Int("icmp-type", -1, "ICMP type of the flow for ICMP and ICMPv6")
+	icmpCode := fs.Int("icmp-code", -1, "ICMP code of the flow for ICMP and ICMPv6")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	check := &easv1alpha1.ConnectivityCheck{
+		Spec: easv1alpha1.ConnectivityCheckSpec{
+			Protocol: *protocol,
+			Port:     int32(*port),
+		},
+	}
+	var err error
+	if check.Spec.Source, err = parseEndpoint(*source); err != nil {
+		return fmt.Errorf("invalid -source: %w", err)
+	}
+	if check.Spec.Destination, err = parseEndpoint(*destination); err != nil {
+		return fmt.Errorf("invalid -destination: %w", err)
+	}
+	if *icmpType >= 0 {
+		v := int32(*icmpType)
+		check.Spec.ICMPType = &v
+	}
+	if *icmpCode >= 0 {
+		v := int32(*icmpCode)
+		check.Spec.ICMPCode = &v
+	}
+
+	namespace := check.Spec.Source.Namespace
+	if namespace == "" {
+		namespace = check.Spec.Destination.Namespace
+	}
+	result, err := checker.Check(ctx, namespace, check)
+	if err != nil {
+		return err
+	}
+	return printResult(out, result)
+}
+
+// parseEndpoint parses <namespace>/<pod>, pod:<namespace>/<pod>, subnetport:<namespace>/<name> or an IP.
+func parseEndpoint(value string) (easv1alpha1.ConnectivityCheckEndpoint, error) {
+	if value == "" {
+		return easv1alpha1.ConnectivityCheckEndpoint{}, fmt.Errorf("endpoint is required")
+	}
+	if net.ParseIP(value) != nil {
+		return easv1alpha1.ConnectivityCheckEndpoint{IP: value}, nil
+	}
+	isSubnetPort := strings.HasPrefix(value, subnetPortPrefix)
+	value = strings.TrimPrefix(strings.TrimPrefix(value, subnetPortPrefix), podPrefix)
+	namespace, name, ok := strings.Cut(value, "/")
+	if !ok || namespace == "" || name == "" || strings.Contains(name, "/") {
+		return easv1alpha1.ConnectivityCheckEndpoint{}, fmt.Errorf("%q is not in the format <namespace>/<name>", value)
+	}
+	if isSubnetPort {
+		return easv1alpha1.ConnectivityCheckEndpoint{Namespace: namespace, SubnetPort: name}, nil
+	}
+	return easv1alpha1.ConnectivityCheckEndpoint{Namespace: namespace, Pod: name}, nil
+}
+
+func printResult(out io.Writer, check *easv1alpha1.ConnectivityCheck) error {
+	fmt.Fprintf(out, "Verdict: %s\n", check.Status.Verdict)
+	if rule := check.Status.DecidingRule; rule != nil {
+		fmt.Fprintf(out, "Deciding rule: %s (%s %s)\n", rule.RulePath, rule.Stage, rule.Category)
+	} else {
+		fmt.Fprintln(out, "Deciding rule: none, no rule created by the operator matches the flow")
+	}
+	if check.Status.Message != "" {
+		fmt.Fprintf(out, "Note: %s\n", check.Status.Message)
+	}
+	fmt.Fprintln(out)
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "STAGE\tCATEGORY\tRULE\tACTION\tMATCHED\tREASON")
+	for _, rule := range check.Status.Trace {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%t\t%s\n", rule.Stage, rule.Category, rule.RulePath, rule.Action, rule.Matched, rule.Reason)
+	}
+	return w.Flush()
+}
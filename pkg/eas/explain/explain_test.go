@@ -0,0 +1,87 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package explain
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	easv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1"
+)
+
+type fakeChecker struct {
+	namespace string
+	check     *easv1alpha1.ConnectivityCheck
+	err       error
+}
+
+func (f *fakeChecker) Check(_ context.Context, namespace string, check *easv1alpha1.ConnectivityCheck) (*easv1alpha1.ConnectivityCheck, error) {
+	f.namespace, f.check = namespace, check
+	if f.err != nil {
+		return nil, f.err
+	}
+	out := check.DeepCopy()
+	out.Status = easv1alpha1.ConnectivityCheckStatus{
+		Verdict:      "DROP",
+		DecidingRule: &easv1alpha1.ConnectivityCheckRule{Stage: "Ingress", Category: "Application", RulePath: "/policies/sp1/rules/drop", Action: "DROP", Matched: true},
+		Trace: []easv1alpha1.ConnectivityCheckRule{
+			{Stage: "Ingress", Category: "Application", RulePath: "/policies/sp1/rules/allow", Action: "ALLOW", Reason: "source is not matched"},
+			{Stage: "Ingress", Category: "Application", RulePath: "/policies/sp1/rules/drop", Action: "DROP", Matched: true},
+		},
+	}
+	return out, nil
+}
+
+func TestRun(t *testing.T) {
+	checker := &fakeChecker{}
+	out := &bytes.Buffer{}
+	err := Run(context.TODO(), []string{"-source", "10.0.0.1", "-destination", "subnetport:ns1/vm-port", "-protocol", "ICMP", "-icmp-type", "8"}, checker, out)
+	require.NoError(t, err)
+	assert.Equal(t, "ns1", checker.namespace)
+	assert.Equal(t, easv1alpha1.ConnectivityCheckEndpoint{IP: "10.0.0.1"}, checker.check.Spec.Source)
+	assert.Equal(t, easv1alpha1.ConnectivityCheckEndpoint{Namespace: "ns1", SubnetPort: "vm-port"}, checker.check.Spec.Destination)
+	assert.Equal(t, int32(8), *checker.check.Spec.ICMPType)
+	assert.Nil(t, checker.check.Spec.ICMPCode)
+	assert.Contains(t, out.String(), "Verdict: DROP")
+	assert.Contains(t, out.String(), "Deciding rule: /policies/sp1/rules/drop (Ingress Application)")
+	assert.Contains(t, out.String(), "source is not matched")
+
+	err = Run(context.TODO(), []string{"-source", "ns1/pod-a"}, checker, out)
+	assert.ErrorContains(t, err, "invalid -destination")
+
+	err = Run(context.TODO(), []string{"-source", "ns1/pod-a", "-destination", "ns2/pod-b"}, &fakeChecker{err: fmt.Errorf("not found")}, out)
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestParseEndpoint(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected easv1alpha1.ConnectivityCheckEndpoint
+		wantErr  bool
+	}{
+		{value: "ns1/pod-a", expected: easv1alpha1.ConnectivityCheckEndpoint{Namespace: "ns1", Pod: "pod-a"}},
+		{value: "pod:ns1/pod-a", expected: easv1alpha1.ConnectivityCheckEndpoint{Namespace: "ns1", Pod: "pod-a"}},
+		{value: "subnetport:ns1/vm-port", expected: easv1alpha1.ConnectivityCheckEndpoint{Namespace: "ns1", SubnetPort: "vm-port"}},
+		{value: "fd00::1", expected: easv1alpha1.ConnectivityCheckEndpoint{IP: "fd00::1"}},
+		{value: "", wantErr: true},
+		{value: "pod-a", wantErr: true},
+		{value: "ns1/pod-a/extra", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			endpoint, err := parseEndpoint(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, endpoint)
+		})
+	}
+}
@@ -0,0 +1,144 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	easv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/eas/storage"
+)
+
+var connectivityCheckColumns = []metav1.TableColumnDefinition{
+	{Name: "Name", Type: "string", Format: "name", Description: "Name of the resource"},
+	{Name: "SOURCE", Type: "string", Description: "Source endpoint"},
+	{Name: "DESTINATION", Type: "string", Description: "Destination endpoint"},
+	{Name: "VERDICT", Type: "string", Description: "Verdict of the flow"},
+	{Name: "RULE", Type: "string", Description: "NSX rule deciding the verdict"},
+}
+
+func connectivityCheckEndpointString(e *easv1alpha1.ConnectivityCheckEndpoint) string {
+	switch {
+	case e.IP != "":
+		return e.IP
+	case e.SubnetPort != "":
+		return "SubnetPort/" + e.SubnetPort
+	default:
+		return "Pod/" + e.Pod
+	}
+}
+
+func connectivityCheckCells(c *easv1alpha1.ConnectivityCheck) []interface{} {
+	rule := ""
+	if c.Status.DecidingRule != nil {
+		rule = c.Status.DecidingRule.RulePath
+	}
+	return []interface{}{
+		connectivityCheckEndpointString(&c.Spec.Source),
+		connectivityCheckEndpointString(&c.Spec.Destination),
+		c.Status.Verdict,
+		rule,
+	}
+}
+
+func NewConnectivityCheckStorage(store *storage.ConnectivityCheckStorage, k8sClient client.Client) *connectivityCheckStorage {
+	return &connectivityCheckStorage{store: store, k8sClient: k8sClient}
+}
+
+// connectivityCheckStorage is create-only, the check result is returned in the response and not persisted.
+type connectivityCheckStorage struct {
+	store *storage.ConnectivityCheckStorage
+	// k8sClient creates the SubjectAccessReviews for the endpoints in the other namespaces.
+	k8sClient client.Client
+}
+
+func (r *connectivityCheckStorage) New() runtime.Object {
+	return &easv1alpha1.ConnectivityCheck{}
+}
+func (r *connectivityCheckStorage) Destroy()                {}
+func (r *connectivityCheckStorage) NamespaceScoped() bool   { return true }
+func (r *connectivityCheckStorage) GetSingularName() string { return "connectivitycheck" }
+
+func (r *connectivityCheckStorage) Create(ctx context.Context, obj runtime.Object, createValidation rest.ValidateObjectFunc, _ *metav1.CreateOptions) (runtime.Object, error) {
+	check, ok := obj.(*easv1alpha1.ConnectivityCheck)
+	if !ok {
+		return nil, k8serrors.NewBadRequest(fmt.Sprintf("not a ConnectivityCheck: %T", obj))
+	}
+	if createValidation != nil {
+		if err := createValidation(ctx, obj); err != nil {
+			return nil, err
+		}
+	}
+	ns, _ := request.NamespaceFrom(ctx)
+	// kube-apiserver only authorizes the request namespace, the endpoints in the other namespaces would expose their
+	// subnet ports and rules to the caller if the caller is not allowed to check them in those namespaces.
+	for _, endpoint := range []*easv1alpha1.ConnectivityCheckEndpoint{&check.Spec.Source, &check.Spec.Destination} {
+		if endpoint.Namespace != "" && endpoint.Namespace != ns {
+			if err := r.authorizeNamespace(ctx, check.Name, endpoint.Namespace); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return r.store.Check(ctx, ns, check)
+}
+
+// authorizeNamespace checks with a SubjectAccessReview that the user of the request is allowed to create
+// ConnectivityCheck in the namespace.
+func (r *connectivityCheckStorage) authorizeNamespace(ctx context.Context, name, namespace string) error {
+	groupResource := easv1alpha1.GroupVersion.WithResource("connectivitychecks").GroupResource()
+	user, ok := request.UserFrom(ctx)
+	if !ok {
+		return k8serrors.NewForbidden(groupResource, name, fmt.Errorf("no user in the request to authorize namespace %s", namespace))
+	}
+	extra := make(map[string]authorizationv1.ExtraValue, len(user.GetExtra()))
+	for key, value := range user.GetExtra() {
+		extra[key] = value
+	}
+	review := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: namespace,
+				Verb:      "create",
+				Group:     groupResource.Group,
+				Resource:  groupResource.Resource,
+			},
+			User:   user.GetName(),
+			Groups: user.GetGroups(),
+			UID:    user.GetUID(),
+			Extra:  extra,
+		},
+	}
+	if err := r.k8sClient.Create(ctx, review); err != nil {
+		return k8serrors.NewInternalError(fmt.Errorf("failed to authorize namespace %s: %w", namespace, err))
+	}
+	if !review.Status.Allowed {
+		reason := fmt.Sprintf("user %s is not allowed to create connectivitychecks in the endpoint namespace %s", user.GetName(), namespace)
+		if review.Status.Reason != "" {
+			reason += ": " + review.Status.Reason
+		}
+		return k8serrors.NewForbidden(groupResource, name, errors.New(reason))
+	}
+	return nil
+}
+
+func (r *connectivityCheckStorage) ConvertToTable(_ context.Context, object runtime.Object, _ runtime.Object) (*metav1.Table, error) {
+	table := &metav1.Table{ColumnDefinitions: connectivityCheckColumns}
+	switch obj := object.(type) {
+	case *easv1alpha1.ConnectivityCheck:
+		table.Rows = []metav1.TableRow{tableRow(obj.Name, obj.Namespace, connectivityCheckCells(obj)...)}
+	default:
+		return nil, fmt.Errorf("unsupported type %T for ConnectivityCheck table", object)
+	}
+	return table, nil
+}
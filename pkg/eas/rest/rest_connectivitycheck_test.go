@@ -0,0 +1,142 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	easv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/eas/storage"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+)
+
+func newConnectivityCheckREST() *connectivityCheckStorage {
+	return NewConnectivityCheckStorage(storage.NewConnectivityCheckStorage(&nsx.Client{}, fakeVPCInfoProvider{}), newTestFakeK8sClient().Build())
+}
+
+// fakeAccessReviewClient answers the SubjectAccessReviews with the allowed namespaces of the users.
+type fakeAccessReviewClient struct {
+	client.Client
+	allowed map[string]string
+	err     error
+	reviews []*authorizationv1.SubjectAccessReview
+}
+
+func (c *fakeAccessReviewClient) Create(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+	if c.err != nil {
+		return c.err
+	}
+	review := obj.(*authorizationv1.SubjectAccessReview)
+	c.reviews = append(c.reviews, review)
+	review.Status.Allowed = c.allowed[review.Spec.User] == review.Spec.ResourceAttributes.Namespace
+	return nil
+}
+
+func TestConnectivityCheckStorage_Metadata(t *testing.T) {
+	r := newConnectivityCheckREST()
+	assert.IsType(t, &easv1alpha1.ConnectivityCheck{}, r.New())
+	assert.True(t, r.NamespaceScoped())
+	assert.Equal(t, "connectivitycheck", r.GetSingularName())
+	r.Destroy() // no-op; verify no panic
+}
+
+func TestConnectivityCheckStorage_Create_Invalid(t *testing.T) {
+	r := newConnectivityCheckREST()
+	_, err := r.Create(context.Background(), &easv1alpha1.IPBlockUsage{}, nil, nil)
+	assert.True(t, k8serrors.IsBadRequest(err))
+
+	validationErr := fmt.Errorf("denied")
+	_, err = r.Create(context.Background(), &easv1alpha1.ConnectivityCheck{}, func(context.Context, runtime.Object) error {
+		return validationErr
+	}, nil)
+	assert.Equal(t, validationErr, err)
+
+	// Neither pod, subnetPort nor ip is set in the endpoints.
+	_, err = r.Create(context.Background(), &easv1alpha1.ConnectivityCheck{}, nil, nil)
+	assert.True(t, k8serrors.IsBadRequest(err))
+}
+
+func TestConnectivityCheckStorage_Create_AuthorizeNamespace(t *testing.T) {
+	reviewClient := &fakeAccessReviewClient{allowed: map[string]string{"alice": "ns2"}}
+	r := NewConnectivityCheckStorage(storage.NewConnectivityCheckStorage(&nsx.Client{}, fakeVPCInfoProvider{}), reviewClient)
+	newCheck := func(destination easv1alpha1.ConnectivityCheckEndpoint) *easv1alpha1.ConnectivityCheck {
+		return &easv1alpha1.ConnectivityCheck{Spec: easv1alpha1.ConnectivityCheckSpec{
+			Source:      easv1alpha1.ConnectivityCheckEndpoint{Namespace: "ns1", Pod: "pod-a"},
+			Destination: destination,
+		}}
+	}
+	ctx := request.WithNamespace(context.Background(), "ns1")
+
+	// No user in the request.
+	_, err := r.Create(ctx, newCheck(easv1alpha1.ConnectivityCheckEndpoint{Namespace: "ns2", Pod: "pod-b"}), nil, nil)
+	assert.True(t, k8serrors.IsForbidden(err))
+
+	// The user is not allowed in the endpoint namespace.
+	bobCtx := request.WithUser(ctx, &user.DefaultInfo{Name: "bob", Groups: []string{"dev"}})
+	_, err = r.Create(bobCtx, newCheck(easv1alpha1.ConnectivityCheckEndpoint{Namespace: "ns2", Pod: "pod-b"}), nil, nil)
+	assert.True(t, k8serrors.IsForbidden(err))
+	require.Len(t, reviewClient.reviews, 1)
+	assert.Equal(t, authorizationv1.SubjectAccessReviewSpec{
+		ResourceAttributes: &authorizationv1.ResourceAttributes{
+			Namespace: "ns2",
+			Verb:      "create",
+			Group:     easv1alpha1.GroupVersion.Group,
+			Resource:  "connectivitychecks",
+		},
+		User:   "bob",
+		Groups: []string{"dev"},
+		Extra:  map[string]authorizationv1.ExtraValue{},
+	}, reviewClient.reviews[0].Spec)
+
+	// The user is allowed in the endpoint namespace, the check fails on the spec validation after the authorization.
+	aliceCtx := request.WithUser(ctx, &user.DefaultInfo{Name: "alice"})
+	_, err = r.Create(aliceCtx, newCheck(easv1alpha1.ConnectivityCheckEndpoint{Namespace: "ns2", Pod: "pod-b", IP: "10.0.0.1"}), nil, nil)
+	assert.True(t, k8serrors.IsBadRequest(err))
+
+	// The endpoints in the request namespace are not reviewed.
+	reviewClient.reviews = nil
+	_, err = r.Create(bobCtx, newCheck(easv1alpha1.ConnectivityCheckEndpoint{Namespace: "ns1", Pod: "pod-b", IP: "10.0.0.1"}), nil, nil)
+	assert.True(t, k8serrors.IsBadRequest(err))
+	assert.Empty(t, reviewClient.reviews)
+
+	// The SubjectAccessReview fails.
+	reviewClient.err = fmt.Errorf("forbidden to create subjectaccessreviews")
+	_, err = r.Create(aliceCtx, newCheck(easv1alpha1.ConnectivityCheckEndpoint{Namespace: "ns2", Pod: "pod-b"}), nil, nil)
+	assert.True(t, k8serrors.IsInternalError(err))
+}
+
+func TestConnectivityCheckStorage_ConvertToTable(t *testing.T) {
+	r := newConnectivityCheckREST()
+	obj := &easv1alpha1.ConnectivityCheck{
+		ObjectMeta: metav1.ObjectMeta{Name: "check-1", Namespace: "ns1"},
+		Spec: easv1alpha1.ConnectivityCheckSpec{
+			Source:      easv1alpha1.ConnectivityCheckEndpoint{Pod: "web"},
+			Destination: easv1alpha1.ConnectivityCheckEndpoint{IP: "10.0.0.1"},
+		},
+		Status: easv1alpha1.ConnectivityCheckStatus{
+			Verdict:      "DROP",
+			DecidingRule: &easv1alpha1.ConnectivityCheckRule{RulePath: "/policies/sp1/rules/drop"},
+		},
+	}
+	table, err := r.ConvertToTable(context.Background(), obj, nil)
+	require.NoError(t, err)
+	require.Len(t, table.Rows, 1)
+	assert.Equal(t, connectivityCheckColumns, table.ColumnDefinitions)
+	assert.Equal(t, []interface{}{"check-1", "Pod/web", "10.0.0.1", "DROP", "/policies/sp1/rules/drop"}, table.Rows[0].Cells)
+
+	_, err = r.ConvertToTable(context.Background(), &easv1alpha1.IPBlockUsage{}, nil)
+	assert.ErrorContains(t, err, "unsupported type")
+}
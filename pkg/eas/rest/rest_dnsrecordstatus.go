@@ -0,0 +1,97 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/request"
+
+	easv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/eas"
+	"github.com/vmware-tanzu/nsx-operator/pkg/eas/storage"
+)
+
+var dnsRecordStatusColumns = []metav1.TableColumnDefinition{
+	{Name: "Name", Type: "string", Format: "name", Description: "Name of the resource"},
+	{Name: "FQDN", Type: "string", Description: "Fully qualified domain name"},
+	{Name: "TYPE", Type: "string", Description: "DNS record type"},
+	{Name: "VALUES", Type: "string", Description: "DNS record values"},
+	{Name: "OWNER", Type: "string", Description: "Primary owner kind/name"},
+	{Name: "CONTRIBUTORS", Type: "integer", Description: "Number of contributing owners"},
+	{Name: "STATE", Type: "string", Description: "NSX realization state"},
+	{Name: "REASON", Type: "string", Description: "Reason why the DNS request was rejected"},
+}
+
+func dnsRecordStatusCells(s *easv1alpha1.DNSRecordStatus) []interface{} {
+	owner := ""
+	if s.Owner.Kind != "" {
+		owner = s.Owner.Kind + "/" + s.Owner.Name
+	}
+	return []interface{}{
+		s.FQDN,
+		s.RecordType,
+		truncateCol(strings.Join(s.Values, ",")),
+		owner,
+		len(s.ContributingOwners),
+		string(s.RealizationState),
+		s.Reason,
+	}
+}
+
+func NewDNSRecordStatusStorage(store *storage.DNSRecordStatusStorage, provider eas.VPCInfoProvider) *dnsRecordStatusStorage {
+	return &dnsRecordStatusStorage{store: store, vpcProvider: provider}
+}
+
+type dnsRecordStatusStorage struct {
+	store       *storage.DNSRecordStatusStorage
+	vpcProvider eas.VPCInfoProvider
+}
+
+func (r *dnsRecordStatusStorage) New() runtime.Object     { return &easv1alpha1.DNSRecordStatus{} }
+func (r *dnsRecordStatusStorage) Destroy()                {}
+func (r *dnsRecordStatusStorage) NamespaceScoped() bool   { return true }
+func (r *dnsRecordStatusStorage) NewList() runtime.Object { return &easv1alpha1.DNSRecordStatusList{} }
+func (r *dnsRecordStatusStorage) GetSingularName() string { return "dnsrecordstatus" }
+
+func (r *dnsRecordStatusStorage) Get(ctx context.Context, name string, _ *metav1.GetOptions) (runtime.Object, error) {
+	ns, _ := request.NamespaceFrom(ctx)
+	return r.store.Get(ctx, ns, name)
+}
+
+func (r *dnsRecordStatusStorage) List(ctx context.Context, _ *metainternalversion.ListOptions) (runtime.Object, error) {
+	if ns, ok := request.NamespaceFrom(ctx); ok {
+		return r.store.List(ctx, ns)
+	}
+	merged := &easv1alpha1.DNSRecordStatusList{}
+	for _, ns := range r.vpcProvider.ListAllVPCNamespaces() {
+		result, err := r.store.List(ctx, ns)
+		if err != nil {
+			continue
+		}
+		merged.Items = append(merged.Items, result.Items...)
+	}
+	return merged, nil
+}
+
+func (r *dnsRecordStatusStorage) ConvertToTable(_ context.Context, object runtime.Object, _ runtime.Object) (*metav1.Table, error) {
+	table := &metav1.Table{ColumnDefinitions: dnsRecordStatusColumns}
+	switch obj := object.(type) {
+	case *easv1alpha1.DNSRecordStatus:
+		table.Rows = []metav1.TableRow{tableRow(obj.Name, obj.Namespace, dnsRecordStatusCells(obj)...)}
+	case *easv1alpha1.DNSRecordStatusList:
+		for i := range obj.Items {
+			item := &obj.Items[i]
+			table.Rows = append(table.Rows, tableRow(item.Name, item.Namespace, dnsRecordStatusCells(item)...))
+		}
+	default:
+		return nil, fmt.Errorf("unsupported type %T for DNSRecordStatus table", object)
+	}
+	return table, nil
+}
@@ -0,0 +1,83 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package rest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	easv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/eas/storage"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+)
+
+func newDNSRecordStatusREST(namespaces ...string) *dnsRecordStatusStorage {
+	return NewDNSRecordStatusStorage(
+		storage.NewDNSRecordStatusStorage(&nsx.Client{}, nil),
+		fakeVPCInfoProvider{namespaces: namespaces},
+	)
+}
+
+func TestDNSRecordStatusStorage_Metadata(t *testing.T) {
+	r := newDNSRecordStatusREST()
+	assert.IsType(t, &easv1alpha1.DNSRecordStatus{}, r.New())
+	assert.IsType(t, &easv1alpha1.DNSRecordStatusList{}, r.NewList())
+	assert.True(t, r.NamespaceScoped())
+	assert.Equal(t, "dnsrecordstatus", r.GetSingularName())
+	r.Destroy() // no-op; verify no panic
+}
+
+func TestDNSRecordStatusStorage_List_CrossNamespace_NoNamespaces(t *testing.T) {
+	// Provider has no namespaces → cross-namespace list returns empty list without NSX calls.
+	r := newDNSRecordStatusREST()
+	result, err := r.List(context.Background(), nil)
+	require.NoError(t, err)
+	list, ok := result.(*easv1alpha1.DNSRecordStatusList)
+	require.True(t, ok)
+	assert.Empty(t, list.Items)
+}
+
+func TestDNSRecordStatusStorage_ConvertToTable_Single(t *testing.T) {
+	r := newDNSRecordStatusREST()
+	obj := &easv1alpha1.DNSRecordStatus{
+		ObjectMeta:         metav1.ObjectMeta{Name: "app_zone-t_a", Namespace: "ns1"},
+		FQDN:               "app.example.com",
+		RecordType:         "A",
+		Values:             []string{"10.0.0.1", "10.0.0.2"},
+		Owner:              easv1alpha1.DNSRecordOwner{Kind: "Gateway", Namespace: "ns1", Name: "gw"},
+		ContributingOwners: []easv1alpha1.DNSRecordOwner{{Kind: "HTTPRoute", Namespace: "ns1", Name: "route-a"}},
+		RealizationState:   easv1alpha1.RealizationStateRealized,
+	}
+	table, err := r.ConvertToTable(context.Background(), obj, nil)
+	require.NoError(t, err)
+	require.Len(t, table.Rows, 1)
+	assert.Equal(t, dnsRecordStatusColumns, table.ColumnDefinitions)
+	assert.Equal(t, []interface{}{"app_zone-t_a", "app.example.com", "A", "10.0.0.1,10.0.0.2", "Gateway/gw", 1, "Realized", ""}, table.Rows[0].Cells)
+}
+
+func TestDNSRecordStatusStorage_ConvertToTable_List(t *testing.T) {
+	r := newDNSRecordStatusREST()
+	list := &easv1alpha1.DNSRecordStatusList{
+		Items: []easv1alpha1.DNSRecordStatus{
+			{ObjectMeta: metav1.ObjectMeta{Name: "r1", Namespace: "ns1"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "r2", Namespace: "ns1"}},
+		},
+	}
+	table, err := r.ConvertToTable(context.Background(), list, nil)
+	require.NoError(t, err)
+	require.Len(t, table.Rows, 2)
+	// Records without decodable owner tags show an empty owner column.
+	assert.Equal(t, "", table.Rows[0].Cells[4])
+}
+
+func TestDNSRecordStatusStorage_ConvertToTable_Error(t *testing.T) {
+	r := newDNSRecordStatusREST()
+	_, err := r.ConvertToTable(context.Background(), &easv1alpha1.IPBlockUsage{}, nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported type")
+}
@@ -0,0 +1,107 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/request"
+
+	easv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/eas"
+	"github.com/vmware-tanzu/nsx-operator/pkg/eas/storage"
+)
+
+var securityPolicyRuleStatsColumns = []metav1.TableColumnDefinition{
+	{Name: "Name", Type: "string", Format: "name", Description: "Name of the resource"},
+	{Name: "OWNER", Type: "string", Description: "Owner kind/name"},
+	{Name: "RULES", Type: "integer", Description: "Number of NSX rules"},
+	{Name: "HITS", Type: "integer", Description: "Total hit count of the rules"},
+	{Name: "PACKETS", Type: "integer", Description: "Total packet count of the rules"},
+	{Name: "BYTES", Type: "integer", Description: "Total byte count of the rules"},
+	{Name: "STATE", Type: "string", Description: "NSX realization state"},
+}
+
+func securityPolicyRuleStatsCells(s *easv1alpha1.SecurityPolicyRuleStats) []interface{} {
+	owner := ""
+	if s.OwnerKind != "" {
+		owner = s.OwnerKind + "/" + s.OwnerName
+	}
+	var hits, packets, bytes int64
+	for _, rule := range s.Rules {
+		if rule.Statistics == nil {
+			continue
+		}
+		hits += rule.Statistics.HitCount
+		packets += rule.Statistics.PacketCount
+		bytes += rule.Statistics.ByteCount
+	}
+	return []interface{}{
+		owner,
+		len(s.Rules),
+		hits,
+		packets,
+		bytes,
+		string(s.RealizationState),
+	}
+}
+
+func NewSecurityPolicyRuleStatsStorage(store *storage.SecurityPolicyRuleStatsStorage, provider eas.VPCInfoProvider) *securityPolicyRuleStatsStorage {
+	return &securityPolicyRuleStatsStorage{store: store, vpcProvider: provider}
+}
+
+type securityPolicyRuleStatsStorage struct {
+	store       *storage.SecurityPolicyRuleStatsStorage
+	vpcProvider eas.VPCInfoProvider
+}
+
+func (r *securityPolicyRuleStatsStorage) New() runtime.Object {
+	return &easv1alpha1.SecurityPolicyRuleStats{}
+}
+func (r *securityPolicyRuleStatsStorage) Destroy()              {}
+func (r *securityPolicyRuleStatsStorage) NamespaceScoped() bool { return true }
+func (r *securityPolicyRuleStatsStorage) NewList() runtime.Object {
+	return &easv1alpha1.SecurityPolicyRuleStatsList{}
+}
+func (r *securityPolicyRuleStatsStorage) GetSingularName() string { return "securitypolicyrulestats" }
+
+func (r *securityPolicyRuleStatsStorage) Get(ctx context.Context, name string, _ *metav1.GetOptions) (runtime.Object, error) {
+	ns, _ := request.NamespaceFrom(ctx)
+	return r.store.Get(ctx, ns, name)
+}
+
+func (r *securityPolicyRuleStatsStorage) List(ctx context.Context, _ *metainternalversion.ListOptions) (runtime.Object, error) {
+	if ns, ok := request.NamespaceFrom(ctx); ok {
+		return r.store.List(ctx, ns)
+	}
+	merged := &easv1alpha1.SecurityPolicyRuleStatsList{}
+	for _, ns := range r.vpcProvider.ListAllVPCNamespaces() {
+		result, err := r.store.List(ctx, ns)
+		if err != nil {
+			continue
+		}
+		merged.Items = append(merged.Items, result.Items...)
+	}
+	return merged, nil
+}
+
+func (r *securityPolicyRuleStatsStorage) ConvertToTable(_ context.Context, object runtime.Object, _ runtime.Object) (*metav1.Table, error) {
+	table := &metav1.Table{ColumnDefinitions: securityPolicyRuleStatsColumns}
+	switch obj := object.(type) {
+	case *easv1alpha1.SecurityPolicyRuleStats:
+		table.Rows = []metav1.TableRow{tableRow(obj.Name, obj.Namespace, securityPolicyRuleStatsCells(obj)...)}
+	case *easv1alpha1.SecurityPolicyRuleStatsList:
+		for i := range obj.Items {
+			item := &obj.Items[i]
+			table.Rows = append(table.Rows, tableRow(item.Name, item.Namespace, securityPolicyRuleStatsCells(item)...))
+		}
+	default:
+		return nil, fmt.Errorf("unsupported type %T for SecurityPolicyRuleStats table", object)
+	}
+	return table, nil
+}
@@ -0,0 +1,107 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/request"
+
+	easv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/eas"
+	"github.com/vmware-tanzu/nsx-operator/pkg/eas/storage"
+)
+
+// Columns with Priority 1 are only shown by "kubectl get -o wide".
+var subnetPortStateColumns = []metav1.TableColumnDefinition{
+	{Name: "Name", Type: "string", Format: "name", Description: "Name of the resource"},
+	{Name: "OWNER", Type: "string", Description: "Kind of the resource owning the port"},
+	{Name: "ADMIN", Type: "string", Description: "Admin state of the port"},
+	{Name: "OPER", Type: "string", Description: "Operational state of the port"},
+	{Name: "IP", Type: "string", Description: "Realized IP addresses"},
+	{Name: "MAC", Type: "string", Description: "Realized MAC addresses"},
+	{Name: "NODES", Type: "string", Priority: 1, Description: "Transport nodes hosting the VIF"},
+	{Name: "ATTACHMENT", Type: "string", Priority: 1, Description: "VIF attachment ID"},
+	{Name: "STATE", Type: "string", Priority: 1, Description: "NSX realization state"},
+	{Name: "SUBNET", Type: "string", Priority: 1, Description: "NSX Subnet path"},
+}
+
+func subnetPortStateCells(s *easv1alpha1.SubnetPortState) []interface{} {
+	var ips, macs []string
+	for _, b := range s.RealizedBindings {
+		if b.IPAddress != "" {
+			ips = append(ips, b.IPAddress)
+		}
+		if b.MACAddress != "" {
+			macs = append(macs, b.MACAddress)
+		}
+	}
+	return []interface{}{
+		s.OwnerKind,
+		s.AdminState,
+		s.OperationalState,
+		truncateCol(strings.Join(ips, ",")),
+		truncateCol(strings.Join(macs, ",")),
+		truncateCol(strings.Join(s.TransportNodeIDs, ",")),
+		s.AttachmentID,
+		string(s.RealizationState),
+		s.SubnetPath,
+	}
+}
+
+func NewSubnetPortStateStorage(store *storage.SubnetPortStateStorage, provider eas.VPCInfoProvider) *subnetPortStateStorage {
+	return &subnetPortStateStorage{store: store, vpcProvider: provider}
+}
+
+type subnetPortStateStorage struct {
+	store       *storage.SubnetPortStateStorage
+	vpcProvider eas.VPCInfoProvider
+}
+
+func (r *subnetPortStateStorage) New() runtime.Object     { return &easv1alpha1.SubnetPortState{} }
+func (r *subnetPortStateStorage) Destroy()                {}
+func (r *subnetPortStateStorage) NamespaceScoped() bool   { return true }
+func (r *subnetPortStateStorage) NewList() runtime.Object { return &easv1alpha1.SubnetPortStateList{} }
+func (r *subnetPortStateStorage) GetSingularName() string { return "subnetportstate" }
+
+func (r *subnetPortStateStorage) Get(ctx context.Context, name string, _ *metav1.GetOptions) (runtime.Object, error) {
+	ns, _ := request.NamespaceFrom(ctx)
+	return r.store.Get(ctx, ns, name)
+}
+
+func (r *subnetPortStateStorage) List(ctx context.Context, _ *metainternalversion.ListOptions) (runtime.Object, error) {
+	if ns, ok := request.NamespaceFrom(ctx); ok {
+		return r.store.List(ctx, ns)
+	}
+	merged := &easv1alpha1.SubnetPortStateList{}
+	for _, ns := range r.vpcProvider.ListAllVPCNamespaces() {
+		result, err := r.store.List(ctx, ns)
+		if err != nil {
+			continue
+		}
+		merged.Items = append(merged.Items, result.Items...)
+	}
+	return merged, nil
+}
+
+func (r *subnetPortStateStorage) ConvertToTable(_ context.Context, object runtime.Object, _ runtime.Object) (*metav1.Table, error) {
+	table := &metav1.Table{ColumnDefinitions: subnetPortStateColumns}
+	switch obj := object.(type) {
+	case *easv1alpha1.SubnetPortState:
+		table.Rows = []metav1.TableRow{tableRow(obj.Name, obj.Namespace, subnetPortStateCells(obj)...)}
+	case *easv1alpha1.SubnetPortStateList:
+		for i := range obj.Items {
+			item := &obj.Items[i]
+			table.Rows = append(table.Rows, tableRow(item.Name, item.Namespace, subnetPortStateCells(item)...))
+		}
+	default:
+		return nil, fmt.Errorf("unsupported type %T for SubnetPortState table", object)
+	}
+	return table, nil
+}
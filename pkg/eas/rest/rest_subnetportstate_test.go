@@ -0,0 +1,89 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package rest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	easv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/eas/storage"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+)
+
+func newSubnetPortStateREST(namespaces ...string) *subnetPortStateStorage {
+	provider := fakeVPCInfoProvider{namespaces: namespaces}
+	return NewSubnetPortStateStorage(storage.NewSubnetPortStateStorage(&nsx.Client{}, provider), provider)
+}
+
+func TestSubnetPortStateStorage_Metadata(t *testing.T) {
+	r := newSubnetPortStateREST()
+	assert.IsType(t, &easv1alpha1.SubnetPortState{}, r.New())
+	assert.IsType(t, &easv1alpha1.SubnetPortStateList{}, r.NewList())
+	assert.True(t, r.NamespaceScoped())
+	assert.Equal(t, "subnetportstate", r.GetSingularName())
+	r.Destroy() // no-op; verify no panic
+}
+
+func TestSubnetPortStateStorage_List_CrossNamespace(t *testing.T) {
+	// The fake provider returns no VPCs for any namespace, so each namespace lists no ports.
+	r := newSubnetPortStateREST("ns1", "ns2")
+	result, err := r.List(context.Background(), nil)
+	require.NoError(t, err)
+	list, ok := result.(*easv1alpha1.SubnetPortStateList)
+	require.True(t, ok)
+	assert.Empty(t, list.Items)
+}
+
+func TestSubnetPortStateStorage_ConvertToTable_Single(t *testing.T) {
+	r := newSubnetPortStateREST()
+	obj := &easv1alpha1.SubnetPortState{
+		ObjectMeta:       metav1.ObjectMeta{Name: "pod-a", Namespace: "ns1"},
+		OwnerKind:        "Pod",
+		SubnetPath:       "/orgs/default/projects/proj1/vpcs/vpc1/subnets/subnet1",
+		AttachmentID:     "vif-1",
+		AdminState:       "UP",
+		OperationalState: "UP",
+		RealizationState: easv1alpha1.RealizationStateRealized,
+		TransportNodeIDs: []string{"tn-1"},
+		RealizedBindings: []easv1alpha1.SubnetPortAddressBinding{
+			{IPAddress: "10.0.0.5", MACAddress: "aa:bb:cc:dd:ee:ff"},
+			{IPAddress: "fd00::5"},
+		},
+	}
+	table, err := r.ConvertToTable(context.Background(), obj, nil)
+	require.NoError(t, err)
+	require.Len(t, table.Rows, 1)
+	assert.Equal(t, subnetPortStateColumns, table.ColumnDefinitions)
+	assert.Equal(t, []interface{}{"pod-a", "Pod", "UP", "UP", "10.0.0.5,fd00::5", "aa:bb:cc:dd:ee:ff",
+		"tn-1", "vif-1", "Realized", "/orgs/default/projects/proj1/vpcs/vpc1/subnets/subnet1"}, table.Rows[0].Cells)
+	for _, col := range table.ColumnDefinitions[6:] {
+		assert.Equal(t, int32(1), col.Priority, "column %s should only be shown with -o wide", col.Name)
+	}
+}
+
+func TestSubnetPortStateStorage_ConvertToTable_List(t *testing.T) {
+	r := newSubnetPortStateREST()
+	list := &easv1alpha1.SubnetPortStateList{
+		Items: []easv1alpha1.SubnetPortState{
+			{ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "ns1"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "p2", Namespace: "ns1"}},
+		},
+	}
+	table, err := r.ConvertToTable(context.Background(), list, nil)
+	require.NoError(t, err)
+	require.Len(t, table.Rows, 2)
+	assert.Equal(t, "p2", table.Rows[1].Cells[0])
+}
+
+func TestSubnetPortStateStorage_ConvertToTable_Error(t *testing.T) {
+	r := newSubnetPortStateREST()
+	_, err := r.ConvertToTable(context.Background(), &easv1alpha1.IPBlockUsage{}, nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported type")
+}
@@ -0,0 +1,71 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+// Package restorestatus implements the "restore-status" subcommand of the EAS binary, which prints the progress of
+// the restore after NSX restore recorded by the operator in the NCPConfig nsx-restore-status.
+package restorestatus
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+const Command = "restore-status"
+
+// Run reads the restore progress with k8sClient and prints it to out.
+func Run(ctx context.Context, k8sClient client.Client, out io.Writer) error {
+	progress, err := util.GetRestoreProgress(ctx, k8sClient)
+	if err != nil {
+		return err
+	}
+	if progress == nil {
+		fmt.Fprintln(out, "No restore has been recorded")
+		return nil
+	}
+	return printProgress(out, progress)
+}
+
+func printProgress(out io.Writer, progress *util.RestoreProgress) error {
+	fmt.Fprintf(out, "Phase: %s\n", progress.Phase)
+	fmt.Fprintf(out, "Attempts: %d\n", progress.Attempts)
+	fmt.Fprintf(out, "Started: %s\n", formatTime(&progress.StartTime))
+	fmt.Fprintf(out, "Last update: %s\n", formatTime(&progress.LastUpdateTime))
+	if progress.CompletionTime != nil {
+		fmt.Fprintf(out, "Completed: %s\n", formatTime(progress.CompletionTime))
+	}
+	fmt.Fprintf(out, "Garbage collected: %t\n", progress.GarbageCollected)
+	if progress.Message != "" {
+		fmt.Fprintf(out, "Message: %s\n", progress.Message)
+	}
+	fmt.Fprintln(out)
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "CONTROLLER\tPHASE\tTOTAL\tRESTORED\tFAILED\tDURATION\tMESSAGE")
+	for _, c := range progress.Controllers {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%s\t%s\n", c.Name, c.Phase, c.Total, c.Restored, c.Failed, duration(c.StartTime, c.CompletionTime), c.Message)
+	}
+	return w.Flush()
+}
+
+func formatTime(t *metav1.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// duration is the time the controller took to restore, or has taken so far if it is running.
+func duration(start, completion *metav1.Time) string {
+	if start == nil {
+		return "-"
+	}
+	end := time.Now()
+	if completion != nil {
+		end = completion.Time
+	}
+	return end.Sub(start.Time).Round(time.Second).String()
+}
@@ -0,0 +1,71 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package restorestatus
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+func newRestoreStatus(t *testing.T, progress *util.RestoreProgress) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(schema.GroupVersionKind{Group: "nsx.vmware.com", Version: "v1", Kind: "NCPConfig"})
+	obj.SetName(util.NSXRestoreStatus)
+	if progress != nil {
+		data, err := json.Marshal(progress)
+		require.NoError(t, err)
+		obj.SetAnnotations(map[string]string{util.AnnotationRestoreProgress: string(data)})
+	}
+	return obj
+}
+
+func TestRun(t *testing.T) {
+	var out bytes.Buffer
+	k8sClient := fake.NewClientBuilder().Build()
+	require.NoError(t, Run(context.TODO(), k8sClient, &out))
+	assert.Equal(t, "No restore has been recorded\n", out.String())
+
+	start := metav1.NewTime(time.Date(2026, 10, 18, 8, 0, 0, 0, time.UTC))
+	end := metav1.NewTime(start.Add(90 * time.Second))
+	progress := &util.RestoreProgress{
+		Phase:            util.RestorePhaseFailed,
+		Attempts:         2,
+		GarbageCollected: true,
+		StartTime:        start,
+		LastUpdateTime:   end,
+		Message:          "failed to restore resources",
+		Controllers: []util.ControllerRestoreProgress{
+			{Name: "networkinfo.NetworkInfoReconciler", Phase: util.ControllerRestoreCompleted, Total: 3, Restored: 3, StartTime: &start, CompletionTime: &end},
+			{Name: "subnetport.SubnetPortReconciler", Phase: util.ControllerRestoreFailed, Total: 5, Restored: 4, Failed: 1, StartTime: &start, CompletionTime: &end, Message: "errors found"},
+			{Name: "pod.PodReconciler", Phase: util.ControllerRestorePending},
+		},
+	}
+	k8sClient = fake.NewClientBuilder().WithObjects(newRestoreStatus(t, progress)).Build()
+	out.Reset()
+	require.NoError(t, Run(context.TODO(), k8sClient, &out))
+	assert.Equal(t, `Phase: Failed
+Attempts: 2
+Started: 2026-10-18T08:00:00Z
+Last update: 2026-10-18T08:01:30Z
+Garbage collected: true
+Message: failed to restore resources
+
+CONTROLLER                         PHASE      TOTAL  RESTORED  FAILED  DURATION  MESSAGE
+networkinfo.NetworkInfoReconciler  Completed  3      3         0       1m30s     
+subnetport.SubnetPortReconciler    Failed     5      4         1       1m30s     errors found
+pod.PodReconciler                  Pending    0      0         0       -         
+`, out.String())
+}
@@ -79,7 +79,7 @@ func (s *EASServer) registerExtensionAPIService(ctx context.Context) error {
 	// Patch caBundle separately using a MergePatch so the raw JSON bytes are
 	// sent directly to the API server — this avoids unstructured deep-copy
 	// limitations and guarantees the []byte field is encoded correctly.
-	if len(s.caCert) > 0 {
+	if len(s.getCACert()) > 0 {
 		if err := s.patchAPIServiceCABundle(ctx, ri, name); err != nil {
 			return err
 		}
@@ -104,7 +104,7 @@ func (s *EASServer) patchAPIServiceCABundle(ctx context.Context, ri dynamic.Reso
 			// preserved.  On non-WCP clusters that accept caBundle, kube-apiserver
 			// will use the bundle for verification; insecureSkipTLSVerify: true is a
 			// no-op when caBundle is present and verified successfully.
-			"caBundle": base64.StdEncoding.EncodeToString(s.caCert),
+			"caBundle": base64.StdEncoding.EncodeToString(s.getCACert()),
 		},
 	}
 	patchBytes, err := json.Marshal(patch)
@@ -129,6 +129,43 @@ func (s *EASServer) patchAPIServiceCABundle(ctx context.Context, ri dynamic.Reso
 	return fmt.Errorf("patch APIService caBundle %s: %w", name, err)
 }
 
+func (s *EASServer) getCACert() []byte {
+	s.caLock.RLock()
+	defer s.caLock.RUnlock()
+	return s.caCert
+}
+
+func (s *EASServer) setCACert(caBundle []byte) {
+	s.caLock.Lock()
+	defer s.caLock.Unlock()
+	s.caCert = caBundle
+}
+
+// UpdateCABundle replaces the CA bundle of the EAS certificate, and patches it in
+// the APIService so kube-apiserver trusts the renewed certificate.  It is called
+// by the certificate rotator before the renewed certificate is served.
+func (s *EASServer) UpdateCABundle(ctx context.Context, caBundle []byte) error {
+	if s.restConfig == nil || !envBool(envEASRegisterAPIService, true) {
+		s.setCACert(caBundle)
+		return nil
+	}
+	dc, err := dynamic.NewForConfig(s.restConfig)
+	if err != nil {
+		return fmt.Errorf("dynamic client for APIService caBundle update: %w", err)
+	}
+	return s.updateCABundle(ctx, dc.Resource(apiserviceGVR), defaultAPIServiceName, caBundle)
+}
+
+// updateCABundle sets caBundle and patches the APIService.  An APIService not
+// registered yet is ignored, the PostStartHook registers it with caBundle.
+func (s *EASServer) updateCABundle(ctx context.Context, ri dynamic.ResourceInterface, name string, caBundle []byte) error {
+	s.setCACert(caBundle)
+	if err := s.patchAPIServiceCABundle(ctx, ri, name); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
 func mergeAPIServiceSpec(into, from *unstructured.Unstructured) {
 	spec, found, _ := unstructured.NestedMap(from.Object, "spec")
 	if !found || spec == nil {
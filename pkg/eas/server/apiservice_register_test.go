@@ -10,6 +10,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/dynamic/fake"
@@ -173,6 +174,25 @@ func TestPatchAPIServiceCABundle_GenericError(t *testing.T) {
 	assert.Contains(t, err.Error(), "patch APIService caBundle")
 }
 
+// ── UpdateCABundle ───────────────────────────────────────────────────────────
+
+func TestUpdateCABundle_NilRestConfig(t *testing.T) {
+	s := &EASServer{caCert: []byte("old-ca")}
+	require.NoError(t, s.UpdateCABundle(context.Background(), []byte("new-ca")))
+	assert.Equal(t, []byte("new-ca"), s.getCACert())
+}
+
+func TestUpdateCABundle_APIServiceNotRegistered(t *testing.T) {
+	// The PostStartHook registers the APIService with the new caBundle later.
+	s := &EASServer{caCert: []byte("old-ca")}
+	fc := fakeDynamicRIWithPatchReactor(t, apierrors.NewNotFound(apiserviceGVR.GroupResource(), defaultAPIServiceName))
+	require.NoError(t, s.updateCABundle(context.Background(), fc.Resource(apiserviceGVR), defaultAPIServiceName, []byte("new-ca")))
+	assert.Equal(t, []byte("new-ca"), s.getCACert())
+
+	fc = fakeDynamicRIWithPatchReactor(t, fmt.Errorf("unexpected server error"))
+	assert.Error(t, s.updateCABundle(context.Background(), fc.Resource(apiserviceGVR), defaultAPIServiceName, []byte("new-ca")))
+}
+
 // ── registerExtensionAPIService body beyond early-exit guards ─────────────────
 
 func TestRegisterExtensionAPIService_GetAPIServiceError(t *testing.T) {
@@ -14,7 +14,10 @@ import (
 // # Authorization model
 //
 // EAS exposes read-only resources (VPCIPAddressUsage, IPBlockUsage,
-// SubnetIPPools, SubnetDHCPServerStats).  All write paths are absent by design.
+// SubnetIPPools, SubnetDHCPServerStats, DNSRecordStatus, SubnetPortState,
+// SecurityPolicyRuleStats) and the create-only ConnectivityCheck, whose result
+// is computed on request and never persisted.  All other write paths are absent
+// by design.
 //
 // In the Kubernetes aggregated-API-server model, every request reaches the EAS
 // server only after the kube-apiserver has already:
@@ -30,6 +33,11 @@ import (
 //
 // This authorizer therefore allows every request that arrives here, trusting
 // that the kube-apiserver aggregation layer has already enforced access control.
+//
+// The exception is a ConnectivityCheck with an endpoint in a namespace other
+// than the request namespace, which kube-apiserver has not authorized.  The
+// ConnectivityCheck storage creates a SubjectAccessReview for that namespace,
+// so cross-namespace checks need the "create subjectaccessreviews" privilege.
 type easAuthorizer struct{}
 
 // Authorize always returns DecisionAllow.  The upstream kube-apiserver
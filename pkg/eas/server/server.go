@@ -10,6 +10,7 @@ import (
 	"os"
 	"path"
 	"strconv"
+	"sync"
 
 	openapinamer "k8s.io/apiserver/pkg/endpoints/openapi"
 	apirest "k8s.io/apiserver/pkg/registry/rest"
@@ -43,11 +44,17 @@ const (
 // Because EAS is read-only it does not need leader election; all replicas serve
 // concurrently and the Kubernetes Service load-balances across Ready pods.
 type EASServer struct {
-	vpcProvider     eas.VPCInfoProvider
-	vpcIPUsage      *storage.VPCIPAddressUsageStorage
-	ipBlockUsage    *storage.IPBlockUsageStorage
-	subnetIPPools   *storage.SubnetIPPoolsStorage
-	subnetDHCPStats *storage.SubnetDHCPStatsStorage
+	vpcProvider             eas.VPCInfoProvider
+	vpcIPUsage              *storage.VPCIPAddressUsageStorage
+	ipBlockUsage            *storage.IPBlockUsageStorage
+	subnetIPPools           *storage.SubnetIPPoolsStorage
+	subnetDHCPStats         *storage.SubnetDHCPStatsStorage
+	dnsRecordStatus         *storage.DNSRecordStatusStorage
+	subnetPortState         *storage.SubnetPortStateStorage
+	securityPolicyRuleStats *storage.SecurityPolicyRuleStatsStorage
+	connectivityCheck       *storage.ConnectivityCheckStorage
+	// k8sClient authorizes the ConnectivityCheck endpoints in the namespaces other than the request namespace.
+	k8sClient k8sclient.Client
 	// nsxHealthChecker is added to the generic API server's /readyz endpoint
 	// so that the pod is removed from Service endpoints when NSX is unreachable.
 	nsxHealthChecker healthz.HealthChecker
@@ -63,8 +70,10 @@ type EASServer struct {
 	kubeConfigFile string
 	// caCert is the PEM-encoded CA that signed the EAS TLS certificate.
 	// When non-nil it is injected into the APIService caBundle so that
-	// kube-apiserver can verify the EAS TLS connection.
+	// kube-apiserver can verify the EAS TLS connection.  It is replaced by
+	// UpdateCABundle when the certificate is renewed.
 	caCert []byte
+	caLock sync.RWMutex
 }
 
 // NewEASServer creates a fully wired EASServer.
@@ -74,8 +83,9 @@ type EASServer struct {
 // ready to serve, so kube-apiserver does not proxy requests to EAS prematurely.
 // kubeConfigFile is the kubeconfig path from ncp.ini [k8s].kubeconfig; pass ""
 // to fall back to the in-cluster service-account token.
-// caCert is the PEM-encoded CA returned by GenerateEASCerts; pass nil to fall
-// back to insecureSkipTLSVerify on the APIService.
+// caCert is the PEM-encoded CA bundle of the EAS certificate installed by the
+// certificate rotator; pass nil to fall back to insecureSkipTLSVerify on the
+// APIService.
 func NewEASServer(
 	nsxClient *nsx.Client,
 	vpcProvider eas.VPCInfoProvider,
@@ -85,11 +95,16 @@ func NewEASServer(
 	caCert []byte,
 ) *EASServer {
 	return &EASServer{
-		vpcProvider:     vpcProvider,
-		vpcIPUsage:      storage.NewVPCIPAddressUsageStorage(nsxClient, vpcProvider),
-		ipBlockUsage:    storage.NewIPBlockUsageStorage(nsxClient, vpcProvider),
-		subnetIPPools:   storage.NewSubnetIPPoolsStorage(nsxClient, k8sClient),
-		subnetDHCPStats: storage.NewSubnetDHCPStatsStorage(nsxClient, k8sClient),
+		vpcProvider:             vpcProvider,
+		vpcIPUsage:              storage.NewVPCIPAddressUsageStorage(nsxClient, vpcProvider),
+		ipBlockUsage:            storage.NewIPBlockUsageStorage(nsxClient, vpcProvider),
+		subnetIPPools:           storage.NewSubnetIPPoolsStorage(nsxClient, k8sClient),
+		subnetDHCPStats:         storage.NewSubnetDHCPStatsStorage(nsxClient, k8sClient),
+		dnsRecordStatus:         storage.NewDNSRecordStatusStorage(nsxClient, k8sClient),
+		subnetPortState:         storage.NewSubnetPortStateStorage(nsxClient, vpcProvider),
+		securityPolicyRuleStats: storage.NewSecurityPolicyRuleStatsStorage(nsxClient),
+		connectivityCheck:       storage.NewConnectivityCheckStorage(nsxClient, vpcProvider),
+		k8sClient:               k8sClient,
 		// /readyz reports not-ready when NSX is unreachable, causing kube-proxy
 		// to stop routing traffic to this pod until connectivity is restored.
 		nsxHealthChecker: healthz.NamedCheck("nsx", nsxClient.NSXChecker.CheckNSXHealth),
@@ -114,7 +129,7 @@ func (s *EASServer) Start(ctx context.Context) error {
 //   - TLS from the EAS cert files (same files used by the previous net/http server)
 //   - Delegated authentication via TokenReview to kube-apiserver (in-cluster)
 //   - Delegated authorization via SubjectAccessReview to kube-apiserver
-//   - The four EAS resource types registered as REST storage
+//   - The EAS resource types registered as REST storage
 func (s *EASServer) buildGenericAPIServer() (*genericapiserver.GenericAPIServer, error) {
 	port, bindAddr, certFile, keyFile := listenerConfig()
 
@@ -233,10 +248,14 @@ func (s *EASServer) buildGenericAPIServer() (*genericapiserver.GenericAPIServer,
 		codecs,
 	)
 	apiGroupInfo.VersionedResourcesStorageMap[easv1alpha1.GroupVersion.Version] = map[string]apirest.Storage{
-		"vpcipaddressusages":    rest.NewVPCIPUsageStorage(s.vpcIPUsage, s.vpcProvider),
-		"ipblockusages":         rest.NewIPBlockUsageStorage(s.ipBlockUsage, s.vpcProvider),
-		"subnetippools":         rest.NewSubnetIPPoolsStorage(s.subnetIPPools),
-		"subnetdhcpserverstats": rest.NewSubnetDHCPStatsStorage(s.subnetDHCPStats),
+		"vpcipaddressusages":      rest.NewVPCIPUsageStorage(s.vpcIPUsage, s.vpcProvider),
+		"ipblockusages":           rest.NewIPBlockUsageStorage(s.ipBlockUsage, s.vpcProvider),
+		"subnetippools":           rest.NewSubnetIPPoolsStorage(s.subnetIPPools),
+		"subnetdhcpserverstats":   rest.NewSubnetDHCPStatsStorage(s.subnetDHCPStats),
+		"dnsrecordstatuses":       rest.NewDNSRecordStatusStorage(s.dnsRecordStatus, s.vpcProvider),
+		"subnetportstates":        rest.NewSubnetPortStateStorage(s.subnetPortState, s.vpcProvider),
+		"securitypolicyrulestats": rest.NewSecurityPolicyRuleStatsStorage(s.securityPolicyRuleStats, s.vpcProvider),
+		"connectivitychecks":      rest.NewConnectivityCheckStorage(s.connectivityCheck, s.k8sClient),
 	}
 
 	if err := srv.InstallAPIGroup(&apiGroupInfo); err != nil {
@@ -50,6 +50,9 @@ func TestNewEASServer(t *testing.T) {
 	assert.NotNil(t, s.ipBlockUsage)
 	assert.NotNil(t, s.subnetIPPools)
 	assert.NotNil(t, s.subnetDHCPStats)
+	assert.NotNil(t, s.dnsRecordStatus)
+	assert.NotNil(t, s.subnetPortState)
+	assert.NotNil(t, s.securityPolicyRuleStats)
 }
 
 func TestBuildGenericAPIServer_ErrorsWithoutCert(t *testing.T) {
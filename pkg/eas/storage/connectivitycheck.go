@@ -0,0 +1,205 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	easv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/eas"
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	nsxcommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/securitypolicy"
+)
+
+const (
+	connectivityCheckResource = "connectivitychecks"
+
+	connectivityCheckProtocolTCP = "TCP"
+
+	connectivityCheckMessage = "Only the SecurityPolicy CRs and NetworkPolicies in the namespaces of the endpoints are evaluated. " +
+		"Rules with L7 or FQDN context profiles are not evaluated. " +
+		"The VPC default rule and the policies not created by the operator, e.g. the project policies, are not evaluated."
+)
+
+// explainerLoader loads the Explainer for the namespaces, it is replaced in the tests.
+var explainerLoader = securitypolicy.LoadExplainer
+
+// ConnectivityCheckStorage implements the create operation for ConnectivityCheck.
+// The NSX rules, groups and subnet ports of the endpoint namespaces are read from NSX, and the
+// flow is evaluated offline with them.
+type ConnectivityCheckStorage struct {
+	nsxClient *nsx.Client
+	ports     *SubnetPortStateStorage
+}
+
+// NewConnectivityCheckStorage creates a new storage instance.
+func NewConnectivityCheckStorage(nsxClient *nsx.Client, vpcService eas.VPCInfoProvider) *ConnectivityCheckStorage {
+	return &ConnectivityCheckStorage{
+		nsxClient: nsxClient,
+		ports:     NewSubnetPortStateStorage(nsxClient, vpcService),
+	}
+}
+
+// Check evaluates the flow in check created in the namespace and fills the result into the check status.
+func (s *ConnectivityCheckStorage) Check(_ context.Context, namespace string, check *easv1alpha1.ConnectivityCheck) (*easv1alpha1.ConnectivityCheck, error) {
+	if err := validateConnectivityCheckSpec(&check.Spec); err != nil {
+		return nil, k8serrors.NewBadRequest(err.Error())
+	}
+	source, err := s.resolveEndpoint(namespace, &check.Spec.Source)
+	if err != nil {
+		return nil, err
+	}
+	destination, err := s.resolveEndpoint(namespace, &check.Spec.Destination)
+	if err != nil {
+		return nil, err
+	}
+
+	var namespaces []string
+	for _, endpoint := range []*easv1alpha1.ConnectivityCheckEndpoint{&check.Spec.Source, &check.Spec.Destination} {
+		if endpoint.IP != "" {
+			continue
+		}
+		ns := connectivityCheckNamespace(namespace, endpoint)
+		if len(namespaces) == 0 || namespaces[0] != ns {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	explainer, err := explainerLoader(nsxcommon.Service{NSXClient: s.nsxClient}, namespaces...)
+	if err != nil {
+		return nil, HandleEASError(err, connectivityCheckResource, check.Name, fmt.Errorf("failed to get security policies from NSX: %w", err))
+	}
+
+	flow := &securitypolicy.ExplainFlow{
+		Source:      *source,
+		Destination: *destination,
+		Protocol:    check.Spec.Protocol,
+		Port:        int64(check.Spec.Port),
+	}
+	if flow.Protocol == "" {
+		flow.Protocol = connectivityCheckProtocolTCP
+	}
+	if check.Spec.ICMPType != nil {
+		flow.ICMPType = nsxcommon.Int64(int64(*check.Spec.ICMPType))
+	}
+	if check.Spec.ICMPCode != nil {
+		flow.ICMPCode = nsxcommon.Int64(int64(*check.Spec.ICMPCode))
+	}
+	result := explainer.Explain(flow)
+	logger.Log.Debug("Checked connectivity", "namespace", namespace, "source", source.Name, "destination", destination.Name, "verdict", result.Verdict)
+
+	out := check.DeepCopy()
+	out.Namespace = namespace
+	out.Status = ConvertExplainResult(result)
+	return out, nil
+}
+
+// resolveEndpoint returns the endpoint with the tags and IPs of its NSX subnet port.
+func (s *ConnectivityCheckStorage) resolveEndpoint(namespace string, endpoint *easv1alpha1.ConnectivityCheckEndpoint) (*securitypolicy.ExplainEndpoint, error) {
+	if endpoint.IP != "" {
+		return &securitypolicy.ExplainEndpoint{Name: endpoint.IP, IPs: []string{endpoint.IP}}, nil
+	}
+	ns := connectivityCheckNamespace(namespace, endpoint)
+	name, kind := endpoint.Pod, subnetPortOwnerKindPod
+	if endpoint.SubnetPort != "" {
+		name, kind = endpoint.SubnetPort, subnetPortOwnerKindSubnetPort
+	}
+	ports, err := s.ports.loadPorts(ns)
+	if err != nil {
+		return nil, HandleEASError(err, connectivityCheckResource, "", fmt.Errorf("failed to get subnet ports from NSX for namespace %s: %w", ns, err))
+	}
+	for _, port := range ports {
+		if portName, portKind := subnetPortOwner(port); portName != name || portKind != kind {
+			continue
+		}
+		return &securitypolicy.ExplainEndpoint{
+			Name:     ns + "/" + name,
+			PortPath: DerefString(port.Path),
+			Tags:     port.Tags,
+			IPs:      subnetPortIPs(port),
+		}, nil
+	}
+	return nil, k8serrors.NewNotFound(schema.GroupResource{Group: easv1alpha1.GroupVersion.Group, Resource: "subnetportstates"}, ns+"/"+name)
+}
+
+func connectivityCheckNamespace(namespace string, endpoint *easv1alpha1.ConnectivityCheckEndpoint) string {
+	if endpoint.Namespace != "" {
+		return endpoint.Namespace
+	}
+	return namespace
+}
+
+func subnetPortIPs(port *model.VpcSubnetPort) []string {
+	var ips []string
+	for _, binding := range port.AddressBindings {
+		if ip := DerefString(binding.IpAddress); ip != "" {
+			ips = append(ips, ip)
+		}
+	}
+	return ips
+}
+
+func validateConnectivityCheckSpec(spec *easv1alpha1.ConnectivityCheckSpec) error {
+	for _, endpoint := range []struct {
+		name     string
+		endpoint *easv1alpha1.ConnectivityCheckEndpoint
+	}{
+		{name: "source", endpoint: &spec.Source},
+		{name: "destination", endpoint: &spec.Destination},
+	} {
+		set := 0
+		for _, value := range []string{endpoint.endpoint.Pod, endpoint.endpoint.SubnetPort, endpoint.endpoint.IP} {
+			if value != "" {
+				set++
+			}
+		}
+		if set != 1 {
+			return fmt.Errorf("exactly one of pod, subnetPort and ip must be set in %s", endpoint.name)
+		}
+		if endpoint.endpoint.IP != "" && net.ParseIP(endpoint.endpoint.IP) == nil {
+			return fmt.Errorf("invalid %s ip %q", endpoint.name, endpoint.endpoint.IP)
+		}
+	}
+	if spec.Source.IP != "" && spec.Destination.IP != "" {
+		return fmt.Errorf("at least one of source and destination must be a pod or subnetPort")
+	}
+	return nil
+}
+
+// ConvertExplainResult converts the Explainer result to ConnectivityCheckStatus.
+func ConvertExplainResult(result *securitypolicy.ExplainResult) easv1alpha1.ConnectivityCheckStatus {
+	status := easv1alpha1.ConnectivityCheckStatus{
+		Verdict: result.Verdict,
+		Message: connectivityCheckMessage,
+	}
+	if result.DecidingRule != nil {
+		rule := convertExplainTraceEntry(result.DecidingRule)
+		status.DecidingRule = &rule
+	}
+	for i := range result.Trace {
+		status.Trace = append(status.Trace, convertExplainTraceEntry(&result.Trace[i]))
+	}
+	return status
+}
+
+func convertExplainTraceEntry(entry *securitypolicy.ExplainTraceEntry) easv1alpha1.ConnectivityCheckRule {
+	return easv1alpha1.ConnectivityCheckRule{
+		Stage:          entry.Stage,
+		Category:       entry.Category,
+		PolicyPath:     entry.PolicyPath,
+		RulePath:       entry.RulePath,
+		RuleName:       entry.RuleName,
+		Action:         entry.Action,
+		SequenceNumber: entry.SequenceNumber,
+		Matched:        entry.Matched,
+		Reason:         entry.Reason,
+	}
+}
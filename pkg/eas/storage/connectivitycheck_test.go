@@ -0,0 +1,158 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	easv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/securitypolicy"
+)
+
+// testExplainer drops the ingress traffic to pod-a from the pods out of the namespace.
+func testExplainer() *securitypolicy.Explainer {
+	sameNamespace := &model.Group{
+		Path: strPtr("/groups/ns1"),
+		Expression: []*data.StructValue{data.NewStructValue("", map[string]data.DataValue{
+			"resource_type":  data.NewStringValue("Condition"),
+			"member_type":    data.NewStringValue("VpcSubnetPort"),
+			"value":          data.NewStringValue(common.TagScopeNamespace + "|ns1"),
+			"key":            data.NewStringValue("Tag"),
+			"operator":       data.NewStringValue("EQUALS"),
+			"scope_operator": data.NewStringValue("EQUALS"),
+		})},
+	}
+	policies := []*model.SecurityPolicy{{Path: strPtr("/policies/sp1"), SequenceNumber: int64Ptr(1), Scope: []string{"ANY"}}}
+	rules := []*model.Rule{
+		{
+			Path: strPtr("/policies/sp1/rules/allow"), ParentPath: strPtr("/policies/sp1"), DisplayName: strPtr("allow"),
+			SequenceNumber: int64Ptr(1), Direction: strPtr("IN"), Action: strPtr(model.Rule_ACTION_ALLOW),
+			SourceGroups: []string{"/groups/ns1"},
+		},
+		{
+			Path: strPtr("/policies/sp1/rules/drop"), ParentPath: strPtr("/policies/sp1"), DisplayName: strPtr("drop"),
+			SequenceNumber: int64Ptr(2), Direction: strPtr("IN"), Action: strPtr(model.Rule_ACTION_DROP),
+		},
+	}
+	return securitypolicy.NewExplainer(policies, rules, []*model.Group{sameNamespace})
+}
+
+func TestConnectivityCheckStorage_Check(t *testing.T) {
+	ports := []model.VpcSubnetPort{
+		testSubnetPort("port2", testSubnetPath+"/ports/port2",
+			model.Tag{Scope: strPtr(common.TagScopeNamespace), Tag: strPtr("ns1")},
+			model.Tag{Scope: strPtr(common.TagScopePodName), Tag: strPtr("pod-a")}),
+		testSubnetPort("port4", testSubnetPath+"/ports/port4",
+			model.Tag{Scope: strPtr(common.TagScopeNamespace), Tag: strPtr("ns1")},
+			model.Tag{Scope: strPtr(common.TagScopePodName), Tag: strPtr("pod-b")}),
+	}
+	ports[1].AddressBindings = []model.PortAddressBindingEntry{{IpAddress: strPtr("10.0.0.4")}}
+	nsxClient, _ := newSubnetPortStateNSXClient(t, ports, nil, &fakePortStateClient{})
+
+	var loadedNamespaces []string
+	origLoader := explainerLoader
+	defer func() { explainerLoader = origLoader }()
+	explainerLoader = func(_ common.Service, namespaces ...string) (*securitypolicy.Explainer, error) {
+		loadedNamespaces = namespaces
+		return testExplainer(), nil
+	}
+	s := NewConnectivityCheckStorage(nsxClient, testSubnetPortStateProvider())
+
+	t.Run("allowed in namespace", func(t *testing.T) {
+		out, err := s.Check(context.TODO(), "ns1", &easv1alpha1.ConnectivityCheck{
+			ObjectMeta: metav1.ObjectMeta{Name: "check1"},
+			Spec: easv1alpha1.ConnectivityCheckSpec{
+				Source:      easv1alpha1.ConnectivityCheckEndpoint{Pod: "pod-b"},
+				Destination: easv1alpha1.ConnectivityCheckEndpoint{Pod: "pod-a"},
+				Port:        80,
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ns1"}, loadedNamespaces)
+		assert.Equal(t, "ns1", out.Namespace)
+		assert.Equal(t, model.Rule_ACTION_ALLOW, out.Status.Verdict)
+		require.NotNil(t, out.Status.DecidingRule)
+		assert.Equal(t, "allow", out.Status.DecidingRule.RuleName)
+		assert.Equal(t, securitypolicy.ExplainStageIngress, out.Status.DecidingRule.Stage)
+		assert.Len(t, out.Status.Trace, 1)
+		assert.NotEmpty(t, out.Status.Message)
+	})
+
+	t.Run("dropped from IP", func(t *testing.T) {
+		out, err := s.Check(context.TODO(), "ns1", &easv1alpha1.ConnectivityCheck{
+			Spec: easv1alpha1.ConnectivityCheckSpec{
+				Source:      easv1alpha1.ConnectivityCheckEndpoint{IP: "192.168.1.1"},
+				Destination: easv1alpha1.ConnectivityCheckEndpoint{Pod: "pod-a"},
+				Protocol:    "UDP",
+				Port:        53,
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, model.Rule_ACTION_DROP, out.Status.Verdict)
+		assert.Equal(t, "drop", out.Status.DecidingRule.RuleName)
+		assert.Len(t, out.Status.Trace, 2)
+		assert.False(t, out.Status.Trace[0].Matched)
+	})
+
+	t.Run("pod not found", func(t *testing.T) {
+		_, err := s.Check(context.TODO(), "ns1", &easv1alpha1.ConnectivityCheck{
+			Spec: easv1alpha1.ConnectivityCheckSpec{
+				Source:      easv1alpha1.ConnectivityCheckEndpoint{Pod: "pod-c"},
+				Destination: easv1alpha1.ConnectivityCheckEndpoint{Pod: "pod-a"},
+			},
+		})
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+
+	t.Run("invalid endpoint", func(t *testing.T) {
+		_, err := s.Check(context.TODO(), "ns1", &easv1alpha1.ConnectivityCheck{
+			Spec: easv1alpha1.ConnectivityCheckSpec{
+				Source:      easv1alpha1.ConnectivityCheckEndpoint{Pod: "pod-b", IP: "10.0.0.1"},
+				Destination: easv1alpha1.ConnectivityCheckEndpoint{Pod: "pod-a"},
+			},
+		})
+		assert.True(t, k8serrors.IsBadRequest(err))
+	})
+
+	t.Run("load error", func(t *testing.T) {
+		explainerLoader = func(_ common.Service, _ ...string) (*securitypolicy.Explainer, error) {
+			return nil, fmt.Errorf("search failed")
+		}
+		_, err := s.Check(context.TODO(), "ns1", &easv1alpha1.ConnectivityCheck{
+			Spec: easv1alpha1.ConnectivityCheckSpec{
+				Source:      easv1alpha1.ConnectivityCheckEndpoint{Pod: "pod-b"},
+				Destination: easv1alpha1.ConnectivityCheckEndpoint{Pod: "pod-a"},
+			},
+		})
+		assert.ErrorContains(t, err, "search failed")
+	})
+}
+
+func TestValidateConnectivityCheckSpec(t *testing.T) {
+	assert.NoError(t, validateConnectivityCheckSpec(&easv1alpha1.ConnectivityCheckSpec{
+		Source:      easv1alpha1.ConnectivityCheckEndpoint{SubnetPort: "vm-port"},
+		Destination: easv1alpha1.ConnectivityCheckEndpoint{IP: "fd00::1"},
+	}))
+	assert.Error(t, validateConnectivityCheckSpec(&easv1alpha1.ConnectivityCheckSpec{
+		Source:      easv1alpha1.ConnectivityCheckEndpoint{IP: "10.0.0.1"},
+		Destination: easv1alpha1.ConnectivityCheckEndpoint{IP: "10.0.0.2"},
+	}))
+	assert.Error(t, validateConnectivityCheckSpec(&easv1alpha1.ConnectivityCheckSpec{
+		Source:      easv1alpha1.ConnectivityCheckEndpoint{Pod: "pod-a"},
+		Destination: easv1alpha1.ConnectivityCheckEndpoint{IP: "not-an-ip"},
+	}))
+	assert.Error(t, validateConnectivityCheckSpec(&easv1alpha1.ConnectivityCheckSpec{
+		Source: easv1alpha1.ConnectivityCheckEndpoint{Pod: "pod-a"},
+	}))
+}
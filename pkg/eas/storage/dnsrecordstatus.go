@@ -0,0 +1,358 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	easv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	nsxcommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/dns"
+)
+
+const (
+	dnsRecordStatusResource = "dnsrecordstatuses"
+	// rejectedDNSRecordStatusPrefix prefixes the names of the rejected DNS requests, which have no NSX DNS record ID.
+	rejectedDNSRecordStatusPrefix = "rejected-"
+
+	// The condition types, reasons and controller name below mirror the DNS status reported on the owners
+	// by the Service, Gateway, Route and Ingress controllers.
+	dnsRecordFailedReason     = "DNSRecordFailed"
+	dnsRecordConfiguredReason = "DNSRecordConfigured"
+	dnsRecordReadyCondition   = "DNSRecordReady"
+	serviceDNSReadyCondition  = "Ready"
+	gatewayDNSControllerName  = gatewayv1.GatewayController("nsx-operator.nsx.vmware.com/gateway-dns")
+)
+
+// DNSRecordStatusStorage implements REST operations for DNSRecordStatus.
+// Records are read from DNS record stores hydrated from NSX with the records whose primary owner or one of
+// the contributing owners is in the requested namespace. The DNS requests rejected by nsx-operator are not
+// published to NSX, so they are read from the DNS status reported on the owners in the namespace.
+type DNSRecordStatusStorage struct {
+	nsxClient *nsx.Client
+	k8sClient k8sclient.Client
+}
+
+// NewDNSRecordStatusStorage creates a new storage instance.
+func NewDNSRecordStatusStorage(nsxClient *nsx.Client, k8sClient k8sclient.Client) *DNSRecordStatusStorage {
+	return &DNSRecordStatusStorage{
+		nsxClient: nsxClient,
+		k8sClient: k8sClient,
+	}
+}
+
+// Get retrieves the DNS record identified by name within the namespace.
+// name must be the NSX DNS record ID, or rejected-<kind>-<name> for a rejected DNS request.
+func (s *DNSRecordStatusStorage) Get(ctx context.Context, namespace, name string) (*easv1alpha1.DNSRecordStatus, error) {
+	if strings.HasPrefix(name, rejectedDNSRecordStatusPrefix) {
+		return s.getRejected(ctx, namespace, name)
+	}
+	records, err := s.loadRecords(namespace)
+	if err != nil {
+		return nil, HandleEASError(err, dnsRecordStatusResource, name, fmt.Errorf("failed to get DNS records from NSX: %w", err))
+	}
+	for _, rec := range records {
+		if DerefString(rec.Id) != name {
+			continue
+		}
+		return s.convertDNSRecord(rec, namespace), nil
+	}
+	return nil, HandleEASError(k8serrors.NewNotFound(schema.GroupResource{Group: easv1alpha1.GroupVersion.Group, Resource: dnsRecordStatusResource}, name), dnsRecordStatusResource, name, nil)
+}
+
+// List retrieves all DNS records whose primary owner or one of the contributing owners is in the namespace,
+// followed by the DNS requests of the owners in the namespace which were rejected.
+func (s *DNSRecordStatusStorage) List(ctx context.Context, namespace string) (*easv1alpha1.DNSRecordStatusList, error) {
+	records, err := s.loadRecords(namespace)
+	if err != nil {
+		return nil, HandleEASError(err, dnsRecordStatusResource, "", fmt.Errorf("failed to list DNS records from NSX for namespace %s: %w", namespace, err))
+	}
+	logger.Log.Debug("Listing DNS record status", "namespace", namespace, "recordCount", len(records))
+
+	list := &easv1alpha1.DNSRecordStatusList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: easv1alpha1.GroupVersion.String(),
+			Kind:       "DNSRecordStatusList",
+		},
+		Items: make([]easv1alpha1.DNSRecordStatus, 0, len(records)),
+	}
+	paths := make([]string, 0, len(records))
+	for _, rec := range records {
+		if rec.Id == nil {
+			continue
+		}
+		list.Items = append(list.Items, *ConvertDNSRecord(rec, namespace))
+		paths = append(paths, DerefString(rec.Path))
+	}
+	// The realization state is fetched per record, so the calls are spread over a bounded number of workers.
+	// A failed call is reported in the Message of its row.
+	forEachConcurrently(len(list.Items), func(i int) {
+		list.Items[i].RealizationState, list.Items[i].Message, _ = realizationState(s.nsxClient, paths[i])
+	})
+
+	rejected, err := s.listRejected(ctx, namespace)
+	if err != nil {
+		return nil, HandleEASError(err, dnsRecordStatusResource, "", fmt.Errorf("failed to list rejected DNS requests for namespace %s: %w", namespace, err))
+	}
+	list.Items = append(list.Items, rejected...)
+	return list, nil
+}
+
+// loadRecords returns the NSX DNS records whose primary owner or one of the contributing owners is in namespace.
+// Only the primary owner namespace is a plain tag, so the records with contributing owners are filtered here.
+func (s *DNSRecordStatusStorage) loadRecords(namespace string) ([]*model.DnsRecord, error) {
+	service := nsxcommon.Service{NSXClient: s.nsxClient}
+	store, err := dns.LoadDNSRecordStoreByOwnerNamespace(service, namespace)
+	if err != nil {
+		return nil, err
+	}
+	records := store.ListDNSRecords()
+	seen := sets.New[string]()
+	for _, rec := range records {
+		seen.Insert(DerefString(rec.Path))
+	}
+
+	shared, err := dns.LoadDNSRecordStoreWithContributingOwners(service)
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range shared.ListDNSRecords() {
+		if seen.Has(DerefString(rec.Path)) || !hasContributingOwnerInNamespace(rec, namespace) {
+			continue
+		}
+		seen.Insert(DerefString(rec.Path))
+		records = append(records, rec)
+	}
+	return records, nil
+}
+
+func hasContributingOwnerInNamespace(rec *model.DnsRecord, namespace string) bool {
+	_, contributing, ok := dns.RecordOwnersFromDNSRecord(rec)
+	if !ok {
+		return false
+	}
+	for _, o := range contributing {
+		if o.Namespace == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *DNSRecordStatusStorage) getRejected(ctx context.Context, namespace, name string) (*easv1alpha1.DNSRecordStatus, error) {
+	rejected, err := s.listRejected(ctx, namespace)
+	if err != nil {
+		return nil, HandleEASError(err, dnsRecordStatusResource, name, fmt.Errorf("failed to get rejected DNS requests for namespace %s: %w", namespace, err))
+	}
+	for i := range rejected {
+		if rejected[i].Name == name {
+			return &rejected[i], nil
+		}
+	}
+	return nil, HandleEASError(k8serrors.NewNotFound(schema.GroupResource{Group: easv1alpha1.GroupVersion.Group, Resource: dnsRecordStatusResource}, name), dnsRecordStatusResource, name, nil)
+}
+
+// listRejected returns the DNS requests of the owners in namespace which nsx-operator rejected, e.g. for a
+// hostname outside the allowed DNS zones or an FQDN already published with different values by another owner.
+// Services and Gateways report the failure in a status condition, Routes in the status.parents entry of the
+// nsx-operator gateway-dns controller, and Ingresses only with Events. The Gateway API kinds are skipped when
+// their CRDs are not installed.
+func (s *DNSRecordStatusStorage) listRejected(ctx context.Context, namespace string) ([]easv1alpha1.DNSRecordStatus, error) {
+	var rejected []easv1alpha1.DNSRecordStatus
+	add := func(kind, name string, cond *metav1.Condition) {
+		rejected = append(rejected, newRejectedDNSRecordStatus(kind, namespace, name, cond.Reason, cond.Message))
+	}
+
+	services := &corev1.ServiceList{}
+	if err := s.k8sClient.List(ctx, services, k8sclient.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	for i := range services.Items {
+		cond := meta.FindStatusCondition(services.Items[i].Status.Conditions, serviceDNSReadyCondition)
+		if cond != nil && cond.Status == metav1.ConditionFalse && cond.Reason == dnsRecordFailedReason {
+			add(dns.ResourceKindService, services.Items[i].Name, cond)
+		}
+	}
+
+	gateways := &gatewayv1.GatewayList{}
+	if err := s.listGatewayAPI(ctx, gateways, namespace); err != nil {
+		return nil, err
+	}
+	for i := range gateways.Items {
+		if cond := failedDNSRecordReadyCondition(gateways.Items[i].Status.Conditions); cond != nil {
+			add(dns.ResourceKindGateway, gateways.Items[i].Name, cond)
+		}
+	}
+
+	httpRoutes := &gatewayv1.HTTPRouteList{}
+	if err := s.listGatewayAPI(ctx, httpRoutes, namespace); err != nil {
+		return nil, err
+	}
+	for i := range httpRoutes.Items {
+		if cond := failedRouteDNSRecordReadyCondition(httpRoutes.Items[i].Status.Parents); cond != nil {
+			add(dns.ResourceKindHTTPRoute, httpRoutes.Items[i].Name, cond)
+		}
+	}
+	grpcRoutes := &gatewayv1.GRPCRouteList{}
+	if err := s.listGatewayAPI(ctx, grpcRoutes, namespace); err != nil {
+		return nil, err
+	}
+	for i := range grpcRoutes.Items {
+		if cond := failedRouteDNSRecordReadyCondition(grpcRoutes.Items[i].Status.Parents); cond != nil {
+			add(dns.ResourceKindGRPCRoute, grpcRoutes.Items[i].Name, cond)
+		}
+	}
+	tlsRoutes := &gatewayv1.TLSRouteList{}
+	if err := s.listGatewayAPI(ctx, tlsRoutes, namespace); err != nil {
+		return nil, err
+	}
+	for i := range tlsRoutes.Items {
+		if cond := failedRouteDNSRecordReadyCondition(tlsRoutes.Items[i].Status.Parents); cond != nil {
+			add(dns.ResourceKindTLSRoute, tlsRoutes.Items[i].Name, cond)
+		}
+	}
+
+	ingresses, err := s.listRejectedIngresses(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return append(rejected, ingresses...), nil
+}
+
+func (s *DNSRecordStatusStorage) listGatewayAPI(ctx context.Context, list k8sclient.ObjectList, namespace string) error {
+	if err := s.k8sClient.List(ctx, list, k8sclient.InNamespace(namespace)); err != nil && !meta.IsNoMatchError(err) {
+		return err
+	}
+	return nil
+}
+
+// listRejectedIngresses returns the Ingresses whose latest DNS Event reports a failure. A failure is only
+// visible until its Event expires, and a later successful update clears it with a DNSRecordConfigured Event.
+func (s *DNSRecordStatusStorage) listRejectedIngresses(ctx context.Context, namespace string) ([]easv1alpha1.DNSRecordStatus, error) {
+	events := &corev1.EventList{}
+	if err := s.k8sClient.List(ctx, events, k8sclient.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	latest := map[string]*corev1.Event{}
+	var names []string
+	for i := range events.Items {
+		ev := &events.Items[i]
+		if ev.InvolvedObject.Kind != dns.ResourceKindIngress || (ev.Reason != dnsRecordFailedReason && ev.Reason != dnsRecordConfiguredReason) {
+			continue
+		}
+		prev, ok := latest[ev.InvolvedObject.Name]
+		if !ok {
+			names = append(names, ev.InvolvedObject.Name)
+		}
+		if !ok || eventTime(prev).Time.Before(eventTime(ev).Time) {
+			latest[ev.InvolvedObject.Name] = ev
+		}
+	}
+	var rejected []easv1alpha1.DNSRecordStatus
+	for _, name := range names {
+		if ev := latest[name]; ev.Reason == dnsRecordFailedReason {
+			rejected = append(rejected, newRejectedDNSRecordStatus(dns.ResourceKindIngress, namespace, name, ev.Reason, ev.Message))
+		}
+	}
+	return rejected, nil
+}
+
+func eventTime(ev *corev1.Event) metav1.Time {
+	if !ev.LastTimestamp.IsZero() {
+		return ev.LastTimestamp
+	}
+	if !ev.EventTime.IsZero() {
+		return metav1.NewTime(ev.EventTime.Time)
+	}
+	return ev.CreationTimestamp
+}
+
+func failedDNSRecordReadyCondition(conditions []metav1.Condition) *metav1.Condition {
+	cond := meta.FindStatusCondition(conditions, dnsRecordReadyCondition)
+	if cond == nil || cond.Status != metav1.ConditionFalse {
+		return nil
+	}
+	return cond
+}
+
+func failedRouteDNSRecordReadyCondition(parents []gatewayv1.RouteParentStatus) *metav1.Condition {
+	for i := range parents {
+		if parents[i].ControllerName == gatewayDNSControllerName {
+			return failedDNSRecordReadyCondition(parents[i].Conditions)
+		}
+	}
+	return nil
+}
+
+func newRejectedDNSRecordStatus(kind, namespace, name, reason, message string) easv1alpha1.DNSRecordStatus {
+	return easv1alpha1.DNSRecordStatus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: easv1alpha1.GroupVersion.String(),
+			Kind:       "DNSRecordStatus",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      rejectedDNSRecordStatusPrefix + strings.ToLower(kind) + "-" + name,
+			Namespace: namespace,
+		},
+		Owner:   easv1alpha1.DNSRecordOwner{Kind: kind, Namespace: namespace, Name: name},
+		Reason:  reason,
+		Message: message,
+	}
+}
+
+func (s *DNSRecordStatusStorage) convertDNSRecord(rec *model.DnsRecord, namespace string) *easv1alpha1.DNSRecordStatus {
+	status := ConvertDNSRecord(rec, namespace)
+	status.RealizationState, status.Message, _ = realizationState(s.nsxClient, DerefString(rec.Path))
+	return status
+}
+
+// ConvertDNSRecord converts an NSX DnsRecord to K8s DNSRecordStatus, decoding the owner and
+// contributing-owner tags. The realization state is left empty.
+func ConvertDNSRecord(rec *model.DnsRecord, namespace string) *easv1alpha1.DNSRecordStatus {
+	status := &easv1alpha1.DNSRecordStatus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: easv1alpha1.GroupVersion.String(),
+			Kind:       "DNSRecordStatus",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      DerefString(rec.Id),
+			Namespace: namespace,
+		},
+		FQDN:       DerefString(rec.Fqdn),
+		RecordType: DerefString(rec.RecordType),
+		Values:     rec.RecordValues,
+		Zone:       DerefString(rec.ZonePath),
+		TTL:        DerefInt64(rec.Ttl),
+	}
+	if status.FQDN == "" {
+		status.FQDN = DerefString(rec.RecordName)
+	}
+	primary, contributing, ok := dns.RecordOwnersFromDNSRecord(rec)
+	if !ok {
+		return status
+	}
+	status.Owner = toDNSRecordOwner(primary)
+	for _, o := range contributing {
+		status.ContributingOwners = append(status.ContributingOwners, toDNSRecordOwner(o))
+	}
+	return status
+}
+
+func toDNSRecordOwner(o dns.RecordOwner) easv1alpha1.DNSRecordOwner {
+	return easv1alpha1.DNSRecordOwner{Kind: o.Kind, Namespace: o.Namespace, Name: o.Name}
+}
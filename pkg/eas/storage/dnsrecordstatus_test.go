@@ -0,0 +1,343 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	easv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	realizedmocks "github.com/vmware-tanzu/nsx-operator/pkg/mock/realizedentitiesclient"
+	searchmocks "github.com/vmware-tanzu/nsx-operator/pkg/mock/searchclient"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+const testDNSRecordPath = "/orgs/default/projects/proj1/dns-records/app_zone-t_a"
+
+func testDNSRecord() model.DnsRecord {
+	return model.DnsRecord{
+		Id:           strPtr("app_zone-t_a"),
+		Path:         strPtr(testDNSRecordPath),
+		RecordName:   strPtr("app"),
+		Fqdn:         strPtr("app.example.com"),
+		RecordType:   strPtr("A"),
+		RecordValues: []string{"10.0.0.1"},
+		ZonePath:     strPtr("/orgs/default/projects/proj1/dns-services/ds1/zones/zone-t"),
+		Ttl:          int64Ptr(300),
+		Tags: []model.Tag{
+			{Scope: strPtr(common.TagScopeDNSRecordFor), Tag: strPtr(common.TagValueDNSRecordForGateway)},
+			{Scope: strPtr(common.TagScopeDNSRecordOwnerNamespace), Tag: strPtr("ns1")},
+			{Scope: strPtr(common.TagScopeDNSRecordOwnerName), Tag: strPtr("gw")},
+			// Plain-text contributing owners are accepted by the decoder as-is.
+			{Scope: strPtr(common.TagScopeDNSRecordContributingOwners), Tag: strPtr("httproute/ns1/route-a")},
+		},
+	}
+}
+
+func dnsRecordSearchResponse(t *testing.T, records []model.DnsRecord) model.SearchResponse {
+	var results []*data.StructValue
+	for _, rec := range records {
+		dv, errs := common.NewConverter().ConvertToVapi(rec, model.DnsRecordBindingType())
+		require.Empty(t, errs)
+		results = append(results, dv.(*data.StructValue))
+	}
+	rc := int64(len(results))
+	return model.SearchResponse{Results: results, ResultCount: &rc}
+}
+
+func newDNSRecordStatusNSXClient(t *testing.T, records []model.DnsRecord, searchErr error) (*nsx.Client, *realizedmocks.MockRealizedEntitiesClient) {
+	ctrl := gomock.NewController(t)
+	qc := searchmocks.NewMockQueryClient(ctrl)
+	qc.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(dnsRecordSearchResponse(t, records), searchErr).AnyTimes()
+	rec := realizedmocks.NewMockRealizedEntitiesClient(ctrl)
+	return &nsx.Client{
+		QueryClient:            qc,
+		RealizedEntitiesClient: rec,
+		NsxConfig:              &config.NSXOperatorConfig{CoeConfig: &config.CoeConfig{Cluster: "unit-test"}},
+	}, rec
+}
+
+func realizedResult(states ...string) model.GenericPolicyRealizedResourceListResult {
+	out := model.GenericPolicyRealizedResourceListResult{}
+	for _, s := range states {
+		r := model.GenericPolicyRealizedResource{State: strPtr(s)}
+		if s == model.GenericPolicyRealizedResource_STATE_ERROR {
+			r.Alarms = []model.PolicyAlarmResource{{Message: strPtr("zone not found")}}
+		}
+		out.Results = append(out.Results, r)
+	}
+	return out
+}
+
+func TestConvertDNSRecord(t *testing.T) {
+	rec := testDNSRecord()
+	out := ConvertDNSRecord(&rec, "ns1")
+	assert.Equal(t, "app_zone-t_a", out.Name)
+	assert.Equal(t, "ns1", out.Namespace)
+	assert.Equal(t, "DNSRecordStatus", out.Kind)
+	assert.Equal(t, "app.example.com", out.FQDN)
+	assert.Equal(t, "A", out.RecordType)
+	assert.Equal(t, []string{"10.0.0.1"}, out.Values)
+	assert.Equal(t, int64(300), out.TTL)
+	assert.Equal(t, easv1alpha1.DNSRecordOwner{Kind: "Gateway", Namespace: "ns1", Name: "gw"}, out.Owner)
+	assert.Equal(t, []easv1alpha1.DNSRecordOwner{{Kind: "HTTPRoute", Namespace: "ns1", Name: "route-a"}}, out.ContributingOwners)
+	assert.Empty(t, out.RealizationState)
+}
+
+func TestConvertDNSRecord_NoFqdnNoOwner(t *testing.T) {
+	rec := model.DnsRecord{Id: strPtr("r1"), RecordName: strPtr("app")}
+	out := ConvertDNSRecord(&rec, "ns1")
+	assert.Equal(t, "app", out.FQDN)
+	assert.Empty(t, out.Owner)
+	assert.Empty(t, out.ContributingOwners)
+}
+
+func TestDNSRecordStatusStorage_Get(t *testing.T) {
+	tests := []struct {
+		name       string
+		recordName string
+		realized   model.GenericPolicyRealizedResourceListResult
+		realizeErr error
+		wantState  easv1alpha1.RealizationState
+		wantMsg    string
+		wantNotFnd bool
+	}{
+		{
+			name:       "realized",
+			recordName: "app_zone-t_a",
+			realized:   realizedResult(model.GenericPolicyRealizedResource_STATE_REALIZED),
+			wantState:  easv1alpha1.RealizationStateRealized,
+		},
+		{
+			name:       "error_with_alarm_message",
+			recordName: "app_zone-t_a",
+			realized:   realizedResult(model.GenericPolicyRealizedResource_STATE_REALIZED, model.GenericPolicyRealizedResource_STATE_ERROR),
+			wantState:  easv1alpha1.RealizationStateError,
+			wantMsg:    "zone not found",
+		},
+		{
+			name:       "in_progress_when_no_entities",
+			recordName: "app_zone-t_a",
+			wantState:  easv1alpha1.RealizationStateInProgress,
+		},
+		{
+			name:       "unknown_when_realization_query_fails",
+			recordName: "app_zone-t_a",
+			realizeErr: fmt.Errorf("nsx unreachable"),
+			wantState:  easv1alpha1.RealizationStateUnknown,
+			wantMsg:    "failed to get realization state: nsx unreachable",
+		},
+		{
+			name:       "not_found",
+			recordName: "missing",
+			wantNotFnd: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nsxClient, realized := newDNSRecordStatusNSXClient(t, []model.DnsRecord{testDNSRecord()}, nil)
+			realized.EXPECT().List(testDNSRecordPath, nil).Return(tt.realized, tt.realizeErr).AnyTimes()
+			s := NewDNSRecordStatusStorage(nsxClient, newFakeK8sClient())
+			out, err := s.Get(context.Background(), "ns1", tt.recordName)
+			if tt.wantNotFnd {
+				require.Error(t, err)
+				assert.True(t, k8serrors.IsNotFound(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantState, out.RealizationState)
+			assert.Equal(t, tt.wantMsg, out.Message)
+		})
+	}
+}
+
+func TestDNSRecordStatusStorage_List(t *testing.T) {
+	nsxClient, realized := newDNSRecordStatusNSXClient(t, []model.DnsRecord{testDNSRecord()}, nil)
+	realized.EXPECT().List(testDNSRecordPath, nil).Return(realizedResult(model.GenericPolicyRealizedResource_STATE_REALIZED), nil).Times(1)
+	s := NewDNSRecordStatusStorage(nsxClient, newFakeK8sClient())
+	list, err := s.List(context.Background(), "ns1")
+	require.NoError(t, err)
+	assert.Equal(t, "DNSRecordStatusList", list.Kind)
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, "app_zone-t_a", list.Items[0].Name)
+	assert.Equal(t, easv1alpha1.RealizationStateRealized, list.Items[0].RealizationState)
+}
+
+func TestDNSRecordStatusStorage_List_SearchError(t *testing.T) {
+	nsxClient, _ := newDNSRecordStatusNSXClient(t, nil, fmt.Errorf("nsx search down"))
+	s := NewDNSRecordStatusStorage(nsxClient, newFakeK8sClient())
+	_, err := s.List(context.Background(), "ns1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to list DNS records from NSX")
+}
+
+func TestDNSRecordStatusStorage_List_PerRecordState(t *testing.T) {
+	var records []model.DnsRecord
+	for i := 0; i < 2*maxConcurrentNSXCalls; i++ {
+		rec := testDNSRecord()
+		rec.Id = strPtr(fmt.Sprintf("record-%d", i))
+		rec.Path = strPtr(fmt.Sprintf("%s-%d", testDNSRecordPath, i))
+		records = append(records, rec)
+	}
+	nsxClient, realized := newDNSRecordStatusNSXClient(t, records, nil)
+	failedPath := testDNSRecordPath + "-3"
+	realized.EXPECT().List(failedPath, nil).Return(model.GenericPolicyRealizedResourceListResult{}, fmt.Errorf("realization unavailable")).Times(1)
+	realized.EXPECT().List(gomock.Not(failedPath), nil).Return(realizedResult(model.GenericPolicyRealizedResource_STATE_REALIZED), nil).Times(len(records) - 1)
+	s := NewDNSRecordStatusStorage(nsxClient, newFakeK8sClient())
+	list, err := s.List(context.Background(), "ns1")
+	require.NoError(t, err)
+	require.Len(t, list.Items, len(records))
+	for _, item := range list.Items {
+		if item.Name == "record-3" {
+			assert.Equal(t, easv1alpha1.RealizationStateUnknown, item.RealizationState)
+			assert.Contains(t, item.Message, "realization unavailable")
+			continue
+		}
+		assert.Equal(t, easv1alpha1.RealizationStateRealized, item.RealizationState)
+		assert.Empty(t, item.Message)
+	}
+}
+
+func TestDNSRecordStatusStorage_List_ContributingOwner(t *testing.T) {
+	shared := testDNSRecord()
+	shared.Id = strPtr("shared")
+	shared.Path = strPtr(testDNSRecordPath + "-shared")
+	shared.Tags = []model.Tag{
+		{Scope: strPtr(common.TagScopeDNSRecordFor), Tag: strPtr(common.TagValueDNSRecordForGateway)},
+		{Scope: strPtr(common.TagScopeDNSRecordOwnerNamespace), Tag: strPtr("ns2")},
+		{Scope: strPtr(common.TagScopeDNSRecordOwnerName), Tag: strPtr("gw")},
+		{Scope: strPtr(common.TagScopeDNSRecordContributingOwners), Tag: strPtr("httproute/ns1/route-a")},
+	}
+	other := shared
+	other.Id = strPtr("other")
+	other.Path = strPtr(testDNSRecordPath + "-other")
+	other.Tags = append([]model.Tag{}, shared.Tags[:3]...)
+	other.Tags = append(other.Tags, model.Tag{Scope: strPtr(common.TagScopeDNSRecordContributingOwners), Tag: strPtr("httproute/ns3/route-b")})
+
+	ctrl := gomock.NewController(t)
+	qc := searchmocks.NewMockQueryClient(ctrl)
+	qc.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(query string, _, _ *string, _ *int64, _ *bool, _ *string) (model.SearchResponse, error) {
+			// The primary owner of both records is in ns2, so only the contributing-owners query returns them.
+			if strings.Contains(query, "dns_owner_namespace") {
+				return dnsRecordSearchResponse(t, nil), nil
+			}
+			return dnsRecordSearchResponse(t, []model.DnsRecord{shared, other}), nil
+		}).Times(2)
+	realized := realizedmocks.NewMockRealizedEntitiesClient(ctrl)
+	realized.EXPECT().List(testDNSRecordPath+"-shared", nil).Return(realizedResult(model.GenericPolicyRealizedResource_STATE_REALIZED), nil).Times(1)
+	nsxClient := &nsx.Client{
+		QueryClient:            qc,
+		RealizedEntitiesClient: realized,
+		NsxConfig:              &config.NSXOperatorConfig{CoeConfig: &config.CoeConfig{Cluster: "unit-test"}},
+	}
+
+	s := NewDNSRecordStatusStorage(nsxClient, newFakeK8sClient())
+	list, err := s.List(context.Background(), "ns1")
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, "shared", list.Items[0].Name)
+	assert.Equal(t, "ns1", list.Items[0].Namespace)
+	assert.Equal(t, easv1alpha1.DNSRecordOwner{Kind: "Gateway", Namespace: "ns2", Name: "gw"}, list.Items[0].Owner)
+	assert.Equal(t, easv1alpha1.RealizationStateRealized, list.Items[0].RealizationState)
+}
+
+func TestDNSRecordStatusStorage_Rejected(t *testing.T) {
+	failed := func(condType, msg string) metav1.Condition {
+		return metav1.Condition{Type: condType, Status: metav1.ConditionFalse, Reason: "DNSRecordFailed", Message: msg}
+	}
+	now := time.Now()
+	ingressEvent := func(name, ingress, reason, msg string, at time.Time) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: "ns1"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Ingress", Namespace: "ns1", Name: ingress},
+			Reason:         reason,
+			Message:        msg,
+			LastTimestamp:  metav1.NewTime(at),
+		}
+	}
+	k8sClient := newFakeK8sClient(
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "svc-rejected", Namespace: "ns1"},
+			Status: corev1.ServiceStatus{Conditions: []metav1.Condition{
+				failed("Ready", `hostname "svc.other.com" does not match any allowed DNS domain in the namespace`),
+			}},
+		},
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "svc-ok", Namespace: "ns1"},
+			Status: corev1.ServiceStatus{Conditions: []metav1.Condition{
+				{Type: "Ready", Status: metav1.ConditionTrue, Reason: "DNSRecordConfigured"},
+			}},
+		},
+		&gatewayv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Name: "gw", Namespace: "ns1"},
+			Status: gatewayv1.GatewayStatus{Conditions: []metav1.Condition{
+				failed("DNSRecordReady", "FQDN gw.example.com is configured with different values in DNS zone zone-t"),
+			}},
+		},
+		&gatewayv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: "route-a", Namespace: "ns1"},
+			Status: gatewayv1.HTTPRouteStatus{RouteStatus: gatewayv1.RouteStatus{Parents: []gatewayv1.RouteParentStatus{
+				{ControllerName: "example.com/gateway", Conditions: []metav1.Condition{failed("DNSRecordReady", "not from nsx-operator")}},
+				{ControllerName: "nsx-operator.nsx.vmware.com/gateway-dns", Conditions: []metav1.Condition{
+					failed("DNSRecordReady", "FQDN app.example.com is configured with different values in DNS zone zone-t"),
+				}},
+			}}},
+		},
+		&gatewayv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: "route-other-controller", Namespace: "ns1"},
+			Status: gatewayv1.HTTPRouteStatus{RouteStatus: gatewayv1.RouteStatus{Parents: []gatewayv1.RouteParentStatus{
+				{ControllerName: "example.com/gateway", Conditions: []metav1.Condition{failed("DNSRecordReady", "not from nsx-operator")}},
+			}}},
+		},
+		ingressEvent("ev1", "ing-fixed", "DNSRecordFailed", "zone rejected", now.Add(-time.Minute)),
+		ingressEvent("ev2", "ing-fixed", "DNSRecordConfigured", "configured", now),
+		ingressEvent("ev3", "ing-rejected", "DNSRecordFailed", `hostname "ing.other.com" does not match any allowed DNS domain in the namespace`, now),
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "svc-other-ns", Namespace: "ns2"},
+			Status:     corev1.ServiceStatus{Conditions: []metav1.Condition{failed("Ready", "zone rejected")}},
+		},
+	)
+	nsxClient, _ := newDNSRecordStatusNSXClient(t, nil, nil)
+	s := NewDNSRecordStatusStorage(nsxClient, k8sClient)
+
+	list, err := s.List(context.Background(), "ns1")
+	require.NoError(t, err)
+	got := map[string]easv1alpha1.DNSRecordStatus{}
+	for _, item := range list.Items {
+		got[item.Name] = item
+	}
+	require.Len(t, got, 4)
+	assert.Equal(t, easv1alpha1.DNSRecordOwner{Kind: "Service", Namespace: "ns1", Name: "svc-rejected"}, got["rejected-service-svc-rejected"].Owner)
+	assert.Equal(t, "DNSRecordFailed", got["rejected-service-svc-rejected"].Reason)
+	assert.Contains(t, got["rejected-service-svc-rejected"].Message, "svc.other.com")
+	assert.Contains(t, got["rejected-gateway-gw"].Message, "gw.example.com")
+	assert.Contains(t, got["rejected-httproute-route-a"].Message, "app.example.com")
+	assert.Contains(t, got["rejected-ingress-ing-rejected"].Message, "ing.other.com")
+
+	out, err := s.Get(context.Background(), "ns1", "rejected-httproute-route-a")
+	require.NoError(t, err)
+	assert.Equal(t, easv1alpha1.DNSRecordOwner{Kind: "HTTPRoute", Namespace: "ns1", Name: "route-a"}, out.Owner)
+	assert.Equal(t, "DNSRecordFailed", out.Reason)
+
+	_, err = s.Get(context.Background(), "ns1", "rejected-ingress-ing-fixed")
+	require.Error(t, err)
+	assert.True(t, k8serrors.IsNotFound(err))
+}
@@ -0,0 +1,79 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package storage
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	easv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+)
+
+// maxConcurrentNSXCalls bounds the NSX calls made in parallel for the items of a List request.
+const maxConcurrentNSXCalls = 8
+
+// forEachConcurrently calls fn for every index in [0, n) with at most maxConcurrentNSXCalls calls in flight,
+// and returns once all calls are done. fn must only write to the item at its index.
+func forEachConcurrently(n int, fn func(i int)) {
+	wg := sync.WaitGroup{}
+	sem := make(chan struct{}, maxConcurrentNSXCalls)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			fn(i)
+		}(i)
+	}
+	wg.Wait()
+}
+
+// realizationState summarizes the NSX realized entities of the intent path.
+// Any entity in ERROR makes the resource Error; all entities REALIZED makes it Realized.
+// The runtime status of the first entity reporting one is returned alongside.
+func realizationState(nsxClient *nsx.Client, path string) (easv1alpha1.RealizationState, string, string) {
+	if path == "" {
+		return easv1alpha1.RealizationStateUnknown, "", ""
+	}
+	results, err := nsxClient.RealizedEntitiesClient.List(path, nil)
+	if err != nil {
+		logger.Log.Debug("Failed to get realization state", "path", path, "error", err)
+		return easv1alpha1.RealizationStateUnknown, fmt.Sprintf("failed to get realization state: %v", err), ""
+	}
+	if len(results.Results) == 0 {
+		return easv1alpha1.RealizationStateInProgress, "", ""
+	}
+	realized := 0
+	runtimeStatus := ""
+	for _, result := range results.Results {
+		if runtimeStatus == "" {
+			runtimeStatus = DerefString(result.RuntimeStatus)
+		}
+		state := DerefString(result.State)
+		if state == model.GenericPolicyRealizedResource_STATE_ERROR {
+			var errMsg []string
+			for _, alarm := range result.Alarms {
+				if alarm.Message != nil {
+					errMsg = append(errMsg, *alarm.Message)
+				}
+			}
+			return easv1alpha1.RealizationStateError, strings.Join(errMsg, "; "), runtimeStatus
+		}
+		if state == model.GenericPolicyRealizedResource_STATE_REALIZED {
+			realized++
+		}
+	}
+	if realized == len(results.Results) {
+		return easv1alpha1.RealizationStateRealized, "", runtimeStatus
+	}
+	return easv1alpha1.RealizationStateInProgress, "", runtimeStatus
+}
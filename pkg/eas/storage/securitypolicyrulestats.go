@@ -0,0 +1,282 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	easv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	nsxcommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/securitypolicy"
+)
+
+const (
+	securityPolicyRuleStatsResource = "securitypolicyrulestats"
+
+	securityPolicyOwnerKindSecurityPolicy = "SecurityPolicy"
+	securityPolicyOwnerKindNetworkPolicy  = "NetworkPolicy"
+)
+
+// SecurityPolicyRuleStatsStorage implements REST operations for SecurityPolicyRuleStats.
+// Security policies and rules are read from stores hydrated from NSX with the resources created for
+// the SecurityPolicy CRs and NetworkPolicies in the requested namespace. Realization state, group
+// membership and rule statistics are fetched from NSX on demand.
+type SecurityPolicyRuleStatsStorage struct {
+	nsxClient *nsx.Client
+}
+
+// NewSecurityPolicyRuleStatsStorage creates a new storage instance.
+func NewSecurityPolicyRuleStatsStorage(nsxClient *nsx.Client) *SecurityPolicyRuleStatsStorage {
+	return &SecurityPolicyRuleStatsStorage{nsxClient: nsxClient}
+}
+
+// Get retrieves the rules of the NSX security policy identified by name within the namespace.
+// name must be the NSX security policy ID.
+func (s *SecurityPolicyRuleStatsStorage) Get(_ context.Context, namespace, name string) (*easv1alpha1.SecurityPolicyRuleStats, error) {
+	spStore, ruleStore, err := securitypolicy.LoadSecurityPolicyStoresByNamespace(nsxcommon.Service{NSXClient: s.nsxClient}, namespace)
+	if err != nil {
+		return nil, HandleEASError(err, securityPolicyRuleStatsResource, name, fmt.Errorf("failed to get security policies from NSX: %w", err))
+	}
+	for _, sp := range spStore.ListSecurityPolicies() {
+		if DerefString(sp.Id) != name {
+			continue
+		}
+		stats := ConvertSecurityPolicy(sp, ruleStore.GetRulesByPolicyPath(DerefString(sp.Path)), namespace)
+		s.fillRealizedStates([]*easv1alpha1.SecurityPolicyRuleStats{stats})
+		return stats, nil
+	}
+	return nil, HandleEASError(k8serrors.NewNotFound(schema.GroupResource{Group: easv1alpha1.GroupVersion.Group, Resource: securityPolicyRuleStatsResource}, name), securityPolicyRuleStatsResource, name, nil)
+}
+
+// List retrieves the rules of all NSX security policies created for the namespace.
+func (s *SecurityPolicyRuleStatsStorage) List(_ context.Context, namespace string) (*easv1alpha1.SecurityPolicyRuleStatsList, error) {
+	spStore, ruleStore, err := securitypolicy.LoadSecurityPolicyStoresByNamespace(nsxcommon.Service{NSXClient: s.nsxClient}, namespace)
+	if err != nil {
+		return nil, HandleEASError(err, securityPolicyRuleStatsResource, "", fmt.Errorf("failed to list security policies from NSX for namespace %s: %w", namespace, err))
+	}
+	securityPolicies := spStore.ListSecurityPolicies()
+	logger.Log.Debug("Listing security policy rule stats", "namespace", namespace, "policyCount", len(securityPolicies))
+
+	list := &easv1alpha1.SecurityPolicyRuleStatsList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: easv1alpha1.GroupVersion.String(),
+			Kind:       "SecurityPolicyRuleStatsList",
+		},
+		Items: make([]easv1alpha1.SecurityPolicyRuleStats, 0, len(securityPolicies)),
+	}
+	for _, sp := range securityPolicies {
+		if sp.Id == nil {
+			continue
+		}
+		list.Items = append(list.Items, *ConvertSecurityPolicy(sp, ruleStore.GetRulesByPolicyPath(DerefString(sp.Path)), namespace))
+	}
+	items := make([]*easv1alpha1.SecurityPolicyRuleStats, len(list.Items))
+	for i := range list.Items {
+		items[i] = &list.Items[i]
+	}
+	s.fillRealizedStates(items)
+	sort.Slice(list.Items, func(i, j int) bool { return list.Items[i].Name < list.Items[j].Name })
+	return list, nil
+}
+
+// fillRealizedStates fetches the realization states, rule statistics and group member counts of the policies from
+// NSX concurrently. Groups are commonly shared by the rules of a namespace, so each group is only queried once.
+func (s *SecurityPolicyRuleStatsStorage) fillRealizedStates(items []*easv1alpha1.SecurityPolicyRuleStats) {
+	var rules []*easv1alpha1.RealizedRule
+	var groupPaths []string
+	groupIndexes := map[string]int{}
+	for _, stats := range items {
+		for i := range stats.Rules {
+			rule := &stats.Rules[i]
+			rules = append(rules, rule)
+			for _, groups := range ruleGroups(rule) {
+				for _, group := range groups {
+					if _, ok := groupIndexes[group.Path]; !ok {
+						groupIndexes[group.Path] = len(groupPaths)
+						groupPaths = append(groupPaths, group.Path)
+					}
+				}
+			}
+		}
+	}
+
+	memberCounts := make([]*int64, len(groupPaths))
+	forEachConcurrently(len(items)+len(rules)+len(groupPaths), func(i int) {
+		switch {
+		case i < len(items):
+			stats := items[i]
+			stats.RealizationState, stats.Message, _ = realizationState(s.nsxClient, stats.PolicyPath)
+		case i < len(items)+len(rules):
+			rule := rules[i-len(items)]
+			rule.RealizationState, rule.Message, _ = realizationState(s.nsxClient, rule.Path)
+			rule.Statistics = s.ruleStatistics(rule.Path)
+		default:
+			j := i - len(items) - len(rules)
+			memberCounts[j] = s.groupMemberCount(groupPaths[j])
+		}
+	})
+
+	for _, rule := range rules {
+		for _, groups := range ruleGroups(rule) {
+			for j := range groups {
+				groups[j].MemberCount = memberCounts[groupIndexes[groups[j].Path]]
+			}
+		}
+	}
+}
+
+func ruleGroups(rule *easv1alpha1.RealizedRule) [][]easv1alpha1.RealizedRuleGroup {
+	return [][]easv1alpha1.RealizedRuleGroup{rule.SourceGroups, rule.DestinationGroups, rule.AppliedTo}
+}
+
+// ruleStatistics sums the statistics NSX reports for the VPC rule at rulePath.
+// nil is returned when the statistics are not available.
+func (s *SecurityPolicyRuleStatsStorage) ruleStatistics(rulePath string) *easv1alpha1.RealizedRuleStatistics {
+	info, err := nsxcommon.ParseVPCResourcePath(rulePath)
+	if err != nil {
+		return nil
+	}
+	result, err := s.nsxClient.VPCRuleStatisticsClient.List(info.OrgID, info.ProjectID, info.VPCID, info.ParentID, info.ID, nil, nil)
+	if err != nil {
+		logger.Log.Debug("Failed to get rule statistics", "path", rulePath, "error", err)
+		return nil
+	}
+	stats := &easv1alpha1.RealizedRuleStatistics{}
+	for _, r := range result.Results {
+		stats.HitCount += DerefInt64(r.HitCount)
+		stats.PacketCount += DerefInt64(r.PacketCount)
+		stats.ByteCount += DerefInt64(r.ByteCount)
+		stats.SessionCount += DerefInt64(r.SessionCount)
+	}
+	return stats
+}
+
+// groupMemberCount returns the number of effective IP address members of the VPC group at groupPath.
+// nil is returned for non-VPC groups or when the members are not available.
+func (s *SecurityPolicyRuleStatsStorage) groupMemberCount(groupPath string) *int64 {
+	info, err := nsxcommon.ParseVPCResourcePath(groupPath)
+	if err != nil || !strings.Contains(groupPath, "/groups/") {
+		return nil
+	}
+	pageSize := int64(1)
+	result, err := s.nsxClient.VpcGroupIPMembersClient.List(info.OrgID, info.ProjectID, info.VPCID, info.ID, nil, nil, nil, nil, &pageSize, nil, nil)
+	if err != nil {
+		logger.Log.Debug("Failed to get group members", "path", groupPath, "error", err)
+		return nil
+	}
+	total := int64(len(result.Results))
+	if result.ResultCount != nil {
+		total = *result.ResultCount
+	}
+	return &total
+}
+
+// ConvertSecurityPolicy converts an NSX SecurityPolicy and its rules to K8s SecurityPolicyRuleStats,
+// decoding the owner from the policy tags. Realization state, group membership and statistics are left empty.
+func ConvertSecurityPolicy(sp *model.SecurityPolicy, rules []*model.Rule, namespace string) *easv1alpha1.SecurityPolicyRuleStats {
+	stats := &easv1alpha1.SecurityPolicyRuleStats{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: easv1alpha1.GroupVersion.String(),
+			Kind:       "SecurityPolicyRuleStats",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      DerefString(sp.Id),
+			Namespace: namespace,
+		},
+		PolicyPath: DerefString(sp.Path),
+	}
+	if name := nsxTagValue(sp.Tags, nsxcommon.TagScopeSecurityPolicyName); name != "" {
+		stats.OwnerKind, stats.OwnerName = securityPolicyOwnerKindSecurityPolicy, name
+	} else if name := nsxTagValue(sp.Tags, nsxcommon.TagScopeNetworkPolicyName); name != "" {
+		stats.OwnerKind, stats.OwnerName = securityPolicyOwnerKindNetworkPolicy, name
+	}
+	for _, rule := range rules {
+		stats.Rules = append(stats.Rules, convertRule(rule))
+	}
+	sort.SliceStable(stats.Rules, func(i, j int) bool { return stats.Rules[i].SequenceNumber < stats.Rules[j].SequenceNumber })
+	return stats
+}
+
+func convertRule(rule *model.Rule) easv1alpha1.RealizedRule {
+	out := easv1alpha1.RealizedRule{
+		ID:                DerefString(rule.Id),
+		DisplayName:       DerefString(rule.DisplayName),
+		Path:              DerefString(rule.Path),
+		Action:            DerefString(rule.Action),
+		Direction:         DerefString(rule.Direction),
+		SequenceNumber:    DerefInt64(rule.SequenceNumber),
+		SourceGroups:      toRealizedRuleGroups(rule.SourceGroups),
+		DestinationGroups: toRealizedRuleGroups(rule.DestinationGroups),
+		AppliedTo:         toRealizedRuleGroups(rule.Scope),
+	}
+	for _, entry := range rule.ServiceEntries {
+		out.ServiceEntries = append(out.ServiceEntries, serviceEntrySummary(entry))
+	}
+	if len(out.ServiceEntries) == 0 {
+		out.ServiceEntries = rule.Services
+	}
+	return out
+}
+
+func toRealizedRuleGroups(paths []string) []easv1alpha1.RealizedRuleGroup {
+	var groups []easv1alpha1.RealizedRuleGroup
+	for _, p := range paths {
+		if p == "" || p == "ANY" {
+			continue
+		}
+		groups = append(groups, easv1alpha1.RealizedRuleGroup{Path: p})
+	}
+	return groups
+}
+
+// serviceEntrySummary renders an NSX rule service entry as "<protocol>/<destination ports>",
+// e.g. "TCP/80,8080-8090". The protocol alone is returned when the entry has no destination ports.
+func serviceEntrySummary(entry *data.StructValue) string {
+	if entry == nil {
+		return ""
+	}
+	protocol := structStringField(entry, "l4_protocol")
+	if protocol == "" {
+		protocol = structStringField(entry, "protocol")
+	}
+	if protocol == "" {
+		protocol = structStringField(entry, "resource_type")
+	}
+	var ports []string
+	if field, err := entry.Field("destination_ports"); err == nil {
+		if list, ok := field.(*data.ListValue); ok {
+			for _, v := range list.List() {
+				if sv, ok := v.(*data.StringValue); ok {
+					ports = append(ports, sv.Value())
+				}
+			}
+		}
+	}
+	if len(ports) == 0 {
+		return protocol
+	}
+	return protocol + "/" + strings.Join(ports, ",")
+}
+
+func structStringField(entry *data.StructValue, name string) string {
+	field, err := entry.Field(name)
+	if err != nil {
+		return ""
+	}
+	if sv, ok := field.(*data.StringValue); ok {
+		return sv.Value()
+	}
+	return ""
+}
@@ -0,0 +1,230 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"go.uber.org/mock/gomock"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	easv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	realizedmocks "github.com/vmware-tanzu/nsx-operator/pkg/mock/realizedentitiesclient"
+	searchmocks "github.com/vmware-tanzu/nsx-operator/pkg/mock/searchclient"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+const (
+	testSecurityPolicyPath = "/orgs/default/projects/proj1/vpcs/vpc1/security-policies/sp-1"
+	testSrcGroupPath       = "/orgs/default/projects/proj1/vpcs/vpc1/groups/sp-1_src"
+	testSharedGroupPath    = "/orgs/default/projects/proj1/infra/domains/default/groups/sp-1_dst"
+)
+
+type fakeRuleStatisticsClient struct {
+	result model.RuleStatisticsListResult
+	err    error
+}
+
+func (f *fakeRuleStatisticsClient) List(_, _, _, _, _ string, _ *string, _ *string) (model.RuleStatisticsListResult, error) {
+	return f.result, f.err
+}
+
+type fakeGroupIPMembersClient struct {
+	mu    sync.Mutex
+	count int64
+	calls int
+}
+
+func (f *fakeGroupIPMembersClient) List(_, _, _, _ string, _ *string, _ *string, _ *bool, _ *string, _ *int64, _ *bool, _ *string) (model.PolicyGroupIPMembersListResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+	return model.PolicyGroupIPMembersListResult{ResultCount: &f.count}, nil
+}
+
+func testSecurityPolicy() model.SecurityPolicy {
+	return model.SecurityPolicy{
+		Id:   strPtr("sp-1"),
+		Path: strPtr(testSecurityPolicyPath),
+		Tags: []model.Tag{
+			{Scope: strPtr(common.TagScopeNamespace), Tag: strPtr("ns1")},
+			{Scope: strPtr(common.TagScopeSecurityPolicyName), Tag: strPtr("allow-web")},
+		},
+	}
+}
+
+func testRules() []model.Rule {
+	ports := data.NewListValue()
+	ports.Add(data.NewStringValue("80"))
+	tcp80 := data.NewStructValue("", map[string]data.DataValue{
+		"resource_type":     data.NewStringValue("L4PortSetServiceEntry"),
+		"l4_protocol":       data.NewStringValue("TCP"),
+		"destination_ports": ports,
+	})
+	return []model.Rule{
+		{
+			Id:                strPtr("rule-2"),
+			Path:              strPtr(testSecurityPolicyPath + "/rules/rule-2"),
+			ParentPath:        strPtr(testSecurityPolicyPath),
+			SequenceNumber:    int64Ptr(2),
+			Action:            strPtr("DROP"),
+			Direction:         strPtr("IN"),
+			SourceGroups:      []string{"ANY"},
+			DestinationGroups: []string{"ANY"},
+			Services:          []string{"ANY"},
+		},
+		{
+			Id:                strPtr("rule-1"),
+			Path:              strPtr(testSecurityPolicyPath + "/rules/rule-1"),
+			ParentPath:        strPtr(testSecurityPolicyPath),
+			SequenceNumber:    int64Ptr(1),
+			Action:            strPtr("ALLOW"),
+			Direction:         strPtr("IN"),
+			SourceGroups:      []string{testSrcGroupPath},
+			DestinationGroups: []string{testSharedGroupPath},
+			Scope:             []string{testSrcGroupPath},
+			ServiceEntries:    []*data.StructValue{tcp80},
+		},
+	}
+}
+
+func newSecurityPolicyRuleStatsNSXClient(t *testing.T, searchErr error, stats *fakeRuleStatisticsClient, members *fakeGroupIPMembersClient) (*nsx.Client, *realizedmocks.MockRealizedEntitiesClient) {
+	ctrl := gomock.NewController(t)
+	qc := searchmocks.NewMockQueryClient(ctrl)
+	toStructValue := func(obj interface{}, bindingType bindings.BindingType) *data.StructValue {
+		dv, errs := common.NewConverter().ConvertToVapi(obj, bindingType)
+		require.Empty(t, errs)
+		return dv.(*data.StructValue)
+	}
+	policies := []*data.StructValue{toStructValue(testSecurityPolicy(), model.SecurityPolicyBindingType())}
+	var rules []*data.StructValue
+	for _, rule := range testRules() {
+		rules = append(rules, toStructValue(rule, model.RuleBindingType()))
+	}
+	qc.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(query string, _ *string, _ *string, _ *int64, _ *bool, _ *string) (model.SearchResponse, error) {
+			results := policies
+			if strings.Contains(query, "resource_type:"+common.ResourceTypeRule) {
+				results = rules
+			}
+			rc := int64(len(results))
+			return model.SearchResponse{Results: results, ResultCount: &rc}, searchErr
+		}).AnyTimes()
+	rec := realizedmocks.NewMockRealizedEntitiesClient(ctrl)
+	return &nsx.Client{
+		QueryClient:             qc,
+		RealizedEntitiesClient:  rec,
+		VPCRuleStatisticsClient: stats,
+		VpcGroupIPMembersClient: members,
+		NsxConfig:               &config.NSXOperatorConfig{CoeConfig: &config.CoeConfig{Cluster: "unit-test"}},
+	}, rec
+}
+
+func TestConvertSecurityPolicy(t *testing.T) {
+	sp := testSecurityPolicy()
+	var rules []*model.Rule
+	for _, rule := range testRules() {
+		rules = append(rules, &rule)
+	}
+	out := ConvertSecurityPolicy(&sp, rules, "ns1")
+	assert.Equal(t, "sp-1", out.Name)
+	assert.Equal(t, "ns1", out.Namespace)
+	assert.Equal(t, "SecurityPolicyRuleStats", out.Kind)
+	assert.Equal(t, "SecurityPolicy", out.OwnerKind)
+	assert.Equal(t, "allow-web", out.OwnerName)
+	assert.Equal(t, testSecurityPolicyPath, out.PolicyPath)
+	require.Len(t, out.Rules, 2)
+	// Rules are ordered by sequence number.
+	assert.Equal(t, "rule-1", out.Rules[0].ID)
+	assert.Equal(t, []easv1alpha1.RealizedRuleGroup{{Path: testSrcGroupPath}}, out.Rules[0].SourceGroups)
+	assert.Equal(t, []easv1alpha1.RealizedRuleGroup{{Path: testSharedGroupPath}}, out.Rules[0].DestinationGroups)
+	assert.Equal(t, []string{"TCP/80"}, out.Rules[0].ServiceEntries)
+	assert.Equal(t, "rule-2", out.Rules[1].ID)
+	assert.Empty(t, out.Rules[1].SourceGroups)
+	assert.Equal(t, []string{"ANY"}, out.Rules[1].ServiceEntries)
+}
+
+func TestConvertSecurityPolicy_NetworkPolicyOwner(t *testing.T) {
+	sp := model.SecurityPolicy{
+		Id:   strPtr("np-1"),
+		Tags: []model.Tag{{Scope: strPtr(common.TagScopeNetworkPolicyName), Tag: strPtr("deny-all")}},
+	}
+	out := ConvertSecurityPolicy(&sp, nil, "ns1")
+	assert.Equal(t, "NetworkPolicy", out.OwnerKind)
+	assert.Equal(t, "deny-all", out.OwnerName)
+	assert.Empty(t, out.Rules)
+}
+
+func TestSecurityPolicyRuleStatsStorage_Get(t *testing.T) {
+	stats := &fakeRuleStatisticsClient{result: model.RuleStatisticsListResult{
+		Results: []model.RuleStatistics{
+			{HitCount: int64Ptr(3), PacketCount: int64Ptr(30), ByteCount: int64Ptr(3000), SessionCount: int64Ptr(2)},
+			{HitCount: int64Ptr(1), PacketCount: int64Ptr(10), ByteCount: int64Ptr(1000)},
+		},
+	}}
+	members := &fakeGroupIPMembersClient{count: 4}
+	nsxClient, realized := newSecurityPolicyRuleStatsNSXClient(t, nil, stats, members)
+	realized.EXPECT().List(gomock.Any(), nil).Return(realizedResult(model.GenericPolicyRealizedResource_STATE_REALIZED), nil).AnyTimes()
+	s := NewSecurityPolicyRuleStatsStorage(nsxClient)
+
+	out, err := s.Get(context.Background(), "ns1", "sp-1")
+	require.NoError(t, err)
+	assert.Equal(t, easv1alpha1.RealizationStateRealized, out.RealizationState)
+	require.Len(t, out.Rules, 2)
+	rule := out.Rules[0]
+	assert.Equal(t, easv1alpha1.RealizationStateRealized, rule.RealizationState)
+	assert.Equal(t, &easv1alpha1.RealizedRuleStatistics{HitCount: 4, PacketCount: 40, ByteCount: 4000, SessionCount: 2}, rule.Statistics)
+	require.NotNil(t, rule.SourceGroups[0].MemberCount)
+	assert.Equal(t, int64(4), *rule.SourceGroups[0].MemberCount)
+	// Shared groups outside the VPC are not counted.
+	assert.Nil(t, rule.DestinationGroups[0].MemberCount)
+	// The source group is also the applied-to group, so it is only queried once.
+	assert.Equal(t, 1, members.calls)
+
+	_, err = s.Get(context.Background(), "ns1", "missing")
+	require.Error(t, err)
+	assert.True(t, k8serrors.IsNotFound(err))
+}
+
+func TestSecurityPolicyRuleStatsStorage_Get_StatisticsUnavailable(t *testing.T) {
+	stats := &fakeRuleStatisticsClient{err: fmt.Errorf("statistics unavailable")}
+	nsxClient, realized := newSecurityPolicyRuleStatsNSXClient(t, nil, stats, &fakeGroupIPMembersClient{})
+	realized.EXPECT().List(gomock.Any(), nil).Return(model.GenericPolicyRealizedResourceListResult{}, nil).AnyTimes()
+	s := NewSecurityPolicyRuleStatsStorage(nsxClient)
+	out, err := s.Get(context.Background(), "ns1", "sp-1")
+	require.NoError(t, err)
+	assert.Equal(t, easv1alpha1.RealizationStateInProgress, out.RealizationState)
+	assert.Nil(t, out.Rules[0].Statistics)
+}
+
+func TestSecurityPolicyRuleStatsStorage_List(t *testing.T) {
+	nsxClient, realized := newSecurityPolicyRuleStatsNSXClient(t, nil, &fakeRuleStatisticsClient{}, &fakeGroupIPMembersClient{})
+	realized.EXPECT().List(gomock.Any(), nil).Return(model.GenericPolicyRealizedResourceListResult{}, nil).AnyTimes()
+	s := NewSecurityPolicyRuleStatsStorage(nsxClient)
+	list, err := s.List(context.Background(), "ns1")
+	require.NoError(t, err)
+	assert.Equal(t, "SecurityPolicyRuleStatsList", list.Kind)
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, "sp-1", list.Items[0].Name)
+	assert.Len(t, list.Items[0].Rules, 2)
+}
+
+func TestSecurityPolicyRuleStatsStorage_List_SearchError(t *testing.T) {
+	nsxClient, _ := newSecurityPolicyRuleStatsNSXClient(t, fmt.Errorf("nsx search down"), &fakeRuleStatisticsClient{}, &fakeGroupIPMembersClient{})
+	s := NewSecurityPolicyRuleStatsStorage(nsxClient)
+	_, err := s.List(context.Background(), "ns1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to list security policies from NSX")
+}
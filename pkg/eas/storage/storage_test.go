@@ -9,8 +9,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	vpcv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
 	"github.com/vmware-tanzu/nsx-operator/pkg/eas"
@@ -180,7 +182,9 @@ func (p multiVPCProvider) ListAllVPCNamespaces() []string    { return nil }
 // newFakeK8sClient builds a fake controller-runtime client pre-populated with the given objects.
 func newFakeK8sClient(objs ...k8sclient.Object) k8sclient.Client {
 	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
 	_ = vpcv1alpha1.AddToScheme(scheme)
+	_ = gatewayv1.Install(scheme)
 	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
 }
 func TestParseSubnetVPCName(t *testing.T) {
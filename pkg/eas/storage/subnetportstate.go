@@ -0,0 +1,213 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	easv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/eas"
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	nsxcommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetport"
+)
+
+const (
+	subnetPortStateResource = "subnetportstates"
+
+	subnetPortOwnerKindSubnetPort = "SubnetPort"
+	subnetPortOwnerKindPod        = "Pod"
+)
+
+// SubnetPortStateStorage implements REST operations for SubnetPortState.
+// Subnet ports are read from the NSX projects of the namespace VPCs, and the port
+// runtime state is fetched from NSX on demand.
+type SubnetPortStateStorage struct {
+	nsxClient  *nsx.Client
+	vpcService eas.VPCInfoProvider
+}
+
+// NewSubnetPortStateStorage creates a new storage instance.
+func NewSubnetPortStateStorage(nsxClient *nsx.Client, vpcService eas.VPCInfoProvider) *SubnetPortStateStorage {
+	return &SubnetPortStateStorage{
+		nsxClient:  nsxClient,
+		vpcService: vpcService,
+	}
+}
+
+// Get retrieves the runtime state of the subnet port backing the SubnetPort CR or Pod named name
+// within the namespace.
+func (s *SubnetPortStateStorage) Get(_ context.Context, namespace, name string) (*easv1alpha1.SubnetPortState, error) {
+	ports, err := s.loadPorts(namespace)
+	if err != nil {
+		return nil, HandleEASError(err, subnetPortStateResource, name, fmt.Errorf("failed to get subnet ports from NSX: %w", err))
+	}
+	for _, port := range ports {
+		if portName, _ := subnetPortOwner(port); portName != name {
+			continue
+		}
+		state, err := s.convertSubnetPort(port, namespace)
+		if err != nil {
+			return nil, err
+		}
+		return state, nil
+	}
+	return nil, HandleEASError(k8serrors.NewNotFound(schema.GroupResource{Group: easv1alpha1.GroupVersion.Group, Resource: subnetPortStateResource}, name), subnetPortStateResource, name, nil)
+}
+
+// List retrieves the runtime state of all subnet ports created for SubnetPort CRs or Pods in the namespace.
+func (s *SubnetPortStateStorage) List(_ context.Context, namespace string) (*easv1alpha1.SubnetPortStateList, error) {
+	ports, err := s.loadPorts(namespace)
+	if err != nil {
+		return nil, HandleEASError(err, subnetPortStateResource, "", fmt.Errorf("failed to list subnet ports from NSX for namespace %s: %w", namespace, err))
+	}
+	logger.Log.Debug("Listing subnet port state", "namespace", namespace, "portCount", len(ports))
+
+	var owned []*model.VpcSubnetPort
+	for _, port := range ports {
+		if name, _ := subnetPortOwner(port); name != "" {
+			owned = append(owned, port)
+		}
+	}
+	list := &easv1alpha1.SubnetPortStateList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: easv1alpha1.GroupVersion.String(),
+			Kind:       "SubnetPortStateList",
+		},
+		Items: make([]easv1alpha1.SubnetPortState, len(owned)),
+	}
+	// The realization and runtime state are fetched per port, so the calls are spread over a bounded number
+	// of workers. A failure is reported on the port row so that the other ports of the namespace are still listed.
+	forEachConcurrently(len(owned), func(i int) {
+		state, err := s.convertSubnetPort(owned[i], namespace)
+		if err != nil {
+			logger.Log.Debug("Failed to get subnet port state", "path", state.PortPath, "error", err)
+			state.Message = appendMessage(state.Message, err.Error())
+		}
+		list.Items[i] = *state
+	})
+	return list, nil
+}
+
+// loadPorts returns the subnet ports of the namespace located in the VPCs the namespace is associated with.
+// Only the ports tagged with the namespace are searched in the NSX project of each VPC.
+func (s *SubnetPortStateStorage) loadPorts(namespace string) ([]*model.VpcSubnetPort, error) {
+	var ports []*model.VpcSubnetPort
+	loaded := map[string]*subnetport.SubnetPortStore{}
+	for _, entry := range s.vpcService.ListVPCInfo(namespace) {
+		info := entry.Info
+		projectKey := info.OrgID + "/" + info.ProjectID
+		store, ok := loaded[projectKey]
+		if !ok {
+			var err error
+			store, err = subnetport.LoadSubnetPortStoreByNamespace(nsxcommon.Service{NSXClient: s.nsxClient}, info.OrgID, info.ProjectID, namespace)
+			if err != nil {
+				return nil, err
+			}
+			loaded[projectKey] = store
+		}
+		vpcPrefix := fmt.Sprintf("/orgs/%s/projects/%s/vpcs/%s/", info.OrgID, info.ProjectID, info.VPCID)
+		for _, port := range store.GetByNamespace(namespace) {
+			if strings.HasPrefix(DerefString(port.Path), vpcPrefix) {
+				ports = append(ports, port)
+			}
+		}
+	}
+	return ports, nil
+}
+
+// convertSubnetPort returns the state of the port with the runtime details fetched from NSX. The state is returned
+// along with the error when the runtime details cannot be fetched.
+func (s *SubnetPortStateStorage) convertSubnetPort(port *model.VpcSubnetPort, namespace string) (*easv1alpha1.SubnetPortState, error) {
+	state := ConvertVpcSubnetPort(port, namespace)
+	state.RealizationState, state.Message, state.OperationalState = realizationState(s.nsxClient, state.PortPath)
+
+	subnetInfo, err := nsxcommon.ParseVPCResourcePath(state.SubnetPath)
+	if err != nil {
+		logger.Log.Debug("Failed to parse subnet path of subnet port", "path", state.PortPath, "error", err)
+		return state, nil
+	}
+	portState, err := s.nsxClient.PortStateClient.Get(subnetInfo.OrgID, subnetInfo.ProjectID, subnetInfo.VPCID, subnetInfo.ID, DerefString(port.Id), nil, nil)
+	if err != nil {
+		return state, HandleEASError(err, subnetPortStateResource, state.Name, fmt.Errorf("failed to get subnet port state from NSX for port %s: %w", state.PortPath, err))
+	}
+	ConvertSegmentPortState(&portState, state)
+	return state, nil
+}
+
+// ConvertVpcSubnetPort converts an NSX VpcSubnetPort to K8s SubnetPortState, decoding the owner
+// from the port tags. The runtime and realization state are left empty.
+func ConvertVpcSubnetPort(port *model.VpcSubnetPort, namespace string) *easv1alpha1.SubnetPortState {
+	name, kind := subnetPortOwner(port)
+	state := &easv1alpha1.SubnetPortState{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: easv1alpha1.GroupVersion.String(),
+			Kind:       "SubnetPortState",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		OwnerKind:  kind,
+		PortPath:   DerefString(port.Path),
+		SubnetPath: DerefString(port.ParentPath),
+		AdminState: DerefString(port.AdminState),
+	}
+	if port.Attachment != nil {
+		state.AttachmentID = DerefString(port.Attachment.Id)
+	}
+	return state
+}
+
+// ConvertSegmentPortState copies the NSX SegmentPortState runtime details into state.
+func ConvertSegmentPortState(portState *model.SegmentPortState, state *easv1alpha1.SubnetPortState) {
+	if portState.Attachment != nil && portState.Attachment.Id != nil {
+		state.AttachmentID = *portState.Attachment.Id
+	}
+	state.TransportNodeIDs = portState.TransportNodeIds
+	state.RealizedBindings = convertAddressBindings(portState.RealizedBindings)
+	state.DuplicateBindings = convertAddressBindings(portState.DuplicateBindings)
+}
+
+func convertAddressBindings(entries []model.AddressBindingEntry) []easv1alpha1.SubnetPortAddressBinding {
+	var bindings []easv1alpha1.SubnetPortAddressBinding
+	for _, entry := range entries {
+		if entry.Binding == nil {
+			continue
+		}
+		bindings = append(bindings, easv1alpha1.SubnetPortAddressBinding{
+			IPAddress:  DerefString(entry.Binding.IpAddress),
+			MACAddress: DerefString(entry.Binding.MacAddress),
+			VLANID:     DerefInt64(entry.Binding.VlanId),
+		})
+	}
+	return bindings
+}
+
+// appendMessage joins the messages reported on a row.
+func appendMessage(message, more string) string {
+	if message == "" {
+		return more
+	}
+	return message + "; " + more
+}
+
+// subnetPortOwner returns the name and kind of the SubnetPort CR or Pod the port was created for.
+func subnetPortOwner(port *model.VpcSubnetPort) (string, string) {
+	if name := nsxTagValue(port.Tags, nsxcommon.TagScopeSubnetPortCRName); name != "" {
+		return name, subnetPortOwnerKindSubnetPort
+	}
+	if name := nsxTagValue(port.Tags, nsxcommon.TagScopePodName); name != "" {
+		return name, subnetPortOwnerKindPod
+	}
+	return "", ""
+}
@@ -0,0 +1,201 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"go.uber.org/mock/gomock"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	easv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/eas/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	realizedmocks "github.com/vmware-tanzu/nsx-operator/pkg/mock/realizedentitiesclient"
+	searchmocks "github.com/vmware-tanzu/nsx-operator/pkg/mock/searchclient"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+const (
+	testSubnetPath     = "/orgs/default/projects/proj1/vpcs/vpc1/subnets/subnet1"
+	testSubnetPortPath = testSubnetPath + "/ports/port1"
+)
+
+type fakePortStateClient struct {
+	mu     sync.Mutex
+	result model.SegmentPortState
+	err    error
+	calls  []string
+}
+
+func (f *fakePortStateClient) Get(orgID, projectID, vpcID, subnetID, portID string, _ *string, _ *string) (model.SegmentPortState, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, fmt.Sprintf("%s/%s/%s/%s/%s", orgID, projectID, vpcID, subnetID, portID))
+	return f.result, f.err
+}
+
+func testSubnetPort(id, path string, tags ...model.Tag) model.VpcSubnetPort {
+	return model.VpcSubnetPort{
+		Id:         strPtr(id),
+		Path:       strPtr(path),
+		ParentPath: strPtr(testSubnetPath),
+		AdminState: strPtr("UP"),
+		Attachment: &model.PortAttachment{Id: strPtr("attachment-" + id)},
+		Tags:       tags,
+	}
+}
+
+func newSubnetPortStateNSXClient(t *testing.T, ports []model.VpcSubnetPort, searchErr error, portState *fakePortStateClient) (*nsx.Client, *realizedmocks.MockRealizedEntitiesClient) {
+	ctrl := gomock.NewController(t)
+	qc := searchmocks.NewMockQueryClient(ctrl)
+	var results []*data.StructValue
+	for _, port := range ports {
+		dv, errs := common.NewConverter().ConvertToVapi(port, model.VpcSubnetPortBindingType())
+		require.Empty(t, errs)
+		results = append(results, dv.(*data.StructValue))
+	}
+	rc := int64(len(results))
+	qc.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(model.SearchResponse{Results: results, ResultCount: &rc}, searchErr).AnyTimes()
+	rec := realizedmocks.NewMockRealizedEntitiesClient(ctrl)
+	return &nsx.Client{
+		QueryClient:            qc,
+		RealizedEntitiesClient: rec,
+		PortStateClient:        portState,
+		NsxConfig:              &config.NSXOperatorConfig{CoeConfig: &config.CoeConfig{Cluster: "unit-test"}},
+	}, rec
+}
+
+func testSubnetPortStateProvider() singleVPCProvider {
+	return singleVPCProvider{info: common.VPCResourceInfo{OrgID: "default", ProjectID: "proj1", VPCID: "vpc1"}}
+}
+
+func testSubnetPorts() []model.VpcSubnetPort {
+	return []model.VpcSubnetPort{
+		testSubnetPort("port1", testSubnetPortPath,
+			model.Tag{Scope: strPtr(common.TagScopeVMNamespace), Tag: strPtr("ns1")},
+			model.Tag{Scope: strPtr(common.TagScopeSubnetPortCRName), Tag: strPtr("vm-port")}),
+		testSubnetPort("port2", testSubnetPath+"/ports/port2",
+			model.Tag{Scope: strPtr(common.TagScopeNamespace), Tag: strPtr("ns1")},
+			model.Tag{Scope: strPtr(common.TagScopePodName), Tag: strPtr("pod-a")}),
+		// Ports in VPCs not associated with the namespace are filtered out.
+		testSubnetPort("port3", "/orgs/default/projects/proj1/vpcs/vpc2/subnets/subnet1/ports/port3",
+			model.Tag{Scope: strPtr(common.TagScopeNamespace), Tag: strPtr("ns1")},
+			model.Tag{Scope: strPtr(common.TagScopePodName), Tag: strPtr("pod-b")}),
+	}
+}
+
+func TestConvertVpcSubnetPort(t *testing.T) {
+	port := testSubnetPorts()[0]
+	out := ConvertVpcSubnetPort(&port, "ns1")
+	assert.Equal(t, "vm-port", out.Name)
+	assert.Equal(t, "ns1", out.Namespace)
+	assert.Equal(t, "SubnetPortState", out.Kind)
+	assert.Equal(t, "SubnetPort", out.OwnerKind)
+	assert.Equal(t, testSubnetPortPath, out.PortPath)
+	assert.Equal(t, testSubnetPath, out.SubnetPath)
+	assert.Equal(t, "UP", out.AdminState)
+	assert.Equal(t, "attachment-port1", out.AttachmentID)
+	assert.Empty(t, out.RealizationState)
+
+	pod := testSubnetPorts()[1]
+	out = ConvertVpcSubnetPort(&pod, "ns1")
+	assert.Equal(t, "pod-a", out.Name)
+	assert.Equal(t, "Pod", out.OwnerKind)
+}
+
+func TestConvertSegmentPortState(t *testing.T) {
+	state := &easv1alpha1.SubnetPortState{}
+	ConvertSegmentPortState(&model.SegmentPortState{
+		Attachment:       &model.SegmentPortAttachmentState{Id: strPtr("vif-1")},
+		TransportNodeIds: []string{"tn-1"},
+		RealizedBindings: []model.AddressBindingEntry{
+			{Binding: &model.PacketAddressClassifier{IpAddress: strPtr("10.0.0.5"), MacAddress: strPtr("aa:bb:cc:dd:ee:ff"), VlanId: int64Ptr(0)}},
+			{},
+		},
+		DuplicateBindings: []model.AddressBindingEntry{
+			{Binding: &model.PacketAddressClassifier{IpAddress: strPtr("10.0.0.6")}},
+		},
+	}, state)
+	assert.Equal(t, "vif-1", state.AttachmentID)
+	assert.Equal(t, []string{"tn-1"}, state.TransportNodeIDs)
+	assert.Equal(t, []easv1alpha1.SubnetPortAddressBinding{{IPAddress: "10.0.0.5", MACAddress: "aa:bb:cc:dd:ee:ff"}}, state.RealizedBindings)
+	assert.Equal(t, []easv1alpha1.SubnetPortAddressBinding{{IPAddress: "10.0.0.6"}}, state.DuplicateBindings)
+}
+
+func TestSubnetPortStateStorage_Get(t *testing.T) {
+	portState := &fakePortStateClient{result: model.SegmentPortState{TransportNodeIds: []string{"tn-1"}}}
+	nsxClient, realized := newSubnetPortStateNSXClient(t, testSubnetPorts(), nil, portState)
+	realized.EXPECT().List(testSubnetPortPath, nil).Return(model.GenericPolicyRealizedResourceListResult{
+		Results: []model.GenericPolicyRealizedResource{{
+			State:         strPtr(model.GenericPolicyRealizedResource_STATE_REALIZED),
+			RuntimeStatus: strPtr("UP"),
+		}},
+	}, nil)
+	s := NewSubnetPortStateStorage(nsxClient, testSubnetPortStateProvider())
+
+	out, err := s.Get(context.Background(), "ns1", "vm-port")
+	require.NoError(t, err)
+	assert.Equal(t, easv1alpha1.RealizationStateRealized, out.RealizationState)
+	assert.Equal(t, "UP", out.OperationalState)
+	assert.Equal(t, []string{"tn-1"}, out.TransportNodeIDs)
+	assert.Equal(t, []string{"default/proj1/vpc1/subnet1/port1"}, portState.calls)
+
+	_, err = s.Get(context.Background(), "ns1", "pod-b")
+	require.Error(t, err)
+	assert.True(t, k8serrors.IsNotFound(err))
+}
+
+func TestSubnetPortStateStorage_Get_PortStateError(t *testing.T) {
+	portState := &fakePortStateClient{err: fmt.Errorf("port state unavailable")}
+	nsxClient, realized := newSubnetPortStateNSXClient(t, testSubnetPorts(), nil, portState)
+	realized.EXPECT().List(gomock.Any(), nil).Return(model.GenericPolicyRealizedResourceListResult{}, nil).AnyTimes()
+	s := NewSubnetPortStateStorage(nsxClient, testSubnetPortStateProvider())
+	_, err := s.Get(context.Background(), "ns1", "pod-a")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to get subnet port state from NSX")
+}
+
+func TestSubnetPortStateStorage_List(t *testing.T) {
+	nsxClient, realized := newSubnetPortStateNSXClient(t, testSubnetPorts(), nil, &fakePortStateClient{})
+	realized.EXPECT().List(gomock.Any(), nil).Return(model.GenericPolicyRealizedResourceListResult{}, nil).Times(2)
+	s := NewSubnetPortStateStorage(nsxClient, testSubnetPortStateProvider())
+	list, err := s.List(context.Background(), "ns1")
+	require.NoError(t, err)
+	assert.Equal(t, "SubnetPortStateList", list.Kind)
+	require.Len(t, list.Items, 2)
+	names := []string{list.Items[0].Name, list.Items[1].Name}
+	assert.ElementsMatch(t, []string{"vm-port", "pod-a"}, names)
+	assert.Equal(t, easv1alpha1.RealizationStateInProgress, list.Items[0].RealizationState)
+}
+
+func TestSubnetPortStateStorage_List_SearchError(t *testing.T) {
+	nsxClient, _ := newSubnetPortStateNSXClient(t, nil, fmt.Errorf("nsx search down"), &fakePortStateClient{})
+	s := NewSubnetPortStateStorage(nsxClient, testSubnetPortStateProvider())
+	_, err := s.List(context.Background(), "ns1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to list subnet ports from NSX")
+}
+
+func TestSubnetPortStateStorage_List_PortStateError(t *testing.T) {
+	portState := &fakePortStateClient{err: fmt.Errorf("port state unavailable")}
+	nsxClient, realized := newSubnetPortStateNSXClient(t, testSubnetPorts(), nil, portState)
+	realized.EXPECT().List(gomock.Any(), nil).Return(model.GenericPolicyRealizedResourceListResult{}, nil).Times(2)
+	s := NewSubnetPortStateStorage(nsxClient, testSubnetPortStateProvider())
+	list, err := s.List(context.Background(), "ns1")
+	require.NoError(t, err)
+	require.Len(t, list.Items, 2)
+	for _, item := range list.Items {
+		assert.Contains(t, item.Message, "failed to get subnet port state from NSX")
+		assert.Equal(t, easv1alpha1.RealizationStateInProgress, item.RealizationState)
+	}
+}
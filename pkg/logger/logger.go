@@ -1,6 +1,7 @@
 package logger
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -8,6 +9,7 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/go-logr/zerologr"
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/trace"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
 
@@ -83,6 +85,31 @@ func (l CustomLogger) Error(err error, msg string, keysAndValues ...interface{})
 	l.Logger.Error(err, msg, keysAndValues...)
 }
 
+// TraceValues returns the trace ID and the span ID of the span in ctx as the key and value pairs of a logger, or nil if
+// ctx has no span.
+func TraceValues(ctx context.Context) []interface{} {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return nil
+	}
+	return []interface{}{"traceID", spanContext.TraceID().String(), "spanID", spanContext.SpanID().String()}
+}
+
+// FromContext returns Log with the trace ID and the span ID of the span in ctx, so the logs of a reconcile can be found
+// from its trace. It returns Log if ctx has no span.
+func FromContext(ctx context.Context) CustomLogger {
+	keysAndValues := TraceValues(ctx)
+	if keysAndValues == nil {
+		return Log
+	}
+	l := CustomLogger{Logger: Log.Logger.WithValues(keysAndValues...)}
+	if Log.zeroLogger != nil {
+		zeroLogger := Log.zeroLogger.With().Fields(keysAndValues).Logger()
+		l.zeroLogger = &zeroLogger
+	}
+	return l
+}
+
 // NewCustomLoggerWithZerolog creates a CustomLogger with both logr.Logger and zerolog.Logger
 func NewCustomLoggerWithZerolog(logger logr.Logger, zeroLogger *zerolog.Logger) CustomLogger {
 	return CustomLogger{Logger: logger, zeroLogger: zeroLogger}
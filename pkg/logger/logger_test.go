@@ -1,8 +1,12 @@
 package logger
 
 import (
+	"context"
 	"testing"
 	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func TestZapLoggerLevels(t *testing.T) {
@@ -79,3 +83,24 @@ func TestCustomLogger(t *testing.T) {
 
 	t.Log("CustomLogger test completed - verify all log levels are displayed with proper formatting and colors")
 }
+
+func TestFromContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Nil(t, TraceValues(ctx))
+	assert.Equal(t, Log, FromContext(ctx))
+
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{0x01, 0x02},
+		SpanID:  trace.SpanID{0x03},
+	})
+	ctx = trace.ContextWithSpanContext(ctx, spanContext)
+	assert.Equal(t, []interface{}{"traceID", "01020000000000000000000000000000", "spanID", "0300000000000000"}, TraceValues(ctx))
+
+	origLog := Log
+	defer func() { Log = origLog }()
+	Log = ZapCustomLogger(false, 0)
+	l := FromContext(ctx)
+	assert.NotNil(t, l.zeroLogger)
+	l.Info("This is an info message with the trace ID")
+	l.Warn("This is a warning message with the trace ID")
+}
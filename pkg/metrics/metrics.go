@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"sync"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
@@ -21,6 +22,30 @@ const (
 	ControllerDeleteTotalKey        = "controller_delete_total"
 	ControllerDeleteSuccessTotalKey = "controller_delete_success_total"
 	ControllerDeleteFailTotalKey    = "controller_delete_fail_total"
+	InventoryQueueDepthKey          = "inventory_queue_depth"
+	InventoryBatchSizeKey           = "inventory_batch_size"
+	InventoryBatchSizeLimitKey      = "inventory_batch_size_limit"
+	InventoryNSXRequestDurationKey  = "inventory_nsx_request_duration_seconds"
+	InventoryRetryTotalKey          = "inventory_retry_total"
+	InventoryResyncTotalKey         = "inventory_resync_total"
+	NSXDriftTotalKey                = "nsx_drift_total"
+	NSXDriftScanFailTotalKey        = "nsx_drift_scan_fail_total"
+	FailoverToFirstReconcileKey     = "failover_to_first_reconcile_seconds"
+	ControllerConfigKey             = "controller_config"
+	ControllerQueueDepthKey         = "controller_queue_depth"
+	ControllerQueuePromotedTotalKey = "controller_queue_promoted_total"
+	NSXLicenseKey                   = "nsx_license"
+	NSXLicenseCheckTimestampKey     = "nsx_license_check_timestamp_seconds"
+	NSXLicenseCheckFailTotalKey     = "nsx_license_check_fail_total"
+	CertExpiryTimestampKey          = "cert_expiry_timestamp_seconds"
+	CertRotationTotalKey            = "cert_rotation_total"
+	HealthCheckStatusKey            = "health_check_status"
+	RestoreInProgressKey            = "restore_in_progress"
+	RestoreObjectsKey               = "restore_objects"
+	RestoreControllerDurationKey    = "restore_controller_duration_seconds"
+	ShardsOwnedKey                  = "shards_owned"
+	ShardMembersKey                 = "shard_members"
+	ShardHandoffTotalKey            = "shard_handoff_total"
 	ScrapeTimeout                   = 30
 )
 
@@ -100,7 +125,256 @@ var (
 	)
 )
 
-var registerMetrics sync.Once
+var (
+	InventoryQueueDepth = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      InventoryQueueDepthKey,
+			Help:      "Number of inventory object keys waiting in the queue to be synchronized to NSX",
+		},
+	)
+	InventoryBatchSize = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      InventoryBatchSizeKey,
+			Help:      "Number of inventory object keys synchronized to NSX in one batch",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 10),
+		},
+	)
+	InventoryBatchSizeLimit = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      InventoryBatchSizeLimitKey,
+			Help:      "Current inventory batch size, reduced from inventory_batch_size on NSX errors",
+		},
+	)
+	InventoryNSXRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      InventoryNSXRequestDurationKey,
+			Help:      "Latency of the NSX container inventory update requests",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"result"},
+	)
+	InventoryRetryTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      InventoryRetryTotalKey,
+			Help:      "Total number of inventory object keys put back to the queue to retry",
+		},
+		[]string{"inventory_type"},
+	)
+	InventoryResyncTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      InventoryResyncTotalKey,
+			Help:      "Total number of inventory objects pushed to NSX again by the full resync as they differ on NSX",
+		},
+		[]string{"resource_type"},
+	)
+)
+
+var (
+	NSXDriftTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      NSXDriftTotalKey,
+			Help:      "Total number of operator-owned NSX resources found changed or deleted outside of NSX Operator",
+		},
+		[]string{"resource_type", "drift_type", "mode"},
+	)
+	NSXDriftScanFailTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      NSXDriftScanFailTotalKey,
+			Help:      "Total number of drift scans failed to query the NSX resources",
+		},
+		[]string{"resource_type"},
+	)
+)
+
+var FailoverToFirstReconcile = prometheus.NewGauge(
+	prometheus.GaugeOpts{
+		Namespace: MetricNamespace,
+		Subsystem: MetricSubsystem,
+		Name:      FailoverToFirstReconcileKey,
+		Help:      "Seconds from the leader election of this replica to its first reconcile",
+	},
+)
+
+var ControllerConfig = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: MetricNamespace,
+		Subsystem: MetricSubsystem,
+		Name:      ControllerConfigKey,
+		Help:      "Effective concurrency and workqueue rate limiter settings of the controllers, durations are in seconds",
+	},
+	[]string{"controller", "setting"},
+)
+
+var ControllerQueueDepth = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: MetricNamespace,
+		Subsystem: MetricSubsystem,
+		Name:      ControllerQueueDepthKey,
+		Help:      "Number of requests in the workqueue of the controller by priority, including the delayed requests",
+	},
+	[]string{"controller", "priority"},
+)
+
+var ControllerQueuePromotedTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: MetricNamespace,
+		Subsystem: MetricSubsystem,
+		Name:      ControllerQueuePromotedTotalKey,
+		Help:      "Total number of low priority requests raised to the high priority after waiting too long in the workqueue",
+	},
+	[]string{"controller"},
+)
+
+var (
+	NSXLicense = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      NSXLicenseKey,
+			Help:      "NSX license of the features used by NSX Operator, 1 if the feature is licensed",
+		},
+		[]string{"feature"},
+	)
+	NSXLicenseCheckTimestamp = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      NSXLicenseCheckTimestampKey,
+			Help:      "Unix time of the last NSX license check, successful or not",
+		},
+	)
+	NSXLicenseCheckFailTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      NSXLicenseCheckFailTotalKey,
+			Help:      "Total number of NSX license checks failed to get the license or found a required license missing",
+		},
+	)
+)
+
+var (
+	CertExpiryTimestamp = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      CertExpiryTimestampKey,
+			Help:      "Unix time when the serving certificate expires",
+		},
+		[]string{"cert", "provider"},
+	)
+	CertRotationTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      CertRotationTotalKey,
+			Help:      "Total number of serving certificate rotations by result",
+		},
+		[]string{"cert", "provider", "result"},
+	)
+)
+
+var HealthCheckStatus = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: MetricNamespace,
+		Subsystem: MetricSubsystem,
+		Name:      HealthCheckStatusKey,
+		Help:      "Last status of the system health checks reported to NSX. 1 for 'status' and 'reason' labels with current status.",
+	},
+	[]string{"check", "status", "reason"},
+)
+
+var (
+	RestoreInProgress = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      RestoreInProgressKey,
+			Help:      "1 while the operator restores the resources after NSX restore",
+		},
+	)
+	RestoreObjects = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      RestoreObjectsKey,
+			Help:      "Number of objects to restore, restored and failed to restore by controller in the last restore",
+		},
+		[]string{"controller", "result"},
+	)
+	RestoreControllerDuration = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      RestoreControllerDurationKey,
+			Help:      "Duration in seconds of restoring the objects by controller in the last restore",
+		},
+		[]string{"controller"},
+	)
+)
+
+var (
+	ShardsOwned = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      ShardsOwnedKey,
+			Help:      "Number of the namespace shards processed by this replica",
+		},
+	)
+	ShardMembers = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      ShardMembersKey,
+			Help:      "Number of the live replicas the namespace shards are distributed to",
+		},
+	)
+	ShardHandoffTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      ShardHandoffTotalKey,
+			Help:      "Total number of the namespace shards acquired and released by this replica",
+		},
+		[]string{"action"},
+	)
+)
+
+var (
+	registerMetrics          sync.Once
+	registerInventoryMetrics sync.Once
+	registerDriftMetrics     sync.Once
+	registerFailoverMetrics  sync.Once
+	registerControllerConfig sync.Once
+	registerLicenseMetrics   sync.Once
+	registerCertMetrics      sync.Once
+	registerHealthMetrics    sync.Once
+	registerRestoreMetrics   sync.Once
+	registerShardMetrics     sync.Once
+)
+
+var failover struct {
+	sync.Mutex
+	electedTime time.Time
+	observed    bool
+}
 
 // Register all metrics.
 func Register(m ...prometheus.Collector) {
@@ -124,11 +398,138 @@ func InitializePrometheusMetrics() {
 	)
 }
 
+// InitializeInventoryMetrics registers the inventory metrics, which are exposed whenever inventory is enabled.
+func InitializeInventoryMetrics() {
+	registerInventoryMetrics.Do(func() {
+		log.Info("Initializing inventory prometheus metrics")
+		metrics.Registry.MustRegister(
+			InventoryQueueDepth,
+			InventoryBatchSize,
+			InventoryBatchSizeLimit,
+			InventoryNSXRequestDuration,
+			InventoryRetryTotal,
+			InventoryResyncTotal,
+		)
+	})
+}
+
+// InitializeDriftMetrics registers the NSX drift metrics, which are exposed whenever the drift detection is enabled.
+func InitializeDriftMetrics() {
+	registerDriftMetrics.Do(func() {
+		log.Info("Initializing NSX drift prometheus metrics")
+		metrics.Registry.MustRegister(
+			NSXDriftTotal,
+			NSXDriftScanFailTotal,
+		)
+	})
+}
+
+// InitializeLicenseMetrics registers the NSX license metrics, which are set on every license check.
+func InitializeLicenseMetrics() {
+	registerLicenseMetrics.Do(func() {
+		log.Info("Initializing NSX license prometheus metrics")
+		metrics.Registry.MustRegister(
+			NSXLicense,
+			NSXLicenseCheckTimestamp,
+			NSXLicenseCheckFailTotal,
+		)
+	})
+}
+
+// InitializeCertMetrics registers the metrics of the serving certificates of the webhook and EAS.
+func InitializeCertMetrics() {
+	registerCertMetrics.Do(func() {
+		log.Info("Initializing certificate prometheus metrics")
+		metrics.Registry.MustRegister(
+			CertExpiryTimestamp,
+			CertRotationTotal,
+		)
+	})
+}
+
+// InitializeHealthMetrics registers the metrics of the system health checks.
+func InitializeHealthMetrics() {
+	registerHealthMetrics.Do(func() {
+		log.Info("Initializing health check prometheus metrics")
+		metrics.Registry.MustRegister(HealthCheckStatus)
+	})
+}
+
+// InitializeRestoreMetrics registers the metrics of the restore after NSX restore.
+func InitializeRestoreMetrics() {
+	registerRestoreMetrics.Do(func() {
+		log.Info("Initializing restore prometheus metrics")
+		metrics.Registry.MustRegister(
+			RestoreInProgress,
+			RestoreObjects,
+			RestoreControllerDuration,
+		)
+	})
+}
+
+// InitializeShardMetrics registers the metrics of the namespace sharding, it is called in sharding mode only.
+func InitializeShardMetrics() {
+	registerShardMetrics.Do(func() {
+		log.Info("Initializing sharding prometheus metrics")
+		metrics.Registry.MustRegister(
+			ShardsOwned,
+			ShardMembers,
+			ShardHandoffTotal,
+		)
+	})
+}
+
+// InitializeFailoverMetrics registers the failover metric and records the time this replica is elected as the leader,
+// it is called in HA mode only.
+func InitializeFailoverMetrics(electedTime time.Time) {
+	registerFailoverMetrics.Do(func() {
+		log.Info("Initializing failover prometheus metrics")
+		metrics.Registry.MustRegister(FailoverToFirstReconcile)
+	})
+	failover.Lock()
+	defer failover.Unlock()
+	failover.electedTime = electedTime
+	failover.observed = false
+}
+
+// observeReconcile sets the failover metric on the first reconcile after the leader election.
+func observeReconcile() {
+	failover.Lock()
+	defer failover.Unlock()
+	if failover.electedTime.IsZero() || failover.observed {
+		return
+	}
+	failover.observed = true
+	seconds := time.Since(failover.electedTime).Seconds()
+	FailoverToFirstReconcile.Set(seconds)
+	log.Info("First reconcile after the leader election", "secondsSinceElected", seconds)
+}
+
+// InitializeControllerConfigMetrics registers the metrics of the controller workqueues and the controller settings
+// loaded from the [controllers] section, and sets the effective values of the settings.
+func InitializeControllerConfigMetrics(cf *config.NSXOperatorConfig) {
+	registerControllerConfig.Do(func() {
+		metrics.Registry.MustRegister(ControllerConfig, ControllerQueueDepth, ControllerQueuePromotedTotal)
+	})
+	for _, name := range config.ControllerNames {
+		controllerConfig := cf.ControllerConfig(name)
+		ControllerConfig.WithLabelValues(name, "max_concurrent_reconciles").Set(float64(controllerConfig.MaxConcurrentReconciles))
+		ControllerConfig.WithLabelValues(name, "base_backoff").Set(controllerConfig.BaseBackoff.Seconds())
+		ControllerConfig.WithLabelValues(name, "max_backoff").Set(controllerConfig.MaxBackoff.Seconds())
+		ControllerConfig.WithLabelValues(name, "qps").Set(controllerConfig.QPS)
+		ControllerConfig.WithLabelValues(name, "burst").Set(float64(controllerConfig.Burst))
+		ControllerConfig.WithLabelValues(name, "low_priority_max_wait").Set(controllerConfig.LowPriorityMaxWait.Seconds())
+	}
+}
+
 func AreMetricsExposed(cf *config.NSXOperatorConfig) bool {
 	return cf.EnforcementPoint == "vmc-enforcementpoint"
 }
 
 func CounterInc(cf *config.NSXOperatorConfig, counter *prometheus.CounterVec, res_type string) {
+	if counter == ControllerSyncTotal {
+		observeReconcile()
+	}
 	if AreMetricsExposed(cf) {
 		counter.WithLabelValues(res_type).Inc()
 	}
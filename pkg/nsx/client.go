@@ -4,6 +4,7 @@
 package nsx
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"strings"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 	nsxt "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/core"
 	vspherelog "github.com/vmware/vsphere-automation-sdk-go/runtime/log"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	nsx_policy "github.com/vmware/vsphere-automation-sdk-go/services/nsxt"
@@ -33,15 +35,19 @@ import (
 	project_infra_ip_blocks "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/infra/ip_blocks"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/transit_gateways"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/vpcs"
+	vpc_group_members "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/vpcs/groups/members"
 	vpc_ip_blocks "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/vpcs/ip_blocks"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/vpcs/nat"
 	vpc_sp "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/vpcs/security_policies"
+	vpc_sp_rules "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/vpcs/security_policies/rules"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/vpcs/subnets"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/vpcs/subnets/dhcp_server_config"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/vpcs/subnets/ip_pools"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/vpcs/subnets/ports"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/search"
+	"go.opentelemetry.io/otel/trace"
 
+	"github.com/vmware-tanzu/nsx-operator/pkg/audit"
 	"github.com/vmware-tanzu/nsx-operator/pkg/config"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/ratelimiter"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
@@ -63,15 +69,18 @@ const (
 	StatefulSetPod
 	IPv6
 	SubnetAssociation
+	SecurityPolicyFQDN
 	AllFeatures
 )
 
-var FeaturesName = [AllFeatures]string{"VPC", "SECURITY_POLICY", "NSX_SERVICE_ACCOUNT", "NSX_SERVICE_ACCOUNT_RESTORE", "NSX_SERVICE_ACCOUNT_CERT_ROTATION", "STATIC_ROUTE", "VPC_PREFERRED_DEFAULT_SNAT_IP", "SUBNET_IP_RESERVATION", "SUBNET_MINIMAL_SIZE_8", "VTEP_LESS_MODE", "RESTORE_VIF", "STATIC_IP_RESERVATION", "STATEFULSET_POD", "IPV6", "SUBNET_ASSOCIATION"}
+var FeaturesName = [AllFeatures]string{"VPC", "SECURITY_POLICY", "NSX_SERVICE_ACCOUNT", "NSX_SERVICE_ACCOUNT_RESTORE", "NSX_SERVICE_ACCOUNT_CERT_ROTATION", "STATIC_ROUTE", "VPC_PREFERRED_DEFAULT_SNAT_IP", "SUBNET_IP_RESERVATION", "SUBNET_MINIMAL_SIZE_8", "VTEP_LESS_MODE", "RESTORE_VIF", "STATIC_IP_RESERVATION", "STATEFULSET_POD", "IPV6", "SUBNET_ASSOCIATION", "SECURITY_POLICY_FQDN"}
 
 type Client struct {
 	NsxConfig     *config.NSXOperatorConfig
 	RestConnector client.Connector
 	Cluster       *Cluster
+	// restConnectorAllowOverwrite is kept to create the clients of WithContext.
+	restConnectorAllowOverwrite client.Connector
 
 	QueryClient    search.QueryClient
 	GroupClient    domains.GroupsClient
@@ -94,6 +103,10 @@ type Client struct {
 	VPCSecurityClient vpcs.SecurityPoliciesClient
 	VPCRuleClient     vpc_sp.RulesClient
 
+	// for realized rule view of SecurityPolicy and NetworkPolicy
+	VPCRuleStatisticsClient vpc_sp_rules.StatisticsClient
+	VpcGroupIPMembersClient vpc_group_members.IpAddressesClient
+
 	OrgRootClient                     nsx_policy.OrgRootClient
 	ProjectInfraClient                projects.InfraClient
 	VPCClient                         projects.VpcsClient
@@ -132,6 +145,7 @@ type Client struct {
 	SubnetConnectionBindingMapsClient subnets.SubnetConnectionBindingMapsClient
 	DynamicIPReservationsClient       subnets.DynamicIpReservationsClient
 	StaticIPReservationsClient        subnets.StaticIpReservationsClient
+	DhcpStaticBindingConfigsClient    subnets.DhcpStaticBindingConfigsClient
 	NsxApiClient                      *nsxt.APIClient
 	VifsClient                        fabric.VifsClient
 	DnsZoneClient                     dns_services.ZonesClient
@@ -198,71 +212,7 @@ func GetClient(cf *config.NSXOperatorConfig) *Client {
 	connector := restConnector(cluster)
 	connectorAllowOverwrite := restConnectorAllowOverwrite(cluster)
 
-	queryClient := search.NewQueryClient(connector)
-	groupClient := domains.NewGroupsClient(connector)
-	securityClient := domains.NewSecurityPoliciesClient(connector)
-	ruleClient := security_policies.NewRulesClient(connector)
-	infraClient := nsx_policy.NewInfraClient(connector)
-	statusClient := restore.NewStatusClient(restConnector(cluster))
-
-	clusterControlPlanesClient := enforcement_points.NewClusterControlPlanesClient(connector)
-	hostTransportNodesClient := enforcement_points.NewHostTransportNodesClient(connector)
-	realizedEntitiesClient := infra_realized.NewRealizedEntitiesClient(connector)
-	realizedEntityClient := infra_realized.NewRealizedEntityClient(connector)
-	mpQueryClient := mpsearch.NewQueryClient(connector)
-	certificatesClient := trust_management.NewCertificatesClient(connector)
-	principalIdentitiesClient := trust_management.NewPrincipalIdentitiesClient(connector)
-	withCertificateClient := principal_identities.NewWithCertificateClient(connector)
-
-	lbAppProfileClient := infra.NewLbAppProfilesClient(connector)
-	lbPersistenceProfilesClient := infra.NewLbPersistenceProfilesClient(connector)
-	lbMonitorProfilesClient := infra.NewLbMonitorProfilesClient(connector)
-
-	orgRootClient := nsx_policy.NewOrgRootClient(connector)
-	projectInfraClient := projects.NewInfraClient(connector)
-	projectClient := orgs.NewProjectsClient(connector)
-	vpcClient := projects.NewVpcsClient(connector)
-	vpcStateClient := vpcs.NewStateClient(connector)
-	vpcConnectivityProfilesClient := projects.NewVpcConnectivityProfilesClient(connector)
-	vpcServiceProfileClient := projects.NewVpcServiceProfilesClient(connector)
-	ipv6NdraProfileClient := infra.NewIpv6NdraProfilesClient(connector)
-	projectIpv6NdraProfileClient := project_infra.NewIpv6NdraProfilesClient(connector)
-	ipBlockClient := project_infra.NewIpBlocksClient(connector)
-	staticRouteClient := vpcs.NewStaticRoutesClient(connector)
-	natRulesClient := nat.NewNatRulesClient(connector)
-	vpcGroupClient := vpcs.NewGroupsClient(connector)
-	portClient := subnets.NewPortsClient(connectorAllowOverwrite)
-	portStateClient := ports.NewStateClient(connector)
-	ipPoolClient := subnets.NewIpPoolsClient(connector)
-	ipAllocationClient := ip_pools.NewIpAllocationsClient(connector)
-	statsClient := dhcp_server_config.NewStatsClient(connector)
-	ipAddressUsageClient := vpcs.NewIpAddressUsageClient(connector)
-	vpcIpBlockUsageClient := vpc_ip_blocks.NewUsageClient(connector)
-	infraIPBlockUsageClient := infra_ip_blocks.NewUsageClient(connector)
-	projectIPBlockUsageClient := project_infra_ip_blocks.NewUsageClient(connector)
-	subnetsClient := vpcs.NewSubnetsClient(connector)
-	subnetStatusClient := subnets.NewStatusClient(connector)
-	ipAddressAllocationClient := vpcs.NewIpAddressAllocationsClient(connectorAllowOverwrite)
-	vpcLBSClient := vpcs.NewVpcLbsClient(connector)
-	vpcLbVirtualServersClient := vpcs.NewVpcLbVirtualServersClient(connector)
-	vpcLbPoolsClient := vpcs.NewVpcLbPoolsClient(connector)
-	vpcAttachmentClient := vpcs.NewAttachmentsClient(connector)
-
-	vpcSecurityClient := vpcs.NewSecurityPoliciesClient(connector)
-	vpcRuleClient := vpc_sp.NewRulesClient(connector)
-
-	transitGatewayClient := projects.NewTransitGatewaysClient(connector)
-	transitGatewayAttachmentClient := transit_gateways.NewAttachmentsClient(connector)
-	transitGatewayStateClient := transit_gateways.NewStateClient(connector)
-
-	subnetConnectionBindingMapsClient := subnets.NewSubnetConnectionBindingMapsClient(connector)
-	DynamicIPReservationsClient := subnets.NewDynamicIpReservationsClient(connector)
-	StaticIPReservationsClient := subnets.NewStaticIpReservationsClient(connector)
-
 	nsxApiClient, _ := CreateNsxtApiClient(cf, cluster.client)
-	vifsClient := fabric.NewVifsClient(connector)
-	dnsZoneClient := dns_services.NewZonesClient(connector)
-	dnsRecordsClient := projects.NewDnsRecordsClient(connector)
 
 	nsxChecker := &NSXHealthChecker{
 		cluster: cluster,
@@ -273,73 +223,15 @@ func GetClient(cf *config.NSXOperatorConfig) *Client {
 	}
 
 	nsxClient := &Client{
-		NsxConfig:                  cf,
-		RestConnector:              connector,
-		QueryClient:                queryClient,
-		GroupClient:                groupClient,
-		SecurityClient:             securityClient,
-		RuleClient:                 ruleClient,
-		InfraClient:                infraClient,
-		StatusClient:               statusClient,
-		Cluster:                    cluster,
-		ClusterControlPlanesClient: clusterControlPlanesClient,
-		HostTransPortNodesClient:   hostTransportNodesClient,
-		RealizedEntitiesClient:     realizedEntitiesClient,
-		RealizedEntityClient:       realizedEntityClient,
-		MPQueryClient:              mpQueryClient,
-		CertificatesClient:         certificatesClient,
-		PrincipalIdentitiesClient:  principalIdentitiesClient,
-		WithCertificateClient:      withCertificateClient,
-
-		// Health clients are now using REST API directly
-
-		OrgRootClient:                     orgRootClient,
-		ProjectInfraClient:                projectInfraClient,
-		VPCClient:                         vpcClient,
-		VPCStateClient:                    vpcStateClient,
-		VPCConnectivityProfilesClient:     vpcConnectivityProfilesClient,
-		VpcServiceProfileClient:           vpcServiceProfileClient,
-		Ipv6NdraProfileClient:             ipv6NdraProfileClient,
-		ProjectIpv6NdraProfileClient:      projectIpv6NdraProfileClient,
-		IPBlockClient:                     ipBlockClient,
-		StaticRouteClient:                 staticRouteClient,
-		NATRuleClient:                     natRulesClient,
-		VpcGroupClient:                    vpcGroupClient,
-		PortClient:                        portClient,
-		PortStateClient:                   portStateClient,
-		SubnetStatusClient:                subnetStatusClient,
-		VPCSecurityClient:                 vpcSecurityClient,
-		VPCRuleClient:                     vpcRuleClient,
-		VPCLBSClient:                      vpcLBSClient,
-		VpcLbVirtualServersClient:         vpcLbVirtualServersClient,
-		VpcLbPoolsClient:                  vpcLbPoolsClient,
-		VpcAttachmentClient:               vpcAttachmentClient,
-		ProjectClient:                     projectClient,
-		NSXChecker:                        *nsxChecker,
-		NSXVerChecker:                     *nsxVersionChecker,
-		IPPoolClient:                      ipPoolClient,
-		IPAllocationClient:                ipAllocationClient,
-		DhcpServerConfigStatsClient:       statsClient,
-		IPAddressUsageClient:              ipAddressUsageClient,
-		VPCIPBlockUsageClient:             vpcIpBlockUsageClient,
-		InfraIPBlockUsageClient:           infraIPBlockUsageClient,
-		ProjectIPBlockUsageClient:         projectIPBlockUsageClient,
-		SubnetsClient:                     subnetsClient,
-		IPAddressAllocationClient:         ipAddressAllocationClient,
-		TransitGatewayClient:              transitGatewayClient,
-		TransitGatewayAttachmentClient:    transitGatewayAttachmentClient,
-		TransitGatewayStateClient:         transitGatewayStateClient,
-		SubnetConnectionBindingMapsClient: subnetConnectionBindingMapsClient,
-		DynamicIPReservationsClient:       DynamicIPReservationsClient,
-		StaticIPReservationsClient:        StaticIPReservationsClient,
-		LbAppProfileClient:                lbAppProfileClient,
-		LbPersistenceProfilesClient:       lbPersistenceProfilesClient,
-		LbMonitorProfilesClient:           lbMonitorProfilesClient,
-		NsxApiClient:                      nsxApiClient,
-		VifsClient:                        vifsClient,
-		DnsZoneClient:                     dnsZoneClient,
-		DnsRecordsClient:                  dnsRecordsClient,
+		NsxConfig:                   cf,
+		RestConnector:               connector,
+		restConnectorAllowOverwrite: connectorAllowOverwrite,
+		Cluster:                     cluster,
+		NsxApiClient:                nsxApiClient,
+		NSXChecker:                  *nsxChecker,
+		NSXVerChecker:               *nsxVersionChecker,
 	}
+	setClients(nsxClient, connector, connectorAllowOverwrite)
 	nsxClient.Cluster.SetOnProductVersionChanged(func(oldVer, newVer string) {
 		nsxClient.resetNSXVersionFeatureCache()
 		log.Info("NSX product version changed; cleared cached feature support gates", "oldVersion", oldVer, "newVersion", newVer)
@@ -366,6 +258,107 @@ func GetClient(cf *config.NSXOperatorConfig) *Client {
 	return nsxClient
 }
 
+// setClients creates the NSX SDK clients with the connectors.
+func setClients(nsxClient *Client, connector, connectorAllowOverwrite client.Connector) {
+	nsxClient.QueryClient = search.NewQueryClient(connector)
+	nsxClient.GroupClient = domains.NewGroupsClient(connector)
+	nsxClient.SecurityClient = domains.NewSecurityPoliciesClient(connector)
+	nsxClient.RuleClient = security_policies.NewRulesClient(connector)
+	nsxClient.InfraClient = nsx_policy.NewInfraClient(connector)
+	nsxClient.StatusClient = restore.NewStatusClient(connector)
+
+	nsxClient.ClusterControlPlanesClient = enforcement_points.NewClusterControlPlanesClient(connector)
+	nsxClient.HostTransPortNodesClient = enforcement_points.NewHostTransportNodesClient(connector)
+	nsxClient.RealizedEntitiesClient = infra_realized.NewRealizedEntitiesClient(connector)
+	nsxClient.RealizedEntityClient = infra_realized.NewRealizedEntityClient(connector)
+	nsxClient.MPQueryClient = mpsearch.NewQueryClient(connector)
+	nsxClient.CertificatesClient = trust_management.NewCertificatesClient(connector)
+	nsxClient.PrincipalIdentitiesClient = trust_management.NewPrincipalIdentitiesClient(connector)
+	nsxClient.WithCertificateClient = principal_identities.NewWithCertificateClient(connector)
+
+	nsxClient.LbAppProfileClient = infra.NewLbAppProfilesClient(connector)
+	nsxClient.LbPersistenceProfilesClient = infra.NewLbPersistenceProfilesClient(connector)
+	nsxClient.LbMonitorProfilesClient = infra.NewLbMonitorProfilesClient(connector)
+
+	nsxClient.OrgRootClient = nsx_policy.NewOrgRootClient(connector)
+	nsxClient.ProjectInfraClient = projects.NewInfraClient(connector)
+	nsxClient.ProjectClient = orgs.NewProjectsClient(connector)
+	nsxClient.VPCClient = projects.NewVpcsClient(connector)
+	nsxClient.VPCStateClient = vpcs.NewStateClient(connector)
+	nsxClient.VPCConnectivityProfilesClient = projects.NewVpcConnectivityProfilesClient(connector)
+	nsxClient.VpcServiceProfileClient = projects.NewVpcServiceProfilesClient(connector)
+	nsxClient.Ipv6NdraProfileClient = infra.NewIpv6NdraProfilesClient(connector)
+	nsxClient.ProjectIpv6NdraProfileClient = project_infra.NewIpv6NdraProfilesClient(connector)
+	nsxClient.IPBlockClient = project_infra.NewIpBlocksClient(connector)
+	nsxClient.StaticRouteClient = vpcs.NewStaticRoutesClient(connector)
+	nsxClient.NATRuleClient = nat.NewNatRulesClient(connector)
+	nsxClient.VpcGroupClient = vpcs.NewGroupsClient(connector)
+	nsxClient.PortClient = subnets.NewPortsClient(connectorAllowOverwrite)
+	nsxClient.PortStateClient = ports.NewStateClient(connector)
+	nsxClient.IPPoolClient = subnets.NewIpPoolsClient(connector)
+	nsxClient.IPAllocationClient = ip_pools.NewIpAllocationsClient(connector)
+	nsxClient.DhcpServerConfigStatsClient = dhcp_server_config.NewStatsClient(connector)
+	nsxClient.IPAddressUsageClient = vpcs.NewIpAddressUsageClient(connector)
+	nsxClient.VPCIPBlockUsageClient = vpc_ip_blocks.NewUsageClient(connector)
+	nsxClient.InfraIPBlockUsageClient = infra_ip_blocks.NewUsageClient(connector)
+	nsxClient.ProjectIPBlockUsageClient = project_infra_ip_blocks.NewUsageClient(connector)
+	nsxClient.SubnetsClient = vpcs.NewSubnetsClient(connector)
+	nsxClient.SubnetStatusClient = subnets.NewStatusClient(connector)
+	nsxClient.IPAddressAllocationClient = vpcs.NewIpAddressAllocationsClient(connectorAllowOverwrite)
+	nsxClient.VPCLBSClient = vpcs.NewVpcLbsClient(connector)
+	nsxClient.VpcLbVirtualServersClient = vpcs.NewVpcLbVirtualServersClient(connector)
+	nsxClient.VpcLbPoolsClient = vpcs.NewVpcLbPoolsClient(connector)
+	nsxClient.VpcAttachmentClient = vpcs.NewAttachmentsClient(connector)
+
+	nsxClient.VPCSecurityClient = vpcs.NewSecurityPoliciesClient(connector)
+	nsxClient.VPCRuleClient = vpc_sp.NewRulesClient(connector)
+	nsxClient.VPCRuleStatisticsClient = vpc_sp_rules.NewStatisticsClient(connector)
+	nsxClient.VpcGroupIPMembersClient = vpc_group_members.NewIpAddressesClient(connector)
+
+	nsxClient.TransitGatewayClient = projects.NewTransitGatewaysClient(connector)
+	nsxClient.TransitGatewayAttachmentClient = transit_gateways.NewAttachmentsClient(connector)
+	nsxClient.TransitGatewayStateClient = transit_gateways.NewStateClient(connector)
+
+	nsxClient.SubnetConnectionBindingMapsClient = subnets.NewSubnetConnectionBindingMapsClient(connector)
+	nsxClient.DynamicIPReservationsClient = subnets.NewDynamicIpReservationsClient(connector)
+	nsxClient.StaticIPReservationsClient = subnets.NewStaticIpReservationsClient(connector)
+	nsxClient.DhcpStaticBindingConfigsClient = subnets.NewDhcpStaticBindingConfigsClient(connector)
+
+	nsxClient.VifsClient = fabric.NewVifsClient(connector)
+	nsxClient.DnsZoneClient = dns_services.NewZonesClient(connector)
+	nsxClient.DnsRecordsClient = projects.NewDnsRecordsClient(connector)
+}
+
+// WithContext returns a copy of the client whose NSX requests are sent with ctx, so that the requests are traced as the
+// children of the span in ctx and audited on behalf of the owner in ctx. The NSX SDK clients do not take a context, the
+// copy is only created if ctx has a span or an audit owner.
+func (client *Client) WithContext(ctx context.Context) *Client {
+	if client == nil || client.RestConnector == nil || client.restConnectorAllowOverwrite == nil ||
+		(!trace.SpanContextFromContext(ctx).IsValid() && audit.OwnerFrom(ctx) == nil) {
+		return client
+	}
+	nsxClient := *client
+	nsxClient.RestConnector = &contextConnector{Connector: client.RestConnector, ctx: ctx}
+	nsxClient.restConnectorAllowOverwrite = &contextConnector{Connector: client.restConnectorAllowOverwrite, ctx: ctx}
+	setClients(&nsxClient, nsxClient.RestConnector, nsxClient.restConnectorAllowOverwrite)
+	return &nsxClient
+}
+
+// contextConnector sets the context of the execution contexts, which is the context of the NSX HTTP requests. The
+// cancellation of the context is not passed to the requests, the NSX requests keep the HTTP timeout of the cluster.
+type contextConnector struct {
+	client.Connector
+	ctx context.Context
+}
+
+func (c *contextConnector) NewExecutionContext() *core.ExecutionContext {
+	executionCtx := c.Connector.NewExecutionContext()
+	executionCtx.WithContext(context.WithoutCancel(c.ctx))
+	// Set the default accepted response type as the SDK connector.
+	executionCtx.SetConnectionMetadata(core.ResponseTypeKey, core.OnlyMonoResponse)
+	return executionCtx
+}
+
 func CreateNsxtApiClient(config *config.NSXOperatorConfig, client *http.Client) (*nsxt.APIClient, error) {
 	var defaultRetryOnStatusCodes = []int{
 		http.StatusRequestTimeout,     // 408
@@ -440,35 +433,37 @@ func (client *Client) FeatureEnabled(feature int) bool {
 
 // ValidateLicense validates NSX license. init is used to indicate whether nsx-operator is init or not
 // if not init, nsx-operator will check if license has been updated.
-// once license updated, operator will restart
-// if FeatureContainer license is false, operatore will restart
+// The DFW features are paused without the DFW license, and all the controllers are paused without the container or
+// VPC license, they are resumed when the license is restored, no restart is needed.
 func (client *Client) ValidateLicense(init bool) error {
 	log.Info("Checking NSX license")
-	oldContainerLicense := util.IsLicensed(util.FeatureContainer)
+	oldNetworkingLicense := util.GetNetworkingLicense()
 	oldDfwLicense := util.GetDFWLicense()
 	err := client.NSXChecker.cluster.FetchLicense()
 	if err != nil {
 		return err
 	}
+	if !init {
+		newNetworkingLicense := util.GetNetworkingLicense()
+		newDfwLicense := util.GetDFWLicense()
+		if newNetworkingLicense != oldNetworkingLicense || newDfwLicense != oldDfwLicense {
+			log.Info("License updated", "networking license new value", newNetworkingLicense, "DFW license new value", newDfwLicense, "networking license old value", oldNetworkingLicense, "DFW license old value", oldDfwLicense)
+		}
+		if newDfwLicense != oldDfwLicense {
+			util.NotifyDFWLicense()
+		}
+	}
 	if !util.IsLicensed(util.FeatureContainer) {
 		err = errors.New("NSX license check failed")
-		log.Error(err, "Container license is not supported")
+		log.Error(err, "Container license is not supported, the controllers are paused")
 		return err
 	}
 	if config.HasVPCNamespaces() {
 		if !util.IsLicensed(util.FeatureVPC) {
 			err = errors.New("NSX license check failed")
-			log.Error(err, "VPC license is not supported")
+			log.Error(err, "VPC license is not supported, the controllers are paused")
 			return err
 		}
 	}
-	if !init {
-		newContainerLicense := util.IsLicensed(util.FeatureContainer)
-		newDfwLicense := util.GetDFWLicense()
-		if newContainerLicense != oldContainerLicense || newDfwLicense != oldDfwLicense {
-			log.Info("License updated, reset", "container license new value", newContainerLicense, "DFW license new value", newDfwLicense, "container license old value", oldContainerLicense, "DFW license old value", oldDfwLicense)
-			return errors.New("license updated")
-		}
-	}
 	return nil
 }
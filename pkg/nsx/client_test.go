@@ -4,6 +4,7 @@
 package nsx
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -13,8 +14,10 @@ import (
 
 	"github.com/agiledragon/gomonkey/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/core"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/search"
+	"go.opentelemetry.io/otel/trace"
 	pkg_log "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
@@ -231,6 +234,31 @@ func TestRestConnectorAllowOverwrite(t *testing.T) {
 	client := search.NewQueryClient(cluster.NewRestConnectorAllowOverwrite())
 	client.List("search", nil, nil, nil, nil, nil)
 }
+func TestClientWithContext(t *testing.T) {
+	config := NewConfig("1.1.1.1", "admin", "passw0rd", []string{}, 10, 3, 20, 20, true, true, true, ratelimiter.AIMD, nil, nil, []string{})
+	cluster, _ := NewCluster(config)
+	client := &Client{RestConnector: cluster.NewRestConnector(), restConnectorAllowOverwrite: cluster.NewRestConnectorAllowOverwrite()}
+	setClients(client, client.RestConnector, client.restConnectorAllowOverwrite)
+
+	// The client is not copied if there is no span in the context.
+	assert.Same(t, client, client.WithContext(context.Background()))
+
+	spanCtx := trace.NewSpanContext(trace.SpanContextConfig{TraceID: trace.TraceID{1}, SpanID: trace.SpanID{1}})
+	ctx, cancel := context.WithCancel(trace.ContextWithSpanContext(context.Background(), spanCtx))
+	cancel()
+	tracedClient := client.WithContext(ctx)
+	assert.NotSame(t, client, tracedClient)
+	for _, connector := range []interface{ NewExecutionContext() *core.ExecutionContext }{tracedClient.RestConnector, tracedClient.restConnectorAllowOverwrite} {
+		executionCtx := connector.NewExecutionContext()
+		assert.Equal(t, spanCtx, trace.SpanContextFromContext(executionCtx.Context()))
+		// The cancellation of the context is not passed to the NSX requests.
+		assert.NoError(t, executionCtx.Context().Err())
+		responseType, err := executionCtx.ConnectionMetadata(core.ResponseTypeKey)
+		assert.NoError(t, err)
+		assert.Equal(t, core.OnlyMonoResponse, responseType)
+	}
+}
+
 func TestValidateLicense(t *testing.T) {
 	pkg_log.SetLogger(zap.New(zap.UseDevMode(true)))
 	cf := config.NSXOperatorConfig{NsxConfig: &config.NsxConfig{NsxApiUser: "admin", NsxApiPassword: "Admin!23Admin", NsxApiManagers: []string{"10.0.0.1"}}}
@@ -250,6 +278,7 @@ func TestValidateLicense(t *testing.T) {
 		dfwLicenseAfter  bool
 		wantErr          bool
 		expectedErrMsg   string
+		dfwNotified      bool
 	}{
 		{
 			name:             "init mode, container license supported",
@@ -301,15 +330,15 @@ func TestValidateLicense(t *testing.T) {
 			wantErr:          false,
 		},
 		{
-			name:             "non-init mode, container license changed",
+			name:             "non-init mode, VPC license lost",
 			init:             false,
 			fetchLicenseErr:  nil,
 			isLicensedBefore: true,
-			isLicensedAfter:  true,
+			isLicensedAfter:  false,
 			dfwLicenseBefore: true,
-			dfwLicenseAfter:  false,
+			dfwLicenseAfter:  true,
 			wantErr:          true,
-			expectedErrMsg:   "license updated",
+			expectedErrMsg:   "NSX license check failed",
 		},
 		{
 			name:             "non-init mode, dfw license changed",
@@ -319,13 +348,19 @@ func TestValidateLicense(t *testing.T) {
 			isLicensedAfter:  true,
 			dfwLicenseBefore: true,
 			dfwLicenseAfter:  false,
-			wantErr:          true,
-			expectedErrMsg:   "license updated",
+			wantErr:          false,
+			dfwNotified:      true,
 		},
 	}
 
+	dfwNotified := false
+	util.AddDFWLicenseHandler(func(bool) {
+		dfwNotified = true
+	})
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			dfwNotified = false
 			patchFetch := gomonkey.ApplyMethod(reflect.TypeOf(cluster), "FetchLicense", func(_ *Cluster) error {
 				return tt.fetchLicenseErr
 			})
@@ -341,6 +376,16 @@ func TestValidateLicense(t *testing.T) {
 			})
 			defer patchIsLicensed.Reset()
 
+			networkingCallCount := 0
+			patchNetworking := gomonkey.ApplyFunc(util.GetNetworkingLicense, func() bool {
+				networkingCallCount++
+				if networkingCallCount == 1 {
+					return tt.isLicensedBefore
+				}
+				return tt.isLicensedAfter
+			})
+			defer patchNetworking.Reset()
+
 			dfwCallCount := 0
 			patchDfw := gomonkey.ApplyFunc(util.GetDFWLicense, func() bool {
 				dfwCallCount++
@@ -365,6 +410,9 @@ func TestValidateLicense(t *testing.T) {
 			if tt.wantErr && err != nil && err.Error() != tt.expectedErrMsg {
 				t.Errorf("ValidateLicense() error message = %v, expected %v", err.Error(), tt.expectedErrMsg)
 			}
+			if dfwNotified != tt.dfwNotified {
+				t.Errorf("ValidateLicense() DFW license handler called = %v, expected %v", dfwNotified, tt.dfwNotified)
+			}
 		})
 	}
 }
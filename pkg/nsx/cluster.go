@@ -576,6 +576,9 @@ func (nsxVersion *NsxVersion) featureSupported(feature int) bool {
 	case SubnetAssociation:
 		minVersion = nsx920Version
 		validFeature = true
+	case SecurityPolicyFQDN:
+		minVersion = nsx910Version
+		validFeature = true
 	}
 
 	if validFeature {
@@ -201,6 +201,10 @@ func TestCluster_enableFeature(t *testing.T) {
 	assert.True(t, nsxVersion.featureSupported(ServiceAccountCertRotation))
 	assert.False(t, nsxVersion.featureSupported(IPv6))
 	assert.False(t, nsxVersion.featureSupported(SubnetAssociation))
+	assert.False(t, nsxVersion.featureSupported(SecurityPolicyFQDN))
+
+	nsxVersion.ProductVersion = "9.1.0"
+	assert.True(t, nsxVersion.featureSupported(SecurityPolicyFQDN))
 
 	nsxVersion.ProductVersion = "9.2.0"
 	assert.True(t, nsxVersion.featureSupported(IPv6))
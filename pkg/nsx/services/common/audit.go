@@ -0,0 +1,22 @@
+package common
+
+import (
+	"github.com/vmware-tanzu/nsx-operator/pkg/audit"
+)
+
+// The path segments of the resource types are registered to build the paths of the resources in an H-API request.
+func init() {
+	// The VPC resource types are registered before the infra ones, so a load balancer without path in an H-API request
+	// is assumed to be in a VPC.
+	for _, r := range []PolicyResourceType{
+		PolicyResourceInfra, PolicyResourceOrg, PolicyResourceProject, PolicyResourceVpc, PolicyResourceStaticRoutes,
+		PolicyResourceVpcSubnet, PolicyResourceVpcSubnetPort, PolicyResourceVpcSubnetConnectionBindingMap,
+		PolicyResourceVpcLBService, PolicyResourceVpcLBPool, PolicyResourceVpcLBVirtualServer,
+		PolicyResourceInfraLBService, PolicyResourceInfraLBPool, PolicyResourceInfraLBVirtualServer,
+		PolicyResourceVpcIPAddressAllocation, PolicyResourceDnsRecord, PolicyResourceDomain, PolicyResourceShare,
+		PolicyResourceSharedResource, PolicyResourceGroup, PolicyResourceRule, PolicyResourceSecurityPolicy,
+		PolicyResourceTlsCertificate, PolicyResourceVpcDynamicIPReservation, PolicyResourceVpcStaticIPReservation,
+	} {
+		audit.RegisterPathSegment(r.ModelKey, r.PathKey)
+	}
+}
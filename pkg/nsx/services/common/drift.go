@@ -0,0 +1,92 @@
+package common
+
+import (
+	"context"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"k8s.io/client-go/tools/cache"
+)
+
+type DriftType string
+
+const (
+	// DriftTypeModified means the NSX resource differs from the one in the store.
+	DriftTypeModified DriftType = "Modified"
+	// DriftTypeDeleted means the NSX resource in the store no longer exists on NSX.
+	DriftTypeDeleted DriftType = "Deleted"
+)
+
+// DriftSource describes the operator-owned NSX resources of one resource type which are scanned for drift.
+type DriftSource struct {
+	// ResourceType is the NSX resource type, e.g. VpcSubnet.
+	ResourceType string
+	// Tags are the tags used to query the resources, the same as the ones passed to InitializeResourceStore.
+	Tags []model.Tag
+	// Store is the service store holding the resources expected on NSX.
+	Store *ResourceStore
+	// ToComparable converts a resource in the store or read from NSX to the comparable of the service.
+	ToComparable func(obj interface{}) Comparable
+}
+
+// Drift is an operator-owned NSX resource which is changed or deleted outside of nsx-operator.
+type Drift struct {
+	ResourceType string
+	Type         DriftType
+	// Object is the resource in the store.
+	Object interface{}
+	// NSXObject is the resource read from NSX, it is nil if the resource is deleted.
+	NSXObject interface{}
+}
+
+// driftStore holds the resources read from NSX for the drift detection.
+type driftStore struct {
+	ResourceStore
+}
+
+func (s *driftStore) Apply(_ interface{}) error {
+	return nil
+}
+
+// DetectDrift queries the resources of the source from NSX and compares them with the ones in the store through the
+// service comparables. The resources which are only on NSX are ignored, they are cleaned up by the garbage collectors.
+// The resources updated in the store while querying NSX are skipped, as they may be written by the operator after
+// the query.
+func (service *Service) DetectDrift(ctx context.Context, source *DriftSource) ([]Drift, error) {
+	existing := source.Store.List()
+	keyFunc := func(obj interface{}) (string, error) {
+		return source.ToComparable(obj).Key(), nil
+	}
+	nsxStore := &driftStore{ResourceStore: ResourceStore{
+		Indexer:     cache.NewIndexer(keyFunc, cache.Indexers{}),
+		BindingType: source.Store.BindingType,
+	}}
+	queryParam := service.buildStoreQuery("", "", source.ResourceType, source.Tags, nsxStore.IsPolicyAPI())
+	if _, err := service.SearchResource(ctx, source.ResourceType, queryParam, nsxStore, nil); err != nil {
+		return nil, err
+	}
+
+	var drifts []Drift
+	for _, obj := range existing {
+		if current, exists, _ := source.Store.Indexer.Get(obj); !exists || current != obj {
+			continue
+		}
+		expected := source.ToComparable(obj)
+		nsxObj := nsxStore.GetByKey(expected.Key())
+		if nsxObj == nil {
+			drifts = append(drifts, Drift{ResourceType: source.ResourceType, Type: DriftTypeDeleted, Object: obj})
+		} else if CompareResource(source.ToComparable(nsxObj), expected) {
+			drifts = append(drifts, Drift{ResourceType: source.ResourceType, Type: DriftTypeModified, Object: obj, NSXObject: nsxObj})
+		}
+	}
+	log.Debug("Detected NSX drift", "resourceType", source.ResourceType, "count", len(drifts))
+	return drifts, nil
+}
+
+// RepairDrift updates the store with the NSX resource of the drift, so the next reconciliation of the owning CR
+// finds the difference with the expected resource and realizes it on NSX again.
+func (source *DriftSource) RepairDrift(drift Drift) error {
+	if drift.Type == DriftTypeDeleted {
+		return source.Store.Indexer.Delete(drift.Object)
+	}
+	return source.Store.Indexer.Update(drift.NSXObject)
+}
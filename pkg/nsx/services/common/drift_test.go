@@ -0,0 +1,84 @@
+package common
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+)
+
+type comparableRule model.Rule
+
+func (r *comparableRule) Key() string {
+	return *r.Id
+}
+
+func (r *comparableRule) Value() data.DataValue {
+	dataValue, _ := (*model.Rule)(&comparableRule{Id: r.Id, Action: r.Action}).GetDataValue__()
+	return dataValue
+}
+
+func TestService_DetectDrift(t *testing.T) {
+	service := &Service{
+		NSXClient: &nsx.Client{
+			NsxConfig: &config.NSXOperatorConfig{CoeConfig: &config.CoeConfig{Cluster: "k8scl-one:test"}},
+		},
+	}
+	ruleStore := &ResourceStore{
+		Indexer:     cache.NewIndexer(keyFunc, cache.Indexers{}),
+		BindingType: model.RuleBindingType(),
+	}
+	newRule := func(id, action string) *model.Rule {
+		return &model.Rule{Id: String(id), Action: String(action)}
+	}
+	for _, rule := range []*model.Rule{newRule("rule-1", "ALLOW"), newRule("rule-2", "ALLOW"), newRule("rule-3", "DROP")} {
+		require.NoError(t, ruleStore.Add(rule))
+	}
+	source := &DriftSource{
+		ResourceType: ResourceTypeRule,
+		Store:        ruleStore,
+		ToComparable: func(obj interface{}) Comparable {
+			return (*comparableRule)(obj.(*model.Rule))
+		},
+	}
+
+	var query string
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(service), "SearchResource", func(_ *Service, _ context.Context, _ string, queryParam string, store Store, _ Filter) (uint64, error) {
+		query = queryParam
+		s := store.(*driftStore)
+		// rule-2 is modified and rule-3 is deleted on NSX, rule-4 is only on NSX.
+		for _, rule := range []*model.Rule{newRule("rule-1", "ALLOW"), newRule("rule-2", "DROP"), newRule("rule-4", "ALLOW")} {
+			assert.NoError(t, s.Add(rule))
+		}
+		return 3, nil
+	})
+	defer patches.Reset()
+
+	drifts, err := service.DetectDrift(context.Background(), source)
+	require.NoError(t, err)
+	assert.Contains(t, query, "resource_type:Rule")
+	assert.Contains(t, query, "marked_for_delete:false")
+	require.Len(t, drifts, 2)
+	driftByType := map[DriftType]Drift{}
+	for _, drift := range drifts {
+		driftByType[drift.Type] = drift
+	}
+	assert.Equal(t, "rule-2", *driftByType[DriftTypeModified].Object.(*model.Rule).Id)
+	assert.Equal(t, "DROP", *driftByType[DriftTypeModified].NSXObject.(*model.Rule).Action)
+	assert.Equal(t, "rule-3", *driftByType[DriftTypeDeleted].Object.(*model.Rule).Id)
+	assert.Nil(t, driftByType[DriftTypeDeleted].NSXObject)
+
+	require.NoError(t, source.RepairDrift(driftByType[DriftTypeModified]))
+	require.NoError(t, source.RepairDrift(driftByType[DriftTypeDeleted]))
+	assert.ElementsMatch(t, []string{"rule-1", "rule-2"}, ruleStore.ListKeys())
+	assert.Equal(t, "DROP", *ruleStore.GetByKey("rule-2").(*model.Rule).Action)
+}
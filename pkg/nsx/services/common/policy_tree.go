@@ -8,10 +8,12 @@ import (
 
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"go.opentelemetry.io/otel/attribute"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 )
 
 const (
@@ -674,7 +676,14 @@ func (builder *PolicyTreeBuilder[T]) PagingUpdateResources(ctx context.Context,
 			log.Info("Batch deletion interrupted by context", "resourceType", builder.leafType, "processedBatches", currentBatch-1, "totalBatches", totalBatches, "successCount", successCount, "failedCount", failedCount)
 			return errors.Join(util.TimeoutFailed, ctx.Err())
 		default:
-			updateErr := builder.UpdateMultipleResourcesOnNSX(partialObjs, nsxClient)
+			batchCtx, span := tracing.Start(ctx, "PagingUpdateResources batch",
+				attribute.String("nsx.resource_type", builder.leafType),
+				attribute.Int("batch", currentBatch),
+				attribute.Int("batch.total", totalBatches),
+				attribute.Int("batch.size", len(partialObjs)),
+			)
+			updateErr := builder.UpdateMultipleResourcesOnNSX(partialObjs, nsxClient.WithContext(batchCtx))
+			tracing.End(span, updateErr)
 			if updateErr == nil {
 				successCount += len(partialObjs)
 				log.Info("Batch update succeeded", "resourceType", builder.leafType, "batch", fmt.Sprintf("%d/%d", currentBatch, totalBatches), "batchResourceCount", len(partialObjs), "cumulativeSuccess", successCount)
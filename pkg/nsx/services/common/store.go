@@ -1,6 +1,7 @@
 package common
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/url"
@@ -12,10 +13,12 @@ import (
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"go.opentelemetry.io/otel/attribute"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
 
 	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 )
 
 const (
@@ -130,22 +133,39 @@ func (service *Service) InitializeVPCResourceStore(wg *sync.WaitGroup, fatalErro
 
 type Filter func(interface{}) *data.StructValue
 
-func (service *Service) SearchResource(resourceTypeValue string, queryParam string, store Store, filter Filter) (uint64, error) {
+// SearchResource queries the resources page by page and adds them to the store. The query is traced in the span of ctx.
+func (service *Service) SearchResource(ctx context.Context, resourceTypeValue string, queryParam string, store Store, filter Filter) (uint64, error) {
 	// TODO: resourceTypeValue is not used in this function, but cannot be deleted, as the `fakeSearchResource` use the parameter
+	ctx, span := tracing.Start(ctx, "SearchResource",
+		attribute.String("nsx.resource_type", resourceTypeValue),
+		attribute.String("nsx.query", queryParam),
+	)
+	count, pages, err := service.searchResource(ctx, queryParam, store, filter)
+	span.SetAttributes(attribute.Int("nsx.pages", pages), attribute.Int64("nsx.count", int64(count)))
+	tracing.End(span, err)
+	return count, err
+}
+
+// searchResource queries the resources page by page and adds them to the store, it returns the number of the resources
+// and the pages.
+func (service *Service) searchResource(ctx context.Context, queryParam string, store Store, filter Filter) (uint64, int, error) {
+	nsxClient := service.NSXClient.WithContext(ctx)
 	var cursor *string
 	count := uint64(0)
+	pages := 0
 	for {
+		pages++
 		var err error
 		var results []*data.StructValue
 		var resultCount *int64
 		if store.IsPolicyAPI() {
-			response, searchErr := service.NSXClient.QueryClient.List(queryParam, cursor, nil, &pageSize, nil, nil)
+			response, searchErr := nsxClient.QueryClient.List(queryParam, cursor, nil, &pageSize, nil, nil)
 			results = response.Results
 			cursor = response.Cursor
 			resultCount = response.ResultCount
 			err = searchErr
 		} else {
-			response, searchErr := service.NSXClient.MPQueryClient.List(queryParam, cursor, nil, &pageSize, nil, nil)
+			response, searchErr := nsxClient.MPQueryClient.List(queryParam, cursor, nil, &pageSize, nil, nil)
 			results = response.Results
 			cursor = response.Cursor
 			resultCount = response.ResultCount
@@ -157,7 +177,7 @@ func (service *Service) SearchResource(resourceTypeValue string, queryParam stri
 				DecrementPageSize(&pageSize)
 				continue
 			}
-			return count, err
+			return count, pages, err
 		}
 		for _, entity := range results {
 			if filter != nil {
@@ -165,7 +185,7 @@ func (service *Service) SearchResource(resourceTypeValue string, queryParam stri
 			}
 			err = store.TransResourceToStore(entity)
 			if err != nil {
-				return count, err
+				return count, pages, err
 			}
 			count++
 		}
@@ -177,13 +197,14 @@ func (service *Service) SearchResource(resourceTypeValue string, queryParam stri
 			break
 		}
 	}
-	return count, nil
+	return count, pages, nil
 }
 
 // PopulateResourcetoStore is the method used by populating resources created not by nsx-operator
 func (service *Service) PopulateResourcetoStore(wg *sync.WaitGroup, fatalErrors chan error, resourceTypeValue string, queryParam string, store Store, filter Filter) {
 	defer wg.Done()
-	count, err := service.SearchResource("", queryParam, store, filter)
+	count, err := service.SearchResource(context.Background(), "", queryParam, store, filter)
+	recordStoreInit(resourceTypeValue, err)
 	if err != nil {
 		fatalErrors <- err
 	}
@@ -192,6 +213,15 @@ func (service *Service) PopulateResourcetoStore(wg *sync.WaitGroup, fatalErrors
 
 // InitializeCommonStore is the common method used by InitializeResourceStore and InitializeVPCResourceStore
 func (service *Service) InitializeCommonStore(wg *sync.WaitGroup, fatalErrors chan error, org string, project string, resourceTypeValue string, tags []model.Tag, store Store) {
+	queryParam := service.buildStoreQuery(org, project, resourceTypeValue, tags, store.IsPolicyAPI())
+	if service.RecordStoreQueries {
+		recordStoreQuery(service, resourceTypeValue, queryParam, store)
+	}
+	service.PopulateResourcetoStore(wg, fatalErrors, resourceTypeValue, queryParam, store, nil)
+}
+
+// buildStoreQuery builds the search query for the resources created by this cluster with the given tags.
+func (service *Service) buildStoreQuery(org string, project string, resourceTypeValue string, tags []model.Tag, isPolicyAPI bool) string {
 	var tagParams []string
 	// Check for specific tag scopes
 	if !containsTagScope(tags, TagScopeCluster, TagScopeNCPCluster) {
@@ -221,10 +251,10 @@ func (service *Service) InitializeCommonStore(wg *sync.WaitGroup, fatalErrors ch
 		pathUnescape, _ := url.PathUnescape("path%3A")
 		queryParam += " AND " + pathUnescape + path
 	}
-	if store.IsPolicyAPI() {
+	if isPolicyAPI {
 		queryParam += " AND marked_for_delete:false"
 	}
-	service.PopulateResourcetoStore(wg, fatalErrors, resourceTypeValue, queryParam, store, nil)
+	return queryParam
 }
 
 // Helper function to check if any tag has the specified scopes
@@ -296,7 +326,7 @@ func (service *Service) QueryNCPCreatedResources(resourceTypes []string, store S
 	if additionalQueryFn != nil {
 		query = additionalQueryFn(query)
 	}
-	count, searchErr := service.SearchResource("", query, store, nil)
+	count, searchErr := service.SearchResource(context.TODO(), "", query, store, nil)
 	if searchErr != nil {
 		log.Error(searchErr, "Failed to query resources", "query", query)
 		return searchErr
@@ -0,0 +1,281 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+// storeQuery is a query run by InitializeCommonStore for the long-lived services, it is recorded to refresh the store
+// on the standby replicas.
+type storeQuery struct {
+	service      *Service
+	resourceType string
+	queryParam   string
+	store        Store
+}
+
+// replaceableStore is implemented by the stores embedding ResourceStore.
+type replaceableStore interface {
+	Store
+	Replace(list []interface{}, resourceVersion string) error
+	getResourceStore() *ResourceStore
+}
+
+func (resourceStore *ResourceStore) getResourceStore() *ResourceStore {
+	return resourceStore
+}
+
+// stagingStore collects the resources searched for a store, they replace the content of the store only after all the
+// queries of the store succeed.
+type stagingStore struct {
+	Store
+	bindingType bindings.BindingType
+	objs        []interface{}
+}
+
+func (s *stagingStore) TransResourceToStore(entity *data.StructValue) error {
+	obj, errs := NewConverter().ConvertToGolang(entity, s.bindingType)
+	for _, err := range errs {
+		return err
+	}
+	objAddr := nsxutil.CasttoPointer(obj)
+	if objAddr == nil {
+		return fmt.Errorf("failed to cast to pointer")
+	}
+	s.objs = append(s.objs, objAddr)
+	return nil
+}
+
+var (
+	storeQueriesLock sync.Mutex
+	storeQueries     []storeQuery
+	// lastStoreRefreshTime is the start time of the last successful refresh, sync or update of the stores.
+	lastStoreRefreshTime time.Time
+	// syncedObjects are the objects of each store left unchanged by the last SyncResourceStores.
+	syncedObjects = map[Store]map[interface{}]bool{}
+	// NSX sets _last_modified_time with its own clock, UpdateResourceStores queries with a margin for the clock
+	// difference.
+	storeUpdateTimeMargin = 5 * time.Minute
+
+	storeInitLock sync.Mutex
+	// storeInitErrors is the last error of initializing or refreshing the stores by resource type.
+	storeInitErrors = map[string]error{}
+)
+
+func recordStoreQuery(service *Service, resourceType string, queryParam string, store Store) {
+	storeQueriesLock.Lock()
+	defer storeQueriesLock.Unlock()
+	if lastStoreRefreshTime.IsZero() {
+		lastStoreRefreshTime = time.Now()
+	}
+	storeQueries = append(storeQueries, storeQuery{service: service, resourceType: resourceType, queryParam: queryParam, store: store})
+}
+
+// RefreshResourceStores queries NSX again for all the recorded stores and replaces their content, so the stores built
+// on a standby replica keep up with the changes made by the leader. The resources are searched into a staging store,
+// a store is only replaced once all its queries succeed, otherwise it keeps its previous content.
+func RefreshResourceStores() error {
+	storeQueriesLock.Lock()
+	defer storeQueriesLock.Unlock()
+	startTime := time.Now()
+	stores, queriesByStore := recordedStores()
+	var errs []error
+	for _, store := range stores {
+		if err := refreshStore(store, queriesByStore[store]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) == 0 {
+		lastStoreRefreshTime = startTime
+	}
+	return errors.Join(errs...)
+}
+
+// recordedStores groups the recorded queries by store. Several queries may populate the same store, the order of the
+// queries is kept for each store.
+func recordedStores() ([]replaceableStore, map[Store][]storeQuery) {
+	var stores []replaceableStore
+	queriesByStore := map[Store][]storeQuery{}
+	for _, query := range storeQueries {
+		replaceable, ok := query.store.(replaceableStore)
+		if !ok {
+			continue
+		}
+		if _, found := queriesByStore[query.store]; !found {
+			stores = append(stores, replaceable)
+		}
+		queriesByStore[query.store] = append(queriesByStore[query.store], query)
+	}
+	return stores, queriesByStore
+}
+
+func refreshStore(store replaceableStore, queries []storeQuery) error {
+	staging := &stagingStore{Store: store, bindingType: store.getResourceStore().BindingType}
+	for _, query := range queries {
+		count, err := query.service.SearchResource(context.Background(), query.resourceType, query.queryParam, staging, nil)
+		recordStoreInit(query.resourceType, err)
+		if err != nil {
+			return fmt.Errorf("failed to refresh store of %s: %w", query.resourceType, err)
+		}
+		log.Debug("Refreshed store", "resourceType", query.resourceType, "count", count)
+	}
+	return store.Replace(staging.objs, "")
+}
+
+// SyncResourceStores queries NSX again for all the recorded stores while the controllers are running. It is used in
+// the sharding mode to remove the resources deleted by the other replicas, which are not found by
+// UpdateResourceStores. The resources written to a store by the controllers while querying NSX are kept. As the NSX
+// search index may lag behind the changes, a resource missing on NSX is only removed from the store if it is
+// unchanged since the previous sync.
+func SyncResourceStores() error {
+	storeQueriesLock.Lock()
+	defer storeQueriesLock.Unlock()
+	startTime := time.Now()
+	stores, queriesByStore := recordedStores()
+	var errs []error
+	for _, store := range stores {
+		if err := syncStore(store, queriesByStore[store]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) == 0 {
+		lastStoreRefreshTime = startTime
+	}
+	return errors.Join(errs...)
+}
+
+func syncStore(store replaceableStore, queries []storeQuery) error {
+	indexer := store.getResourceStore().Indexer
+	existing := map[string]interface{}{}
+	keys := map[interface{}]string{}
+	for _, key := range indexer.ListKeys() {
+		if obj, exists, _ := indexer.GetByKey(key); exists {
+			existing[key] = obj
+			keys[obj] = key
+		}
+	}
+	staging := &stagingStore{Store: store, bindingType: store.getResourceStore().BindingType}
+	for _, query := range queries {
+		if _, err := query.service.SearchResource(context.Background(), query.resourceType, query.queryParam, staging, nil); err != nil {
+			return fmt.Errorf("failed to sync store of %s: %w", query.resourceType, err)
+		}
+	}
+
+	// The resources deleted by the controllers while querying NSX may be returned by the query, the new resources are
+	// only added if no resource is deleted.
+	deletedByControllers := false
+	for key := range existing {
+		if _, exists, _ := indexer.GetByKey(key); !exists {
+			deletedByControllers = true
+			break
+		}
+	}
+	found := sets.New[string]()
+	written := map[interface{}]bool{}
+	for _, nsxObj := range staging.objs {
+		current, exists, err := indexer.Get(nsxObj)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			if deletedByControllers {
+				continue
+			}
+			if err := indexer.Add(nsxObj); err != nil {
+				return err
+			}
+			written[nsxObj] = true
+			continue
+		}
+		key, ok := keys[current]
+		if !ok {
+			// The resource is written by the controllers while querying NSX.
+			continue
+		}
+		found.Insert(key)
+		if err := indexer.Update(nsxObj); err != nil {
+			return err
+		}
+		written[nsxObj] = true
+	}
+
+	previous := syncedObjects[store]
+	removed := 0
+	for key, obj := range existing {
+		if found.Has(key) {
+			continue
+		}
+		if current, exists, _ := indexer.GetByKey(key); !exists || current != obj || !previous[obj] {
+			continue
+		}
+		if err := indexer.Delete(obj); err != nil {
+			return err
+		}
+		removed++
+	}
+	synced := map[interface{}]bool{}
+	for _, obj := range indexer.List() {
+		if _, unchanged := keys[obj]; unchanged || written[obj] {
+			synced[obj] = true
+		}
+	}
+	syncedObjects[store] = synced
+	log.Debug("Synced store", "resourceType", queries[0].resourceType, "count", len(staging.objs), "removed", removed)
+	return nil
+}
+
+// UpdateResourceStores adds or updates the resources changed on NSX since the last refresh, sync or update of the
+// stores. It is called on the leader election to catch up with the last changes of the previous leader, and
+// periodically in the sharding mode to catch up with the changes of the other replicas. The resources deleted since
+// then are not found by the query, they are removed by RefreshResourceStores and SyncResourceStores.
+func UpdateResourceStores() error {
+	storeQueriesLock.Lock()
+	defer storeQueriesLock.Unlock()
+	startTime := time.Now()
+	since := lastStoreRefreshTime.Add(-storeUpdateTimeMargin).UnixMilli()
+	var errs []error
+	for _, query := range storeQueries {
+		queryParam := fmt.Sprintf("%s AND _last_modified_time:[%d TO *]", query.queryParam, since)
+		count, err := query.service.SearchResource(context.Background(), query.resourceType, queryParam, query.store, nil)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to update store of %s: %w", query.resourceType, err))
+			continue
+		}
+		log.Info("Updated store with the changes since the last refresh", "resourceType", query.resourceType, "count", count)
+	}
+	if len(errs) == 0 {
+		lastStoreRefreshTime = startTime
+	}
+	return errors.Join(errs...)
+}
+
+func recordStoreInit(resourceType string, err error) {
+	storeInitLock.Lock()
+	defer storeInitLock.Unlock()
+	if err != nil {
+		storeInitErrors[resourceType] = err
+	} else {
+		delete(storeInitErrors, resourceType)
+	}
+}
+
+// UninitializedStores returns the errors of the stores failed to load the resources from NSX by resource type, a
+// store is removed once it is refreshed.
+func UninitializedStores() map[string]error {
+	storeInitLock.Lock()
+	defer storeInitLock.Unlock()
+	result := make(map[string]error, len(storeInitErrors))
+	for resourceType, err := range storeInitErrors {
+		result[resourceType] = err
+	}
+	return result
+}
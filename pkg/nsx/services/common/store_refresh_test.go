@@ -0,0 +1,130 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestRefreshResourceStores(t *testing.T) {
+	origQueries, origRefreshTime := storeQueries, lastStoreRefreshTime
+	defer func() {
+		storeQueries, lastStoreRefreshTime = origQueries, origRefreshTime
+	}()
+	storeQueries, lastStoreRefreshTime = nil, time.Time{}
+
+	service := &Service{}
+	ruleStore := &ResourceStore{
+		Indexer:     cache.NewIndexer(keyFunc, cache.Indexers{}),
+		BindingType: model.RuleBindingType(),
+	}
+	newRule := func(id string) *model.Rule {
+		return &model.Rule{Id: String(id)}
+	}
+	// Two queries populate the same store.
+	recordStoreQuery(service, ResourceTypeRule, "query-1", ruleStore)
+	recordStoreQuery(service, ResourceTypeRule, "query-2", ruleStore)
+	require.NoError(t, ruleStore.Add(newRule("rule-deleted")))
+
+	var queries []string
+	var searchErr error
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(service), "SearchResource", func(_ *Service, _ context.Context, _ string, queryParam string, store Store, _ Filter) (uint64, error) {
+		queries = append(queries, queryParam)
+		if searchErr != nil && queryParam == "query-2" {
+			return 0, searchErr
+		}
+		if staging, ok := store.(*stagingStore); ok {
+			staging.objs = append(staging.objs, newRule(queryParam))
+		} else {
+			assert.NoError(t, store.(*ResourceStore).Add(newRule(queryParam)))
+		}
+		return 1, nil
+	})
+	defer patches.Reset()
+
+	initTime := lastStoreRefreshTime
+	require.NoError(t, RefreshResourceStores())
+	assert.Equal(t, []string{"query-1", "query-2"}, queries)
+	assert.ElementsMatch(t, []string{"query-1", "query-2"}, ruleStore.ListKeys())
+	assert.True(t, lastStoreRefreshTime.After(initTime))
+
+	// The store keeps its content if one of its queries fails.
+	refreshTime := lastStoreRefreshTime
+	searchErr = errors.New("NSX is unreachable")
+	require.NoError(t, ruleStore.Add(newRule("rule-created")))
+	assert.Error(t, RefreshResourceStores())
+	assert.ElementsMatch(t, []string{"query-1", "query-2", "rule-created"}, ruleStore.ListKeys())
+	assert.Equal(t, refreshTime, lastStoreRefreshTime)
+	require.NoError(t, ruleStore.Delete(newRule("rule-created")))
+	searchErr = nil
+
+	queries = nil
+	require.NoError(t, UpdateResourceStores())
+	require.Len(t, queries, 2)
+	assert.Contains(t, queries[0], "query-1 AND _last_modified_time:[")
+	// The resources changed since the last refresh are added without clearing the store.
+	assert.Len(t, ruleStore.ListKeys(), 4)
+	// The next update only queries the changes since this update.
+	assert.True(t, lastStoreRefreshTime.After(refreshTime))
+}
+
+func TestSyncResourceStores(t *testing.T) {
+	origQueries, origRefreshTime, origSynced := storeQueries, lastStoreRefreshTime, syncedObjects
+	defer func() {
+		storeQueries, lastStoreRefreshTime, syncedObjects = origQueries, origRefreshTime, origSynced
+	}()
+	storeQueries, lastStoreRefreshTime, syncedObjects = nil, time.Time{}, map[Store]map[interface{}]bool{}
+
+	service := &Service{}
+	ruleStore := &ResourceStore{
+		Indexer:     cache.NewIndexer(keyFunc, cache.Indexers{}),
+		BindingType: model.RuleBindingType(),
+	}
+	recordStoreQuery(service, ResourceTypeRule, "query", ruleStore)
+	newRule := func(id string) *model.Rule {
+		return &model.Rule{Id: String(id)}
+	}
+	var nsxRules []string
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(service), "SearchResource", func(_ *Service, _ context.Context, _ string, _ string, store Store, _ Filter) (uint64, error) {
+		staging := store.(*stagingStore)
+		for _, id := range nsxRules {
+			staging.objs = append(staging.objs, newRule(id))
+		}
+		return uint64(len(nsxRules)), nil
+	})
+	defer patches.Reset()
+
+	require.NoError(t, ruleStore.Add(newRule("rule-1")))
+	require.NoError(t, ruleStore.Add(newRule("rule-2")))
+	// rule-3 is created by another replica, rule-2 is deleted by another replica.
+	nsxRules = []string{"rule-1", "rule-3"}
+	require.NoError(t, SyncResourceStores())
+	// rule-2 is kept until the next sync, as it may be missing in the NSX search index.
+	assert.ElementsMatch(t, []string{"rule-1", "rule-2", "rule-3"}, ruleStore.ListKeys())
+
+	// rule-4 is created by the controllers after the previous sync.
+	require.NoError(t, ruleStore.Add(newRule("rule-4")))
+	require.NoError(t, SyncResourceStores())
+	assert.ElementsMatch(t, []string{"rule-1", "rule-3", "rule-4"}, ruleStore.ListKeys())
+}
+
+func TestUninitializedStores(t *testing.T) {
+	defer func() {
+		storeInitErrors = map[string]error{}
+	}()
+	recordStoreInit(ResourceTypeRule, errors.New("NSX is unreachable"))
+	recordStoreInit(ResourceTypeSecurityPolicy, nil)
+	assert.Equal(t, map[string]error{ResourceTypeRule: errors.New("NSX is unreachable")}, UninitializedStores())
+
+	// The store is initialized by the refresh.
+	recordStoreInit(ResourceTypeRule, nil)
+	assert.Empty(t, UninitializedStores())
+}
@@ -1,6 +1,7 @@
 package common
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"sync"
@@ -268,7 +269,7 @@ func TestService_SearchResource(t *testing.T) {
 					MPQueryClient: &fakeMPQueryClient{},
 				},
 			}
-			got, err := service.SearchResource(tt.args.resourceTypeValue, tt.args.queryParam, tt.args.store, tt.args.filter)
+			got, err := service.SearchResource(context.Background(), tt.args.resourceTypeValue, tt.args.queryParam, tt.args.store, tt.args.filter)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("SearchResource() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -26,6 +26,7 @@ const (
 	VPCLbResourcePathMinSegments       int    = 8
 	PriorityNetworkPolicyAllowRule     int    = 2010
 	PriorityNetworkPolicyIsolationRule int    = 2090
+	PriorityBaselineAdminNetworkPolicy int    = 3000
 	TagScopeNCPCluster                 string = "ncp/cluster"
 	TagScopeNCPProjectUID              string = "ncp/project_uid"
 	TagScopeNCPCreateFor               string = "ncp/created_for"
@@ -101,6 +102,7 @@ const (
 	AnnotationReconfigureNic           string = "nsx/reconfigure-nic"
 	AnnotationPodMAC                   string = "nsx.vmware.com/mac"
 	AnnotationAttachment               string = "nsx.vmware.com/attachment"
+	AnnotationNetworkPolicyLogging     string = "nsx.vmware.com/enable-logging"
 	LabelCPVM                          string = "iaas.vmware.com/is-cpvm-subnetport"
 	TagScopePodName                    string = "nsx-op/pod_name"
 	TagScopePodUID                     string = "nsx-op/pod_uid"
@@ -108,7 +110,7 @@ const (
 	TagScopeStatefulSetUID             string = "nsx-op/sts_uid"
 
 	// Tags and annotations for DNS record use case.
-	TagScopeDNSRecordFor                string = "nsx-op/dns_for" // value: gateway, service, xxroutes
+	TagScopeDNSRecordFor                string = "nsx-op/dns_for" // value: gateway, service, ingress, xxroutes
 	TagScopeDNSRecordGatewayIndexList   string = "nsx-op/dns_gateway_index_list"
 	TagScopeDNSRecordOwnerNamespace     string = "nsx-op/dns_owner_namespace"
 	TagScopeDNSRecordOwnerName          string = "nsx-op/dns_owner_name"
@@ -118,10 +120,19 @@ const (
 	TagValueDNSRecordForGRPCRoute       string = "grpcroute"
 	TagValueDNSRecordForTLSRoute        string = "tlsroute"
 	TagValueDNSRecordForService         string = "service"
+	TagValueDNSRecordForIngress         string = "ingress"
 	AnnotationDNSHostnameKey            string = "external-dns.alpha.kubernetes.io/hostname"
 	AnnotationDNSHostnameSourceKey      string = "external-dns.alpha.kubernetes.io/gateway-hostname-source"
 	AnnotationsDNSSkip                  string = "dns.nsx.vmware.com/skip"
 
+	// Tags synced onto the Pod port for the SecurityPolicy serviceAccountSelector and serviceRef.
+	// The labels of the Pod ServiceAccount are synced with the prefix, and the ServiceAccount name
+	// is synced as the label kubernetes.io/metadata.name. Each Service backed by the Pod is synced
+	// as a tag whose scope is the prefix and the Service name.
+	TagScopeServiceAccountLabelPrefix string = "nsx-op/sa_label/"
+	TagScopeServiceMemberPrefix       string = "nsx-op/svc/"
+	TagValueServiceMember             string = "true"
+
 	// TagScopePodIndex is the NSX tag scope for Pod label apps.kubernetes.io/pod-index when synced onto the port (not set in BuildBasicTags).
 	TagScopePodIndex   string = "apps.kubernetes.io/pod-index"
 	ValueMajorVersion  string = "1"
@@ -162,6 +173,7 @@ const (
 	RuleActionAllow        = "allow"
 	RuleActionDrop         = "isolation"
 	RuleActionReject       = "reject"
+	RuleActionPass         = "pass"
 	RuleAnyPorts           = "all"
 	DefaultProject         = "default"
 	DefaultVpcAttachmentId = "default"
@@ -193,6 +205,8 @@ var (
 	ResourceTypeDomain                           = "Domain"
 	ResourceTypeSecurityPolicy                   = "SecurityPolicy"
 	ResourceTypeNetworkPolicy                    = "NetworkPolicy"
+	ResourceTypeAdminNetworkPolicy               = "AdminNetworkPolicy"
+	ResourceTypeBaselineAdminNetworkPolicy       = "BaselineAdminNetworkPolicy"
 	ResourceTypeGroup                            = "Group"
 	ResourceTypeRule                             = "Rule"
 	ResourceTypeIPBlock                          = "IpAddressBlock"
@@ -206,6 +220,7 @@ var (
 	ResourceTypeLBService                        = "LBService"
 	ResourceTypeVpcAttachment                    = "VpcAttachment"
 	ResourceTypeShare                            = "Share"
+	ResourceTypeContextProfile                   = "PolicyContextProfile"
 	ResourceTypeSharedResource                   = "SharedResource"
 	ResourceTypeStaticRoutes                     = "StaticRoutes"
 	ResourceTypeChildLBPool                      = "ChildLBPool"
@@ -215,6 +230,7 @@ var (
 	ResourceTypeChildShare                       = "ChildShare"
 	ResourceTypeChildRule                        = "ChildRule"
 	ResourceTypeChildGroup                       = "ChildGroup"
+	ResourceTypeChildContextProfile              = "ChildPolicyContextProfile"
 	ResourceTypeChildSecurityPolicy              = "ChildSecurityPolicy"
 	ResourceTypeChildStaticRoutes                = "ChildStaticRoutes"
 	ResourceTypeChildSubnetConnectionBindingMap  = "ChildSubnetConnectionBindingMap"
@@ -263,6 +279,10 @@ type Service struct {
 	Client    client.Client
 	NSXClient *nsx.Client
 	NSXConfig *config.NSXOperatorConfig
+	// RecordStoreQueries records the queries of the stores initialized by InitializeCommonStore, so the stores can be
+	// refreshed by RefreshResourceStores and UpdateResourceStores. It is only set for the long-lived services of the
+	// operator, the stores built for a single request are not recorded.
+	RecordStoreQueries bool
 }
 
 func NewConverter() *bindings.TypeConverter {
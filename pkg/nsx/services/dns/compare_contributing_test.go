@@ -119,6 +119,14 @@ func TestResourceRefFromDNSRecord_table(t *testing.T) {
 			wantNS:   "ns1",
 			wantName: "svcA",
 		},
+		{
+			name:     "valid Ingress record",
+			rec:      &model.DnsRecord{Tags: validTags(ResourceKindIngress, "ns1", "ingA")},
+			wantOk:   true,
+			wantKind: ResourceKindIngress,
+			wantNS:   "ns1",
+			wantName: "ingA",
+		},
 		{
 			name:     "valid HTTPRoute record",
 			rec:      &model.DnsRecord{Tags: validTags(ResourceKindHTTPRoute, "app", "route1")},
@@ -202,6 +210,11 @@ func TestOwnerNNIndexKeyForResourceRef_table(t *testing.T) {
 			owner: &ResourceRef{Kind: ResourceKindService, Object: &metav1.ObjectMeta{Namespace: "ns", Name: "svc"}},
 			want:  "service/ns/svc",
 		},
+		{
+			name:  "Ingress kind returns key",
+			owner: &ResourceRef{Kind: ResourceKindIngress, Object: &metav1.ObjectMeta{Namespace: "ns", Name: "ing"}},
+			want:  "ingress/ns/ing",
+		},
 		{
 			name:  "GRPCRoute kind returns key",
 			owner: &ResourceRef{Kind: ResourceKindGRPCRoute, Object: &metav1.ObjectMeta{Namespace: "app", Name: "gr1"}},
@@ -0,0 +1,70 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package dns
+
+import (
+	"sync"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+// RecordOwner is a decoded primary or contributing owner of an NSX DNS record.
+type RecordOwner struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// LoadDNSRecordStoreByOwnerNamespace returns a RecordStore hydrated from NSX Policy search with the DNS records
+// created by this cluster whose primary owner lives in namespace. It is used by read-only consumers (e.g. EAS)
+// which do not run the DNSRecordService.
+func LoadDNSRecordStoreByOwnerNamespace(commonService common.Service, namespace string) (*RecordStore, error) {
+	return loadDNSRecordStore(commonService, []model.Tag{modelTag(common.TagScopeDNSRecordOwnerNamespace, namespace)})
+}
+
+// LoadDNSRecordStoreWithContributingOwners returns a RecordStore hydrated from NSX Policy search with the DNS
+// records created by this cluster which carry a contributing-owners tag. The tag value is compressed, so callers
+// filter the records by namespace with RecordOwnersFromDNSRecord.
+func LoadDNSRecordStoreWithContributingOwners(commonService common.Service) (*RecordStore, error) {
+	return loadDNSRecordStore(commonService, []model.Tag{{Scope: common.String(common.TagScopeDNSRecordContributingOwners)}})
+}
+
+func loadDNSRecordStore(commonService common.Service, tags []model.Tag) (*RecordStore, error) {
+	store := BuildDNSRecordStore()
+	wg := sync.WaitGroup{}
+	fatalErrors := make(chan error, 1)
+	wg.Add(1)
+	commonService.InitializeResourceStore(&wg, fatalErrors, common.ResourceTypeDnsRecord, tags, store)
+	wg.Wait()
+	select {
+	case err := <-fatalErrors:
+		return nil, err
+	default:
+	}
+	return store, nil
+}
+
+// RecordOwnersFromDNSRecord decodes the primary owner tags and the compressed contributing-owners tag on rec.
+// ok is false when the primary owner tags are missing or carry an unknown dns_for value.
+func RecordOwnersFromDNSRecord(rec *model.DnsRecord) (primary RecordOwner, contributing []RecordOwner, ok bool) {
+	ref, ok := resourceRefFromDNSRecord(rec)
+	if !ok {
+		return RecordOwner{}, nil, false
+	}
+	primary = RecordOwner{Kind: ref.Kind, Namespace: ref.GetNamespace(), Name: ref.GetName()}
+	for _, key := range parseContributingOwnersFromRecord(rec) {
+		createdFor, ns, name, keyOK := parseOwnerNNIndexKey(key)
+		if !keyOK {
+			continue
+		}
+		kind := resourceKindFromCreatedForTag(createdFor)
+		if kind == "" {
+			continue
+		}
+		contributing = append(contributing, RecordOwner{Kind: kind, Namespace: ns, Name: name})
+	}
+	return primary, contributing, true
+}
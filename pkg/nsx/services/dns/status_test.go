@@ -0,0 +1,113 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package dns
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"go.uber.org/mock/gomock"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	searchmocks "github.com/vmware-tanzu/nsx-operator/pkg/mock/searchclient"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+func TestLoadDNSRecordStoreByOwnerNamespace(t *testing.T) {
+	cfg := &config.NSXOperatorConfig{CoeConfig: &config.CoeConfig{Cluster: "unit-test"}}
+	rec := model.DnsRecord{
+		Id:   servicecommon.String("app_zone-t_a"),
+		Path: servicecommon.String("/orgs/org1/projects/proj1/dns-records/app_zone-t_a"),
+		Tags: []model.Tag{modelTag(servicecommon.TagScopeDNSRecordOwnerNamespace, "ns1")},
+	}
+
+	t.Run("search_query_scoped_by_owner_namespace", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		qc := searchmocks.NewMockQueryClient(ctrl)
+		rc := int64(1)
+		qc.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(query string, _ *string, _ *string, _ *int64, _ *bool, _ *string) (model.SearchResponse, error) {
+				assert.True(t, strings.Contains(query, "tags.tag:ns1"), query)
+				assert.True(t, strings.Contains(query, servicecommon.ResourceTypeDnsRecord), query)
+				return model.SearchResponse{Results: []*data.StructValue{dnsRecordStructValue(t, rec)}, ResultCount: &rc}, nil
+			}).Times(1)
+		svc := servicecommon.Service{NSXClient: &nsx.Client{QueryClient: qc, NsxConfig: cfg}}
+
+		store, err := LoadDNSRecordStoreByOwnerNamespace(svc, "ns1")
+		require.NoError(t, err)
+		records := store.ListDNSRecords()
+		require.Len(t, records, 1)
+		assert.Equal(t, "app_zone-t_a", *records[0].Id)
+	})
+
+	t.Run("search_error_propagates", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		qc := searchmocks.NewMockQueryClient(ctrl)
+		qc.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(model.SearchResponse{}, errors.New("nsx search down")).Times(1)
+		svc := servicecommon.Service{NSXClient: &nsx.Client{QueryClient: qc, NsxConfig: cfg}}
+
+		_, err := LoadDNSRecordStoreByOwnerNamespace(svc, "ns1")
+		require.Error(t, err)
+	})
+}
+
+func TestRecordOwnersFromDNSRecord_table(t *testing.T) {
+	ownerTags := []model.Tag{
+		modelTag(servicecommon.TagScopeDNSRecordFor, servicecommon.TagValueDNSRecordForHTTPRoute),
+		modelTag(servicecommon.TagScopeDNSRecordOwnerNamespace, "ns1"),
+		modelTag(servicecommon.TagScopeDNSRecordOwnerName, "route-a"),
+	}
+	tests := []struct {
+		name             string
+		rec              *model.DnsRecord
+		wantOK           bool
+		wantPrimary      RecordOwner
+		wantContributing []RecordOwner
+	}{
+		{
+			name:   "nil record",
+			rec:    nil,
+			wantOK: false,
+		},
+		{
+			name:   "missing owner tags",
+			rec:    &model.DnsRecord{Tags: []model.Tag{modelTag(servicecommon.TagScopeDNSRecordFor, servicecommon.TagValueDNSRecordForService)}},
+			wantOK: false,
+		},
+		{
+			name:        "primary owner only",
+			rec:         &model.DnsRecord{Tags: ownerTags},
+			wantOK:      true,
+			wantPrimary: RecordOwner{Kind: ResourceKindHTTPRoute, Namespace: "ns1", Name: "route-a"},
+		},
+		{
+			name: "compressed contributing owners decoded and unknown kinds skipped",
+			rec: &model.DnsRecord{Tags: append(append([]model.Tag{}, ownerTags...),
+				modelTag(servicecommon.TagScopeDNSRecordContributingOwners,
+					compressString("grpcroute/ns2/route-b,unknown/ns2/x,malformed,ingress/ns1/ing"))),
+			},
+			wantOK:      true,
+			wantPrimary: RecordOwner{Kind: ResourceKindHTTPRoute, Namespace: "ns1", Name: "route-a"},
+			wantContributing: []RecordOwner{
+				{Kind: ResourceKindGRPCRoute, Namespace: "ns2", Name: "route-b"},
+				{Kind: ResourceKindIngress, Namespace: "ns1", Name: "ing"},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			primary, contributing, ok := RecordOwnersFromDNSRecord(tc.rec)
+			require.Equal(t, tc.wantOK, ok)
+			assert.Equal(t, tc.wantPrimary, primary)
+			assert.Equal(t, tc.wantContributing, contributing)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package health
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+// Names of the health checks
+const (
+	CheckNSX                = "NSX"
+	CheckKubernetes         = "Kubernetes"
+	CheckRestore            = "Restore"
+	CheckNSXStore           = "NSXStore"
+	CheckWorkqueue          = "Workqueue"
+	CheckWebhookCertificate = "WebhookCertificate"
+	CheckInventorySync      = "InventorySync"
+)
+
+// Reasons reported by the health checks
+const (
+	ReasonRestoreInProgress       = "RestoreInProgress"
+	ReasonNSXRestoreInProgress    = "NSXRestoreInProgress"
+	ReasonNSXRestoreFailed        = "NSXRestoreFailed"
+	ReasonNSXRestoreUnknown       = "NSXRestoreStatusUnknown"
+	ReasonStoreNotInitialized     = "StoreNotInitialized"
+	ReasonWorkqueueSaturated      = "WorkqueueSaturated"
+	ReasonCertificateNotInstalled = "CertificateNotInstalled"
+	ReasonCertificateExpired      = "CertificateExpired"
+	ReasonCertificateExpiring     = "CertificateExpiring"
+	ReasonInventorySyncLagging    = "InventorySyncLagging"
+)
+
+const (
+	restoreStatusFailed  = "FAILED"
+	restoreStatusAborted = "ABORTED"
+
+	workqueueDepthMetric = "workqueue_depth"
+)
+
+var (
+	// workqueueSaturationDepth is the depth from which a controller workqueue is reported as saturated
+	workqueueSaturationDepth = 1000.0
+	// inventorySyncLagThreshold is how long the inventory updates may fail before the inventory is reported as lagging
+	inventorySyncLagThreshold = 10 * time.Minute
+	// metricsGatherer gathers the workqueue metrics of the controllers
+	metricsGatherer prometheus.Gatherer = ctrlmetrics.Registry
+)
+
+// checkRestore reports DEGRADED while the operator or NSX is restoring, and DOWN if the NSX restore failed.
+func (c *ClusterHealthChecker) checkRestore() error {
+	if util.IsRestoreInProgress() {
+		return NewHealthCheckError(HealthStatusDegraded, ReasonRestoreInProgress, "the operator is restoring the resources after NSX restore")
+	}
+	if c.nsxClient == nil || c.nsxClient.StatusClient == nil {
+		return nil
+	}
+	restoreStatus, err := c.nsxClient.StatusClient.Get(nil)
+	if err != nil {
+		return NewHealthCheckError(HealthStatusDegraded, ReasonNSXRestoreUnknown, "failed to get NSX restore status: %v", err)
+	}
+	if restoreStatus.Status == nil || restoreStatus.Status.Value == nil {
+		return nil
+	}
+	switch status := *restoreStatus.Status.Value; status {
+	case util.RestoreStatusInitial, util.RestoreStatusSuccess:
+		return nil
+	case restoreStatusFailed, restoreStatusAborted:
+		return NewHealthCheckError(HealthStatusDown, ReasonNSXRestoreFailed, "NSX restore is %s", status)
+	default:
+		return NewHealthCheckError(HealthStatusDegraded, ReasonNSXRestoreInProgress, "NSX restore is %s", status)
+	}
+}
+
+// checkNSXStores reports DOWN if the NSX resources of some stores failed to be loaded.
+func checkNSXStores() error {
+	storeErrors := common.UninitializedStores()
+	if len(storeErrors) == 0 {
+		return nil
+	}
+	resourceTypes := make([]string, 0, len(storeErrors))
+	for resourceType := range storeErrors {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+	return NewHealthCheckError(HealthStatusDown, ReasonStoreNotInitialized, "stores of %s are not initialized: %v",
+		strings.Join(resourceTypes, ", "), storeErrors[resourceTypes[0]])
+}
+
+// checkWorkqueueSaturation reports DEGRADED if the depth of some controller workqueues reaches workqueueSaturationDepth.
+func checkWorkqueueSaturation() error {
+	families, err := metricsGatherer.Gather()
+	if err != nil {
+		return NewHealthCheckError(HealthStatusDegraded, ReasonWorkqueueSaturated, "failed to gather workqueue metrics: %v", err)
+	}
+	var saturated []string
+	for _, family := range families {
+		if family.GetName() != workqueueDepthMetric {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			depth := metric.GetGauge().GetValue()
+			if depth < workqueueSaturationDepth {
+				continue
+			}
+			name := ""
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "name" {
+					name = label.GetValue()
+				}
+			}
+			saturated = append(saturated, fmt.Sprintf("%s=%.0f", name, depth))
+		}
+	}
+	if len(saturated) == 0 {
+		return nil
+	}
+	sort.Strings(saturated)
+	return NewHealthCheckError(HealthStatusDegraded, ReasonWorkqueueSaturated, "workqueues reach depth %.0f: %s",
+		workqueueSaturationDepth, strings.Join(saturated, ", "))
+}
+
+// CertExpiryCheck returns a handler reporting DOWN if the certificate installed by the rotator is missing or expired,
+// and DEGRADED if it is not renewed before the renewal time.
+func CertExpiryCheck(rotator *util.CertRotator) HealthCheckHandler {
+	return func() error {
+		cert := rotator.Current()
+		if cert == nil {
+			return NewHealthCheckError(HealthStatusDown, ReasonCertificateNotInstalled, "certificate %s is not installed", rotator.Target.Name)
+		}
+		remaining := time.Until(cert.NotAfter)
+		if remaining <= 0 {
+			return NewHealthCheckError(HealthStatusDown, ReasonCertificateExpired, "certificate %s expired at %s", rotator.Target.Name, cert.NotAfter.Format(time.RFC3339))
+		}
+		if remaining < rotator.RenewBefore {
+			return NewHealthCheckError(HealthStatusDegraded, ReasonCertificateExpiring, "certificate %s expires at %s and is not renewed", rotator.Target.Name, cert.NotAfter.Format(time.RFC3339))
+		}
+		return nil
+	}
+}
+
+// InventorySyncLagCheck returns a handler reporting DEGRADED if the inventory updates have been failing longer than
+// inventorySyncLagThreshold.
+func InventorySyncLagCheck(syncLag func() time.Duration) HealthCheckHandler {
+	return func() error {
+		if lag := syncLag(); lag > inventorySyncLagThreshold {
+			return NewHealthCheckError(HealthStatusDegraded, ReasonInventorySyncLagging, "inventory updates have been failing for %s", lag.Round(time.Second))
+		}
+		return nil
+	}
+}
@@ -0,0 +1,60 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package health
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+func assertHealthCheckError(t *testing.T, err error, status HealthStatus, reason string) {
+	t.Helper()
+	var checkErr *HealthCheckError
+	require.True(t, errors.As(err, &checkErr), "unexpected error %v", err)
+	assert.Equal(t, status, checkErr.Status)
+	assert.Equal(t, reason, checkErr.Reason)
+}
+
+func TestCheckWorkqueueSaturation(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	depth := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: workqueueDepthMetric}, []string{"name"})
+	registry.MustRegister(depth)
+	defer func(gatherer prometheus.Gatherer) { metricsGatherer = gatherer }(metricsGatherer)
+	metricsGatherer = registry
+
+	depth.WithLabelValues("subnetset").Set(10)
+	assert.NoError(t, checkWorkqueueSaturation())
+
+	depth.WithLabelValues("subnetport").Set(workqueueSaturationDepth)
+	err := checkWorkqueueSaturation()
+	assertHealthCheckError(t, err, HealthStatusDegraded, ReasonWorkqueueSaturated)
+	assert.ErrorContains(t, err, "subnetport=1000")
+	assert.NotContains(t, err.Error(), "subnetset")
+}
+
+func TestCertExpiryCheck(t *testing.T) {
+	rotator := &util.CertRotator{Target: util.EASCertTarget(), RenewBefore: 30 * 24 * time.Hour}
+	assertHealthCheckError(t, CertExpiryCheck(rotator)(), HealthStatusDown, ReasonCertificateNotInstalled)
+}
+
+func TestInventorySyncLagCheck(t *testing.T) {
+	lag := time.Duration(0)
+	check := InventorySyncLagCheck(func() time.Duration { return lag })
+	assert.NoError(t, check())
+
+	lag = inventorySyncLagThreshold + time.Minute
+	assertHealthCheckError(t, check(), HealthStatusDegraded, ReasonInventorySyncLagging)
+}
+
+func TestCheckRestore_NoNSXClient(t *testing.T) {
+	checker := &ClusterHealthChecker{}
+	assert.NoError(t, checker.checkRestore())
+}
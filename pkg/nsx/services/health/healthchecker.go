@@ -2,7 +2,11 @@ package health
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -10,6 +14,7 @@ import (
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/config"
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
 	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
@@ -22,20 +27,82 @@ type HealthStatus string
 const (
 	// HealthStatusHealthy indicates the system is healthy
 	HealthStatusHealthy HealthStatus = "HEALTHY"
+	// HealthStatusDegraded indicates the system works with reduced functionality
+	HealthStatusDegraded HealthStatus = "DEGRADED"
 	// HealthStatusDown indicates the system is down
 	HealthStatusDown HealthStatus = "DOWN"
 	// DefaultReportInterval is the default interval for health status reporting
 	DefaultReportInterval = 60 * time.Second
 )
 
-// HealthCheckHandler defines the interface for health check handlers
+// ReasonCheckFailed is the reason of a handler failed with an error other than HealthCheckError
+const ReasonCheckFailed = "CheckFailed"
+
+// HealthCheckHandler defines the interface for health check handlers. A handler returns a HealthCheckError to report
+// the status and the reason, any other error reports DOWN.
 type HealthCheckHandler func() error
 
+// HealthCheckError is returned by a health check handler when the component is not healthy
+type HealthCheckError struct {
+	Status  HealthStatus
+	Reason  string
+	Message string
+}
+
+func (e *HealthCheckError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Reason, e.Message)
+}
+
+// NewHealthCheckError creates a HealthCheckError with the status, the reason and a formatted message
+func NewHealthCheckError(status HealthStatus, reason string, format string, args ...interface{}) error {
+	return &HealthCheckError{Status: status, Reason: reason, Message: fmt.Sprintf(format, args...)}
+}
+
+// HealthCheckResult is the result of a health check
+type HealthCheckResult struct {
+	Status  HealthStatus
+	Reason  string
+	Message string
+}
+
+func newHealthCheckResult(err error) HealthCheckResult {
+	if err == nil {
+		return HealthCheckResult{Status: HealthStatusHealthy}
+	}
+	var checkErr *HealthCheckError
+	if errors.As(err, &checkErr) {
+		return HealthCheckResult{Status: checkErr.Status, Reason: checkErr.Reason, Message: checkErr.Message}
+	}
+	return HealthCheckResult{Status: HealthStatusDown, Reason: ReasonCheckFailed, Message: err.Error()}
+}
+
+// severity orders the statuses, the overall status is the most severe status of the checks
+func (s HealthStatus) severity() int {
+	switch s {
+	case HealthStatusHealthy:
+		return 0
+	case HealthStatusDegraded:
+		return 1
+	default:
+		return 2
+	}
+}
+
 // HealthCheckHandlers contains all the health check handlers
 type HealthCheckHandlers struct {
+	lock     sync.RWMutex
 	handlers map[string]HealthCheckHandler
 }
 
+// registeredHandlers are the handlers registered by the subsystems, they are run by all the health checkers
+var registeredHandlers = NewHealthCheckHandlers()
+
+// RegisterHandler registers a health check handler of a subsystem, which is run together with the default handlers
+// in each health report. A handler registered with the same name replaces the previous one.
+func RegisterHandler(name string, handler HealthCheckHandler) {
+	registeredHandlers.AddHandler(name, handler)
+}
+
 // NewHealthCheckHandlers creates a new HealthCheckHandlers instance
 func NewHealthCheckHandlers() *HealthCheckHandlers {
 	return &HealthCheckHandlers{
@@ -45,11 +112,15 @@ func NewHealthCheckHandlers() *HealthCheckHandlers {
 
 // AddHandler adds a health check handler
 func (h *HealthCheckHandlers) AddHandler(name string, handler HealthCheckHandler) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
 	h.handlers[name] = handler
 }
 
 // GetHandlers returns all registered handlers
 func (h *HealthCheckHandlers) GetHandlers() map[string]HealthCheckHandler {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
 	handlers := make(map[string]HealthCheckHandler)
 	for name, handler := range h.handlers {
 		handlers[name] = handler
@@ -87,12 +158,19 @@ func NewClusterHealthChecker(nsxClient *nsx.Client, k8sClient client.Client) *Cl
 // registerDefaultHandlers registers the default health check handlers
 func (c *ClusterHealthChecker) registerDefaultHandlers() {
 	// NSX health check handler
-	c.handlers.AddHandler("NSX", c.checkNSXHealth)
+	c.handlers.AddHandler(CheckNSX, c.checkNSXHealth)
 
 	// Kubernetes API server health check handler
-	c.handlers.AddHandler("Kubernetes", c.checkKubernetesHealth)
+	c.handlers.AddHandler(CheckKubernetes, c.checkKubernetesHealth)
 
-	// TODO add _nsx_restore_check
+	// NSX restore check handler
+	c.handlers.AddHandler(CheckRestore, c.checkRestore)
+
+	// NSX store initialization check handler
+	c.handlers.AddHandler(CheckNSXStore, checkNSXStores)
+
+	// Controller workqueue saturation check handler
+	c.handlers.AddHandler(CheckWorkqueue, checkWorkqueueSaturation)
 }
 
 // checkNSXHealth checks the health of NSX
@@ -114,20 +192,45 @@ func (c *ClusterHealthChecker) checkKubernetesHealth() error {
 
 // CheckClusterHealth performs a one-time health check and returns the overall status
 func (c *ClusterHealthChecker) CheckClusterHealth() HealthStatus {
-	handlers := c.handlers.GetHandlers()
-	hasErrors := false
+	status, _ := c.CheckHealth()
+	return status
+}
 
+// CheckHealth runs the default and the registered handlers, and returns the most severe status and the result of each
+// check. The results are also exposed in the health check metric.
+func (c *ClusterHealthChecker) CheckHealth() (HealthStatus, map[string]HealthCheckResult) {
+	handlers := registeredHandlers.GetHandlers()
+	for name, handler := range c.handlers.GetHandlers() {
+		handlers[name] = handler
+	}
+
+	status := HealthStatusHealthy
+	results := make(map[string]HealthCheckResult, len(handlers))
 	for checkItem, checkHandler := range handlers {
-		if err := checkHandler(); err != nil {
-			log.Debug("Health check failed", "component", checkItem, "error", err)
-			hasErrors = true
+		result := newHealthCheckResult(checkHandler())
+		if result.Status != HealthStatusHealthy {
+			log.Debug("Health check failed", "component", checkItem, "status", result.Status, "reason", result.Reason, "message", result.Message)
+		}
+		if result.Status.severity() > status.severity() {
+			status = result.Status
 		}
+		results[checkItem] = result
+		metrics.HealthCheckStatus.DeletePartialMatch(map[string]string{"check": checkItem})
+		metrics.HealthCheckStatus.WithLabelValues(checkItem, string(result.Status), result.Reason).Set(1)
 	}
+	return status, results
+}
 
-	if hasErrors {
-		return HealthStatusDown
+// healthDetail describes the checks which are not healthy, e.g. "Restore: NSXRestoreInProgress: NSX restore is RUNNING"
+func healthDetail(results map[string]HealthCheckResult) string {
+	var details []string
+	for checkItem, result := range results {
+		if result.Status != HealthStatusHealthy {
+			details = append(details, fmt.Sprintf("%s: %s: %s", checkItem, result.Reason, result.Message))
+		}
 	}
-	return HealthStatusHealthy
+	sort.Strings(details)
+	return strings.Join(details, "; ")
 }
 
 // SystemHealthReporter reports system health status to NSX
@@ -206,12 +309,13 @@ func (r *SystemHealthReporter) updateReportInterval(interval int) {
 
 // reportHealthStatus reports the current health status to NSX and returns the reporting interval
 func (r *SystemHealthReporter) reportHealthStatus() (int, error) {
-	healthStatus := r.healthChecker.CheckClusterHealth()
+	healthStatus, results := r.healthChecker.CheckHealth()
+	detail := healthDetail(results)
 
-	log.Debug("Reporting health status", "status", healthStatus, "cluster", r.clusterID)
+	log.Debug("Reporting health status", "status", healthStatus, "detail", detail, "cluster", r.clusterID)
 
 	// Send health status to NSX Manager
-	response, err := r.sendHealthStatusToNSX(healthStatus)
+	response, err := r.sendHealthStatusToNSX(healthStatus, detail)
 	if err != nil {
 		return 0, err
 	}
@@ -224,7 +328,7 @@ func (r *SystemHealthReporter) reportHealthStatus() (int, error) {
 }
 
 // sendHealthStatusToNSX sends the health status to NSX Manager using REST API
-func (r *SystemHealthReporter) sendHealthStatusToNSX(status HealthStatus) (map[string]interface{}, error) {
+func (r *SystemHealthReporter) sendHealthStatusToNSX(status HealthStatus, detail string) (map[string]interface{}, error) {
 	// Convert HealthStatus to string for NSX API
 	statusStr := string(status)
 
@@ -233,6 +337,10 @@ func (r *SystemHealthReporter) sendHealthStatusToNSX(status HealthStatus) (map[s
 		"cluster_id": r.clusterID,
 		"status":     statusStr,
 	}
+	// The reasons of the checks which are not healthy
+	if detail != "" {
+		requestBody["detail"] = detail
+	}
 
 	// Health clients are now using REST API directly
 	// Create the URL for the health status API
@@ -260,6 +368,7 @@ func (r *SystemHealthReporter) extractIntervalFromResponse(response map[string]i
 
 func Start(nsxClient *nsx.Client, cf *config.NSXOperatorConfig, k8sClient client.Client) {
 	log.Info("System health reporter started")
+	metrics.InitializeHealthMetrics()
 	clusterUUID := util.GetClusterUUID(cf.Cluster).String()
 	healthReporter := NewSystemHealthReporter(nsxClient, clusterUUID, k8sClient)
 	healthReporter.Start()
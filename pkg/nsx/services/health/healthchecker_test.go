@@ -5,10 +5,14 @@ package health
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
 )
 
 // TestHealthCheckHandlers tests the HealthCheckHandlers struct
@@ -80,6 +84,37 @@ func TestClusterHealthChecker(t *testing.T) {
 	})
 }
 
+// TestClusterHealthChecker_CheckHealth tests the status and reason reported by the handlers
+func TestClusterHealthChecker_CheckHealth(t *testing.T) {
+	handlers := NewHealthCheckHandlers()
+	handlers.AddHandler("Healthy", func() error { return nil })
+	handlers.AddHandler("Degraded", func() error {
+		return fmt.Errorf("wrapped: %w", NewHealthCheckError(HealthStatusDegraded, "Slow", "queue is %d", 10))
+	})
+	checker := &ClusterHealthChecker{handlers: handlers}
+
+	status, results := checker.CheckHealth()
+	assert.Equal(t, HealthStatusDegraded, status)
+	assert.Equal(t, HealthCheckResult{Status: HealthStatusHealthy}, results["Healthy"])
+	assert.Equal(t, HealthCheckResult{Status: HealthStatusDegraded, Reason: "Slow", Message: "queue is 10"}, results["Degraded"])
+	assert.Equal(t, "Degraded: Slow: queue is 10", healthDetail(results))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.HealthCheckStatus.WithLabelValues("Degraded", string(HealthStatusDegraded), "Slow")))
+
+	// A registered handler is run with the handlers of the checker, and DOWN is the most severe status.
+	RegisterHandler("Registered", func() error { return errors.New("broken") })
+	defer registeredHandlers.AddHandler("Registered", func() error { return nil })
+	status, results = checker.CheckHealth()
+	assert.Equal(t, HealthStatusDown, status)
+	assert.Equal(t, HealthCheckResult{Status: HealthStatusDown, Reason: ReasonCheckFailed, Message: "broken"}, results["Registered"])
+	assert.Equal(t, "Degraded: Slow: queue is 10; Registered: CheckFailed: broken", healthDetail(results))
+
+	// The metric only keeps the current status of a check.
+	handlers.AddHandler("Degraded", func() error { return nil })
+	checker.CheckHealth()
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.HealthCheckStatus.WithLabelValues("Degraded", string(HealthStatusHealthy), "")))
+	assert.Equal(t, 1, metrics.HealthCheckStatus.DeletePartialMatch(map[string]string{"check": "Degraded"}))
+}
+
 // TestSystemHealthReporter tests the SystemHealthReporter struct
 func TestSystemHealthReporter(t *testing.T) {
 	t.Run("extractIntervalFromResponse", func(t *testing.T) {
@@ -4,14 +4,19 @@ import (
 	"context"
 	"crypto/sha1" // #nosec G505: not used for security
 	"fmt"
+	"slices"
 	"sort"
+	"strings"
 
+	vmv1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
 	"github.com/vmware/go-vmware-nsxt/common"
 	"github.com/vmware/go-vmware-nsxt/containerinventory"
 	"gopkg.in/yaml.v2"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	ctrcommon "github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/networkinfo"
@@ -681,3 +686,207 @@ func (s *InventoryService) BuildNetworkPolicy(networkPolicy *networkingv1.Networ
 	}
 	return
 }
+
+// getOriginKind returns the Kubernetes kind recorded in the origin properties, empty for Pods and Ingresses.
+func getOriginKind(originProperties []common.KeyValuePair) string {
+	for _, property := range originProperties {
+		if property.Key == OriginPropertyKind {
+			return property.Value
+		}
+	}
+	return ""
+}
+
+// conditionNetworkErrors returns the network status and errors from the conditions of the types which are not True.
+func conditionNetworkErrors(conditions []metav1.Condition, conditionTypes ...string) (string, []common.NetworkError) {
+	networkStatus := NetworkStatusHealthy
+	networkErrors := make([]common.NetworkError, 0)
+	uniqueErrors := make(map[string]bool)
+	for _, condition := range conditions {
+		if !slices.Contains(conditionTypes, condition.Type) || condition.Status == metav1.ConditionTrue {
+			continue
+		}
+		networkStatus = NetworkStatusUnhealthy
+		errorMessage := condition.Type + ":" + condition.Reason
+		if condition.Message != "" {
+			errorMessage += ":" + condition.Message
+		}
+		if !uniqueErrors[errorMessage] {
+			uniqueErrors[errorMessage] = true
+			networkErrors = append(networkErrors, common.NetworkError{
+				ErrorMessage: errorMessage,
+			})
+		}
+	}
+	return networkStatus, networkErrors
+}
+
+func (s *InventoryService) BuildVirtualMachine(vm *vmv1alpha1.VirtualMachine) (retry bool) {
+	log.Trace("Add VirtualMachine", "VirtualMachine", vm.Name, "Namespace", vm.Namespace)
+	retry = false
+	var containerApplicationIds []string
+	preContainerApplicationInstance := s.ApplicationInstanceStore.GetByKey(string(vm.UID))
+	if preContainerApplicationInstance != nil {
+		containerApplicationIds = preContainerApplicationInstance.(*containerinventory.ContainerApplicationInstance).ContainerApplicationIds
+		preContainerApplicationInstance = *preContainerApplicationInstance.(*containerinventory.ContainerApplicationInstance)
+	}
+	namespace, err := s.GetNamespace(vm.Namespace)
+	if err != nil {
+		retry = true
+		log.Error(err, "Failed to build VirtualMachine", "VirtualMachine", vm)
+		return
+	}
+
+	status := InventoryStatusUnknown
+	switch vm.Status.PowerState {
+	case vmv1alpha1.VirtualMachinePoweredOn:
+		status = InventoryStatusUp
+	case vmv1alpha1.VirtualMachinePoweredOff, vmv1alpha1.VirtualMachineSuspended:
+		status = InventoryStatusDown
+	}
+
+	// A powered on VirtualMachine without IP is unhealthy, the errors are from the conditions which are not True.
+	networkErrors := make([]common.NetworkError, 0)
+	networkStatus := NetworkStatusHealthy
+	if vm.Status.PowerState == vmv1alpha1.VirtualMachinePoweredOn && vm.Status.VmIp == "" {
+		networkStatus = NetworkStatusUnhealthy
+		uniqueErrors := make(map[string]bool)
+		for _, condition := range vm.Status.Conditions {
+			if condition.Message != "" && condition.Status != corev1.ConditionTrue && !uniqueErrors[condition.Message] {
+				uniqueErrors[condition.Message] = true
+				networkErrors = append(networkErrors, common.NetworkError{
+					ErrorMessage: condition.Message,
+				})
+			}
+		}
+	}
+
+	originProperties := []common.KeyValuePair{{Key: OriginPropertyKind, Value: KindVirtualMachine}}
+	if vm.Status.VmIp != "" {
+		originProperties = append(originProperties, common.KeyValuePair{Key: "ip", Value: vm.Status.VmIp})
+	}
+
+	containerApplicationInstance := containerinventory.ContainerApplicationInstance{
+		DisplayName:             vm.Name,
+		ResourceType:            string(ContainerApplicationInstance),
+		Tags:                    GetTagsFromLabels(vm.Labels),
+		ContainerApplicationIds: containerApplicationIds,
+		ContainerClusterId:      util.GetClusterUUID(s.NSXConfig.Cluster).String(),
+		ContainerProjectId:      string(namespace.UID),
+		ExternalId:              string(vm.UID),
+		NetworkErrors:           networkErrors,
+		NetworkStatus:           networkStatus,
+		OriginProperties:        originProperties,
+		Status:                  status,
+	}
+	log.Trace("Build VirtualMachine", "current instance", containerApplicationInstance, "pre instance", preContainerApplicationInstance)
+	operation, _ := s.compareAndMergeUpdate(preContainerApplicationInstance, containerApplicationInstance)
+	if operation != operationNone {
+		s.pendingAdd[containerApplicationInstance.ExternalId] = &containerApplicationInstance
+	}
+	return
+}
+
+func (s *InventoryService) BuildGateway(gateway *gatewayv1.Gateway) (retry bool) {
+	log.Trace("Add Gateway", "Name", gateway.Name, "Namespace", gateway.Namespace)
+	namespace, err := s.GetNamespace(gateway.Namespace)
+	retry = true
+	if err != nil {
+		log.Error(err, "Cannot find namespace for Gateway", "Gateway", gateway)
+		return
+	}
+	spec, err := yaml.Marshal(gateway.Spec)
+	if err != nil {
+		log.Error(err, "Failed to dump spec for Gateway", "Gateway", gateway)
+		return
+	}
+
+	preGateway := s.IngressPolicyStore.GetByKey(string(gateway.UID))
+	if preGateway != nil {
+		preGateway = *preGateway.(*containerinventory.ContainerIngressPolicy)
+	}
+
+	networkStatus, networkErrors := conditionNetworkErrors(gateway.Status.Conditions,
+		string(gatewayv1.GatewayConditionAccepted), string(gatewayv1.GatewayConditionProgrammed))
+	originProperties := []common.KeyValuePair{{Key: OriginPropertyKind, Value: KindGateway}}
+	var addresses []string
+	for _, address := range gateway.Status.Addresses {
+		addresses = append(addresses, address.Value)
+	}
+	if len(addresses) > 0 {
+		originProperties = append(originProperties, common.KeyValuePair{Key: "ip", Value: strings.Join(addresses, ",")})
+	}
+
+	containerIngress := containerinventory.ContainerIngressPolicy{
+		DisplayName:        gateway.Name,
+		ResourceType:       string(ContainerIngressPolicy),
+		Tags:               GetTagsFromLabels(gateway.Labels),
+		ContainerClusterId: util.GetClusterUUID(s.NSXConfig.Cluster).String(),
+		ContainerProjectId: string(namespace.UID),
+		ExternalId:         string(gateway.UID),
+		NetworkErrors:      networkErrors,
+		NetworkStatus:      networkStatus,
+		OriginProperties:   originProperties,
+		Spec:               string(spec),
+	}
+	log.Trace("Build Gateway", "current instance", containerIngress, "pre instance", preGateway)
+	operation, _ := s.compareAndMergeUpdate(preGateway, containerIngress)
+	if operation != operationNone {
+		s.pendingAdd[containerIngress.ExternalId] = &containerIngress
+	}
+	retry = false
+	return
+}
+
+func (s *InventoryService) BuildHTTPRoute(route *gatewayv1.HTTPRoute) (retry bool) {
+	log.Trace("Add HTTPRoute", "Name", route.Name, "Namespace", route.Namespace)
+	namespace, err := s.GetNamespace(route.Namespace)
+	retry = true
+	if err != nil {
+		log.Error(err, "Cannot find namespace for HTTPRoute", "HTTPRoute", route)
+		return
+	}
+	spec, err := yaml.Marshal(route.Spec)
+	if err != nil {
+		log.Error(err, "Failed to dump spec for HTTPRoute", "HTTPRoute", route)
+		return
+	}
+
+	preRoute := s.IngressPolicyStore.GetByKey(string(route.UID))
+	if preRoute != nil {
+		preRoute = *preRoute.(*containerinventory.ContainerIngressPolicy)
+	}
+
+	// The route is unhealthy if it is not accepted by, or its references are not resolved for any parent.
+	var conditions []metav1.Condition
+	for _, parent := range route.Status.Parents {
+		conditions = append(conditions, parent.Conditions...)
+	}
+	networkStatus, networkErrors := conditionNetworkErrors(conditions,
+		string(gatewayv1.RouteConditionAccepted), string(gatewayv1.RouteConditionResolvedRefs))
+
+	containerIngress := containerinventory.ContainerIngressPolicy{
+		DisplayName:        route.Name,
+		ResourceType:       string(ContainerIngressPolicy),
+		Tags:               GetTagsFromLabels(route.Labels),
+		ContainerClusterId: util.GetClusterUUID(s.NSXConfig.Cluster).String(),
+		ContainerProjectId: string(namespace.UID),
+		ExternalId:         string(route.UID),
+		NetworkErrors:      networkErrors,
+		NetworkStatus:      networkStatus,
+		OriginProperties:   []common.KeyValuePair{{Key: OriginPropertyKind, Value: KindHTTPRoute}},
+		Spec:               string(spec),
+	}
+	appIDs := s.getHTTPRouteAppIds(route)
+	if len(appIDs) > 0 {
+		sort.Strings(appIDs)
+		containerIngress.ContainerApplicationIds = appIDs
+	}
+	log.Trace("Build HTTPRoute", "current instance", containerIngress, "pre instance", preRoute)
+	operation, _ := s.compareAndMergeUpdate(preRoute, containerIngress)
+	if operation != operationNone {
+		s.pendingAdd[containerIngress.ExternalId] = &containerIngress
+	}
+	retry = false
+	return
+}
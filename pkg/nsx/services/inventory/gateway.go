@@ -0,0 +1,146 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+
+	"github.com/vmware/go-vmware-nsxt/containerinventory"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// isObjectDeleted checks whether the object is deleted, or replaced by a new one with the same name.
+func (s *InventoryService) isObjectDeleted(namespace, name, externalId string, obj client.Object) bool {
+	err := s.Client.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: namespace}, obj)
+	// The Gateway API CRDs are optional and may be removed.
+	if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) ||
+		((err == nil) && (string(obj.GetUID()) != externalId)) {
+		return true
+	}
+	if err != nil {
+		log.Error(err, "Check object deleted", "Name", name, "Namespace", namespace, "External id", externalId)
+	}
+	return false
+}
+
+func (s *InventoryService) IsGatewayDeleted(namespace, name, externalId string, gateway *gatewayv1.Gateway) bool {
+	if gateway == nil {
+		gateway = &gatewayv1.Gateway{}
+	}
+	return s.isObjectDeleted(namespace, name, externalId, gateway)
+}
+
+func (s *InventoryService) IsHTTPRouteDeleted(namespace, name, externalId string, route *gatewayv1.HTTPRoute) bool {
+	if route == nil {
+		route = &gatewayv1.HTTPRoute{}
+	}
+	return s.isObjectDeleted(namespace, name, externalId, route)
+}
+
+func (s *InventoryService) SyncContainerGateway(name string, namespace string, key InventoryKey) *InventoryKey {
+	gateway := &gatewayv1.Gateway{}
+	externalId := key.ExternalId
+	if deleted := s.IsGatewayDeleted(namespace, name, externalId, gateway); deleted {
+		err := s.DeleteResource(externalId, ContainerIngressPolicy)
+		if err != nil {
+			log.Error(err, "Delete ContainerIngressPolicy Resource error for Gateway", "key", key)
+			return &key
+		}
+	} else if gateway.UID == types.UID(externalId) {
+		retry := s.BuildGateway(gateway)
+		if retry {
+			return &key
+		}
+	} else {
+		log.Error(errors.New("no gateway found"), "Unexpected error is found while processing Gateway", "key", key)
+	}
+	return nil
+}
+
+func (s *InventoryService) SyncContainerHTTPRoute(name string, namespace string, key InventoryKey) *InventoryKey {
+	route := &gatewayv1.HTTPRoute{}
+	externalId := key.ExternalId
+	if deleted := s.IsHTTPRouteDeleted(namespace, name, externalId, route); deleted {
+		err := s.DeleteResource(externalId, ContainerIngressPolicy)
+		if err != nil {
+			log.Error(err, "Delete ContainerIngressPolicy Resource error for HTTPRoute", "key", key)
+			return &key
+		}
+	} else if route.UID == types.UID(externalId) {
+		retry := s.BuildHTTPRoute(route)
+		if retry {
+			return &key
+		}
+	} else {
+		log.Error(errors.New("no HTTPRoute found"), "Unexpected error is found while processing HTTPRoute", "key", key)
+	}
+	return nil
+}
+
+// getHTTPRouteAppIds returns the UIDs of the Services referred by the HTTPRoute backendRefs.
+func (s *InventoryService) getHTTPRouteAppIds(route *gatewayv1.HTTPRoute) []string {
+	serviceSet := sets.Set[types.NamespacedName]{}
+	for _, rule := range route.Spec.Rules {
+		for _, backendRef := range rule.BackendRefs {
+			ref := backendRef.BackendObjectReference
+			if (ref.Group != nil && *ref.Group != "") || (ref.Kind != nil && *ref.Kind != "Service") {
+				continue
+			}
+			namespace := route.Namespace
+			if ref.Namespace != nil {
+				namespace = string(*ref.Namespace)
+			}
+			serviceSet.Insert(types.NamespacedName{Namespace: namespace, Name: string(ref.Name)})
+		}
+	}
+
+	var result []string
+	for serviceName := range serviceSet {
+		service := &corev1.Service{}
+		err := s.Client.Get(context.TODO(), serviceName, service)
+		if err != nil {
+			log.Error(err, "Failed to get service", "service", serviceName)
+			continue
+		}
+		result = append(result, string(service.UID))
+	}
+	return result
+}
+
+// CleanStaleInventoryGateway deletes the ContainerIngressPolicies reported for the Gateways and HTTPRoutes which
+// no longer exist.
+func (s *InventoryService) CleanStaleInventoryGateway() error {
+	log.Trace("Clean stale InventoryGateway")
+	containerIngressPolicies := s.IngressPolicyStore.List()
+	for _, ingressPolicy := range containerIngressPolicies {
+		ingress := ingressPolicy.(*containerinventory.ContainerIngressPolicy)
+		kind := getOriginKind(ingress.OriginProperties)
+		if kind != KindGateway && kind != KindHTTPRoute {
+			continue
+		}
+		project := s.ProjectStore.GetByKey(ingress.ContainerProjectId)
+		deleted := project == nil
+		if project == nil {
+			log.Info("Cannot find ContainerProject by id, so clean up stale InventoryGateway", "Project Id", ingress.ContainerProjectId,
+				"Kind", kind, "Name", ingress.DisplayName, "External Id", ingress.ExternalId)
+		} else if kind == KindGateway {
+			deleted = s.IsGatewayDeleted(project.(*containerinventory.ContainerProject).DisplayName, ingress.DisplayName, ingress.ExternalId, nil)
+		} else {
+			deleted = s.IsHTTPRouteDeleted(project.(*containerinventory.ContainerProject).DisplayName, ingress.DisplayName, ingress.ExternalId, nil)
+		}
+		if deleted {
+			log.Info("Clean stale InventoryGateway", "Kind", kind, "Name", ingress.DisplayName, "External Id", ingress.ExternalId)
+			err := s.DeleteResource(ingress.ExternalId, ContainerIngressPolicy)
+			if err != nil {
+				log.Error(err, "Clean stale InventoryGateway", "External Id", ingress.ExternalId)
+				return err
+			}
+		}
+	}
+	return nil
+}
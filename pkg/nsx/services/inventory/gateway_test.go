@@ -0,0 +1,169 @@
+package inventory
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/go-vmware-nsxt/common"
+	"github.com/vmware/go-vmware-nsxt/containerinventory"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestBuildGateway(t *testing.T) {
+	inventoryService, _ := createService(t)
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(inventoryService), "GetNamespace", func(_ *InventoryService, _ string) (*corev1.Namespace, error) {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1", UID: "ns-uid"}}, nil
+	})
+	defer patches.Reset()
+
+	gateway := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "gw1", Namespace: "ns1", UID: "gw-uid"},
+		Spec:       gatewayv1.GatewaySpec{GatewayClassName: "istio"},
+		Status: gatewayv1.GatewayStatus{
+			Addresses: []gatewayv1.GatewayStatusAddress{{Value: "192.168.0.10"}, {Value: "fd00::10"}},
+			Conditions: []metav1.Condition{
+				{Type: string(gatewayv1.GatewayConditionAccepted), Status: metav1.ConditionTrue, Reason: "Accepted"},
+				{Type: string(gatewayv1.GatewayConditionProgrammed), Status: metav1.ConditionFalse, Reason: "AddressNotAssigned", Message: "no IP"},
+			},
+		},
+	}
+	assert.False(t, inventoryService.BuildGateway(gateway))
+	policy := inventoryService.pendingAdd["gw-uid"].(*containerinventory.ContainerIngressPolicy)
+	assert.Equal(t, string(ContainerIngressPolicy), policy.ResourceType)
+	assert.Equal(t, "gw1", policy.DisplayName)
+	assert.Equal(t, "ns-uid", policy.ContainerProjectId)
+	assert.Equal(t, NetworkStatusUnhealthy, policy.NetworkStatus)
+	assert.Equal(t, []common.NetworkError{{ErrorMessage: "Programmed:AddressNotAssigned:no IP"}}, policy.NetworkErrors)
+	assert.Equal(t, []common.KeyValuePair{{Key: OriginPropertyKind, Value: KindGateway}, {Key: "ip", Value: "192.168.0.10,fd00::10"}}, policy.OriginProperties)
+	assert.Contains(t, policy.Spec, "istio")
+}
+
+func TestBuildHTTPRoute(t *testing.T) {
+	inventoryService, mockClient := createService(t)
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(inventoryService), "GetNamespace", func(_ *InventoryService, _ string) (*corev1.Namespace, error) {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1", UID: "ns-uid"}}, nil
+	})
+	defer patches.Reset()
+
+	otherNamespace := gatewayv1.Namespace("ns2")
+	otherKind := gatewayv1.Kind("ServiceImport")
+	otherGroup := gatewayv1.Group("multicluster.x-k8s.io")
+	route := &gatewayv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "route1", Namespace: "ns1", UID: "route-uid"},
+		Spec: gatewayv1.HTTPRouteSpec{
+			Rules: []gatewayv1.HTTPRouteRule{{
+				BackendRefs: []gatewayv1.HTTPBackendRef{
+					{BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "svc1"}}},
+					{BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "svc2", Namespace: &otherNamespace}}},
+					{BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "import1", Group: &otherGroup, Kind: &otherKind}}},
+				},
+			}},
+		},
+		Status: gatewayv1.HTTPRouteStatus{RouteStatus: gatewayv1.RouteStatus{Parents: []gatewayv1.RouteParentStatus{{
+			Conditions: []metav1.Condition{{Type: string(gatewayv1.RouteConditionAccepted), Status: metav1.ConditionTrue, Reason: "Accepted"}},
+		}}}},
+	}
+	serviceUIDs := map[types.NamespacedName]types.UID{
+		{Namespace: "ns1", Name: "svc1"}: "svc1-uid",
+		{Namespace: "ns2", Name: "svc2"}: "svc2-uid",
+	}
+	mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, key types.NamespacedName, obj client.Object, _ ...client.GetOption) error {
+		obj.(*corev1.Service).UID = serviceUIDs[key]
+		return nil
+	}).Times(2)
+
+	assert.False(t, inventoryService.BuildHTTPRoute(route))
+	policy := inventoryService.pendingAdd["route-uid"].(*containerinventory.ContainerIngressPolicy)
+	assert.Equal(t, "route1", policy.DisplayName)
+	assert.Equal(t, NetworkStatusHealthy, policy.NetworkStatus)
+	assert.Empty(t, policy.NetworkErrors)
+	assert.Equal(t, []string{"svc1-uid", "svc2-uid"}, policy.ContainerApplicationIds)
+	assert.Equal(t, []common.KeyValuePair{{Key: OriginPropertyKind, Value: KindHTTPRoute}}, policy.OriginProperties)
+}
+
+func TestInventoryService_SyncContainerGateway(t *testing.T) {
+	t.Run("GatewayCRDNotInstalled", func(t *testing.T) {
+		inventoryService, mockClient := createService(t)
+		key := InventoryKey{InventoryType: ContainerGateway, ExternalId: "gw-uid", Key: "ns1/gw1"}
+		mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(&meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: gatewayv1.GroupName, Kind: "Gateway"}})
+		var deletedType InventoryType
+		patches := gomonkey.ApplyMethod(reflect.TypeOf(inventoryService), "DeleteResource", func(_ *InventoryService, _ string, resourceType InventoryType) error {
+			deletedType = resourceType
+			return nil
+		})
+		defer patches.Reset()
+
+		assert.Nil(t, inventoryService.SyncContainerGateway("gw1", "ns1", key))
+		assert.Equal(t, ContainerIngressPolicy, deletedType)
+	})
+
+	t.Run("GatewayExists", func(t *testing.T) {
+		inventoryService, mockClient := createService(t)
+		key := InventoryKey{InventoryType: ContainerGateway, ExternalId: "gw-uid", Key: "ns1/gw1"}
+		mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ types.NamespacedName, obj client.Object, _ ...client.GetOption) error {
+			obj.(*gatewayv1.Gateway).UID = "gw-uid"
+			return nil
+		})
+		patches := gomonkey.ApplyMethod(reflect.TypeOf(inventoryService), "BuildGateway", func(_ *InventoryService, _ *gatewayv1.Gateway) bool {
+			return false
+		})
+		defer patches.Reset()
+
+		assert.Nil(t, inventoryService.SyncContainerGateway("gw1", "ns1", key))
+	})
+}
+
+func TestInventoryService_SyncContainerHTTPRoute(t *testing.T) {
+	inventoryService, mockClient := createService(t)
+	key := InventoryKey{InventoryType: ContainerHTTPRoute, ExternalId: "route-uid", Key: "ns1/route1"}
+	mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ types.NamespacedName, obj client.Object, _ ...client.GetOption) error {
+		obj.(*gatewayv1.HTTPRoute).UID = "route-uid"
+		return nil
+	})
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(inventoryService), "BuildHTTPRoute", func(_ *InventoryService, _ *gatewayv1.HTTPRoute) bool {
+		return true
+	})
+	defer patches.Reset()
+
+	assert.Equal(t, &key, inventoryService.SyncContainerHTTPRoute("route1", "ns1", key))
+}
+
+func TestInventoryService_CleanStaleInventoryGateway(t *testing.T) {
+	inventoryService, mockClient := createService(t)
+	assert.NoError(t, inventoryService.ProjectStore.Add(&containerinventory.ContainerProject{DisplayName: "ns1", ResourceType: string(ContainerProject), ExternalId: "ns-uid"}))
+	for _, policy := range []*containerinventory.ContainerIngressPolicy{
+		{DisplayName: "gw1", ExternalId: "gw1-uid", ContainerProjectId: "ns-uid", OriginProperties: []common.KeyValuePair{{Key: OriginPropertyKind, Value: KindGateway}}},
+		{DisplayName: "route1", ExternalId: "route1-uid", ContainerProjectId: "ns-uid", OriginProperties: []common.KeyValuePair{{Key: OriginPropertyKind, Value: KindHTTPRoute}}},
+		// Ingresses are not cleaned with the Gateways and HTTPRoutes.
+		{DisplayName: "ingress1", ExternalId: "ingress1-uid", ContainerProjectId: "stale-ns-uid"},
+	} {
+		policy.ResourceType = string(ContainerIngressPolicy)
+		assert.NoError(t, inventoryService.IngressPolicyStore.Add(policy))
+	}
+
+	mockClient.EXPECT().Get(gomock.Any(), types.NamespacedName{Namespace: "ns1", Name: "gw1"}, gomock.Any()).DoAndReturn(func(_ context.Context, _ types.NamespacedName, obj client.Object, _ ...client.GetOption) error {
+		obj.(*gatewayv1.Gateway).UID = "gw1-uid"
+		return nil
+	})
+	mockClient.EXPECT().Get(gomock.Any(), types.NamespacedName{Namespace: "ns1", Name: "route1"}, gomock.Any()).Return(apierrors.NewNotFound(gatewayv1.Resource("httproutes"), "route1"))
+	var deleted []string
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(inventoryService), "DeleteResource", func(_ *InventoryService, externalId string, _ InventoryType) error {
+		deleted = append(deleted, externalId)
+		return nil
+	})
+	defer patches.Reset()
+
+	assert.NoError(t, inventoryService.CleanStaleInventoryGateway())
+	assert.Equal(t, []string{"route1-uid"}, deleted)
+}
@@ -113,6 +113,10 @@ func (s *InventoryService) CleanStaleInventoryIngressPolicy() error {
 	containerIngressPolicies := s.IngressPolicyStore.List()
 	for _, ingressPolicy := range containerIngressPolicies {
 		ingress := ingressPolicy.(*containerinventory.ContainerIngressPolicy)
+		// Gateways and HTTPRoutes are cleaned in CleanStaleInventoryGateway.
+		if kind := getOriginKind(ingress.OriginProperties); kind == KindGateway || kind == KindHTTPRoute {
+			continue
+		}
 		project := s.ProjectStore.GetByKey(ingress.ContainerProjectId)
 		if project == nil {
 			log.Info("Cannot find ContainerProject by id, so clean up stale InventoryIngressPolicy", "Project Id", ingress.ContainerProjectId,
@@ -5,9 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sync/atomic"
 	"time"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
 	commonservice "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	nsx_util "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
 	"github.com/vmware-tanzu/nsx-operator/pkg/util"
@@ -36,6 +38,10 @@ type InventoryService struct {
 	pendingDelete map[string]interface{}
 
 	stalePods map[string]interface{}
+
+	// syncFailedSince is the Unix time in nanoseconds of the first failed update to NSX since the last successful one,
+	// it is 0 if the last update succeeded.
+	syncFailedSince atomic.Int64
 }
 
 func InitializeService(service commonservice.Service, cleanup bool) (*InventoryService, error) {
@@ -206,6 +212,21 @@ func (s *InventoryService) SyncInventoryObject(bufferedKeys sets.Set[InventoryKe
 			if retryKey != nil {
 				retryKeys.Insert(*retryKey)
 			}
+		case ContainerVirtualMachine:
+			retryKey := s.SyncContainerVirtualMachine(name, namespace, key)
+			if retryKey != nil {
+				retryKeys.Insert(*retryKey)
+			}
+		case ContainerGateway:
+			retryKey := s.SyncContainerGateway(name, namespace, key)
+			if retryKey != nil {
+				retryKeys.Insert(*retryKey)
+			}
+		case ContainerHTTPRoute:
+			retryKey := s.SyncContainerHTTPRoute(name, namespace, key)
+			if retryKey != nil {
+				retryKeys.Insert(*retryKey)
+			}
 		}
 	}
 
@@ -293,9 +314,15 @@ func (s *InventoryService) sendNSXRequestAndUpdateInventoryStore(ctx context.Con
 	if len(s.requestBuffer) > 0 {
 		log.Info("Send update to inventory", "ContainerInventoryData", s.requestBuffer)
 		// TODO, check the context.TODO() be replaced by NsxApiClient related todo
+		requestStart := time.Now()
 		resp, err := s.NSXClient.NsxApiClient.ContainerInventoryApi.AddContainerInventoryUpdateUpdates(ctx,
 			util.GetClusterUUID(s.NSXConfig.Cluster).String(),
 			containerinventory.ContainerInventoryData{ContainerInventoryObjects: s.requestBuffer})
+		result := "success"
+		if err != nil {
+			result = "failure"
+		}
+		metrics.InventoryNSXRequestDuration.WithLabelValues(result).Observe(time.Since(requestStart).Seconds())
 
 		// Update NSX Inventory store when the request succeeds.
 		if resp != nil {
@@ -304,6 +331,11 @@ func (s *InventoryService) sendNSXRequestAndUpdateInventoryStore(ctx context.Con
 		if err == nil {
 			err = s.updateInventoryStore()
 		}
+		if err != nil {
+			s.syncFailedSince.CompareAndSwap(0, time.Now().UnixNano())
+		} else {
+			s.syncFailedSince.Store(0)
+		}
 		s.requestBuffer = make([]containerinventory.ContainerInventoryObject, 0)
 		s.pendingAdd = make(map[string]interface{})
 		s.pendingDelete = make(map[string]interface{})
@@ -312,6 +344,16 @@ func (s *InventoryService) sendNSXRequestAndUpdateInventoryStore(ctx context.Con
 	return nil
 }
 
+// SyncLag returns how long the inventory updates have been failing to be sent to NSX, it is 0 if the last update
+// succeeded.
+func (s *InventoryService) SyncLag() time.Duration {
+	failedSince := s.syncFailedSince.Load()
+	if failedSince == 0 {
+		return 0
+	}
+	return time.Since(time.Unix(0, failedSince))
+}
+
 func (s *InventoryService) updateInventoryStore() error {
 	log.Trace("Update Inventory store after NSX request succeeds")
 	for _, addItem := range s.pendingAdd {
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 
 	"k8s.io/apimachinery/pkg/util/sets"
 
@@ -330,6 +331,32 @@ func TestInventoryService_sendNSXRequestAndUpdateInventoryStore(t *testing.T) {
 	assert.Equal(t, 1, itemNum, "expected 1 item in the inventory, got %d", itemNum)
 }
 
+func TestInventoryService_SyncLag(t *testing.T) {
+	clusterApiService := &nsxt.ManagementPlaneApiFabricContainerInventoryApiService{}
+	inventoryService, _ := createService(t)
+	var sendErr error
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(clusterApiService), "AddContainerInventoryUpdateUpdates", func(_ *nsxt.ManagementPlaneApiFabricContainerInventoryApiService, _ context.Context, _ string, _ containerinventory.ContainerInventoryData) (*http.Response, error) {
+		return nil, sendErr
+	})
+	defer patches.Reset()
+	send := func() error {
+		inventoryService.requestBuffer = []containerinventory.ContainerInventoryObject{{}}
+		return inventoryService.sendNSXRequestAndUpdateInventoryStore(context.TODO())
+	}
+	assert.Equal(t, time.Duration(0), inventoryService.SyncLag())
+
+	// The lag is counted from the first failed update.
+	sendErr = errors.New("NSX is unreachable")
+	assert.Error(t, send())
+	time.Sleep(10 * time.Millisecond)
+	assert.Error(t, send())
+	assert.GreaterOrEqual(t, inventoryService.SyncLag(), 10*time.Millisecond)
+
+	sendErr = nil
+	assert.NoError(t, send())
+	assert.Equal(t, time.Duration(0), inventoryService.SyncLag())
+}
+
 func TestInventoryService_updateInventoryStore(t *testing.T) {
 	service, _ := createService(t)
 
@@ -109,6 +109,10 @@ func (s *InventoryService) CleanStaleInventoryApplicationInstance() error {
 	containerApplicationInstances := s.ApplicationInstanceStore.List()
 	for _, applicationInstance := range containerApplicationInstances {
 		applicationInstance := applicationInstance.(*containerinventory.ContainerApplicationInstance)
+		// VirtualMachines are cleaned in CleanStaleInventoryVirtualMachine.
+		if getOriginKind(applicationInstance.OriginProperties) == KindVirtualMachine {
+			continue
+		}
 		project := s.ProjectStore.GetByKey(applicationInstance.ContainerProjectId)
 		if project == nil {
 			log.Info("Cannot find ContainerProject by id, so clean up stale ContainerApplicationInstance", "Project Id", applicationInstance.ContainerProjectId,
@@ -0,0 +1,75 @@
+package inventory
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
+	commonservice "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+// ListNSXInventoryObjects lists the inventory objects on NSX into the stores of a new InventoryService. It does not
+// access the stores of s, so it can run without blocking the incremental inventory updates.
+func (s *InventoryService) ListNSXInventoryObjects() (*InventoryService, error) {
+	log.Info("List inventory objects from NSX for resync")
+	clusterUUID := util.GetClusterUUID(s.NSXConfig.Cluster).String()
+	nsxService := NewInventoryService(s.Service)
+	if err := nsxService.SyncInventoryStoreByType(clusterUUID); err != nil {
+		return nil, err
+	}
+	return nsxService, nil
+}
+
+// ResyncInventoryObjects compares the inventory objects in the stores with the objects listed from NSX by
+// ListNSXInventoryObjects, and pushes the objects which are changed or removed on NSX side again. The objects which
+// only exist on NSX are added to the stores, so they are cleaned by the next GC if their Kubernetes objects do not
+// exist. The objects updated after the listing are pushed again, which is harmless.
+// It returns the number of objects pushed again.
+func (s *InventoryService) ResyncInventoryObjects(ctx context.Context, nsxService *InventoryService) (int, error) {
+	log.Info("Resync inventory objects with NSX")
+	// The stores are resynced in the dependency order, the same as SyncInventoryStoreByType.
+	storePairs := []struct {
+		store    *commonservice.ResourceStore
+		nsxStore *commonservice.ResourceStore
+	}{
+		{&s.ProjectStore.ResourceStore, &nsxService.ProjectStore.ResourceStore},
+		{&s.ApplicationInstanceStore.ResourceStore, &nsxService.ApplicationInstanceStore.ResourceStore},
+		{&s.ApplicationStore.ResourceStore, &nsxService.ApplicationStore.ResourceStore},
+		{&s.ClusterNodeStore.ResourceStore, &nsxService.ClusterNodeStore.ResourceStore},
+		{&s.NetworkPolicyStore.ResourceStore, &nsxService.NetworkPolicyStore.ResourceStore},
+		{&s.IngressPolicyStore.ResourceStore, &nsxService.IngressPolicyStore.ResourceStore},
+	}
+	resynced := 0
+	for _, pair := range storePairs {
+		for _, obj := range pair.store.List() {
+			externalId := reflect.ValueOf(obj).Elem().FieldByName("ExternalId").String()
+			var pre interface{}
+			if nsxObj := pair.nsxStore.GetByKey(externalId); nsxObj != nil {
+				pre = reflect.ValueOf(nsxObj).Elem().Interface()
+			}
+			operation, _ := s.compareAndMergeUpdate(pre, reflect.ValueOf(obj).Elem().Interface())
+			if operation == operationNone {
+				continue
+			}
+			log.Info("Inventory object differs on NSX, push it again", "external_id", externalId, "operation", operation)
+			s.pendingAdd[externalId] = obj
+			resynced++
+			metrics.InventoryResyncTotal.WithLabelValues(reflect.ValueOf(obj).Elem().FieldByName("ResourceType").String()).Inc()
+		}
+		for _, nsxObj := range pair.nsxStore.List() {
+			externalId := reflect.ValueOf(nsxObj).Elem().FieldByName("ExternalId").String()
+			if pair.store.GetByKey(externalId) != nil {
+				continue
+			}
+			log.Info("Inventory object only exists on NSX, add it to store for GC", "external_id", externalId)
+			if err := pair.store.Add(nsxObj); err != nil {
+				return resynced, err
+			}
+		}
+	}
+	if err := s.sendNSXRequestAndUpdateInventoryStore(ctx); err != nil {
+		return resynced, err
+	}
+	return resynced, nil
+}
@@ -0,0 +1,71 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/go-vmware-nsxt/containerinventory"
+)
+
+func TestInventoryService_ResyncInventoryObjects(t *testing.T) {
+	inventoryService, _ := createService(t)
+	// In sync with NSX.
+	assert.NoError(t, inventoryService.ProjectStore.Add(&containerinventory.ContainerProject{
+		DisplayName: "ns1", ResourceType: string(ContainerProject), ExternalId: "ns1-uid", NetworkStatus: NetworkStatusHealthy,
+	}))
+	// Changed on NSX side.
+	assert.NoError(t, inventoryService.ApplicationInstanceStore.Add(&containerinventory.ContainerApplicationInstance{
+		DisplayName: "pod1", ResourceType: string(ContainerApplicationInstance), ExternalId: "pod1-uid", ContainerProjectId: "ns1-uid", Status: InventoryStatusUp,
+	}))
+	// Removed on NSX side.
+	assert.NoError(t, inventoryService.NetworkPolicyStore.Add(&containerinventory.ContainerNetworkPolicy{
+		DisplayName: "np1", ResourceType: string(ContainerNetworkPolicy), ExternalId: "np1-uid", ContainerProjectId: "ns1-uid",
+	}))
+
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(inventoryService), "SyncInventoryStoreByType", func(s *InventoryService, _ string) error {
+		assert.NotSame(t, inventoryService, s)
+		assert.NoError(t, s.ProjectStore.Add(&containerinventory.ContainerProject{
+			DisplayName: "ns1", ResourceType: string(ContainerProject), ExternalId: "ns1-uid", NetworkStatus: NetworkStatusHealthy,
+		}))
+		assert.NoError(t, s.ApplicationInstanceStore.Add(&containerinventory.ContainerApplicationInstance{
+			DisplayName: "pod1", ResourceType: string(ContainerApplicationInstance), ExternalId: "pod1-uid", ContainerProjectId: "ns1-uid", Status: InventoryStatusDown,
+		}))
+		// Only exists on NSX side.
+		assert.NoError(t, s.ClusterNodeStore.Add(&containerinventory.ContainerClusterNode{
+			DisplayName: "node1", ResourceType: string(ContainerClusterNode), ExternalId: "node1-uid",
+		}))
+		return nil
+	})
+	defer patches.Reset()
+	var requests []containerinventory.ContainerInventoryObject
+	patches.ApplyPrivateMethod(reflect.TypeOf(inventoryService), "sendNSXRequestAndUpdateInventoryStore", func(s *InventoryService, _ context.Context) error {
+		requests = s.requestBuffer
+		return nil
+	})
+
+	nsxService, err := inventoryService.ListNSXInventoryObjects()
+	assert.NoError(t, err)
+	resynced, err := inventoryService.ResyncInventoryObjects(context.TODO(), nsxService)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, resynced)
+	assert.Len(t, requests, 2)
+	assert.Equal(t, operationUpdate, requests[0].ObjectUpdateType)
+	assert.Equal(t, "pod1-uid", requests[0].ContainerObject["external_id"])
+	assert.Equal(t, InventoryStatusUp, requests[0].ContainerObject["status"])
+	assert.Equal(t, operationCreate, requests[1].ObjectUpdateType)
+	assert.Equal(t, "np1-uid", requests[1].ContainerObject["external_id"])
+	assert.NotNil(t, inventoryService.ClusterNodeStore.GetByKey("node1-uid"))
+
+	t.Run("ListFromNSXFailure", func(t *testing.T) {
+		patches := gomonkey.ApplyMethod(reflect.TypeOf(inventoryService), "SyncInventoryStoreByType", func(_ *InventoryService, _ string) error {
+			return errors.New("list error")
+		})
+		defer patches.Reset()
+		_, err := inventoryService.ListNSXInventoryObjects()
+		assert.ErrorContains(t, err, "list error")
+	})
+}
@@ -22,6 +22,18 @@ const (
 	// typically mapping to Kubernetes network policies.
 	ContainerNetworkPolicy InventoryType = "ContainerNetworkPolicy"
 	ContainerIngressPolicy InventoryType = "ContainerIngressPolicy"
+	// ContainerVirtualMachine, ContainerGateway and ContainerHTTPRoute are the inventory types to sync
+	// the VM Service VirtualMachines, Gateway API Gateways and HTTPRoutes. NSX has no such resource types,
+	// a VirtualMachine is reported as a ContainerApplicationInstance, a Gateway or HTTPRoute is reported as
+	// a ContainerIngressPolicy, with the Kubernetes kind in the origin property OriginPropertyKind.
+	ContainerVirtualMachine InventoryType = "ContainerVirtualMachine"
+	ContainerGateway        InventoryType = "ContainerGateway"
+	ContainerHTTPRoute      InventoryType = "ContainerHTTPRoute"
+
+	OriginPropertyKind = "kind"
+	KindVirtualMachine = "VirtualMachine"
+	KindGateway        = "Gateway"
+	KindHTTPRoute      = "HTTPRoute"
 
 	InventoryClusterTypeSupervisor = "SupervisorCluster"
 	InventoryClusterCNIType        = "NCP"
@@ -0,0 +1,79 @@
+package inventory
+
+import (
+	"context"
+
+	vmv1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	"github.com/vmware/go-vmware-nsxt/containerinventory"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// IsVirtualMachineDeleted checks with the result of getting the VirtualMachine whether it is deleted, or replaced by
+// a new one with the same name.
+func IsVirtualMachineDeleted(externalId string, vm *vmv1alpha1.VirtualMachine, err error) bool {
+	// The VirtualMachine CRD is removed when VM Service is disabled.
+	return apierrors.IsNotFound(err) || meta.IsNoMatchError(err) ||
+		((err == nil) && (string(vm.UID) != externalId))
+}
+
+func (s *InventoryService) getVirtualMachine(namespace, name string) (*vmv1alpha1.VirtualMachine, error) {
+	vm := &vmv1alpha1.VirtualMachine{}
+	err := s.Client.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: namespace}, vm)
+	return vm, err
+}
+
+func (s *InventoryService) SyncContainerVirtualMachine(name string, namespace string, key InventoryKey) *InventoryKey {
+	externalId := key.ExternalId
+	vm, err := s.getVirtualMachine(namespace, name)
+	if IsVirtualMachineDeleted(externalId, vm, err) {
+		err = s.DeleteResource(externalId, ContainerApplicationInstance)
+		if err != nil {
+			log.Error(err, "Delete ContainerApplicationInstance Resource error for VirtualMachine", "key", key)
+			return &key
+		}
+	} else if err == nil {
+		retry := s.BuildVirtualMachine(vm)
+		if retry {
+			return &key
+		}
+	} else {
+		log.Error(err, "Unexpected error is found while processing VirtualMachine", "key", key)
+	}
+	return nil
+}
+
+// CleanStaleInventoryVirtualMachine deletes the ContainerApplicationInstances reported for the VirtualMachines which
+// no longer exist.
+func (s *InventoryService) CleanStaleInventoryVirtualMachine() error {
+	log.Trace("Clean stale InventoryVirtualMachine")
+	containerApplicationInstances := s.ApplicationInstanceStore.List()
+	for _, applicationInstance := range containerApplicationInstances {
+		applicationInstance := applicationInstance.(*containerinventory.ContainerApplicationInstance)
+		if getOriginKind(applicationInstance.OriginProperties) != KindVirtualMachine {
+			continue
+		}
+		project := s.ProjectStore.GetByKey(applicationInstance.ContainerProjectId)
+		if project == nil {
+			log.Info("Cannot find ContainerProject by id, so clean up stale VirtualMachine", "Project Id", applicationInstance.ContainerProjectId,
+				"VirtualMachine name", applicationInstance.DisplayName, "External Id", applicationInstance.ExternalId)
+			err := s.DeleteResource(applicationInstance.ExternalId, ContainerApplicationInstance)
+			if err != nil {
+				log.Error(err, "Clean stale InventoryVirtualMachine", "External Id", applicationInstance.ExternalId)
+				return err
+			}
+			continue
+		}
+		vm, err := s.getVirtualMachine(project.(*containerinventory.ContainerProject).DisplayName, applicationInstance.DisplayName)
+		if IsVirtualMachineDeleted(applicationInstance.ExternalId, vm, err) {
+			log.Info("Clean stale VirtualMachine", "Name", applicationInstance.DisplayName, "External Id", applicationInstance.ExternalId)
+			err = s.DeleteResource(applicationInstance.ExternalId, ContainerApplicationInstance)
+			if err != nil {
+				log.Error(err, "Clean stale InventoryVirtualMachine", "External Id", applicationInstance.ExternalId)
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package inventory
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	vmv1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	"github.com/vmware/go-vmware-nsxt/common"
+	"github.com/vmware/go-vmware-nsxt/containerinventory"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestBuildVirtualMachine(t *testing.T) {
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1", UID: "ns-uid"}}
+	tests := []struct {
+		name                   string
+		vm                     *vmv1alpha1.VirtualMachine
+		expectStatus           string
+		expectNetworkStatus    string
+		expectNetworkErrors    int
+		expectOriginProperties []common.KeyValuePair
+	}{
+		{
+			name: "powered on VirtualMachine with IP",
+			vm: &vmv1alpha1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "vm1", Namespace: "ns1", UID: "vm-uid", Labels: map[string]string{"app": "db"}},
+				Status:     vmv1alpha1.VirtualMachineStatus{PowerState: vmv1alpha1.VirtualMachinePoweredOn, VmIp: "10.0.0.10"},
+			},
+			expectStatus:           InventoryStatusUp,
+			expectNetworkStatus:    NetworkStatusHealthy,
+			expectOriginProperties: []common.KeyValuePair{{Key: OriginPropertyKind, Value: KindVirtualMachine}, {Key: "ip", Value: "10.0.0.10"}},
+		},
+		{
+			name: "powered on VirtualMachine without IP",
+			vm: &vmv1alpha1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "vm1", Namespace: "ns1", UID: "vm-uid"},
+				Status: vmv1alpha1.VirtualMachineStatus{
+					PowerState: vmv1alpha1.VirtualMachinePoweredOn,
+					Conditions: []vmv1alpha1.Condition{
+						{Type: "VirtualMachinePrereqReady", Status: corev1.ConditionFalse, Message: "network is not ready"},
+						{Type: "GuestCustomization", Status: corev1.ConditionTrue, Message: "done"},
+					},
+				},
+			},
+			expectStatus:           InventoryStatusUp,
+			expectNetworkStatus:    NetworkStatusUnhealthy,
+			expectNetworkErrors:    1,
+			expectOriginProperties: []common.KeyValuePair{{Key: OriginPropertyKind, Value: KindVirtualMachine}},
+		},
+		{
+			name: "powered off VirtualMachine",
+			vm: &vmv1alpha1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "vm1", Namespace: "ns1", UID: "vm-uid"},
+				Status:     vmv1alpha1.VirtualMachineStatus{PowerState: vmv1alpha1.VirtualMachinePoweredOff},
+			},
+			expectStatus:           InventoryStatusDown,
+			expectNetworkStatus:    NetworkStatusHealthy,
+			expectOriginProperties: []common.KeyValuePair{{Key: OriginPropertyKind, Value: KindVirtualMachine}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inventoryService, _ := createService(t)
+			patches := gomonkey.ApplyMethod(reflect.TypeOf(inventoryService), "GetNamespace", func(_ *InventoryService, _ string) (*corev1.Namespace, error) {
+				return namespace, nil
+			})
+			defer patches.Reset()
+
+			retry := inventoryService.BuildVirtualMachine(tt.vm)
+			assert.False(t, retry)
+			instance := inventoryService.pendingAdd["vm-uid"].(*containerinventory.ContainerApplicationInstance)
+			assert.Equal(t, string(ContainerApplicationInstance), instance.ResourceType)
+			assert.Equal(t, "vm1", instance.DisplayName)
+			assert.Equal(t, "ns-uid", instance.ContainerProjectId)
+			assert.Equal(t, clusterUUID, instance.ContainerClusterId)
+			assert.Equal(t, tt.expectStatus, instance.Status)
+			assert.Equal(t, tt.expectNetworkStatus, instance.NetworkStatus)
+			assert.Len(t, instance.NetworkErrors, tt.expectNetworkErrors)
+			assert.Equal(t, tt.expectOriginProperties, instance.OriginProperties)
+			assert.Len(t, inventoryService.requestBuffer, 1)
+		})
+	}
+}
+
+func TestInventoryService_SyncContainerVirtualMachine(t *testing.T) {
+	t.Run("VirtualMachineNotFound", func(t *testing.T) {
+		inventoryService, mockClient := createService(t)
+		key := InventoryKey{InventoryType: ContainerVirtualMachine, ExternalId: "vm-uid", Key: "ns1/vm1"}
+		mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(apierrors.NewNotFound(vmv1alpha1.GroupVersion.WithResource("virtualmachines").GroupResource(), "vm1")).Times(1)
+		var deletedType InventoryType
+		patches := gomonkey.ApplyMethod(reflect.TypeOf(inventoryService), "DeleteResource", func(_ *InventoryService, _ string, resourceType InventoryType) error {
+			deletedType = resourceType
+			return nil
+		})
+		defer patches.Reset()
+
+		assert.Nil(t, inventoryService.SyncContainerVirtualMachine("vm1", "ns1", key))
+		assert.Equal(t, ContainerApplicationInstance, deletedType)
+	})
+
+	t.Run("VirtualMachineExists", func(t *testing.T) {
+		inventoryService, mockClient := createService(t)
+		key := InventoryKey{InventoryType: ContainerVirtualMachine, ExternalId: "vm-uid", Key: "ns1/vm1"}
+		mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ types.NamespacedName, obj client.Object, _ ...client.GetOption) error {
+			obj.(*vmv1alpha1.VirtualMachine).UID = "vm-uid"
+			return nil
+		}).Times(1)
+		patches := gomonkey.ApplyMethod(reflect.TypeOf(inventoryService), "BuildVirtualMachine", func(_ *InventoryService, _ *vmv1alpha1.VirtualMachine) bool {
+			return true
+		})
+		defer patches.Reset()
+
+		assert.Equal(t, &key, inventoryService.SyncContainerVirtualMachine("vm1", "ns1", key))
+	})
+}
+
+func TestInventoryService_CleanStaleInventoryVirtualMachine(t *testing.T) {
+	inventoryService, mockClient := createService(t)
+	project := &containerinventory.ContainerProject{DisplayName: "ns1", ResourceType: string(ContainerProject), ExternalId: "ns-uid"}
+	assert.NoError(t, inventoryService.ProjectStore.Add(project))
+	vmKind := []common.KeyValuePair{{Key: OriginPropertyKind, Value: KindVirtualMachine}}
+	assert.NoError(t, inventoryService.ApplicationInstanceStore.Add(&containerinventory.ContainerApplicationInstance{
+		DisplayName: "vm1", ResourceType: string(ContainerApplicationInstance), ExternalId: "vm1-uid", ContainerProjectId: "ns-uid", OriginProperties: vmKind,
+	}))
+	assert.NoError(t, inventoryService.ApplicationInstanceStore.Add(&containerinventory.ContainerApplicationInstance{
+		DisplayName: "vm2", ResourceType: string(ContainerApplicationInstance), ExternalId: "vm2-uid", ContainerProjectId: "stale-ns-uid", OriginProperties: vmKind,
+	}))
+	// Pods are not cleaned with the VirtualMachines.
+	assert.NoError(t, inventoryService.ApplicationInstanceStore.Add(&containerinventory.ContainerApplicationInstance{
+		DisplayName: "pod1", ResourceType: string(ContainerApplicationInstance), ExternalId: "pod1-uid", ContainerProjectId: "stale-ns-uid",
+	}))
+
+	mockClient.EXPECT().Get(gomock.Any(), types.NamespacedName{Namespace: "ns1", Name: "vm1"}, gomock.Any()).Return(apierrors.NewNotFound(vmv1alpha1.GroupVersion.WithResource("virtualmachines").GroupResource(), "vm1"))
+	var deleted []string
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(inventoryService), "DeleteResource", func(_ *InventoryService, externalId string, _ InventoryType) error {
+		deleted = append(deleted, externalId)
+		return nil
+	})
+	defer patches.Reset()
+
+	assert.NoError(t, inventoryService.CleanStaleInventoryVirtualMachine())
+	assert.ElementsMatch(t, []string{"vm1-uid", "vm2-uid"}, deleted)
+}
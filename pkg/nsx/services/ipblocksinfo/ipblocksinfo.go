@@ -54,13 +54,19 @@ func NewIPBlocksInfoSyncTask(syncInterval time.Duration, retryInterval time.Dura
 }
 
 func InitializeIPBlocksInfoService(service common.Service, subnetService common.SubnetServiceProvider) *IPBlocksInfoService {
-	ipBlocksInfoService := &IPBlocksInfoService{
+	ipBlocksInfoService := NewIPBlocksInfoService(service, subnetService)
+	go ipBlocksInfoService.StartPeriodicSync()
+	return ipBlocksInfoService
+}
+
+// NewIPBlocksInfoService creates the service without starting the periodic sync, which updates the IPBlocksInfo CR
+// and should be started on the leader only.
+func NewIPBlocksInfoService(service common.Service, subnetService common.SubnetServiceProvider) *IPBlocksInfoService {
+	return &IPBlocksInfoService{
 		Service:       service,
 		SyncTask:      NewIPBlocksInfoSyncTask(syncInterval, retryInterval),
 		subnetService: subnetService,
 	}
-	go ipBlocksInfoService.StartPeriodicSync()
-	return ipBlocksInfoService
 }
 
 func (s *IPBlocksInfoService) StartPeriodicSync() {
@@ -331,7 +337,7 @@ func (s *IPBlocksInfoService) getIPBlockCIDRsByVPCConfig(vpcConfigList []v1alpha
 	// for all VPC path, get VPCConnectivityProfile from VPC attachment
 	vpcAttachmentStore := NewVpcAttachmentStore()
 	queryParam := fmt.Sprintf("%s:%s", common.ResourceType, common.ResourceTypeVpcAttachment)
-	count, err = s.SearchResource(common.ResourceTypeVpcAttachment, queryParam, vpcAttachmentStore, nil)
+	count, err = s.SearchResource(context.TODO(), common.ResourceTypeVpcAttachment, queryParam, vpcAttachmentStore, nil)
 	if err != nil {
 		log.Error(err, "failed to query VPC attachment")
 		return
@@ -371,7 +377,7 @@ func (s *IPBlocksInfoService) getIPBlockCIDRsByVPCConfig(vpcConfigList []v1alpha
 		BindingType: model.VpcConnectivityProfileBindingType(),
 	}}
 	queryParam = fmt.Sprintf("%s:%s", common.ResourceType, common.ResourceTypeVpcConnectivityProfile)
-	count, err = s.SearchResource(common.ResourceTypeVpcConnectivityProfile, queryParam, vpcConnectivityProfileStore, nil)
+	count, err = s.SearchResource(context.TODO(), common.ResourceTypeVpcConnectivityProfile, queryParam, vpcConnectivityProfileStore, nil)
 	if err != nil {
 		return
 	}
@@ -408,7 +414,7 @@ func (s *IPBlocksInfoService) getIPBlockCIDRsByVPCConfig(vpcConfigList []v1alpha
 		BindingType: model.IpAddressBlockBindingType(),
 	}}
 	queryParam = fmt.Sprintf("%s:%s", common.ResourceType, common.ResourceTypeIPBlock)
-	count, err = s.SearchResource(common.ResourceTypeIPBlock, queryParam, ipBlockStore, nil)
+	count, err = s.SearchResource(context.TODO(), common.ResourceTypeIPBlock, queryParam, ipBlockStore, nil)
 	if err != nil {
 		return
 	}
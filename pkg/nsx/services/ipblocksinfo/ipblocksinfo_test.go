@@ -65,7 +65,7 @@ func createService(t *testing.T) (*IPBlocksInfoService, *gomock.Controller, *moc
 	return service, mockCtl, k8sClient
 }
 
-func fakeSearchResource(_ *common.Service, resourceTypeValue string, _ string, store common.Store, _ common.Filter) (uint64, error) {
+func fakeSearchResource(_ *common.Service, _ context.Context, resourceTypeValue string, _ string, store common.Store, _ common.Filter) (uint64, error) {
 	var count uint64
 	switch resourceTypeValue {
 	case common.ResourceTypeVpcAttachment:
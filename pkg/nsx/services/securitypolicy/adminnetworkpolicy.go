@@ -0,0 +1,372 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package securitypolicy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	policyv1alpha1 "sigs.k8s.io/network-policy-api/apis/v1alpha1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+const (
+	adminNetworkPolicySuffix         = "anp"
+	baselineAdminNetworkPolicySuffix = "banp"
+
+	securityPolicyCategoryEnvironment = "Environment"
+	securityPolicyCategoryApplication = "Application"
+)
+
+// adminNetworkPolicy is the common form of AdminNetworkPolicy and BaselineAdminNetworkPolicy, whose rules are
+// already converted to SecurityPolicy rules.
+type adminNetworkPolicy struct {
+	name       string
+	uid        types.UID
+	createdFor string
+	priority   int
+	subject    policyv1alpha1.AdminNetworkPolicySubject
+	rules      []v1alpha1.SecurityPolicyRule
+}
+
+func adminNetworkPolicySuffixFor(createdFor string) string {
+	if createdFor == common.ResourceTypeBaselineAdminNetworkPolicy {
+		return baselineAdminNetworkPolicySuffix
+	}
+	return adminNetworkPolicySuffix
+}
+
+// buildAdminNetworkPolicySectionUID builds the UID of the internal SecurityPolicy generated for the AdminNetworkPolicy
+// or BaselineAdminNetworkPolicy in a Namespace, in the format of ${uid}.${namespace}_anp or ${uid}.${namespace}_banp.
+func buildAdminNetworkPolicySectionUID(uid types.UID, namespace, createdFor string) string {
+	return fmt.Sprintf("%s.%s%s%s", uid, namespace, common.ConnectorUnderline, adminNetworkPolicySuffixFor(createdFor))
+}
+
+// isAdminNetworkPolicySection returns whether the internal SecurityPolicy UID is generated for an AdminNetworkPolicy
+// or BaselineAdminNetworkPolicy, they share the NetworkPolicy tag scopes with the NetworkPolicy sections.
+func isAdminNetworkPolicySection(uid string) bool {
+	_, suffix := parseSuffixInUid(types.UID(uid))
+	return suffix == adminNetworkPolicySuffix || suffix == baselineAdminNetworkPolicySuffix
+}
+
+// parseAdminNetworkPolicySectionUID returns the AdminNetworkPolicy or BaselineAdminNetworkPolicy UID of the internal
+// SecurityPolicy UID, and whether the section is created for the given resource type.
+func parseAdminNetworkPolicySectionUID(sectionUID, createdFor string) (string, bool) {
+	uidAndNamespace, suffix := parseSuffixInUid(types.UID(sectionUID))
+	if suffix != adminNetworkPolicySuffixFor(createdFor) {
+		return "", false
+	}
+	uid, _, _ := strings.Cut(uidAndNamespace, ".")
+	return uid, true
+}
+
+func newAdminNetworkPolicy(obj client.Object) (*adminNetworkPolicy, error) {
+	switch obj := obj.(type) {
+	case *policyv1alpha1.AdminNetworkPolicy:
+		anp := &adminNetworkPolicy{
+			name:       obj.Name,
+			uid:        obj.UID,
+			createdFor: common.ResourceTypeAdminNetworkPolicy,
+			priority:   int(obj.Spec.Priority),
+			subject:    obj.Spec.Subject,
+		}
+		for _, ingress := range obj.Spec.Ingress {
+			rule, err := convertAdminNetworkPolicyRule(ingress.Name, string(ingress.Action), v1alpha1.RuleDirectionIn, ingress.Ports)
+			if err != nil {
+				return nil, err
+			}
+			for _, p := range ingress.From {
+				rule.From = append(rule.From, convertAdminNetworkPolicyPeer(p.Namespaces, p.Pods))
+			}
+			anp.rules = append(anp.rules, *rule)
+		}
+		for _, egress := range obj.Spec.Egress {
+			rule, err := convertAdminNetworkPolicyRule(egress.Name, string(egress.Action), v1alpha1.RuleDirectionOut, egress.Ports)
+			if err != nil {
+				return nil, err
+			}
+			for _, p := range egress.To {
+				if len(p.DomainNames) > 0 {
+					rule.To = append(rule.To, convertAdminNetworkPolicyDomainNames(p.DomainNames))
+					continue
+				}
+				peer, err := convertAdminNetworkPolicyEgressPeer(egress.Name, p.Namespaces, p.Pods, p.Nodes, p.Networks)
+				if err != nil {
+					return nil, err
+				}
+				rule.To = append(rule.To, *peer)
+			}
+			anp.rules = append(anp.rules, *rule)
+		}
+		return anp, nil
+	case *policyv1alpha1.BaselineAdminNetworkPolicy:
+		banp := &adminNetworkPolicy{
+			name:       obj.Name,
+			uid:        obj.UID,
+			createdFor: common.ResourceTypeBaselineAdminNetworkPolicy,
+			priority:   common.PriorityBaselineAdminNetworkPolicy,
+			subject:    obj.Spec.Subject,
+		}
+		for _, ingress := range obj.Spec.Ingress {
+			rule, err := convertAdminNetworkPolicyRule(ingress.Name, string(ingress.Action), v1alpha1.RuleDirectionIn, ingress.Ports)
+			if err != nil {
+				return nil, err
+			}
+			for _, p := range ingress.From {
+				rule.From = append(rule.From, convertAdminNetworkPolicyPeer(p.Namespaces, p.Pods))
+			}
+			banp.rules = append(banp.rules, *rule)
+		}
+		for _, egress := range obj.Spec.Egress {
+			rule, err := convertAdminNetworkPolicyRule(egress.Name, string(egress.Action), v1alpha1.RuleDirectionOut, egress.Ports)
+			if err != nil {
+				return nil, err
+			}
+			for _, p := range egress.To {
+				peer, err := convertAdminNetworkPolicyEgressPeer(egress.Name, p.Namespaces, p.Pods, p.Nodes, p.Networks)
+				if err != nil {
+					return nil, err
+				}
+				rule.To = append(rule.To, *peer)
+			}
+			banp.rules = append(banp.rules, *rule)
+		}
+		return banp, nil
+	}
+	return nil, fmt.Errorf("unsupported admin network policy type %T", obj)
+}
+
+func convertAdminNetworkPolicyRuleAction(action string) (v1alpha1.RuleAction, error) {
+	switch action {
+	case string(policyv1alpha1.AdminNetworkPolicyRuleActionAllow):
+		return v1alpha1.RuleActionAllow, nil
+	case string(policyv1alpha1.AdminNetworkPolicyRuleActionDeny):
+		return v1alpha1.RuleActionDrop, nil
+	case string(policyv1alpha1.AdminNetworkPolicyRuleActionPass):
+		return ruleActionPass, nil
+	}
+	return "", &nsxutil.ValidationError{Desc: fmt.Sprintf("invalid admin network policy rule action %s", action)}
+}
+
+func convertAdminNetworkPolicyRule(name, action string, direction v1alpha1.RuleDirection, ports *[]policyv1alpha1.AdminNetworkPolicyPort) (*v1alpha1.SecurityPolicyRule, error) {
+	ruleAction, err := convertAdminNetworkPolicyRuleAction(action)
+	if err != nil {
+		return nil, err
+	}
+	rule := &v1alpha1.SecurityPolicyRule{
+		Name:      name,
+		Action:    &ruleAction,
+		Direction: &direction,
+	}
+	if ports == nil {
+		return rule, nil
+	}
+	for _, p := range *ports {
+		spPort, err := convertAdminNetworkPolicyPort(&p)
+		if err != nil {
+			return nil, err
+		}
+		rule.Ports = append(rule.Ports, *spPort)
+	}
+	return rule, nil
+}
+
+func convertAdminNetworkPolicyPeer(namespaces *metav1.LabelSelector, pods *policyv1alpha1.NamespacedPod) v1alpha1.SecurityPolicyPeer {
+	if pods != nil {
+		return v1alpha1.SecurityPolicyPeer{
+			PodSelector:       &pods.PodSelector,
+			NamespaceSelector: &pods.NamespaceSelector,
+		}
+	}
+	return v1alpha1.SecurityPolicyPeer{
+		PodSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{},
+		},
+		NamespaceSelector: namespaces,
+	}
+}
+
+func convertAdminNetworkPolicyEgressPeer(ruleName string, namespaces *metav1.LabelSelector, pods *policyv1alpha1.NamespacedPod,
+	nodes *metav1.LabelSelector, networks []policyv1alpha1.CIDR,
+) (*v1alpha1.SecurityPolicyPeer, error) {
+	if nodes != nil {
+		return nil, &nsxutil.ValidationError{Desc: fmt.Sprintf("unsupported admin network policy egress peer nodes in rule %q", ruleName)}
+	}
+	if len(networks) > 0 {
+		peer := &v1alpha1.SecurityPolicyPeer{}
+		for _, cidr := range networks {
+			peer.IPBlocks = append(peer.IPBlocks, v1alpha1.IPBlock{CIDR: string(cidr)})
+		}
+		return peer, nil
+	}
+	peer := convertAdminNetworkPolicyPeer(namespaces, pods)
+	return &peer, nil
+}
+
+// convertAdminNetworkPolicyDomainNames converts the domainNames egress peer to the FQDNs peer, which is realized
+// with the context profile as the FQDNs of SecurityPolicy.
+func convertAdminNetworkPolicyDomainNames(domainNames []policyv1alpha1.DomainName) v1alpha1.SecurityPolicyPeer {
+	peer := v1alpha1.SecurityPolicyPeer{}
+	for _, domainName := range domainNames {
+		peer.FQDNs = append(peer.FQDNs, string(domainName))
+	}
+	return peer
+}
+
+// convertAdminNetworkPolicyPort converts the AdminNetworkPolicyPort to SecurityPolicyPort. The named port has no
+// protocol in AdminNetworkPolicy, so it is resolved with TCP which is the default protocol of the container port.
+func convertAdminNetworkPolicyPort(port *policyv1alpha1.AdminNetworkPolicyPort) (*v1alpha1.SecurityPolicyPort, error) {
+	switch {
+	case port.PortNumber != nil:
+		return &v1alpha1.SecurityPolicyPort{
+			Protocol: port.PortNumber.Protocol,
+			Port:     intstr.FromInt32(port.PortNumber.Port),
+		}, nil
+	case port.NamedPort != nil:
+		return &v1alpha1.SecurityPolicyPort{
+			Protocol: corev1.ProtocolTCP,
+			Port:     intstr.FromString(*port.NamedPort),
+		}, nil
+	case port.PortRange != nil:
+		return &v1alpha1.SecurityPolicyPort{
+			Protocol: port.PortRange.Protocol,
+			Port:     intstr.FromInt32(port.PortRange.Start),
+			EndPort:  int(port.PortRange.End),
+		}, nil
+	}
+	return nil, &nsxutil.ValidationError{Desc: "empty admin network policy port"}
+}
+
+// generateSectionForAdminNetworkPolicy generates the internal SecurityPolicy of the AdminNetworkPolicy or
+// BaselineAdminNetworkPolicy in the subject Namespace.
+func (service *SecurityPolicyService) generateSectionForAdminNetworkPolicy(anp *adminNetworkPolicy, namespace string) *v1alpha1.SecurityPolicy {
+	podSelector := &metav1.LabelSelector{
+		MatchLabels: map[string]string{},
+	}
+	if anp.subject.Pods != nil {
+		podSelector = anp.subject.Pods.PodSelector.DeepCopy()
+	}
+	section := &v1alpha1.SecurityPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      anp.name,
+			UID:       types.UID(buildAdminNetworkPolicySectionUID(anp.uid, namespace, anp.createdFor)),
+		},
+		Spec: v1alpha1.SecurityPolicySpec{
+			Priority: anp.priority,
+			AppliedTo: []v1alpha1.SecurityPolicyTarget{
+				{
+					PodSelector: podSelector,
+				},
+			},
+		},
+	}
+	for _, rule := range anp.rules {
+		section.Spec.Rules = append(section.Spec.Rules, *rule.DeepCopy())
+	}
+	return section
+}
+
+// listAdminNetworkPolicySectionUIDs lists the UIDs of the internal SecurityPolicies realized for the
+// AdminNetworkPolicy or BaselineAdminNetworkPolicy.
+func (service *SecurityPolicyService) listAdminNetworkPolicySectionUIDs(uid types.UID, createdFor string) sets.Set[string] {
+	sectionUIDs := sets.New[string]()
+	for sectionUID := range service.getGCSecurityPolicyIDSet(common.TagScopeNetworkPolicyUID) {
+		if parentUID, ok := parseAdminNetworkPolicySectionUID(sectionUID, createdFor); ok && parentUID == string(uid) {
+			sectionUIDs.Insert(sectionUID)
+		}
+	}
+	return sectionUIDs
+}
+
+// NamespaceVPCNotReadyError is returned when some subject Namespaces of an AdminNetworkPolicy or
+// BaselineAdminNetworkPolicy have no VPC yet. The policy is realized in the other Namespaces, and it should be retried
+// until the VPCs are created.
+type NamespaceVPCNotReadyError struct {
+	Namespaces []string
+}
+
+func (e *NamespaceVPCNotReadyError) Error() string {
+	return fmt.Sprintf("VPC is not ready in Namespaces %v", e.Namespaces)
+}
+
+// CreateOrUpdateAdminNetworkPolicy realizes the AdminNetworkPolicy or BaselineAdminNetworkPolicy with an internal
+// SecurityPolicy in each of the subject Namespaces, and deletes the internal SecurityPolicies in the Namespaces which
+// are no longer selected. The Namespaces without VPC are skipped and reported with a NamespaceVPCNotReadyError.
+func (service *SecurityPolicyService) CreateOrUpdateAdminNetworkPolicy(obj client.Object, namespaces []string) error {
+	if !nsxutil.GetDFWLicense() {
+		log.Warn("No DFW license, skip creating AdminNetworkPolicy.")
+		return nsxutil.RestrictionError{Desc: "no DFW license"}
+	}
+	if !IsVPCEnabled(service) {
+		return errors.New("admin network policy is only supported with VPC network")
+	}
+	anp, err := newAdminNetworkPolicy(obj)
+	if err != nil {
+		return err
+	}
+
+	var errList []error
+	var pendingNamespaces []string
+	desired := sets.New[string]()
+	for _, namespace := range namespaces {
+		if len(service.vpcService.ListVPCInfo(namespace)) == 0 {
+			log.Debug("Skip AdminNetworkPolicy in Namespace without VPC", "createdFor", anp.createdFor, "name", anp.name, "namespace", namespace)
+			pendingNamespaces = append(pendingNamespaces, namespace)
+			continue
+		}
+		section := service.generateSectionForAdminNetworkPolicy(anp, namespace)
+		desired.Insert(string(section.UID))
+		if err := service.createOrUpdateVPCSecurityPolicy(context.TODO(), section, anp.createdFor); err != nil {
+			errList = append(errList, fmt.Errorf("namespace %s: %w", namespace, err))
+		}
+	}
+	for sectionUID := range service.listAdminNetworkPolicySectionUIDs(anp.uid, anp.createdFor).Difference(desired) {
+		if err := service.DeleteSecurityPolicy(context.TODO(), types.UID(sectionUID), false, anp.createdFor); err != nil {
+			errList = append(errList, err)
+		}
+	}
+	if err := errors.Join(errList...); err != nil {
+		return err
+	}
+	if len(pendingNamespaces) > 0 {
+		return &NamespaceVPCNotReadyError{Namespaces: pendingNamespaces}
+	}
+	return nil
+}
+
+// DeleteAdminNetworkPolicy deletes the internal SecurityPolicies of the AdminNetworkPolicy or
+// BaselineAdminNetworkPolicy in all Namespaces.
+func (service *SecurityPolicyService) DeleteAdminNetworkPolicy(uid types.UID, isGC bool, createdFor string) error {
+	var errList []error
+	for sectionUID := range service.listAdminNetworkPolicySectionUIDs(uid, createdFor) {
+		if err := service.DeleteSecurityPolicy(context.TODO(), types.UID(sectionUID), isGC, createdFor); err != nil {
+			errList = append(errList, err)
+		}
+	}
+	return errors.Join(errList...)
+}
+
+// ListAdminNetworkPolicyUID lists the UIDs of the AdminNetworkPolicies or BaselineAdminNetworkPolicies which have
+// internal SecurityPolicies realized on NSX.
+func (service *SecurityPolicyService) ListAdminNetworkPolicyUID(createdFor string) sets.Set[string] {
+	uids := sets.New[string]()
+	for sectionUID := range service.getGCSecurityPolicyIDSet(common.TagScopeNetworkPolicyUID) {
+		if uid, ok := parseAdminNetworkPolicySectionUID(sectionUID, createdFor); ok {
+			uids.Insert(uid)
+		}
+	}
+	return uids
+}
@@ -0,0 +1,294 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package securitypolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	policyv1alpha1 "sigs.k8s.io/network-policy-api/apis/v1alpha1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+func Test_AdminNetworkPolicySectionUID(t *testing.T) {
+	uid := buildAdminNetworkPolicySectionUID("uidANP", "ns1", common.ResourceTypeAdminNetworkPolicy)
+	assert.Equal(t, "uidANP.ns1_anp", uid)
+	assert.True(t, isAdminNetworkPolicySection(uid))
+	parentUID, ok := parseAdminNetworkPolicySectionUID(uid, common.ResourceTypeAdminNetworkPolicy)
+	assert.True(t, ok)
+	assert.Equal(t, "uidANP", parentUID)
+	_, ok = parseAdminNetworkPolicySectionUID(uid, common.ResourceTypeBaselineAdminNetworkPolicy)
+	assert.False(t, ok)
+
+	uid = buildAdminNetworkPolicySectionUID("uidBANP", "ns1", common.ResourceTypeBaselineAdminNetworkPolicy)
+	assert.Equal(t, "uidBANP.ns1_banp", uid)
+	assert.True(t, isAdminNetworkPolicySection(uid))
+
+	assert.False(t, isAdminNetworkPolicySection("uidNP_allow"))
+	assert.False(t, isAdminNetworkPolicySection("uidNP_isolation"))
+}
+
+func Test_newAdminNetworkPolicy(t *testing.T) {
+	allow := v1alpha1.RuleActionAllow
+	drop := v1alpha1.RuleActionDrop
+	pass := ruleActionPass
+	in := v1alpha1.RuleDirectionIn
+	out := v1alpha1.RuleDirectionOut
+	nsSelector := metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}
+	podSelector := metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}
+
+	tests := []struct {
+		name     string
+		obj      client.Object
+		expected *adminNetworkPolicy
+		wantErr  bool
+	}{
+		{
+			name: "AdminNetworkPolicy",
+			obj: &policyv1alpha1.AdminNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "anp1", UID: "uidANP"},
+				Spec: policyv1alpha1.AdminNetworkPolicySpec{
+					Priority: 10,
+					Subject:  policyv1alpha1.AdminNetworkPolicySubject{Namespaces: &nsSelector},
+					Ingress: []policyv1alpha1.AdminNetworkPolicyIngressRule{
+						{
+							Name:   "pass-from-team",
+							Action: policyv1alpha1.AdminNetworkPolicyRuleActionPass,
+							From:   []policyv1alpha1.AdminNetworkPolicyIngressPeer{{Namespaces: &nsSelector}},
+						},
+						{
+							Name:   "deny-db",
+							Action: policyv1alpha1.AdminNetworkPolicyRuleActionDeny,
+							From: []policyv1alpha1.AdminNetworkPolicyIngressPeer{
+								{Pods: &policyv1alpha1.NamespacedPod{NamespaceSelector: nsSelector, PodSelector: podSelector}},
+							},
+							Ports: &[]policyv1alpha1.AdminNetworkPolicyPort{
+								{PortNumber: &policyv1alpha1.Port{Protocol: corev1.ProtocolTCP, Port: 5432}},
+								{NamedPort: ptr.To("http")},
+							},
+						},
+					},
+					Egress: []policyv1alpha1.AdminNetworkPolicyEgressRule{
+						{
+							Name:   "allow-dns",
+							Action: policyv1alpha1.AdminNetworkPolicyRuleActionAllow,
+							To:     []policyv1alpha1.AdminNetworkPolicyEgressPeer{{Networks: []policyv1alpha1.CIDR{"10.0.0.0/24"}}},
+							Ports: &[]policyv1alpha1.AdminNetworkPolicyPort{
+								{PortRange: &policyv1alpha1.PortRange{Protocol: corev1.ProtocolUDP, Start: 53, End: 60}},
+							},
+						},
+					},
+				},
+			},
+			expected: &adminNetworkPolicy{
+				name:       "anp1",
+				uid:        "uidANP",
+				createdFor: common.ResourceTypeAdminNetworkPolicy,
+				priority:   10,
+				subject:    policyv1alpha1.AdminNetworkPolicySubject{Namespaces: &nsSelector},
+				rules: []v1alpha1.SecurityPolicyRule{
+					{
+						Name:      "pass-from-team",
+						Action:    &pass,
+						Direction: &in,
+						From: []v1alpha1.SecurityPolicyPeer{
+							{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{}}, NamespaceSelector: &nsSelector},
+						},
+					},
+					{
+						Name:      "deny-db",
+						Action:    &drop,
+						Direction: &in,
+						From: []v1alpha1.SecurityPolicyPeer{
+							{PodSelector: &podSelector, NamespaceSelector: &nsSelector},
+						},
+						Ports: []v1alpha1.SecurityPolicyPort{
+							{Protocol: corev1.ProtocolTCP, Port: intstr.FromInt32(5432)},
+							{Protocol: corev1.ProtocolTCP, Port: intstr.FromString("http")},
+						},
+					},
+					{
+						Name:      "allow-dns",
+						Action:    &allow,
+						Direction: &out,
+						To: []v1alpha1.SecurityPolicyPeer{
+							{IPBlocks: []v1alpha1.IPBlock{{CIDR: "10.0.0.0/24"}}},
+						},
+						Ports: []v1alpha1.SecurityPolicyPort{
+							{Protocol: corev1.ProtocolUDP, Port: intstr.FromInt32(53), EndPort: 60},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "BaselineAdminNetworkPolicy",
+			obj: &policyv1alpha1.BaselineAdminNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "default", UID: "uidBANP"},
+				Spec: policyv1alpha1.BaselineAdminNetworkPolicySpec{
+					Subject: policyv1alpha1.AdminNetworkPolicySubject{Namespaces: &metav1.LabelSelector{}},
+					Egress: []policyv1alpha1.BaselineAdminNetworkPolicyEgressRule{
+						{
+							Name:   "deny-all",
+							Action: policyv1alpha1.BaselineAdminNetworkPolicyRuleActionDeny,
+							To:     []policyv1alpha1.BaselineAdminNetworkPolicyEgressPeer{{Namespaces: &metav1.LabelSelector{}}},
+						},
+					},
+				},
+			},
+			expected: &adminNetworkPolicy{
+				name:       "default",
+				uid:        "uidBANP",
+				createdFor: common.ResourceTypeBaselineAdminNetworkPolicy,
+				priority:   common.PriorityBaselineAdminNetworkPolicy,
+				subject:    policyv1alpha1.AdminNetworkPolicySubject{Namespaces: &metav1.LabelSelector{}},
+				rules: []v1alpha1.SecurityPolicyRule{
+					{
+						Name:      "deny-all",
+						Action:    &drop,
+						Direction: &out,
+						To: []v1alpha1.SecurityPolicyPeer{
+							{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{}}, NamespaceSelector: &metav1.LabelSelector{}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Unsupported nodes peer",
+			obj: &policyv1alpha1.AdminNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "anp2", UID: "uidANP2"},
+				Spec: policyv1alpha1.AdminNetworkPolicySpec{
+					Egress: []policyv1alpha1.AdminNetworkPolicyEgressRule{
+						{
+							Action: policyv1alpha1.AdminNetworkPolicyRuleActionDeny,
+							To:     []policyv1alpha1.AdminNetworkPolicyEgressPeer{{Nodes: &metav1.LabelSelector{}}},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "DomainNames peer",
+			obj: &policyv1alpha1.AdminNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "anp3", UID: "uidANP3"},
+				Spec: policyv1alpha1.AdminNetworkPolicySpec{
+					Priority: 20,
+					Egress: []policyv1alpha1.AdminNetworkPolicyEgressRule{
+						{
+							Name:   "allow-example",
+							Action: policyv1alpha1.AdminNetworkPolicyRuleActionAllow,
+							To:     []policyv1alpha1.AdminNetworkPolicyEgressPeer{{DomainNames: []policyv1alpha1.DomainName{"*.example.com", "example.org"}}},
+						},
+					},
+				},
+			},
+			expected: &adminNetworkPolicy{
+				name:       "anp3",
+				uid:        "uidANP3",
+				createdFor: common.ResourceTypeAdminNetworkPolicy,
+				priority:   20,
+				rules: []v1alpha1.SecurityPolicyRule{
+					{
+						Name:      "allow-example",
+						Action:    &allow,
+						Direction: &out,
+						To: []v1alpha1.SecurityPolicyPeer{
+							{FQDNs: []string{"*.example.com", "example.org"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			anp, err := newAdminNetworkPolicy(tt.obj)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, anp)
+		})
+	}
+}
+
+func Test_generateSectionForAdminNetworkPolicy(t *testing.T) {
+	fakeService := fakeSecurityPolicyService()
+	drop := v1alpha1.RuleActionDrop
+	in := v1alpha1.RuleDirectionIn
+	rules := []v1alpha1.SecurityPolicyRule{{Name: "deny", Action: &drop, Direction: &in}}
+
+	anp := &adminNetworkPolicy{
+		name:       "anp1",
+		uid:        "uidANP",
+		createdFor: common.ResourceTypeAdminNetworkPolicy,
+		priority:   10,
+		subject: policyv1alpha1.AdminNetworkPolicySubject{
+			Pods: &policyv1alpha1.NamespacedPod{PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}},
+		},
+		rules: rules,
+	}
+	section := fakeService.generateSectionForAdminNetworkPolicy(anp, "ns1")
+	assert.Equal(t, &v1alpha1.SecurityPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "anp1", UID: "uidANP.ns1_anp"},
+		Spec: v1alpha1.SecurityPolicySpec{
+			Priority:  10,
+			AppliedTo: []v1alpha1.SecurityPolicyTarget{{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}}},
+			Rules:     rules,
+		},
+	}, section)
+
+	anp.subject = policyv1alpha1.AdminNetworkPolicySubject{Namespaces: &metav1.LabelSelector{}}
+	anp.createdFor = common.ResourceTypeBaselineAdminNetworkPolicy
+	section = fakeService.generateSectionForAdminNetworkPolicy(anp, "ns2")
+	assert.Equal(t, "uidANP.ns2_banp", string(section.UID))
+	assert.Equal(t, &metav1.LabelSelector{MatchLabels: map[string]string{}}, section.Spec.AppliedTo[0].PodSelector)
+}
+
+func Test_ListAdminNetworkPolicyUID(t *testing.T) {
+	fakeService := fakeSecurityPolicyService()
+	fakeService.setUpStore(common.TagValueScopeSecurityPolicyUID, false)
+
+	for i, uid := range []string{"uidNP_allow", "uidNP_isolation", "uidANP.ns1_anp", "uidANP.ns2_anp", "uidBANP.ns1_banp"} {
+		sp := &model.SecurityPolicy{
+			Id:   common.String(string(rune('a' + i))),
+			Tags: []model.Tag{{Scope: util.Ptr(common.TagScopeNetworkPolicyUID), Tag: util.Ptr(uid)}},
+		}
+		assert.NoError(t, fakeService.securityPolicyStore.Apply(sp))
+	}
+
+	assert.Equal(t, sets.New[string]("uidANP"), fakeService.ListAdminNetworkPolicyUID(common.ResourceTypeAdminNetworkPolicy))
+	assert.Equal(t, sets.New[string]("uidBANP"), fakeService.ListAdminNetworkPolicyUID(common.ResourceTypeBaselineAdminNetworkPolicy))
+	assert.Equal(t, sets.New[string]("uidANP.ns1_anp", "uidANP.ns2_anp"), fakeService.listAdminNetworkPolicySectionUIDs("uidANP", common.ResourceTypeAdminNetworkPolicy))
+	// The NetworkPolicy garbage collector must not collect the sections of AdminNetworkPolicy.
+	assert.Equal(t, sets.New[string]("uidNP_allow", "uidNP_isolation"), fakeService.ListNetworkPolicyID())
+}
+
+func Test_buildPassRule(t *testing.T) {
+	fakeService := fakeSecurityPolicyService()
+	pass := ruleActionPass
+	in := v1alpha1.RuleDirectionIn
+	rule := &v1alpha1.SecurityPolicyRule{Name: "pass-all", Action: &pass, Direction: &in}
+
+	action, err := getRuleAction(rule)
+	assert.NoError(t, err)
+	assert.Equal(t, nsxRuleActionJumpToApplication, action)
+
+	displayName, err := fakeService.buildRuleDisplayName(rule, common.ResourceTypeAdminNetworkPolicy, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "pass-all_ingress_pass", displayName)
+}
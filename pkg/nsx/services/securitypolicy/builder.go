@@ -34,6 +34,11 @@ const (
 	MaxMatchExpressionInValues  int = 5
 	ClusterTagCount             int = 1
 	NameSpaceTagCount           int = 1
+
+	maxICMPTypeCode int32 = 255
+	// The protocols of NSX ICMPTypeServiceEntry.
+	icmpServiceEntryProtocolV4 = "ICMPv4"
+	icmpServiceEntryProtocolV6 = "ICMPv6"
 )
 
 var (
@@ -55,7 +60,7 @@ func (service *SecurityPolicyService) buildSecurityPolicyName(obj *v1alpha1.Secu
 
 func (service *SecurityPolicyService) buildSecurityPolicyIDAndName(obj *v1alpha1.SecurityPolicy, createdFor string) (string, string) {
 	indexScope := common.TagValueScopeSecurityPolicyUID
-	if createdFor == common.ResourceTypeNetworkPolicy {
+	if isCreatedForNetworkPolicy(createdFor) {
 		indexScope = common.TagScopeNetworkPolicyUID
 	}
 	existingSecurityPolicies := service.securityPolicyStore.GetByIndex(indexScope, string(obj.GetUID()))
@@ -125,6 +130,10 @@ func (service *SecurityPolicyService) buildSecurityPolicy(obj *v1alpha1.Security
 	nsxSecurityPolicy.DisplayName = String(policyName)
 	// TODO: confirm the sequence number: offset
 	nsxSecurityPolicy.SequenceNumber = Int64(int64(obj.Spec.Priority))
+	if createdFor == common.ResourceTypeAdminNetworkPolicy {
+		// AdminNetworkPolicy is evaluated before all the namespace-scoped policies in the Application category.
+		nsxSecurityPolicy.Category = String(securityPolicyCategoryEnvironment)
+	}
 
 	policyGroup, policyGroupPath, err := service.buildPolicyGroup(obj, createdFor, vpcInfo)
 	if err != nil {
@@ -291,7 +300,7 @@ func (service *SecurityPolicyService) buildTargetTags(obj *v1alpha1.SecurityPoli
 func (service *SecurityPolicyService) buildBasicTags(obj *v1alpha1.SecurityPolicy, createdFor string) []model.Tag {
 	scopeOwnerName := common.TagValueScopeSecurityPolicyName
 	scopeOwnerUID := common.TagValueScopeSecurityPolicyUID
-	if createdFor == common.ResourceTypeNetworkPolicy {
+	if isCreatedForNetworkPolicy(createdFor) {
 		scopeOwnerName = common.TagScopeNetworkPolicyName
 		scopeOwnerUID = common.TagScopeNetworkPolicyUID
 	}
@@ -479,6 +488,16 @@ func (service *SecurityPolicyService) buildRuleAndGroups(obj *v1alpha1.SecurityP
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	if err = validateRuleFQDNPeers(rule, ruleDirection); err != nil {
+		return nil, nil, nil, err
+	}
+	if err = ValidateSecurityPolicyPorts(rule.Ports); err != nil {
+		return nil, nil, nil, err
+	}
+	var ruleProfiles []string
+	if fqdns := getRuleFQDNs(rule); len(fqdns) > 0 {
+		ruleProfiles = []string{buildContextProfilePath(service.buildContextProfileID(obj, fqdns))}
+	}
 
 	// Since a named port may map to multiple port numbers, then it would return multiple rules.
 	// We use the destination port number of service entry to group the rules.
@@ -518,6 +537,7 @@ func (service *SecurityPolicyService) buildRuleAndGroups(obj *v1alpha1.SecurityP
 
 		nsxRule.SourceGroups = []string{nsxRuleSrcGroupPath}
 		nsxRule.DestinationGroups = []string{nsxRuleDstGroupPath}
+		nsxRule.Profiles = ruleProfiles
 
 		nsxRuleAppliedGroup, nsxRuleAppliedGroupPath, err = service.buildRuleAppliedToGroup(
 			obj, rule, ruleIdx, nsxRuleSrcGroupPath, nsxRuleDstGroupPath, createdFor, policyGroupPath, ruleBaseID, vpcInfo)
@@ -530,7 +550,62 @@ func (service *SecurityPolicyService) buildRuleAndGroups(obj *v1alpha1.SecurityP
 	return nsxRules, ruleGroups, nsxGroupShares, nil
 }
 
+func isICMPProtocol(protocol corev1.Protocol) bool {
+	return protocol == v1alpha1.ProtocolICMP || protocol == v1alpha1.ProtocolICMPv6
+}
+
+// ValidateSecurityPolicyPorts checks the ICMP type and code are only set for the ICMP protocols, and the port
+// numbers are not set for the ICMP protocols.
+func ValidateSecurityPolicyPorts(ports []v1alpha1.SecurityPolicyPort) error {
+	var zeroPort intstr.IntOrString
+	for _, port := range ports {
+		if !isICMPProtocol(port.Protocol) {
+			if port.ICMPType != nil || port.ICMPCode != nil {
+				return &nsxutil.ValidationError{Desc: fmt.Sprintf("icmpType and icmpCode are not supported with protocol %s", port.Protocol)}
+			}
+			continue
+		}
+		if port.Port != zeroPort || port.EndPort != 0 {
+			return &nsxutil.ValidationError{Desc: fmt.Sprintf("port and endPort are not supported with protocol %s", port.Protocol)}
+		}
+		if port.ICMPCode != nil && port.ICMPType == nil {
+			return &nsxutil.ValidationError{Desc: "icmpCode requires icmpType"}
+		}
+		for _, value := range []*int32{port.ICMPType, port.ICMPCode} {
+			if value != nil && (*value < 0 || *value > maxICMPTypeCode) {
+				return &nsxutil.ValidationError{Desc: fmt.Sprintf("icmpType and icmpCode must be in range 0-%d", maxICMPTypeCode)}
+			}
+		}
+	}
+	return nil
+}
+
+func buildRuleICMPServiceEntry(port v1alpha1.SecurityPolicyPort) *data.StructValue {
+	protocol := icmpServiceEntryProtocolV4
+	if port.Protocol == v1alpha1.ProtocolICMPv6 {
+		protocol = icmpServiceEntryProtocolV6
+	}
+	fields := map[string]data.DataValue{
+		"protocol":          data.NewStringValue(protocol),
+		"resource_type":     data.NewStringValue("ICMPTypeServiceEntry"),
+		"marked_for_delete": data.NewBooleanValue(false),
+		"overridden":        data.NewBooleanValue(false),
+	}
+	if port.ICMPType != nil {
+		fields["icmp_type"] = data.NewIntegerValue(int64(*port.ICMPType))
+	}
+	if port.ICMPCode != nil {
+		fields["icmp_code"] = data.NewIntegerValue(int64(*port.ICMPCode))
+	}
+	log.Debug("Built rule ICMP service entry", "protocol", protocol, "icmpType", port.ICMPType, "icmpCode", port.ICMPCode)
+	return data.NewStructValue("", fields)
+}
+
 func buildRuleServiceEntries(port v1alpha1.SecurityPolicyPort) *data.StructValue {
+	if isICMPProtocol(port.Protocol) {
+		return buildRuleICMPServiceEntry(port)
+	}
+
 	var portRange string
 	sourcePorts := data.NewListValue()
 	destinationPorts := data.NewListValue()
@@ -625,7 +700,8 @@ func (service *SecurityPolicyService) buildRuleOutGroup(obj *v1alpha1.SecurityPo
 		nsxRuleDstGroupPath = nsxRule.DestinationGroups[0]
 	} else {
 		destinations := getRuleDestinationPeers(rule)
-		if len(destinations) > 0 {
+		// The FQDN peers are matched by the rule context profile but not a destination group.
+		if len(destinations) > 0 && len(getRuleFQDNs(rule)) == 0 {
 			nsxRuleDstGroup, nsxRuleDstGroupPath, nsxGroupShare, err = service.buildRulePeerGroup(obj, rule, ruleIdx, ruleBaseID, false, createdFor, vpcInfo, isDefaultProject)
 			if err != nil {
 				return nil, "", "", nil, err
@@ -702,6 +778,8 @@ func (service *SecurityPolicyService) buildRuleDisplayName(rule *v1alpha1.Securi
 		ruleAct = common.RuleActionDrop
 	case util.ToUpper(v1alpha1.RuleActionReject):
 		ruleAct = common.RuleActionReject
+	case nsxRuleActionJumpToApplication:
+		ruleAct = common.RuleActionPass
 	}
 	ruleDir := common.RuleEgress
 	if ruleDirection == "IN" {
@@ -1071,6 +1149,13 @@ func (service *SecurityPolicyService) buildRuleBasicInfo(obj *v1alpha1.SecurityP
 		Services:       []string{"ANY"},
 		Tags:           basicTags,
 	}
+	// Logging is only set when enabled, wrapRules sends logged=false and an empty log label to NSX for the unset fields.
+	if logged, logLabel := getRuleLogging(obj, rule); logged {
+		nsxRule.Logged = common.Bool(true)
+		if logLabel != "" {
+			nsxRule.Tag = String(logLabel)
+		}
+	}
 	log.Debug("Built rule basic info", "ruleBaseID", ruleBaseID, "nsxRule", nsxRule)
 	return &nsxRule, nil
 }
@@ -1996,6 +2081,16 @@ func (service *SecurityPolicyService) buildRulePortNumberString(port v1alpha1.Se
 	// - protocol: UDP
 	//   port: 3308
 	// The built port number string is: 3308
+	// - protocol: ICMP
+	//   icmpType: 3
+	//   icmpCode: 1
+	// The built port number string is: 3.1
+	if isICMPProtocol(port.Protocol) && port.ICMPType != nil {
+		if port.ICMPCode != nil {
+			return fmt.Sprintf("%d.%d", *port.ICMPType, *port.ICMPCode)
+		}
+		return fmt.Sprintf("%d", *port.ICMPType)
+	}
 	if port.EndPort != 0 {
 		return fmt.Sprintf("%s.%d", (port.Port).String(), port.EndPort)
 	}
@@ -2016,7 +2111,12 @@ func (service *SecurityPolicyService) buildRulePortsNumberString(ports []v1alpha
 }
 
 func (service *SecurityPolicyService) buildRuleHashString(rule *v1alpha1.SecurityPolicyRule) string {
-	serializedBytes, _ := json.Marshal(rule)
+	// Logging settings are excluded from the hash so that the NSX rule is updated in place
+	// rather than recreated when they change.
+	r := *rule
+	r.Logging = nil
+	r.LogLabel = ""
+	serializedBytes, _ := json.Marshal(r)
 	return util.Sha1(string(serializedBytes))
 }
 
@@ -2063,7 +2163,7 @@ func (service *SecurityPolicyService) getPolicyAppliedGroupByCRUID(indexScope, u
 
 func (service *SecurityPolicyService) getAppliedGroupByRuleID(createdFor, uid string, ruleID string) *model.Group {
 	indexScope := common.TagValueScopeSecurityPolicyUID
-	if createdFor == common.ResourceTypeNetworkPolicy {
+	if isCreatedForNetworkPolicy(createdFor) {
 		indexScope = common.TagScopeNetworkPolicyUID
 	}
 
@@ -2121,7 +2221,7 @@ func (service *SecurityPolicyService) getPeerGroupByRuleID(ruleID string, isSour
 func (service *SecurityPolicyService) getRuleIDByUUIDAndRuleHash(uuid types.UID, ruleHash string, createdFor string) *string {
 	var rules []*model.Rule
 	indexKey := SPIndexByUUIDAndRuleHashFuncKey
-	if createdFor == common.ResourceTypeNetworkPolicy {
+	if isCreatedForNetworkPolicy(createdFor) {
 		indexKey = NPIndexByUUIDAndRuleHashFuncKey
 	}
 
@@ -2172,6 +2272,16 @@ func newPortInfoForNamedPort(portAddr nsxutil.PortAddress, protocol corev1.Proto
 	}
 }
 
+// isCreatedForNetworkPolicy returns whether the NetworkPolicy tag scopes and indexes are used for the internal
+// SecurityPolicy, which is the case for NetworkPolicy, AdminNetworkPolicy and BaselineAdminNetworkPolicy.
+func isCreatedForNetworkPolicy(createdFor string) bool {
+	switch createdFor {
+	case common.ResourceTypeNetworkPolicy, common.ResourceTypeAdminNetworkPolicy, common.ResourceTypeBaselineAdminNetworkPolicy:
+		return true
+	}
+	return false
+}
+
 func parseSuffixInUid(uid types.UID) (string, string) {
 	objUUIDParts := strings.Split(string(uid), common.ConnectorUnderline)
 	suffix := ""
@@ -16,6 +16,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
 	"github.com/vmware-tanzu/nsx-operator/pkg/config"
@@ -1253,6 +1254,14 @@ func Test_BuildRulePortsNumberString(t *testing.T) {
 			inputPorts:              securityPolicyWithOneNamedPort.Spec.Rules[3].Ports,
 			expectedRulePortsString: "db",
 		},
+		{
+			name: "build-string-for-icmp-ports",
+			inputPorts: []v1alpha1.SecurityPolicyPort{
+				{Protocol: v1alpha1.ProtocolICMP, ICMPType: ptr.To[int32](3), ICMPCode: ptr.To[int32](1)},
+				{Protocol: v1alpha1.ProtocolICMPv6, ICMPType: ptr.To[int32](128)},
+			},
+			expectedRulePortsString: "3.1_128",
+		},
 		{
 			name:                    "build-string-for-nil-ports",
 			inputPorts:              nil,
@@ -1332,6 +1341,62 @@ func Test_BuildRuleDisplayName(t *testing.T) {
 	}
 }
 
+func Test_BuildRuleBasicInfoWithLogging(t *testing.T) {
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(&service.Service), "GetNamespaceUID",
+		func(s *common.Service, ns string) types.UID {
+			return types.UID(tagValueNSUID)
+		})
+	defer patches.Reset()
+
+	disabled := false
+	tests := []struct {
+		name        string
+		logging     bool
+		logLabel    string
+		ruleLogging *bool
+		expLogged   *bool
+		expTag      *string
+	}{
+		{
+			name: "logging-not-set",
+		},
+		{
+			name:      "logging-enabled-by-policy-with-label",
+			logging:   true,
+			logLabel:  "ns1-audit",
+			expLogged: common.Bool(true),
+			expTag:    common.String("ns1-audit"),
+		},
+		{
+			name:        "logging-disabled-by-rule",
+			logging:     true,
+			logLabel:    "ns1-audit",
+			ruleLogging: &disabled,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sp := spWithPodSelector.DeepCopy()
+			sp.Spec.Logging = tt.logging
+			sp.Spec.LogLabel = tt.logLabel
+			sp.Spec.Rules[0].Logging = tt.ruleLogging
+			rule, err := service.buildRuleBasicInfo(sp, &sp.Spec.Rules[0], 0, "ruleBaseID", common.ResourceTypeSecurityPolicy, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expLogged, rule.Logged)
+			assert.Equal(t, tt.expTag, rule.Tag)
+		})
+	}
+}
+
+func Test_BuildRuleHashStringIgnoresLogging(t *testing.T) {
+	enabled := true
+	rule := spWithPodSelector.Spec.Rules[0]
+	ruleWithLogging := *rule.DeepCopy()
+	ruleWithLogging.Logging = &enabled
+	ruleWithLogging.LogLabel = "ns1-audit"
+	assert.Equal(t, service.buildRuleHashString(&rule), service.buildRuleHashString(&ruleWithLogging))
+}
+
 func Test_BuildExpandedRuleID(t *testing.T) {
 	svc := &SecurityPolicyService{
 		Service: common.Service{
@@ -1834,6 +1899,57 @@ func Test_buildRuleServiceEntries(t *testing.T) {
 				)
 			}(),
 		},
+		{
+			name: "ICMP echo request",
+			port: v1alpha1.SecurityPolicyPort{
+				Protocol: v1alpha1.ProtocolICMP,
+				ICMPType: ptr.To[int32](8),
+				ICMPCode: ptr.To[int32](0),
+			},
+			expected: data.NewStructValue(
+				"",
+				map[string]data.DataValue{
+					"protocol":          data.NewStringValue("ICMPv4"),
+					"icmp_type":         data.NewIntegerValue(8),
+					"icmp_code":         data.NewIntegerValue(0),
+					"resource_type":     data.NewStringValue("ICMPTypeServiceEntry"),
+					"marked_for_delete": data.NewBooleanValue(false),
+					"overridden":        data.NewBooleanValue(false),
+				},
+			),
+		},
+		{
+			name: "ICMPv6 type without code",
+			port: v1alpha1.SecurityPolicyPort{
+				Protocol: v1alpha1.ProtocolICMPv6,
+				ICMPType: ptr.To[int32](137),
+			},
+			expected: data.NewStructValue(
+				"",
+				map[string]data.DataValue{
+					"protocol":          data.NewStringValue("ICMPv6"),
+					"icmp_type":         data.NewIntegerValue(137),
+					"resource_type":     data.NewStringValue("ICMPTypeServiceEntry"),
+					"marked_for_delete": data.NewBooleanValue(false),
+					"overridden":        data.NewBooleanValue(false),
+				},
+			),
+		},
+		{
+			name: "ICMP any type",
+			port: v1alpha1.SecurityPolicyPort{
+				Protocol: v1alpha1.ProtocolICMP,
+			},
+			expected: data.NewStructValue(
+				"",
+				map[string]data.DataValue{
+					"protocol":          data.NewStringValue("ICMPv4"),
+					"resource_type":     data.NewStringValue("ICMPTypeServiceEntry"),
+					"marked_for_delete": data.NewBooleanValue(false),
+					"overridden":        data.NewBooleanValue(false),
+				},
+			),
+		},
 	}
 
 	for _, tt := range tests {
@@ -1844,6 +1960,53 @@ func Test_buildRuleServiceEntries(t *testing.T) {
 	}
 }
 
+func Test_ValidateSecurityPolicyPorts(t *testing.T) {
+	tests := []struct {
+		name    string
+		ports   []v1alpha1.SecurityPolicyPort
+		wantErr string
+	}{
+		{
+			name:  "TCP and ICMP ports",
+			ports: []v1alpha1.SecurityPolicyPort{{Protocol: "TCP", Port: intstr.FromInt(80)}, {Protocol: v1alpha1.ProtocolICMP, ICMPType: ptr.To[int32](8), ICMPCode: ptr.To[int32](0)}},
+		},
+		{
+			name:  "ICMPv6 without type",
+			ports: []v1alpha1.SecurityPolicyPort{{Protocol: v1alpha1.ProtocolICMPv6}},
+		},
+		{
+			name:    "ICMP type with TCP",
+			ports:   []v1alpha1.SecurityPolicyPort{{Protocol: "TCP", ICMPType: ptr.To[int32](8)}},
+			wantErr: "not supported with protocol TCP",
+		},
+		{
+			name:    "port with ICMP",
+			ports:   []v1alpha1.SecurityPolicyPort{{Protocol: v1alpha1.ProtocolICMP, Port: intstr.FromInt(80)}},
+			wantErr: "port and endPort are not supported with protocol ICMP",
+		},
+		{
+			name:    "ICMP code without type",
+			ports:   []v1alpha1.SecurityPolicyPort{{Protocol: v1alpha1.ProtocolICMPv6, ICMPCode: ptr.To[int32](0)}},
+			wantErr: "icmpCode requires icmpType",
+		},
+		{
+			name:    "ICMP type out of range",
+			ports:   []v1alpha1.SecurityPolicyPort{{Protocol: v1alpha1.ProtocolICMP, ICMPType: ptr.To[int32](256)}},
+			wantErr: "must be in range 0-255",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSecurityPolicyPorts(tt.ports)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
 func Test_dedupBlocks(t *testing.T) {
 	svc := &SecurityPolicyService{
 		Service: common.Service{
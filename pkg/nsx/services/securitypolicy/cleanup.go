@@ -155,7 +155,7 @@ func (service *SecurityPolicyService) CleanupInfraResources(ctx context.Context)
 			return err
 		}
 	}
-	return nil
+	return service.cleanContextProfiles(ctx)
 }
 
 func cleanShares(ctx context.Context, store *ShareStore, builder *common.PolicyTreeBuilder[*model.Share], nsxClient *nsx.Client) error {
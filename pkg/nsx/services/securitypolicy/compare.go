@@ -15,6 +15,7 @@ type (
 	Rule           model.Rule
 	Group          model.Group
 	Share          model.Share
+	ContextProfile model.PolicyContextProfile
 )
 
 type Comparable = common.Comparable
@@ -35,6 +36,10 @@ func (share *Share) Key() string {
 	return *share.Id
 }
 
+func (profile *ContextProfile) Key() string {
+	return *profile.Id
+}
+
 func (sp *SecurityPolicy) Value() data.DataValue {
 	s := &SecurityPolicy{
 		Id:             sp.Id,
@@ -43,6 +48,10 @@ func (sp *SecurityPolicy) Value() data.DataValue {
 		Scope:          sp.Scope,
 		Tags:           sp.Tags,
 	}
+	// NSX reports the Application category on the policies created without a category.
+	if sp.Category != nil && *sp.Category != securityPolicyCategoryApplication {
+		s.Category = sp.Category
+	}
 	dataValue, _ := ComparableToSecurityPolicy(s).GetDataValue__()
 	return dataValue
 }
@@ -61,6 +70,17 @@ func (rule *Rule) Value() data.DataValue {
 		DestinationGroups: rule.DestinationGroups,
 		SourceGroups:      rule.SourceGroups,
 	}
+	// NSX reports profiles ["ANY"] on rules without context profiles, which is the same as leaving it unset.
+	if len(rule.Profiles) > 0 && !(len(rule.Profiles) == 1 && rule.Profiles[0] == "ANY") {
+		r.Profiles = rule.Profiles
+	}
+	// NSX may leave logged and tag unset on rules without logging, which is the same as false and empty, they are
+	// always compared so that disabling logging is detected.
+	r.Logged = common.Bool(rule.Logged != nil && *rule.Logged)
+	r.Tag = String("")
+	if rule.Tag != nil {
+		r.Tag = rule.Tag
+	}
 	dataValue, _ := ComparableToRule(r).GetDataValue__()
 	return dataValue
 }
@@ -88,6 +108,26 @@ func (share *Share) Value() data.DataValue {
 	return dataValue
 }
 
+func (profile *ContextProfile) Value() data.DataValue {
+	// NSX renders more fields of the attributes, only the ones set by the operator are compared.
+	attributes := make([]model.PolicyAttributes, 0, len(profile.Attributes))
+	for _, attribute := range profile.Attributes {
+		attributes = append(attributes, model.PolicyAttributes{
+			Key:      attribute.Key,
+			Datatype: attribute.Datatype,
+			Value:    attribute.Value,
+		})
+	}
+	p := &ContextProfile{
+		Id:          profile.Id,
+		DisplayName: profile.DisplayName,
+		Tags:        profile.Tags,
+		Attributes:  attributes,
+	}
+	dataValue, _ := ComparableToContextProfile(p).GetDataValue__()
+	return dataValue
+}
+
 func SecurityPolicyPtrToComparable(sp *model.SecurityPolicy) Comparable {
 	return (*SecurityPolicy)(sp)
 }
@@ -183,3 +223,31 @@ func ComparableToShares(shares []Comparable) []model.Share {
 func ComparableToShare(share Comparable) *model.Share {
 	return (*model.Share)(share.(*Share))
 }
+
+func ContextProfilesPtrToComparable(profiles []*model.PolicyContextProfile) []Comparable {
+	res := make([]Comparable, 0, len(profiles))
+	for i := range profiles {
+		res = append(res, (*ContextProfile)(profiles[i]))
+	}
+	return res
+}
+
+func ContextProfilesToComparable(profiles []model.PolicyContextProfile) []Comparable {
+	res := make([]Comparable, 0, len(profiles))
+	for i := range profiles {
+		res = append(res, (*ContextProfile)(&(profiles[i])))
+	}
+	return res
+}
+
+func ComparableToContextProfiles(profiles []Comparable) []model.PolicyContextProfile {
+	res := make([]model.PolicyContextProfile, 0, len(profiles))
+	for _, profile := range profiles {
+		res = append(res, (model.PolicyContextProfile)(*(profile.(*ContextProfile))))
+	}
+	return res
+}
+
+func ComparableToContextProfile(profile Comparable) *model.PolicyContextProfile {
+	return (*model.PolicyContextProfile)(profile.(*ContextProfile))
+}
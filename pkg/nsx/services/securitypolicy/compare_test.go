@@ -149,6 +149,91 @@ func Test_RulesEqual(t *testing.T) {
 			expectedResult1: []model.Rule{},
 			expectedResult2: []model.Rule{},
 		},
+		{
+			name: "rule-with-logging-disabled-equal-to-unset",
+			inputRule1: []model.Rule{
+				{
+					Id:     &ruleID0,
+					Logged: common.Bool(false),
+					Tag:    common.String(""),
+				},
+			},
+			inputRule2: []model.Rule{
+				{
+					Id: &ruleID0,
+				},
+			},
+			expectedResult1: []model.Rule{},
+			expectedResult2: []model.Rule{},
+		},
+		{
+			name: "rule-with-log-label-changed",
+			inputRule1: []model.Rule{
+				{
+					Id:     &ruleID0,
+					Logged: common.Bool(true),
+					Tag:    common.String("audit"),
+				},
+			},
+			inputRule2: []model.Rule{
+				{
+					Id:     &ruleID0,
+					Logged: common.Bool(true),
+					Tag:    common.String("compliance"),
+				},
+			},
+			expectedResult1: []model.Rule{
+				{
+					Id:     &ruleID0,
+					Logged: common.Bool(true),
+					Tag:    common.String("compliance"),
+				},
+			},
+			expectedResult2: []model.Rule{},
+		},
+		{
+			name: "rule-with-logging-turned-off",
+			inputRule1: []model.Rule{
+				{
+					Id:     &ruleID0,
+					Logged: common.Bool(true),
+				},
+			},
+			inputRule2: []model.Rule{
+				{
+					Id: &ruleID0,
+				},
+			},
+			expectedResult1: []model.Rule{
+				{
+					Id: &ruleID0,
+				},
+			},
+			expectedResult2: []model.Rule{},
+		},
+		{
+			name: "rule-with-log-label-removed",
+			inputRule1: []model.Rule{
+				{
+					Id:     &ruleID0,
+					Logged: common.Bool(true),
+					Tag:    common.String("audit"),
+				},
+			},
+			inputRule2: []model.Rule{
+				{
+					Id:     &ruleID0,
+					Logged: common.Bool(true),
+				},
+			},
+			expectedResult1: []model.Rule{
+				{
+					Id:     &ruleID0,
+					Logged: common.Bool(true),
+				},
+			},
+			expectedResult2: []model.Rule{},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -209,6 +294,36 @@ func Test_SecurityPolicyEqual(t *testing.T) {
 			},
 			expectedResult2: false,
 		},
+		{
+			name: "security-policy-with-default-category",
+			inputPolicy1: &model.SecurityPolicy{
+				Id:       &spID,
+				Category: common.String(securityPolicyCategoryApplication),
+			},
+			inputPolicy2: &model.SecurityPolicy{
+				Id: &spID,
+			},
+			expectedResult: &model.SecurityPolicy{
+				Id: &spID,
+			},
+			expectedResult2: false,
+		},
+		{
+			name: "security-policy-with-category-changed",
+			inputPolicy1: &model.SecurityPolicy{
+				Id:       &spID,
+				Category: common.String(securityPolicyCategoryApplication),
+			},
+			inputPolicy2: &model.SecurityPolicy{
+				Id:       &spID,
+				Category: common.String(securityPolicyCategoryEnvironment),
+			},
+			expectedResult: &model.SecurityPolicy{
+				Id:       &spID,
+				Category: common.String(securityPolicyCategoryEnvironment),
+			},
+			expectedResult2: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
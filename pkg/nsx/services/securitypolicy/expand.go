@@ -185,6 +185,9 @@ func validateNamedPortRule(rule *v1alpha1.SecurityPolicyRule) error {
 		if len(peer.IPBlocks) > 0 {
 			return &nsxutil.ValidationError{Desc: "ipBlock selectors in egress rules are not supported with named port"}
 		}
+		if len(peer.FQDNs) > 0 {
+			return &nsxutil.ValidationError{Desc: "fqdns in egress rules are not supported with named port"}
+		}
 	}
 	return nil
 }
@@ -0,0 +1,571 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package securitypolicy
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+const (
+	ExplainStageEgress  = "Egress"
+	ExplainStageIngress = "Ingress"
+
+	// The verdict of the flow which is not matched by any rule created by the operator. The VPC default rule and
+	// the policies created on NSX out of the operator are not evaluated, so the flow is not known to be allowed.
+	ExplainVerdictNoMatchingRule = "NO_MATCHING_OPERATOR_RULE"
+
+	explainAny = "ANY"
+)
+
+// explainCategoryOrder is the order in which NSX evaluates the distributed firewall categories.
+var explainCategoryOrder = []string{"Ethernet", "Emergency", "Infrastructure", securityPolicyCategoryEnvironment, securityPolicyCategoryApplication}
+
+// ExplainEndpoint is an endpoint of the flow evaluated by the Explainer.
+type ExplainEndpoint struct {
+	// Name identifies the endpoint in the trace, e.g. the namespace/name of the Pod.
+	Name string
+	// PortPath is the NSX path of the workload port. It is empty for the endpoints which are not NSX ports,
+	// e.g. an external IP, on which no rule is enforced.
+	PortPath string
+	// Tags are the tags of the workload port, which are matched with the tag conditions of the NSX groups.
+	Tags []model.Tag
+	// IPs are matched with the IP address expressions of the NSX groups.
+	IPs []string
+}
+
+// ExplainFlow is the flow evaluated by the Explainer.
+type ExplainFlow struct {
+	Source      ExplainEndpoint
+	Destination ExplainEndpoint
+	// Protocol is TCP, UDP, SCTP, ICMP or ICMPv6.
+	Protocol string
+	// Port is the destination port for TCP, UDP and SCTP.
+	Port int64
+	// ICMPType and ICMPCode are matched for ICMP and ICMPv6.
+	ICMPType *int64
+	ICMPCode *int64
+}
+
+// ExplainTraceEntry is a rule evaluated for the flow.
+type ExplainTraceEntry struct {
+	// Stage is Egress when the rule is evaluated on the source port, or Ingress on the destination port.
+	Stage          string
+	Category       string
+	PolicyPath     string
+	RulePath       string
+	RuleName       string
+	Action         string
+	SequenceNumber int64
+	Matched        bool
+	// Reason tells why the rule is not matched.
+	Reason string
+}
+
+// ExplainResult is the verdict of the flow with the deciding rule and the evaluated rules.
+type ExplainResult struct {
+	// Verdict is the action of the deciding rule, ALLOW, DROP or REJECT, or NO_MATCHING_OPERATOR_RULE if no rule
+	// is matched.
+	Verdict string
+	// DecidingRule is nil if no rule is matched.
+	DecidingRule *ExplainTraceEntry
+	Trace        []ExplainTraceEntry
+}
+
+// Explainer evaluates the flows offline with the NSX security policies, rules and groups built by the operator.
+// The NSX groups are evaluated with the workload port tags, as what the operator expects NSX to do with the
+// group criteria, so the NSX effective group members are not needed.
+type Explainer struct {
+	policies []*model.SecurityPolicy
+	rules    map[string][]*model.Rule
+	groups   map[string]*model.Group
+}
+
+func NewExplainer(policies []*model.SecurityPolicy, rules []*model.Rule, groups []*model.Group) *Explainer {
+	e := &Explainer{
+		rules:  map[string][]*model.Rule{},
+		groups: map[string]*model.Group{},
+	}
+	for _, policy := range policies {
+		if policy.Path != nil {
+			e.policies = append(e.policies, policy)
+		}
+	}
+	sort.SliceStable(e.policies, func(i, j int) bool {
+		ci, cj := explainCategoryIndex(e.policies[i].Category), explainCategoryIndex(e.policies[j].Category)
+		if ci != cj {
+			return ci < cj
+		}
+		return explainInt64(e.policies[i].SequenceNumber) < explainInt64(e.policies[j].SequenceNumber)
+	})
+	for _, rule := range rules {
+		if rule.ParentPath != nil {
+			e.rules[*rule.ParentPath] = append(e.rules[*rule.ParentPath], rule)
+		}
+	}
+	for _, policyRules := range e.rules {
+		sort.SliceStable(policyRules, func(i, j int) bool {
+			return explainInt64(policyRules[i].SequenceNumber) < explainInt64(policyRules[j].SequenceNumber)
+		})
+	}
+	for _, group := range groups {
+		if group.Path != nil {
+			e.groups[*group.Path] = group
+		}
+	}
+	return e
+}
+
+// Explain evaluates the flow with the rules applied to the source port in the egress direction and then the
+// rules applied to the destination port in the ingress direction. The flow is allowed only if both stages
+// allow it.
+func (e *Explainer) Explain(flow *ExplainFlow) *ExplainResult {
+	result := &ExplainResult{Verdict: ExplainVerdictNoMatchingRule}
+	stages := []struct {
+		name      string
+		endpoint  *ExplainEndpoint
+		direction string
+	}{
+		{name: ExplainStageEgress, endpoint: &flow.Source, direction: "OUT"},
+		{name: ExplainStageIngress, endpoint: &flow.Destination, direction: "IN"},
+	}
+	for _, stage := range stages {
+		if stage.endpoint.PortPath == "" {
+			continue
+		}
+		decidingRule := e.explainStage(flow, stage.name, stage.endpoint, stage.direction, result)
+		if decidingRule == nil {
+			continue
+		}
+		result.DecidingRule = decidingRule
+		result.Verdict = decidingRule.Action
+		if decidingRule.Action != model.Rule_ACTION_ALLOW {
+			break
+		}
+	}
+	return result
+}
+
+// explainStage evaluates the rules enforced on the endpoint in the direction in the NSX order and appends them
+// to the trace. The first matched rule with action ALLOW, DROP or REJECT is returned.
+func (e *Explainer) explainStage(flow *ExplainFlow, stage string, endpoint *ExplainEndpoint, direction string, result *ExplainResult) *ExplainTraceEntry {
+	skipToCategory := -1
+	for _, policy := range e.policies {
+		categoryIdx := explainCategoryIndex(policy.Category)
+		if categoryIdx < skipToCategory {
+			continue
+		}
+		policyApplied := e.appliedTo(policy.Scope, endpoint)
+		for _, rule := range e.rules[*policy.Path] {
+			ruleDirection := explainString(rule.Direction)
+			if ruleDirection != direction && ruleDirection != model.Rule_DIRECTION_IN_OUT {
+				continue
+			}
+			// The rule without scope is applied to the policy scope.
+			applied := policyApplied
+			if !isAnyPaths(rule.Scope) {
+				applied = e.appliedTo(rule.Scope, endpoint)
+			}
+			if !applied {
+				continue
+			}
+			entry := ExplainTraceEntry{
+				Stage:          stage,
+				Category:       explainCategory(policy.Category),
+				PolicyPath:     *policy.Path,
+				RulePath:       explainString(rule.Path),
+				RuleName:       explainString(rule.DisplayName),
+				Action:         explainString(rule.Action),
+				SequenceNumber: explainInt64(rule.SequenceNumber),
+			}
+			entry.Reason = e.ruleMismatchReason(flow, rule)
+			entry.Matched = entry.Reason == ""
+			result.Trace = append(result.Trace, entry)
+			if !entry.Matched {
+				continue
+			}
+			if entry.Action == nsxRuleActionJumpToApplication {
+				skipToCategory = explainCategoryIndex(String(securityPolicyCategoryApplication))
+				break
+			}
+			return &entry
+		}
+	}
+	return nil
+}
+
+// ruleMismatchReason returns why the flow is not matched by the rule, or empty if it is matched.
+func (e *Explainer) ruleMismatchReason(flow *ExplainFlow, rule *model.Rule) string {
+	if !isAnyPaths(rule.Profiles) {
+		return "context profiles are not evaluated"
+	}
+	if !isAnyPaths(rule.Services) && len(rule.ServiceEntries) == 0 {
+		return "service paths are not evaluated"
+	}
+	if len(rule.ServiceEntries) > 0 && !explainServiceEntriesMatch(rule.ServiceEntries, flow) {
+		return "service entries are not matched"
+	}
+	if !isAnyPaths(rule.SourceGroups) {
+		matched := e.inAnyGroup(rule.SourceGroups, &flow.Source)
+		if rule.SourcesExcluded != nil && *rule.SourcesExcluded {
+			matched = !matched
+		}
+		if !matched {
+			return "source is not matched"
+		}
+	}
+	if !isAnyPaths(rule.DestinationGroups) {
+		matched := e.inAnyGroup(rule.DestinationGroups, &flow.Destination)
+		if rule.DestinationsExcluded != nil && *rule.DestinationsExcluded {
+			matched = !matched
+		}
+		if !matched {
+			return "destination is not matched"
+		}
+	}
+	return ""
+}
+
+func (e *Explainer) appliedTo(scope []string, endpoint *ExplainEndpoint) bool {
+	if isAnyPaths(scope) {
+		return true
+	}
+	return e.inAnyGroup(scope, endpoint)
+}
+
+func (e *Explainer) inAnyGroup(groupPaths []string, endpoint *ExplainEndpoint) bool {
+	for _, groupPath := range groupPaths {
+		if e.groupContains(groupPath, endpoint, map[string]bool{}) {
+			return true
+		}
+	}
+	return false
+}
+
+// groupContains evaluates the group criteria with the endpoint. The groups which are not known by the
+// Explainer are treated as not containing the endpoint.
+func (e *Explainer) groupContains(groupPath string, endpoint *ExplainEndpoint, visited map[string]bool) bool {
+	group, ok := e.groups[groupPath]
+	if !ok {
+		log.Debug("Group is not found for explain", "groupPath", groupPath)
+		return false
+	}
+	if visited[groupPath] {
+		return false
+	}
+	visited[groupPath] = true
+	return e.expressionsMatch(group.Expression, endpoint, visited)
+}
+
+// expressionsMatch evaluates the expressions joined with the conjunction operators, AND takes precedence over OR.
+func (e *Explainer) expressionsMatch(expressions []*data.StructValue, endpoint *ExplainEndpoint, visited map[string]bool) bool {
+	if len(expressions) == 0 {
+		return false
+	}
+	matched, term := false, true
+	for _, expression := range expressions {
+		if explainStructString(expression, "resource_type") == "ConjunctionOperator" {
+			if explainStructString(expression, "conjunction_operator") == "OR" {
+				matched = matched || term
+				term = true
+			}
+			continue
+		}
+		term = term && e.expressionMatch(expression, endpoint, visited)
+	}
+	return matched || term
+}
+
+func (e *Explainer) expressionMatch(expression *data.StructValue, endpoint *ExplainEndpoint, visited map[string]bool) bool {
+	switch explainStructString(expression, "resource_type") {
+	case "NestedExpression":
+		var nested []*data.StructValue
+		for _, value := range explainStructList(expression, "expressions") {
+			if sv, ok := value.(*data.StructValue); ok {
+				nested = append(nested, sv)
+			}
+		}
+		return e.expressionsMatch(nested, endpoint, visited)
+	case "Condition":
+		return explainConditionMatch(expression, endpoint)
+	case "IPAddressExpression":
+		for _, value := range explainStructList(expression, "ip_addresses") {
+			if sv, ok := value.(*data.StringValue); ok && explainIPsMatch(sv.Value(), endpoint.IPs) {
+				return true
+			}
+		}
+		return false
+	case "PathExpression":
+		for _, value := range explainStructList(expression, "paths") {
+			if sv, ok := value.(*data.StringValue); ok && e.groupContains(sv.Value(), endpoint, visited) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+// explainConditionMatch evaluates the tag condition built by buildExpression with the workload port tags.
+func explainConditionMatch(condition *data.StructValue, endpoint *ExplainEndpoint) bool {
+	memberType := explainStructString(condition, "member_type")
+	if memberType != "VpcSubnetPort" && memberType != "SegmentPort" {
+		return false
+	}
+	if explainStructString(condition, "key") != "Tag" {
+		return false
+	}
+	scope, value, _ := strings.Cut(explainStructString(condition, "value"), "|")
+	var tagValue *string
+	for i := range endpoint.Tags {
+		if explainString(endpoint.Tags[i].Scope) == scope {
+			tagValue = endpoint.Tags[i].Tag
+			break
+		}
+	}
+	if explainStructString(condition, "scope_operator") == "NOTEQUALS" {
+		return tagValue == nil
+	}
+	switch explainStructString(condition, "operator") {
+	case "EQUALS":
+		return tagValue != nil && (value == "" || *tagValue == value)
+	case "IN":
+		return tagValue != nil && explainContains(strings.Split(value, ","), *tagValue)
+	case "NOTIN":
+		return tagValue == nil || !explainContains(strings.Split(value, ","), *tagValue)
+	default:
+		return false
+	}
+}
+
+func explainServiceEntriesMatch(entries []*data.StructValue, flow *ExplainFlow) bool {
+	for _, entry := range entries {
+		switch explainStructString(entry, "resource_type") {
+		case "L4PortSetServiceEntry":
+			if !strings.EqualFold(explainStructString(entry, "l4_protocol"), flow.Protocol) {
+				continue
+			}
+			ports := explainStructList(entry, "destination_ports")
+			if len(ports) == 0 {
+				return true
+			}
+			for _, port := range ports {
+				if sv, ok := port.(*data.StringValue); ok && explainPortMatch(sv.Value(), flow.Port) {
+					return true
+				}
+			}
+		case "ICMPTypeServiceEntry":
+			protocol := icmpServiceEntryProtocolV4
+			if strings.EqualFold(flow.Protocol, icmpServiceEntryProtocolV6) {
+				protocol = icmpServiceEntryProtocolV6
+			} else if !strings.EqualFold(flow.Protocol, "ICMP") && !strings.EqualFold(flow.Protocol, icmpServiceEntryProtocolV4) {
+				continue
+			}
+			if explainStructString(entry, "protocol") != protocol {
+				continue
+			}
+			if icmpType, ok := explainStructInt(entry, "icmp_type"); ok && (flow.ICMPType == nil || *flow.ICMPType != icmpType) {
+				continue
+			}
+			if icmpCode, ok := explainStructInt(entry, "icmp_code"); ok && (flow.ICMPCode == nil || *flow.ICMPCode != icmpCode) {
+				continue
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// explainPortMatch checks the port is in the NSX port range, e.g. "80" or "8080-8090".
+func explainPortMatch(portRange string, port int64) bool {
+	start, end, isRange := strings.Cut(portRange, "-")
+	startPort, err := strconv.ParseInt(start, 10, 64)
+	if err != nil {
+		return false
+	}
+	if !isRange {
+		return port == startPort
+	}
+	endPort, err := strconv.ParseInt(end, 10, 64)
+	if err != nil {
+		return false
+	}
+	return port >= startPort && port <= endPort
+}
+
+// explainIPsMatch checks any of the IPs is in the NSX IP address, which is an IP, a CIDR or an IP range.
+func explainIPsMatch(address string, ips []string) bool {
+	for _, ipStr := range ips {
+		ip := net.ParseIP(ipStr)
+		if ip == nil {
+			continue
+		}
+		if start, end, isRange := strings.Cut(address, "-"); isRange {
+			startIP, endIP := net.ParseIP(start), net.ParseIP(end)
+			if startIP != nil && endIP != nil && explainIPCompare(ip, startIP) >= 0 && explainIPCompare(ip, endIP) <= 0 {
+				return true
+			}
+			continue
+		}
+		if _, cidr, err := net.ParseCIDR(address); err == nil {
+			if cidr.Contains(ip) {
+				return true
+			}
+			continue
+		}
+		if addressIP := net.ParseIP(address); addressIP != nil && addressIP.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func explainIPCompare(a, b net.IP) int {
+	return strings.Compare(string(a.To16()), string(b.To16()))
+}
+
+func isAnyPaths(paths []string) bool {
+	return len(paths) == 0 || (len(paths) == 1 && paths[0] == explainAny)
+}
+
+func explainContains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func explainCategory(category *string) string {
+	// NSX reports the Application category on the policies created without a category.
+	if category == nil || *category == "" {
+		return securityPolicyCategoryApplication
+	}
+	return *category
+}
+
+func explainCategoryIndex(category *string) int {
+	c := explainCategory(category)
+	for i, name := range explainCategoryOrder {
+		if name == c {
+			return i
+		}
+	}
+	return len(explainCategoryOrder)
+}
+
+func explainString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func explainInt64(i *int64) int64 {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
+
+func explainStructField(sv *data.StructValue, name string) data.DataValue {
+	if sv == nil {
+		return nil
+	}
+	field, err := sv.Field(name)
+	if err != nil {
+		return nil
+	}
+	if ov, ok := field.(*data.OptionalValue); ok {
+		return ov.Value()
+	}
+	return field
+}
+
+func explainStructString(sv *data.StructValue, name string) string {
+	if value, ok := explainStructField(sv, name).(*data.StringValue); ok {
+		return value.Value()
+	}
+	return ""
+}
+
+func explainStructInt(sv *data.StructValue, name string) (int64, bool) {
+	if value, ok := explainStructField(sv, name).(*data.IntegerValue); ok {
+		return value.Value(), true
+	}
+	return 0, false
+}
+
+func explainStructList(sv *data.StructValue, name string) []data.DataValue {
+	if value, ok := explainStructField(sv, name).(*data.ListValue); ok {
+		return value.List()
+	}
+	return nil
+}
+
+// LoadExplainer returns the Explainer with the security policies, rules and groups created by this cluster for
+// the SecurityPolicy CRs and NetworkPolicies in the namespaces, which are read from NSX Policy search.
+// It is used by read-only consumers (e.g. EAS) which do not run the SecurityPolicyService.
+func LoadExplainer(service common.Service, namespaces ...string) (*Explainer, error) {
+	var policies []*model.SecurityPolicy
+	var rules []*model.Rule
+	var groups []*model.Group
+	for _, namespace := range namespaces {
+		s := &SecurityPolicyService{Service: service}
+		s.setUpStore(common.TagScopeSecurityPolicyUID, true)
+		tags := []model.Tag{
+			{
+				Scope: String(common.TagScopeNamespace),
+				Tag:   String(namespace),
+			},
+		}
+
+		wg := sync.WaitGroup{}
+		fatalErrors := make(chan error, 3)
+		wg.Add(3)
+		s.InitializeResourceStore(&wg, fatalErrors, ResourceTypeSecurityPolicy, tags, s.securityPolicyStore)
+		s.InitializeResourceStore(&wg, fatalErrors, ResourceTypeRule, tags, s.ruleStore)
+		s.InitializeResourceStore(&wg, fatalErrors, ResourceTypeGroup, tags, s.groupStore)
+		wg.Wait()
+		select {
+		case err := <-fatalErrors:
+			return nil, fmt.Errorf("failed to load security policies for namespace %s: %w", namespace, err)
+		default:
+		}
+
+		policies = append(policies, s.securityPolicyStore.ListSecurityPolicies()...)
+		for _, obj := range s.ruleStore.List() {
+			rules = append(rules, obj.(*model.Rule))
+		}
+		for _, obj := range s.groupStore.List() {
+			groups = append(groups, obj.(*model.Group))
+		}
+	}
+	return NewExplainer(dedupExplainPolicies(policies), rules, groups), nil
+}
+
+// dedupExplainPolicies removes the duplicated policies loaded for both the source and destination namespaces.
+func dedupExplainPolicies(policies []*model.SecurityPolicy) []*model.SecurityPolicy {
+	seen := map[string]bool{}
+	var result []*model.SecurityPolicy
+	for _, policy := range policies {
+		if policy.Path == nil || seen[*policy.Path] {
+			continue
+		}
+		seen[*policy.Path] = true
+		result = append(result, policy)
+	}
+	return result
+}
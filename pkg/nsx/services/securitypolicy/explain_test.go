@@ -0,0 +1,166 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package securitypolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func explainTestGroup(path string, expressions ...*data.StructValue) *model.Group {
+	return &model.Group{Path: String(path), Expression: expressions}
+}
+
+func explainTestL4Entry(protocol string, ports ...string) *data.StructValue {
+	destinationPorts := data.NewListValue()
+	for _, port := range ports {
+		destinationPorts.Add(data.NewStringValue(port))
+	}
+	return data.NewStructValue("", map[string]data.DataValue{
+		"resource_type":     data.NewStringValue("L4PortSetServiceEntry"),
+		"l4_protocol":       data.NewStringValue(protocol),
+		"destination_ports": destinationPorts,
+	})
+}
+
+func TestExplainer_Explain(t *testing.T) {
+	s := &SecurityPolicyService{}
+	appliedGroup := explainTestGroup("/groups/applied",
+		s.buildExpression("Condition", "VpcSubnetPort", "nsx-op/app|db", "Tag", "EQUALS", "EQUALS"))
+	srcGroup := explainTestGroup("/groups/src",
+		s.buildExpression("Condition", "VpcSubnetPort", "nsx-op/app|web", "Tag", "EQUALS", "EQUALS"),
+		data.NewStructValue("", map[string]data.DataValue{
+			"resource_type":        data.NewStringValue("ConjunctionOperator"),
+			"conjunction_operator": data.NewStringValue("AND"),
+		}),
+		s.buildExpression("Condition", "VpcSubnetPort", "nsx-op/env|dev,test", "Tag", "NOTIN", "EQUALS"))
+	ipAddresses := data.NewListValue()
+	ipAddresses.Add(data.NewStringValue("192.168.0.0/24"))
+	ipGroup := explainTestGroup("/groups/ip", data.NewStructValue("", map[string]data.DataValue{
+		"resource_type": data.NewStringValue("IPAddressExpression"),
+		"ip_addresses":  ipAddresses,
+	}))
+	groups := []*model.Group{appliedGroup, srcGroup, ipGroup}
+
+	policies := []*model.SecurityPolicy{
+		{Path: String("/policies/app"), SequenceNumber: Int64(1), Scope: []string{"/groups/applied"}},
+		{Path: String("/policies/env"), SequenceNumber: Int64(1), Category: String(securityPolicyCategoryEnvironment), Scope: []string{"ANY"}},
+	}
+	rules := []*model.Rule{
+		{
+			Path: String("/policies/app/rules/allow-web"), ParentPath: String("/policies/app"), DisplayName: String("allow-web"),
+			SequenceNumber: Int64(1), Direction: String("IN"), Action: String(model.Rule_ACTION_ALLOW),
+			SourceGroups: []string{"/groups/src"}, ServiceEntries: []*data.StructValue{explainTestL4Entry("TCP", "5432", "8000-8080")},
+		},
+		{
+			Path: String("/policies/app/rules/drop-all"), ParentPath: String("/policies/app"), DisplayName: String("drop-all"),
+			SequenceNumber: Int64(2), Direction: String("IN"), Action: String(model.Rule_ACTION_DROP),
+		},
+		{
+			Path: String("/policies/env/rules/jump"), ParentPath: String("/policies/env"), DisplayName: String("jump"),
+			SequenceNumber: Int64(1), Direction: String("IN_OUT"), Action: String(nsxRuleActionJumpToApplication),
+			SourceGroups: []string{"/groups/ip"},
+		},
+		{
+			Path: String("/policies/env/rules/reject-ip"), ParentPath: String("/policies/env"), DisplayName: String("reject-ip"),
+			SequenceNumber: Int64(2), Direction: String("OUT"), Action: String(model.Rule_ACTION_REJECT),
+			DestinationGroups: []string{"/groups/ip"},
+		},
+	}
+	explainer := NewExplainer(policies, rules, groups)
+
+	web := ExplainEndpoint{Name: "ns1/web", PortPath: "/ports/web", Tags: []model.Tag{{Scope: String("nsx-op/app"), Tag: String("web")}}, IPs: []string{"10.0.0.1"}}
+	webDev := ExplainEndpoint{Name: "ns1/web-dev", PortPath: "/ports/web-dev", Tags: []model.Tag{{Scope: String("nsx-op/app"), Tag: String("web")}, {Scope: String("nsx-op/env"), Tag: String("dev")}}}
+	db := ExplainEndpoint{Name: "ns1/db", PortPath: "/ports/db", Tags: []model.Tag{{Scope: String("nsx-op/app"), Tag: String("db")}}, IPs: []string{"10.0.0.10"}}
+	external := ExplainEndpoint{Name: "192.168.0.20", IPs: []string{"192.168.0.20"}}
+
+	tests := []struct {
+		name         string
+		flow         *ExplainFlow
+		verdict      string
+		decidingRule string
+		traceLen     int
+	}{
+		{
+			name:         "allowed by port range",
+			flow:         &ExplainFlow{Source: web, Destination: db, Protocol: "TCP", Port: 8080},
+			verdict:      model.Rule_ACTION_ALLOW,
+			decidingRule: "allow-web",
+			traceLen:     4,
+		},
+		{
+			name:         "dropped for the port not in rule",
+			flow:         &ExplainFlow{Source: web, Destination: db, Protocol: "TCP", Port: 22},
+			verdict:      model.Rule_ACTION_DROP,
+			decidingRule: "drop-all",
+			traceLen:     5,
+		},
+		{
+			name:         "dropped for the source tag in NOTIN values",
+			flow:         &ExplainFlow{Source: webDev, Destination: db, Protocol: "TCP", Port: 5432},
+			verdict:      model.Rule_ACTION_DROP,
+			decidingRule: "drop-all",
+			traceLen:     5,
+		},
+		{
+			name:         "egress rejected to IP group",
+			flow:         &ExplainFlow{Source: web, Destination: external, Protocol: "UDP", Port: 53},
+			verdict:      model.Rule_ACTION_REJECT,
+			decidingRule: "reject-ip",
+			traceLen:     2,
+		},
+		{
+			name:         "jump to application from environment",
+			flow:         &ExplainFlow{Source: external, Destination: db, Protocol: "TCP", Port: 5432},
+			verdict:      model.Rule_ACTION_DROP,
+			decidingRule: "drop-all",
+			traceLen:     3,
+		},
+		{
+			name:     "no rule matched",
+			flow:     &ExplainFlow{Source: db, Destination: web, Protocol: "TCP", Port: 80},
+			verdict:  ExplainVerdictNoMatchingRule,
+			traceLen: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := explainer.Explain(tt.flow)
+			assert.Equal(t, tt.verdict, result.Verdict)
+			if tt.decidingRule == "" {
+				assert.Nil(t, result.DecidingRule)
+			} else {
+				assert.Equal(t, tt.decidingRule, result.DecidingRule.RuleName)
+			}
+			assert.Len(t, result.Trace, tt.traceLen)
+		})
+	}
+}
+
+func TestExplainServiceEntriesMatch(t *testing.T) {
+	icmpEcho := data.NewStructValue("", map[string]data.DataValue{
+		"resource_type": data.NewStringValue("ICMPTypeServiceEntry"),
+		"protocol":      data.NewStringValue(icmpServiceEntryProtocolV4),
+		"icmp_type":     data.NewIntegerValue(8),
+	})
+	entries := []*data.StructValue{explainTestL4Entry("UDP"), icmpEcho}
+
+	assert.True(t, explainServiceEntriesMatch(entries, &ExplainFlow{Protocol: "UDP", Port: 53}))
+	assert.False(t, explainServiceEntriesMatch(entries, &ExplainFlow{Protocol: "TCP", Port: 53}))
+	assert.True(t, explainServiceEntriesMatch(entries, &ExplainFlow{Protocol: "ICMP", ICMPType: Int64(8)}))
+	assert.False(t, explainServiceEntriesMatch(entries, &ExplainFlow{Protocol: "ICMP", ICMPType: Int64(0)}))
+	assert.False(t, explainServiceEntriesMatch(entries, &ExplainFlow{Protocol: "ICMPv6", ICMPType: Int64(8)}))
+}
+
+func TestExplainIPsMatch(t *testing.T) {
+	assert.True(t, explainIPsMatch("10.0.0.1", []string{"10.0.0.1"}))
+	assert.True(t, explainIPsMatch("10.0.0.0/24", []string{"fd00::1", "10.0.0.9"}))
+	assert.True(t, explainIPsMatch("10.0.0.5-10.0.0.10", []string{"10.0.0.7"}))
+	assert.False(t, explainIPsMatch("10.0.0.5-10.0.0.10", []string{"10.0.0.11"}))
+	assert.False(t, explainIPsMatch("10.0.1.0/24", []string{"10.0.0.9"}))
+}
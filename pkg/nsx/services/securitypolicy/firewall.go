@@ -4,6 +4,7 @@
 package securitypolicy
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -35,6 +36,7 @@ var (
 	ResourceTypeRule           = common.ResourceTypeRule
 	ResourceTypeGroup          = common.ResourceTypeGroup
 	ResourceTypeShare          = common.ResourceTypeShare
+	ResourceTypeContextProfile = common.ResourceTypeContextProfile
 	NewConverter               = common.NewConverter
 )
 
@@ -47,6 +49,7 @@ type SecurityPolicyService struct {
 	infraShareStore     *ShareStore
 	projectGroupStore   *GroupStore
 	projectShareStore   *ShareStore
+	contextProfileStore *ContextProfileStore
 	vpcService          common.VPCServiceProvider
 
 	securityPolicyBuilder *common.PolicyTreeBuilder[*model.SecurityPolicy]
@@ -90,7 +93,7 @@ func InitializeSecurityPolicy(service common.Service, vpcService common.VPCServi
 	wgDone := make(chan bool)
 	fatalErrors := make(chan error)
 
-	wg.Add(7)
+	wg.Add(8)
 
 	securityPolicyService := &SecurityPolicyService{
 		Service: service,
@@ -145,6 +148,7 @@ func InitializeSecurityPolicy(service common.Service, vpcService common.VPCServi
 	}
 	go securityPolicyService.InitializeResourceStore(&wg, fatalErrors, ResourceTypeSecurityPolicy, nil, securityPolicyService.securityPolicyStore)
 	go securityPolicyService.InitializeResourceStore(&wg, fatalErrors, ResourceTypeRule, nil, securityPolicyService.ruleStore)
+	go securityPolicyService.InitializeResourceStore(&wg, fatalErrors, ResourceTypeContextProfile, nil, securityPolicyService.contextProfileStore)
 
 	go func() {
 		wg.Wait()
@@ -161,6 +165,44 @@ func InitializeSecurityPolicy(service common.Service, vpcService common.VPCServi
 	return securityPolicyService, nil
 }
 
+// DriftSources returns the NSX SecurityPolicies, Rules and Groups in the stores to be scanned for the drift, the
+// shared Groups and Shares are not included as they are not owned by a single CR.
+func (s *SecurityPolicyService) DriftSources() []common.DriftSource {
+	var groupTags []model.Tag
+	if IsVPCEnabled(s) {
+		groupTags = []model.Tag{
+			{
+				Scope: String(common.TagScopeNSXShareCreatedFor),
+				Tag:   String(common.TagValueShareNotCreated),
+			},
+		}
+	}
+	return []common.DriftSource{
+		{
+			ResourceType: ResourceTypeSecurityPolicy,
+			Store:        &s.securityPolicyStore.ResourceStore,
+			ToComparable: func(obj interface{}) common.Comparable {
+				return SecurityPolicyPtrToComparable(obj.(*model.SecurityPolicy))
+			},
+		},
+		{
+			ResourceType: ResourceTypeRule,
+			Store:        &s.ruleStore.ResourceStore,
+			ToComparable: func(obj interface{}) common.Comparable {
+				return (*Rule)(obj.(*model.Rule))
+			},
+		},
+		{
+			ResourceType: ResourceTypeGroup,
+			Tags:         groupTags,
+			Store:        &s.groupStore.ResourceStore,
+			ToComparable: func(obj interface{}) common.Comparable {
+				return (*Group)(obj.(*model.Group))
+			},
+		},
+	}
+}
+
 func (s *SecurityPolicyService) setUpStore(indexScope string, indexWithVPCPath bool) {
 	vpcResourceIndexWrapper := func(indexers cache.Indexers) cache.Indexers {
 		indexers[indexScope] = indexBySecurityPolicyUID
@@ -226,9 +268,17 @@ func (s *SecurityPolicyService) setUpStore(indexScope string, indexWithVPCPath b
 		}),
 		BindingType: model.ShareBindingType(),
 	}}
+	s.contextProfileStore = &ContextProfileStore{ResourceStore: common.ResourceStore{
+		Indexer: cache.NewIndexer(keyFunc, cache.Indexers{
+			indexScope:                      indexBySecurityPolicyUID,
+			common.TagScopeNetworkPolicyUID: indexByNetworkPolicyUID,
+		}),
+		BindingType: model.PolicyContextProfileBindingType(),
+	}}
 }
 
-func (service *SecurityPolicyService) CreateOrUpdateSecurityPolicy(obj interface{}) error {
+// CreateOrUpdateSecurityPolicy realizes the SecurityPolicy or NetworkPolicy, the NSX requests are traced in the span of ctx.
+func (service *SecurityPolicyService) CreateOrUpdateSecurityPolicy(ctx context.Context, obj interface{}) error {
 	if !nsxutil.GetDFWLicense() {
 		log.Warn("No DFW license, skip creating SecurityPolicy.")
 		return nsxutil.RestrictionError{Desc: "no DFW license"}
@@ -242,17 +292,17 @@ func (service *SecurityPolicyService) CreateOrUpdateSecurityPolicy(obj interface
 			return err
 		}
 		for _, internalSecurityPolicy := range internalSecurityPolicies {
-			err = service.createOrUpdateVPCSecurityPolicy(internalSecurityPolicy, common.ResourceTypeNetworkPolicy)
+			err = service.createOrUpdateVPCSecurityPolicy(ctx, internalSecurityPolicy, common.ResourceTypeNetworkPolicy)
 			if err != nil {
 				return err
 			}
 		}
 	case *v1alpha1.SecurityPolicy:
 		if IsVPCEnabled(service) {
-			err = service.createOrUpdateVPCSecurityPolicy(obj, common.ResourceTypeSecurityPolicy)
+			err = service.createOrUpdateVPCSecurityPolicy(ctx, obj, common.ResourceTypeSecurityPolicy)
 		} else {
 			// For T1 network SecurityPolicy create/update
-			err = service.createOrUpdateT1SecurityPolicy(obj, common.ResourceTypeSecurityPolicy)
+			err = service.createOrUpdateT1SecurityPolicy(ctx, obj, common.ResourceTypeSecurityPolicy)
 		}
 	}
 	return err
@@ -358,6 +408,8 @@ func (service *SecurityPolicyService) generateSectionForNetworkPolicy(networkPol
 		},
 		Spec: v1alpha1.SecurityPolicySpec{
 			Priority: priority,
+			// Firewall logging is enabled for all rules generated from the NetworkPolicy by annotation.
+			Logging: networkPolicy.Annotations[common.AnnotationNetworkPolicyLogging] == "true",
 			AppliedTo: []v1alpha1.SecurityPolicyTarget{
 				{
 					PodSelector: &networkPolicy.Spec.PodSelector,
@@ -493,6 +545,13 @@ func (service *SecurityPolicyService) getFinalSecurityPolicyResource(obj *v1alph
 
 	// Normalize rule peers so that deprecated Sources/Destinations are migrated into From/To
 	normalizeSecurityPolicyRules(obj)
+	if err := service.checkFQDNSupported(obj); err != nil {
+		return nil, nil, nil, nil, false, err
+	}
+	if err := service.validateWorkloadReferences(obj); err != nil {
+		return nil, nil, nil, nil, false, err
+	}
+	normalizeWorkloadReferences(obj)
 
 	nsxSecurityPolicy, nsxGroups, nsxGroupShares, err := service.buildSecurityPolicy(obj, createdFor, vpcInfo, isDefaultProject)
 	if err != nil {
@@ -504,7 +563,7 @@ func (service *SecurityPolicyService) getFinalSecurityPolicyResource(obj *v1alph
 		log.Info("SecurityPolicy has empty policy-level appliedTo field")
 	}
 	indexScope := common.TagValueScopeSecurityPolicyUID
-	if createdFor == common.ResourceTypeNetworkPolicy {
+	if isCreatedForNetworkPolicy(createdFor) {
 		indexScope = common.TagScopeNetworkPolicyUID
 	}
 
@@ -559,18 +618,19 @@ func normalizeSecurityPolicyRules(obj *v1alpha1.SecurityPolicy) {
 	}
 }
 
-func (service *SecurityPolicyService) createOrUpdateT1SecurityPolicy(obj *v1alpha1.SecurityPolicy, createdFor string) error {
+func (service *SecurityPolicyService) createOrUpdateT1SecurityPolicy(ctx context.Context, obj *v1alpha1.SecurityPolicy, createdFor string) error {
 	finalSecurityPolicy, finalGroups, _, _, isChanged, err := service.getFinalSecurityPolicyResource(obj, createdFor, nil, false)
 	if err != nil {
 		log.Error(err, "Failed to get SecurityPolicy resources from CR", "securityPolicyUID", obj.UID)
 		return err
 	}
+	finalContextProfiles := service.getFinalContextProfiles(obj, common.TagValueScopeSecurityPolicyUID, createdFor)
 
 	// WrapHierarchySecurityPolicy will modify the input security policy rules and move the rules to Children fields for HAPI wrap,
 	// so we need to make a copy for the rules store update.
 	finalRules := finalSecurityPolicy.Rules
 
-	if !isChanged && len(finalSecurityPolicy.Rules) == 0 && len(finalGroups) == 0 {
+	if !isChanged && len(finalSecurityPolicy.Rules) == 0 && len(finalGroups) == 0 && len(finalContextProfiles) == 0 {
 		log.Info("SecurityPolicy, rules, groups are not changed, skip updating them", "nsxSecurityPolicyId", finalSecurityPolicy.Id)
 		return nil
 	}
@@ -580,14 +640,21 @@ func (service *SecurityPolicyService) createOrUpdateT1SecurityPolicy(obj *v1alph
 		log.Error(err, "Failed to wrap SecurityPolicy", "nsxSecurityPolicyId", finalSecurityPolicy.Id)
 		return err
 	}
-	err = service.NSXClient.InfraClient.Patch(*infraSecurityPolicy, &EnforceRevisionCheckParam)
+	contextProfilesChildren, err := service.wrapContextProfiles(finalContextProfiles)
+	if err != nil {
+		log.Error(err, "Failed to wrap context profiles", "nsxSecurityPolicyId", finalSecurityPolicy.Id)
+		return err
+	}
+	infraSecurityPolicy.Children = append(infraSecurityPolicy.Children, contextProfilesChildren...)
+	nsxClient := service.NSXClient.WithContext(ctx)
+	err = nsxClient.InfraClient.Patch(*infraSecurityPolicy, &EnforceRevisionCheckParam)
 	err = nsxutil.TransNSXApiError(err)
 	if err != nil {
 		log.Error(err, "Failed to create or update SecurityPolicy", "nsxSecurityPolicyId", finalSecurityPolicy.Id)
 		return err
 	}
 	// Get SecurityPolicy from NSX after HAPI call as NSX renders several fields like `path`/`parent_path`.
-	finalGetNSXSecurityPolicy, err := service.NSXClient.SecurityClient.Get(getDomain(service), *finalSecurityPolicy.Id)
+	finalGetNSXSecurityPolicy, err := nsxClient.SecurityClient.Get(getDomain(service), *finalSecurityPolicy.Id)
 	err = nsxutil.TransNSXApiError(err)
 	if err != nil {
 		log.Error(err, "Failed to get SecurityPolicy", "nsxSecurityPolicyId", finalSecurityPolicy.Id)
@@ -614,11 +681,16 @@ func (service *SecurityPolicyService) createOrUpdateT1SecurityPolicy(obj *v1alph
 		log.Error(err, "Failed to apply store", "nsxGroups", finalGroups)
 		return err
 	}
+	err = service.contextProfileStore.Apply(&finalContextProfiles)
+	if err != nil {
+		log.Error(err, "Failed to apply store", "nsxContextProfiles", finalContextProfiles)
+		return err
+	}
 	log.Info("Successfully created or updated NSX SecurityPolicy", "nsxSecurityPolicy", finalGetNSXSecurityPolicy)
 	return nil
 }
 
-func (service *SecurityPolicyService) createOrUpdateVPCSecurityPolicy(obj *v1alpha1.SecurityPolicy, createdFor string) error {
+func (service *SecurityPolicyService) createOrUpdateVPCSecurityPolicy(ctx context.Context, obj *v1alpha1.SecurityPolicy, createdFor string) error {
 	var err error
 	var finalGetNSXSecurityPolicy *model.SecurityPolicy
 
@@ -637,19 +709,30 @@ func (service *SecurityPolicyService) createOrUpdateVPCSecurityPolicy(obj *v1alp
 		log.Error(err, "Failed to get SecurityPolicy resources from CR", "securityPolicyUID", obj.UID)
 		return err
 	}
+	indexScope := common.TagValueScopeSecurityPolicyUID
+	if isCreatedForNetworkPolicy(createdFor) {
+		indexScope = common.TagScopeNetworkPolicyUID
+	}
+	finalContextProfiles := service.getFinalContextProfiles(obj, indexScope, createdFor)
 
 	// WrapHierarchyVpcSecurityPolicy will modify the input security policy rules and move the rules to Children fields for HAPI wrap,
 	// so we need to make a copy for the rules store update.
 	finalRules := finalSecurityPolicy.Rules
 
-	if !isChanged && len(finalSecurityPolicy.Rules) == 0 && len(finalGroups) == 0 && len(finalShares) == 0 {
+	if !isChanged && len(finalSecurityPolicy.Rules) == 0 && len(finalGroups) == 0 && len(finalShares) == 0 && len(finalContextProfiles) == 0 {
 		log.Info("SecurityPolicy, rules, groups and shares are not changed, skip updating them", "nsxSecurityPolicyId", finalSecurityPolicy.Id)
 		return nil
 	}
+
+	// The infra context profiles are created before the rules referring them, and deleted after the rules are updated.
+	staleContextProfiles, changedContextProfiles := service.getStaleUpdateContextProfiles(finalContextProfiles)
+	if err = service.patchInfraContextProfiles(ctx, changedContextProfiles); err != nil {
+		return err
+	}
 	if !isDefaultProject {
-		finalGetNSXSecurityPolicy, err = service.createOrUpdateNSXSecurityPolicy(finalSecurityPolicy, finalGroups, finalShares, finalShareGroups, vpcInfo)
+		finalGetNSXSecurityPolicy, err = service.createOrUpdateNSXSecurityPolicy(ctx, finalSecurityPolicy, finalGroups, finalShares, finalShareGroups, vpcInfo)
 	} else {
-		finalGetNSXSecurityPolicy, err = service.createOrUpdateNSXSecurityPolicyForDefaultProject(finalSecurityPolicy, finalGroups, finalShares, finalShareGroups, vpcInfo)
+		finalGetNSXSecurityPolicy, err = service.createOrUpdateNSXSecurityPolicyForDefaultProject(ctx, finalSecurityPolicy, finalGroups, finalShares, finalShareGroups, vpcInfo)
 	}
 	if err != nil {
 		return err
@@ -663,24 +746,32 @@ func (service *SecurityPolicyService) createOrUpdateVPCSecurityPolicy(obj *v1alp
 	if err != nil {
 		return err
 	}
+	if err = service.patchInfraContextProfiles(ctx, staleContextProfiles); err != nil {
+		return err
+	}
+	err = service.contextProfileStore.Apply(&finalContextProfiles)
+	if err != nil {
+		log.Error(err, "Failed to apply store", "nsxContextProfiles", finalContextProfiles)
+		return err
+	}
 
 	log.Info("Successfully created or updated NSX SecurityPolicy resources in VPC", "nsxSecurityPolicy", *finalGetNSXSecurityPolicy)
 	return nil
 }
 
-func (service *SecurityPolicyService) DeleteSecurityPolicy(spUid types.UID, isGC bool, createdFor string) error {
+func (service *SecurityPolicyService) DeleteSecurityPolicy(ctx context.Context, spUid types.UID, isGC bool, createdFor string) error {
 	var err error
 	// For VPC network, SecurityPolicy normal deletion, GC deletion and cleanup
 	if IsVPCEnabled(service) {
-		err = service.deleteVPCSecurityPolicy(spUid, isGC, createdFor)
+		err = service.deleteVPCSecurityPolicy(ctx, spUid, isGC, createdFor)
 	} else {
 		// For T1 network, SecurityPolicy normal deletion and GC deletion
-		err = service.deleteT1SecurityPolicy(spUid)
+		err = service.deleteT1SecurityPolicy(ctx, spUid)
 	}
 	return err
 }
 
-func (service *SecurityPolicyService) deleteT1SecurityPolicy(spUid types.UID) error {
+func (service *SecurityPolicyService) deleteT1SecurityPolicy(ctx context.Context, spUid types.UID) error {
 	var nsxSecurityPolicy *model.SecurityPolicy
 	var err error
 
@@ -712,6 +803,7 @@ func (service *SecurityPolicyService) deleteT1SecurityPolicy(spUid types.UID) er
 	existingRules := ruleStore.GetByIndex(indexScope, string(spUid))
 	nsxRules := service.getMarkDeleteRules(existingRules, spUid)
 	nsxSecurityPolicy.Rules = nsxRules
+	nsxContextProfiles := service.getMarkDeleteContextProfiles(indexScope, spUid)
 
 	// WrapHierarchySecurityPolicy will modify the input security policy, so we need to make a copy for the following store update.
 	finalSecurityPolicyCopy := *nsxSecurityPolicy
@@ -722,7 +814,13 @@ func (service *SecurityPolicyService) deleteT1SecurityPolicy(spUid types.UID) er
 		log.Error(err, "Failed to wrap SecurityPolicy", "nsxSecurityPolicyId", nsxSecurityPolicy.Id)
 		return err
 	}
-	err = service.NSXClient.InfraClient.Patch(*infraSecurityPolicy, &EnforceRevisionCheckParam)
+	contextProfilesChildren, err := service.wrapContextProfiles(nsxContextProfiles)
+	if err != nil {
+		log.Error(err, "Failed to wrap context profiles", "nsxSecurityPolicyId", nsxSecurityPolicy.Id)
+		return err
+	}
+	infraSecurityPolicy.Children = append(infraSecurityPolicy.Children, contextProfilesChildren...)
+	err = service.NSXClient.WithContext(ctx).InfraClient.Patch(*infraSecurityPolicy, &EnforceRevisionCheckParam)
 	err = nsxutil.TransNSXApiError(err)
 	if err != nil {
 		log.Error(err, "Failed to delete SecurityPolicy", "nsxSecurityPolicyId", nsxSecurityPolicy.Id)
@@ -744,14 +842,19 @@ func (service *SecurityPolicyService) deleteT1SecurityPolicy(spUid types.UID) er
 		log.Error(err, "Failed to apply store", "nsxGroups", nsxGroups)
 		return err
 	}
+	err = service.contextProfileStore.Apply(&nsxContextProfiles)
+	if err != nil {
+		log.Error(err, "Failed to apply store", "nsxContextProfiles", nsxContextProfiles)
+		return err
+	}
 
 	log.Info("Successfully deleted NSX SecurityPolicy", "nsxSecurityPolicy", finalSecurityPolicyCopy)
 	return nil
 }
 
-func (service *SecurityPolicyService) deleteVPCSecurityPolicy(spUID types.UID, isGC bool, createdFor string) error {
+func (service *SecurityPolicyService) deleteVPCSecurityPolicy(ctx context.Context, spUID types.UID, isGC bool, createdFor string) error {
 	indexScope := common.TagValueScopeSecurityPolicyUID
-	if createdFor == common.ResourceTypeNetworkPolicy {
+	if isCreatedForNetworkPolicy(createdFor) {
 		indexScope = common.TagScopeNetworkPolicyUID
 	}
 
@@ -766,6 +869,13 @@ func (service *SecurityPolicyService) deleteVPCSecurityPolicy(spUID types.UID, i
 		return err
 	}
 
+	nsxContextProfiles := service.getMarkDeleteContextProfiles(indexScope, spUID)
+	// The context profiles are the only stale resources if they failed to be deleted after the other resources.
+	if isGC && len(nsxContextProfiles) != 0 && nsxSecurityPolicy == nil && len(nsxGroups) == 0 && len(nsxInfraShares) == 0 && len(nsxInfraShareGroups) == 0 &&
+		len(nsxProjectShares) == 0 && len(nsxProjectShareGroups) == 0 {
+		return service.deleteContextProfiles(ctx, nsxContextProfiles)
+	}
+
 	isDefaultProject := false
 	// For GC case, it usually will follow the normal deletion process.
 	// Infra shares and groups also could be GC with NSX security policy together if security policy is found in store.
@@ -793,7 +903,7 @@ func (service *SecurityPolicyService) deleteVPCSecurityPolicy(spUID types.UID, i
 	}
 
 	if nsxSecurityPolicy != nil {
-		err = service.deleteNSXSecurityPolicy(nsxSecurityPolicy, &vpcInfo)
+		err = service.deleteNSXSecurityPolicy(ctx, nsxSecurityPolicy, &vpcInfo)
 		if err != nil {
 			log.Error(err, "Failed to delete NSX SecurityPolicy and rules in VPC", "nsxSecurityPolicyUID", spUID)
 			return err
@@ -806,9 +916,9 @@ func (service *SecurityPolicyService) deleteVPCSecurityPolicy(spUID types.UID, i
 	}
 
 	if !isDefaultProject {
-		err = service.deleteNSXSecurityPolicyGroupShare(nsxGroups, nsxProjectShares, nsxProjectShareGroups, &vpcInfo)
+		err = service.deleteNSXSecurityPolicyGroupShare(ctx, nsxGroups, nsxProjectShares, nsxProjectShareGroups, &vpcInfo)
 	} else {
-		err = service.deleteNSXSecurityPolicyGroupShareForDefaultProject(nsxGroups, nsxInfraShares, nsxInfraShareGroups, &vpcInfo)
+		err = service.deleteNSXSecurityPolicyGroupShareForDefaultProject(ctx, nsxGroups, nsxInfraShares, nsxInfraShareGroups, &vpcInfo)
 	}
 	// Ignore error here to make groups/shares to be deleted in GC.
 	// Because NSX SecurityPolicy is deleted, it's unable to get SecurityPolicyUID from SecurityPolicyStore for fetching groups/shares even by requeuing the error.
@@ -827,6 +937,11 @@ func (service *SecurityPolicyService) deleteVPCSecurityPolicy(spUID types.UID, i
 		return err
 	}
 
+	// Ignore error here as the stale context profiles will be GC.
+	if err = service.deleteContextProfiles(ctx, nsxContextProfiles); err != nil {
+		log.Error(err, "Failed to delete NSX context profiles after NSX SecurityPolicy is deleted, and the context profiles will be GC", "nsxSecurityPolicyUID", spUID)
+	}
+
 	if isGC {
 		log.Info("Successfully GC NSX SecurityPolicy, rules, groups and shares in VPC", "nsxSecurityPolicyUID", spUID)
 	} else {
@@ -1039,11 +1154,12 @@ func (service *SecurityPolicyService) markSecurityPolicyResourcesDelete(indexSco
 }
 
 // createOrUpdateNSXSecurityPolicy uses hierarchy API call to create/update SecurityPolicy on the whole resource tree for non-Default Project.
-func (service *SecurityPolicyService) createOrUpdateNSXSecurityPolicy(nsxSecurityPolicy *model.SecurityPolicy, nsxGroups []model.Group,
+func (service *SecurityPolicyService) createOrUpdateNSXSecurityPolicy(ctx context.Context, nsxSecurityPolicy *model.SecurityPolicy, nsxGroups []model.Group,
 	nsxShares []model.Share, nsxShareGroups []model.Group, vpcInfo *common.VPCResourceInfo,
 ) (*model.SecurityPolicy, error) {
 	var err error
 	var projectInfraResource []*data.StructValue
+	nsxClient := service.NSXClient.WithContext(ctx)
 
 	if len(nsxShares) != 0 {
 		// Wrap project groups and shares into project child infra.
@@ -1061,7 +1177,7 @@ func (service *SecurityPolicyService) createOrUpdateNSXSecurityPolicy(nsxSecurit
 		return nil, err
 	}
 	// Create/update SecurityPolicy together with groups, rules under VPC level and project groups, shares.
-	err = service.NSXClient.OrgRootClient.Patch(*orgRoot, &EnforceRevisionCheckParam)
+	err = nsxClient.OrgRootClient.Patch(*orgRoot, &EnforceRevisionCheckParam)
 	err = nsxutil.TransNSXApiError(err)
 	if err != nil {
 		log.Error(err, "Failed to create or update NSX SecurityPolicy in VPC", "nsxSecurityPolicyId", nsxSecurityPolicy.Id)
@@ -1069,7 +1185,7 @@ func (service *SecurityPolicyService) createOrUpdateNSXSecurityPolicy(nsxSecurit
 	}
 
 	// Get SecurityPolicy from NSX after HAPI call as NSX renders several fields like `path`/`parent_path`.
-	nsxGetSecurityPolicy, err := service.NSXClient.VPCSecurityClient.Get(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, *nsxSecurityPolicy.Id)
+	nsxGetSecurityPolicy, err := nsxClient.VPCSecurityClient.Get(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, *nsxSecurityPolicy.Id)
 	err = nsxutil.TransNSXApiError(err)
 	if err != nil {
 		log.Error(err, "Failed to get NSX SecurityPolicy in VPC", "nsxSecurityPolicyId", nsxSecurityPolicy.Id)
@@ -1086,13 +1202,14 @@ func (service *SecurityPolicyService) createOrUpdateNSXSecurityPolicy(nsxSecurit
 }
 
 // createOrUpdateNSXSecurityPolicyForDefaultProject uses hierarchy API call to create/update SecurityPolicy on the whole resource tree for Default Project.
-func (service *SecurityPolicyService) createOrUpdateNSXSecurityPolicyForDefaultProject(nsxSecurityPolicy *model.SecurityPolicy, nsxGroups []model.Group,
+func (service *SecurityPolicyService) createOrUpdateNSXSecurityPolicyForDefaultProject(ctx context.Context, nsxSecurityPolicy *model.SecurityPolicy, nsxGroups []model.Group,
 	nsxShares []model.Share, nsxShareGroups []model.Group, vpcInfo *common.VPCResourceInfo,
 ) (*model.SecurityPolicy, error) {
 	var err error
 	var infraResource *model.Infra
 	var projectInfraResource []*data.StructValue
 	nsxGetSecurityPolicy := model.SecurityPolicy{}
+	nsxClient := service.NSXClient.WithContext(ctx)
 
 	finalStaleShares, finalChangedShares := service.getStaleUpdateShares(nsxShares)
 	finalStaleShareGroups, finalChangedShareGroups := service.getStaleUpdateGroups(nsxShareGroups)
@@ -1106,7 +1223,7 @@ func (service *SecurityPolicyService) createOrUpdateNSXSecurityPolicyForDefaultP
 			return nil, err
 		}
 
-		err = service.NSXClient.InfraClient.Patch(*infraResource, &EnforceRevisionCheckParam)
+		err = nsxClient.InfraClient.Patch(*infraResource, &EnforceRevisionCheckParam)
 		err = nsxutil.TransNSXApiError(err)
 		if err != nil {
 			log.Error(err, "Failed to create or update NSX infra resource", "nsxSecurityPolicyId", nsxSecurityPolicy.Id)
@@ -1122,7 +1239,7 @@ func (service *SecurityPolicyService) createOrUpdateNSXSecurityPolicyForDefaultP
 	}
 
 	// Create/update SecurityPolicy together with groups, rules under VPC level.
-	err = service.NSXClient.OrgRootClient.Patch(*orgRoot, &EnforceRevisionCheckParam)
+	err = nsxClient.OrgRootClient.Patch(*orgRoot, &EnforceRevisionCheckParam)
 	err = nsxutil.TransNSXApiError(err)
 	if err != nil {
 		log.Error(err, "Failed to create or update SecurityPolicy in VPC", "nsxSecurityPolicyId", nsxSecurityPolicy.Id)
@@ -1137,7 +1254,7 @@ func (service *SecurityPolicyService) createOrUpdateNSXSecurityPolicyForDefaultP
 			log.Error(err, "Failed to wrap NSX infra stale groups and shares", "nsxSecurityPolicyId", nsxSecurityPolicy.Id)
 			return nil, err
 		}
-		err = service.NSXClient.InfraClient.Patch(*infraResource, &EnforceRevisionCheckParam)
+		err = nsxClient.InfraClient.Patch(*infraResource, &EnforceRevisionCheckParam)
 		err = nsxutil.TransNSXApiError(err)
 		if err != nil {
 			log.Error(err, "Failed to delete NSX infra Resource", "nsxSecurityPolicyId", nsxSecurityPolicy.Id)
@@ -1146,7 +1263,7 @@ func (service *SecurityPolicyService) createOrUpdateNSXSecurityPolicyForDefaultP
 	}
 
 	// Get SecurityPolicy from NSX after HAPI call as NSX renders several fields like `path`/`parent_path`.
-	nsxGetSecurityPolicy, err = service.NSXClient.VPCSecurityClient.Get(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, *nsxSecurityPolicy.Id)
+	nsxGetSecurityPolicy, err = nsxClient.VPCSecurityClient.Get(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, *nsxSecurityPolicy.Id)
 	err = nsxutil.TransNSXApiError(err)
 	if err != nil {
 		log.Error(err, "Failed to get SecurityPolicy in VPC", "nsxSecurityPolicyId", nsxSecurityPolicy.Id)
@@ -1163,11 +1280,11 @@ func (service *SecurityPolicyService) createOrUpdateNSXSecurityPolicyForDefaultP
 }
 
 // deleteNSXSecurityPolicy deletes NSX SecurityPolicy, rules and the groups/shares for both NSX Default Project and non-Default Project.
-func (service *SecurityPolicyService) deleteNSXSecurityPolicy(nsxSecurityPolicy *model.SecurityPolicy, vpcInfo *common.VPCResourceInfo) error {
+func (service *SecurityPolicyService) deleteNSXSecurityPolicy(ctx context.Context, nsxSecurityPolicy *model.SecurityPolicy, vpcInfo *common.VPCResourceInfo) error {
 	var err error
 
 	// Delete NSX SecurityPolicy and rules only.
-	err = service.NSXClient.VPCSecurityClient.Delete(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, *nsxSecurityPolicy.Id)
+	err = service.NSXClient.WithContext(ctx).VPCSecurityClient.Delete(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, *nsxSecurityPolicy.Id)
 	err = nsxutil.TransNSXApiError(err)
 	if err != nil {
 		log.Error(err, "Failed to delete NSX SecurityPolicy in VPC", "nsxSecurityPolicyId", nsxSecurityPolicy.Id)
@@ -1178,7 +1295,7 @@ func (service *SecurityPolicyService) deleteNSXSecurityPolicy(nsxSecurityPolicy
 }
 
 // deleteNSXSecurityPolicyGroupShare deletes NSX SecurityPolicy associated the groups/shares for non-Default Project.
-func (service *SecurityPolicyService) deleteNSXSecurityPolicyGroupShare(nsxGroups []model.Group,
+func (service *SecurityPolicyService) deleteNSXSecurityPolicyGroupShare(ctx context.Context, nsxGroups []model.Group,
 	nsxShares []model.Share, nsxShareGroups []model.Group, vpcInfo *common.VPCResourceInfo,
 ) error {
 	var err error
@@ -1200,7 +1317,7 @@ func (service *SecurityPolicyService) deleteNSXSecurityPolicyGroupShare(nsxGroup
 		return err
 	}
 	// Delete groups under VPC level together with project groups, shares.
-	err = service.NSXClient.OrgRootClient.Patch(*orgRoot, &EnforceRevisionCheckParam)
+	err = service.NSXClient.WithContext(ctx).OrgRootClient.Patch(*orgRoot, &EnforceRevisionCheckParam)
 	err = nsxutil.TransNSXApiError(err)
 	if err != nil {
 		log.Error(err, "Failed to delete NSX groups and shares in VPC")
@@ -1211,10 +1328,11 @@ func (service *SecurityPolicyService) deleteNSXSecurityPolicyGroupShare(nsxGroup
 }
 
 // deleteNSXSecurityPolicyGroupShareForDefaultProject deletes NSX SecurityPolicy associated the groups/shares for Default Project.
-func (service *SecurityPolicyService) deleteNSXSecurityPolicyGroupShareForDefaultProject(nsxGroups []model.Group,
+func (service *SecurityPolicyService) deleteNSXSecurityPolicyGroupShareForDefaultProject(ctx context.Context, nsxGroups []model.Group,
 	nsxShares []model.Share, nsxShareGroups []model.Group, vpcInfo *common.VPCResourceInfo,
 ) error {
 	var projectInfraResource []*data.StructValue
+	nsxClient := service.NSXClient.WithContext(ctx)
 
 	if len(nsxGroups) != 0 {
 		// Wrap VPC level groups into project child infra.
@@ -1225,7 +1343,7 @@ func (service *SecurityPolicyService) deleteNSXSecurityPolicyGroupShareForDefaul
 		}
 
 		// Delete groups under VPC level.
-		err = service.NSXClient.OrgRootClient.Patch(*orgRoot, &EnforceRevisionCheckParam)
+		err = nsxClient.OrgRootClient.Patch(*orgRoot, &EnforceRevisionCheckParam)
 		err = nsxutil.TransNSXApiError(err)
 		if err != nil {
 			log.Error(err, "Failed to delete NSX groups in VPC")
@@ -1241,7 +1359,7 @@ func (service *SecurityPolicyService) deleteNSXSecurityPolicyGroupShareForDefaul
 			return err
 		}
 		// Delete infra groups and shares.
-		err = service.NSXClient.InfraClient.Patch(*infraResource, &EnforceRevisionCheckParam)
+		err = nsxClient.InfraClient.Patch(*infraResource, &EnforceRevisionCheckParam)
 		err = nsxutil.TransNSXApiError(err)
 		if err != nil {
 			log.Error(err, "Failed to delete NSX infra groups and shares")
@@ -1315,7 +1433,14 @@ func (service *SecurityPolicyService) ListSecurityPolicyID() sets.Set[string] {
 
 func (service *SecurityPolicyService) ListNetworkPolicyID() sets.Set[string] {
 	indexScope := common.TagScopeNetworkPolicyUID
-	return service.getGCSecurityPolicyIDSet(indexScope)
+	idSet := service.getGCSecurityPolicyIDSet(indexScope)
+	// The internal SecurityPolicies of AdminNetworkPolicy and BaselineAdminNetworkPolicy are collected by their own controllers.
+	for id := range idSet {
+		if isAdminNetworkPolicySection(id) {
+			idSet.Delete(id)
+		}
+	}
+	return idSet
 }
 
 func (service *SecurityPolicyService) ListSecurityPolicyByName(ns, name string) []*model.SecurityPolicy {
@@ -1335,7 +1460,7 @@ func (service *SecurityPolicyService) ListNetworkPolicyByName(ns, name string) [
 	securityPolicies := service.securityPolicyStore.GetByIndex(common.TagScopeNamespace, ns)
 	for _, securityPolicy := range securityPolicies {
 		securityPolicyCRName := nsxutil.FindTag(securityPolicy.Tags, common.TagScopeNetworkPolicyName)
-		if securityPolicyCRName == name {
+		if securityPolicyCRName == name && !isAdminNetworkPolicySection(nsxutil.FindTag(securityPolicy.Tags, common.TagScopeNetworkPolicyUID)) {
 			result = append(result, securityPolicy)
 		}
 	}
@@ -1354,8 +1479,10 @@ func (service *SecurityPolicyService) getGCSecurityPolicyIDSet(indexScope string
 	// List SecurityPolicyID to which share resources are associated in infra share/group store
 	infraShareSet := service.infraShareStore.ListIndexFuncValues(indexScope)
 	infraGroupSet := service.infraGroupStore.ListIndexFuncValues(indexScope)
+	// List SecurityPolicyID to which context profiles are associated in context profile store
+	contextProfileSet := service.contextProfileStore.ListIndexFuncValues(indexScope)
 
-	return groupSet.Union(policySet).Union(projectShareSet).Union(projectGroupSet).Union(infraShareSet).Union(infraGroupSet)
+	return groupSet.Union(policySet).Union(projectShareSet).Union(projectGroupSet).Union(infraShareSet).Union(infraGroupSet).Union(contextProfileSet)
 }
 
 func (service *SecurityPolicyService) getVPCInfo(spNameSpace string) (*common.VPCResourceInfo, error) {
@@ -1380,3 +1507,30 @@ func (service *SecurityPolicyService) checkSecurityPolicyRealizationState(sp *mo
 	}
 	return nil
 }
+
+// LoadSecurityPolicyStoresByNamespace returns the SecurityPolicy and Rule stores hydrated from NSX Policy search with
+// the security policies and rules created by this cluster for the SecurityPolicy CRs and NetworkPolicies in namespace.
+// It is used by read-only consumers (e.g. EAS) which do not run the SecurityPolicyService.
+func LoadSecurityPolicyStoresByNamespace(service common.Service, namespace string) (*SecurityPolicyStore, *RuleStore, error) {
+	s := &SecurityPolicyService{Service: service}
+	s.setUpStore(common.TagScopeSecurityPolicyUID, true)
+	tags := []model.Tag{
+		{
+			Scope: String(common.TagScopeNamespace),
+			Tag:   String(namespace),
+		},
+	}
+
+	wg := sync.WaitGroup{}
+	fatalErrors := make(chan error, 2)
+	wg.Add(2)
+	s.InitializeResourceStore(&wg, fatalErrors, ResourceTypeSecurityPolicy, tags, s.securityPolicyStore)
+	s.InitializeResourceStore(&wg, fatalErrors, ResourceTypeRule, tags, s.ruleStore)
+	wg.Wait()
+	select {
+	case err := <-fatalErrors:
+		return nil, nil, err
+	default:
+	}
+	return s.securityPolicyStore, s.ruleStore, nil
+}
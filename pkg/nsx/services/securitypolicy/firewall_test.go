@@ -4,6 +4,7 @@
 package securitypolicy
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strings"
@@ -1254,7 +1255,7 @@ func Test_DeleteSecurityPolicy(t *testing.T) {
 				})
 			defer patches.Reset()
 
-			if err := fakeService.DeleteSecurityPolicy(tt.args.uid, false, tt.args.createdFor); (err != nil) != tt.wantErr {
+			if err := fakeService.DeleteSecurityPolicy(context.TODO(), tt.args.uid, false, tt.args.createdFor); (err != nil) != tt.wantErr {
 				t.Errorf("DeleteSecurityPolicy error = %v, wantErr %v", err, tt.wantErr)
 			}
 			assert.Equal(t, tt.wantSecurityPolicyStoreCount, len(fakeService.securityPolicyStore.ListKeys()))
@@ -1438,7 +1439,7 @@ func Test_deleteT1SecurityPolicy(t *testing.T) {
 			patches := tt.prepareFunc(t, fakeService)
 			defer patches.Reset()
 
-			if err := fakeService.deleteT1SecurityPolicy(tt.args.uid); (err != nil) != tt.wantErr {
+			if err := fakeService.deleteT1SecurityPolicy(context.TODO(), tt.args.uid); (err != nil) != tt.wantErr {
 				t.Errorf("deleteT1SecurityPolicy error = %v, wantErr %v", err, tt.wantErr)
 			}
 			assert.Equal(t, tt.wantSecurityPolicyStoreCount, len(fakeService.securityPolicyStore.ListKeys()))
@@ -1706,7 +1707,7 @@ func Test_deleteVPCSecurityPolicy(t *testing.T) {
 				})
 			defer patches.Reset()
 
-			if err := fakeService.deleteVPCSecurityPolicy(tt.args.uid, false, tt.args.createdFor); (err != nil) != tt.wantErr {
+			if err := fakeService.deleteVPCSecurityPolicy(context.TODO(), tt.args.uid, false, tt.args.createdFor); (err != nil) != tt.wantErr {
 				t.Errorf("deleteVPCSecurityPolicy error = %v, wantErr %v", err, tt.wantErr)
 			}
 			assert.Equal(t, tt.wantSecurityPolicyStoreCount, len(fakeService.securityPolicyStore.ListKeys()))
@@ -2105,7 +2106,7 @@ func Test_deleteVPCSecurityPolicyInDefaultProject(t *testing.T) {
 				})
 			defer patches.Reset()
 
-			if err := fakeService.deleteVPCSecurityPolicy(tt.args.uid, false, tt.args.createdFor); (err != nil) != tt.wantErr {
+			if err := fakeService.deleteVPCSecurityPolicy(context.TODO(), tt.args.uid, false, tt.args.createdFor); (err != nil) != tt.wantErr {
 				t.Errorf("deleteVPCSecurityPolicy error = %v, wantErr %v", err, tt.wantErr)
 			}
 			assert.Equal(t, tt.wantSecurityPolicyStoreCount, len(fakeService.securityPolicyStore.ListKeys()))
@@ -2246,7 +2247,7 @@ func Test_CreateOrUpdateSecurityPolicy(t *testing.T) {
 				})
 			defer patches.Reset()
 
-			if err := fakeService.CreateOrUpdateSecurityPolicy(tt.args.spObj); (err != nil) != tt.wantErr {
+			if err := fakeService.CreateOrUpdateSecurityPolicy(context.Background(), tt.args.spObj); (err != nil) != tt.wantErr {
 				t.Errorf("CreateOrUpdateSecurityPolicy error = %v, wantErr %v", err, tt.wantErr)
 			}
 
@@ -2376,7 +2377,7 @@ func Test_CreateOrUpdateSecurityPolicyFromNetworkPolicy(t *testing.T) {
 			assert.Equal(t, tt.wantSPStoreCountBeforeCreate, len(fakeService.securityPolicyStore.ListKeys()))
 			assert.Equal(t, tt.wantRuleStoreCountBeforeCreate, len(fakeService.ruleStore.ListKeys()))
 
-			if err := fakeService.CreateOrUpdateSecurityPolicy(tt.npObj); (err != nil) != tt.wantErr {
+			if err := fakeService.CreateOrUpdateSecurityPolicy(context.Background(), tt.npObj); (err != nil) != tt.wantErr {
 				t.Errorf("CreateOrUpdateSecurityPolicy error = %v, wantErr %v", err, tt.wantErr)
 			}
 
@@ -2521,7 +2522,7 @@ func Test_createOrUpdateT1SecurityPolicy(t *testing.T) {
 			}})
 			defer patches.Reset()
 
-			if err := fakeService.createOrUpdateT1SecurityPolicy(tt.args.spObj, tt.args.createdFor); (err != nil) != tt.wantErr {
+			if err := fakeService.createOrUpdateT1SecurityPolicy(context.Background(), tt.args.spObj, tt.args.createdFor); (err != nil) != tt.wantErr {
 				t.Errorf("createOrUpdateT1SecurityPolicy error = %v, wantErr %v", err, tt.wantErr)
 			}
 
@@ -2693,7 +2694,7 @@ func Test_createOrUpdateVPCSecurityPolicy(t *testing.T) {
 				})
 			defer patches.Reset()
 
-			if err := fakeService.createOrUpdateVPCSecurityPolicy(tt.args.spObj, tt.args.createdFor); (err != nil) != tt.wantErr {
+			if err := fakeService.createOrUpdateVPCSecurityPolicy(context.Background(), tt.args.spObj, tt.args.createdFor); (err != nil) != tt.wantErr {
 				t.Errorf("createOrUpdateVPCSecurityPolicy error = %v, wantErr %v", err, tt.wantErr)
 			}
 
@@ -2874,7 +2875,7 @@ func Test_createOrUpdateVPCSecurityPolicyInDefaultProject(t *testing.T) {
 			})
 			defer patches.Reset()
 
-			if err := fakeService.createOrUpdateVPCSecurityPolicy(tt.args.spObj, tt.args.createdFor); (err != nil) != tt.wantErr {
+			if err := fakeService.createOrUpdateVPCSecurityPolicy(context.Background(), tt.args.spObj, tt.args.createdFor); (err != nil) != tt.wantErr {
 				t.Errorf("createOrUpdateVPCSecurityPolicy error = %v, wantErr %v", err, tt.wantErr)
 			}
 
@@ -3719,7 +3720,7 @@ func Test_deleteVPCSecurityPolicyGC(t *testing.T) {
 			patches := tt.prepareFunc(t, fakeService)
 			defer patches.Reset()
 
-			if err := fakeService.deleteVPCSecurityPolicy(tt.args.uid, true, tt.args.createdFor); (err != nil) != tt.wantErr {
+			if err := fakeService.deleteVPCSecurityPolicy(context.TODO(), tt.args.uid, true, tt.args.createdFor); (err != nil) != tt.wantErr {
 				t.Errorf("deleteVPCSecurityPolicyGC error = %v, wantErr %v", err, tt.wantErr)
 			}
 
@@ -4113,3 +4114,19 @@ func Test_convertNetworkPolicyPeerToSecurityPolicyPeer_IPv6(t *testing.T) {
 		})
 	}
 }
+
+func Test_GenerateSectionForNetworkPolicyLogging(t *testing.T) {
+	fakeService := fakeSecurityPolicyService()
+	np := npWithNsSelecotr.DeepCopy()
+
+	section, err := fakeService.generateSectionForNetworkPolicy(np, common.RuleActionAllow)
+	assert.NoError(t, err)
+	assert.False(t, section.Spec.Logging)
+
+	np.Annotations = map[string]string{common.AnnotationNetworkPolicyLogging: "true"}
+	for _, sectionType := range []string{common.RuleActionAllow, common.RuleActionDrop} {
+		section, err = fakeService.generateSectionForNetworkPolicy(np, sectionType)
+		assert.NoError(t, err)
+		assert.True(t, section.Spec.Logging)
+	}
+}
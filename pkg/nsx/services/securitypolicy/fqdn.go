@@ -0,0 +1,223 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package securitypolicy
+
+import (
+	"context"
+	"strings"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+// The FQDNs of an egress rule are realized by a NSX context profile with the DOMAIN_NAME attribute,
+// the rule destination is ANY and the context profile is set in the rule profiles.
+// The context profiles are created under /infra so that they can be referred by the rules in both T1 and VPC.
+const (
+	contextProfilePrefix                  = "fqdn"
+	contextProfilePathPrefix              = "/infra/context-profiles/"
+	contextProfileAttributeDomainName     = "DOMAIN_NAME"
+	contextProfileAttributeDatatypeString = "STRING"
+)
+
+// getRuleFQDNs returns the sorted and deduplicated FQDNs in the rule destination peers.
+func getRuleFQDNs(rule *v1alpha1.SecurityPolicyRule) []string {
+	fqdns := sets.New[string]()
+	for _, peer := range getRuleDestinationPeers(rule) {
+		fqdns.Insert(peer.FQDNs...)
+	}
+	return sets.List(fqdns)
+}
+
+func hasFQDNPeers(obj *v1alpha1.SecurityPolicy) bool {
+	for i := range obj.Spec.Rules {
+		if len(getRuleFQDNs(&obj.Spec.Rules[i])) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// validateRuleFQDNPeers checks the FQDN peers are only in the destinations of an egress rule,
+// and they are not mixed with the other peers as the context profile is applied to the whole rule.
+func validateRuleFQDNPeers(rule *v1alpha1.SecurityPolicyRule, ruleDirection string) error {
+	for _, peer := range getRuleSourcePeers(rule) {
+		if len(peer.FQDNs) > 0 {
+			return &nsxutil.ValidationError{Desc: "fqdns is only supported in the destinations of egress rules"}
+		}
+	}
+	destinations := getRuleDestinationPeers(rule)
+	fqdnPeerCount := 0
+	for _, peer := range destinations {
+		if len(peer.FQDNs) > 0 {
+			fqdnPeerCount++
+		}
+	}
+	if fqdnPeerCount == 0 {
+		return nil
+	}
+	if ruleDirection != "OUT" {
+		return &nsxutil.ValidationError{Desc: "fqdns is only supported in the destinations of egress rules"}
+	}
+	if fqdnPeerCount != len(destinations) {
+		return &nsxutil.ValidationError{Desc: "fqdns peers cannot be mixed with other peers in one rule"}
+	}
+	return nil
+}
+
+// ValidateRuleFQDNPeers checks the FQDN peers of the rule in the same way as the reconciler, so that the SecurityPolicy
+// webhook rejects the invalid rules at admission. The rule direction is validated by the CRD schema.
+func ValidateRuleFQDNPeers(rule *v1alpha1.SecurityPolicyRule) error {
+	ruleDirection := ""
+	if rule.Direction != nil {
+		ruleDirection, _ = getRuleDirection(rule)
+	}
+	return validateRuleFQDNPeers(rule, ruleDirection)
+}
+
+// checkFQDNSupported returns a ValidationError if the SecurityPolicy has FQDN peers while NSX doesn't support them.
+func (service *SecurityPolicyService) checkFQDNSupported(obj *v1alpha1.SecurityPolicy) error {
+	if hasFQDNPeers(obj) && !service.NSXClient.NSXCheckVersion(nsx.SecurityPolicyFQDN) {
+		return &nsxutil.ValidationError{Desc: "fqdns peers are not supported in current NSX version"}
+	}
+	return nil
+}
+
+// buildContextProfileID builds the ID from the SecurityPolicy UID and the FQDNs, so the rules with the same FQDNs
+// in one SecurityPolicy share the context profile, and a new context profile is created once the FQDNs are changed.
+func (service *SecurityPolicyService) buildContextProfileID(obj *v1alpha1.SecurityPolicy, fqdns []string) string {
+	fqdnHash := util.Sha1(strings.Join(fqdns, ","))
+	return util.NormalizeId(strings.Join([]string{contextProfilePrefix, string(obj.UID), fqdnHash}, common.ConnectorUnderline))
+}
+
+func buildContextProfilePath(profileID string) string {
+	return contextProfilePathPrefix + profileID
+}
+
+func (service *SecurityPolicyService) buildContextProfile(obj *v1alpha1.SecurityPolicy, fqdns []string, createdFor string) model.PolicyContextProfile {
+	profileID := service.buildContextProfileID(obj, fqdns)
+	return model.PolicyContextProfile{
+		Id:          String(profileID),
+		DisplayName: String(profileID),
+		Path:        String(buildContextProfilePath(profileID)),
+		Tags:        service.buildBasicTags(obj, createdFor),
+		Attributes: []model.PolicyAttributes{
+			{
+				Key:      String(contextProfileAttributeDomainName),
+				Datatype: String(contextProfileAttributeDatatypeString),
+				Value:    fqdns,
+			},
+		},
+	}
+}
+
+// buildContextProfiles builds the context profiles for the rules with FQDN peers.
+func (service *SecurityPolicyService) buildContextProfiles(obj *v1alpha1.SecurityPolicy, createdFor string) []model.PolicyContextProfile {
+	profiles := make([]model.PolicyContextProfile, 0)
+	profileIDs := sets.New[string]()
+	for i := range obj.Spec.Rules {
+		fqdns := getRuleFQDNs(&obj.Spec.Rules[i])
+		if len(fqdns) == 0 {
+			continue
+		}
+		profile := service.buildContextProfile(obj, fqdns, createdFor)
+		if profileIDs.Has(*profile.Id) {
+			continue
+		}
+		profileIDs.Insert(*profile.Id)
+		profiles = append(profiles, profile)
+	}
+	return profiles
+}
+
+// getFinalContextProfiles returns the changed context profiles and the stale ones marked for delete.
+func (service *SecurityPolicyService) getFinalContextProfiles(obj *v1alpha1.SecurityPolicy, indexScope string, createdFor string) []model.PolicyContextProfile {
+	expectedProfiles := service.buildContextProfiles(obj, createdFor)
+	existingProfiles := service.contextProfileStore.GetByIndex(indexScope, string(obj.UID))
+	changed, stale := common.CompareResources(ContextProfilesPtrToComparable(existingProfiles), ContextProfilesToComparable(expectedProfiles))
+	changedProfiles, staleProfiles := ComparableToContextProfiles(changed), ComparableToContextProfiles(stale)
+	for i := len(staleProfiles) - 1; i >= 0; i-- {
+		staleProfiles[i].MarkedForDelete = &MarkedForDelete
+	}
+	finalProfiles := make([]model.PolicyContextProfile, 0)
+	finalProfiles = append(finalProfiles, staleProfiles...)
+	finalProfiles = append(finalProfiles, changedProfiles...)
+	return finalProfiles
+}
+
+func (service *SecurityPolicyService) getMarkDeleteContextProfiles(indexScope string, spUID types.UID) []model.PolicyContextProfile {
+	deleteProfiles := make([]model.PolicyContextProfile, 0)
+	for _, profile := range service.contextProfileStore.GetByIndex(indexScope, string(spUID)) {
+		deleteProfile := *profile
+		deleteProfile.MarkedForDelete = &MarkedForDelete
+		deleteProfiles = append(deleteProfiles, deleteProfile)
+	}
+	return deleteProfiles
+}
+
+func (service *SecurityPolicyService) getStaleUpdateContextProfiles(profiles []model.PolicyContextProfile) ([]model.PolicyContextProfile, []model.PolicyContextProfile) {
+	staleProfiles := make([]model.PolicyContextProfile, 0)
+	changedProfiles := make([]model.PolicyContextProfile, 0)
+	for i := range profiles {
+		if profiles[i].MarkedForDelete != nil && *profiles[i].MarkedForDelete {
+			staleProfiles = append(staleProfiles, profiles[i])
+		} else {
+			changedProfiles = append(changedProfiles, profiles[i])
+		}
+	}
+	return staleProfiles, changedProfiles
+}
+
+// patchInfraContextProfiles creates, updates or deletes the context profiles under /infra with the hierarchy API.
+func (service *SecurityPolicyService) patchInfraContextProfiles(ctx context.Context, profiles []model.PolicyContextProfile) error {
+	if len(profiles) == 0 {
+		return nil
+	}
+	profilesChildren, err := service.wrapContextProfiles(profiles)
+	if err != nil {
+		return err
+	}
+	infraResource, err := service.wrapInfra(profilesChildren)
+	if err != nil {
+		return err
+	}
+	err = service.NSXClient.WithContext(ctx).InfraClient.Patch(*infraResource, &EnforceRevisionCheckParam)
+	err = nsxutil.TransNSXApiError(err)
+	if err != nil {
+		log.Error(err, "Failed to patch NSX context profiles", "count", len(profiles))
+		return err
+	}
+	return nil
+}
+
+// deleteContextProfiles deletes the context profiles marked for delete from NSX and the store.
+func (service *SecurityPolicyService) deleteContextProfiles(ctx context.Context, profiles []model.PolicyContextProfile) error {
+	if err := service.patchInfraContextProfiles(ctx, profiles); err != nil {
+		return err
+	}
+	return service.contextProfileStore.Apply(&profiles)
+}
+
+// cleanContextProfiles deletes all the context profiles created by SecurityPolicyService from NSX and the store.
+func (service *SecurityPolicyService) cleanContextProfiles(ctx context.Context) error {
+	cachedObjs := service.contextProfileStore.List()
+	if len(cachedObjs) == 0 {
+		return nil
+	}
+	log.Info("Cleaning up context profiles", "Count", len(cachedObjs))
+	profiles := make([]model.PolicyContextProfile, 0, len(cachedObjs))
+	for _, obj := range cachedObjs {
+		profile := *obj.(*model.PolicyContextProfile)
+		profile.MarkedForDelete = &MarkedForDelete
+		profiles = append(profiles, profile)
+	}
+	return service.deleteContextProfiles(ctx, profiles)
+}
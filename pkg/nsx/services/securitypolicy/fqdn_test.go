@@ -0,0 +1,119 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package securitypolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+func fqdnSecurityPolicy(rules ...v1alpha1.SecurityPolicyRule) *v1alpha1.SecurityPolicy {
+	return &v1alpha1.SecurityPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "sp-fqdn", UID: "uid-fqdn"},
+		Spec:       v1alpha1.SecurityPolicySpec{Rules: rules},
+	}
+}
+
+func Test_getRuleFQDNs(t *testing.T) {
+	rule := &v1alpha1.SecurityPolicyRule{
+		To: []v1alpha1.SecurityPolicyPeer{
+			{FQDNs: []string{"b.example.com", "*.example.com"}},
+			{FQDNs: []string{"b.example.com"}},
+		},
+	}
+	assert.Equal(t, []string{"*.example.com", "b.example.com"}, getRuleFQDNs(rule))
+	assert.Empty(t, getRuleFQDNs(&v1alpha1.SecurityPolicyRule{}))
+}
+
+func Test_validateRuleFQDNPeers(t *testing.T) {
+	fqdnPeer := v1alpha1.SecurityPolicyPeer{FQDNs: []string{"example.com"}}
+	podPeer := v1alpha1.SecurityPolicyPeer{PodSelector: &metav1.LabelSelector{}}
+	tests := []struct {
+		name      string
+		rule      v1alpha1.SecurityPolicyRule
+		direction string
+		wantErr   bool
+	}{
+		{name: "egress fqdns", rule: v1alpha1.SecurityPolicyRule{To: []v1alpha1.SecurityPolicyPeer{fqdnPeer}}, direction: "OUT"},
+		{name: "no fqdns", rule: v1alpha1.SecurityPolicyRule{To: []v1alpha1.SecurityPolicyPeer{podPeer}}, direction: "IN"},
+		{name: "ingress fqdns", rule: v1alpha1.SecurityPolicyRule{To: []v1alpha1.SecurityPolicyPeer{fqdnPeer}}, direction: "IN", wantErr: true},
+		{name: "source fqdns", rule: v1alpha1.SecurityPolicyRule{From: []v1alpha1.SecurityPolicyPeer{fqdnPeer}}, direction: "OUT", wantErr: true},
+		{name: "mixed peers", rule: v1alpha1.SecurityPolicyRule{To: []v1alpha1.SecurityPolicyPeer{fqdnPeer, podPeer}}, direction: "OUT", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRuleFQDNPeers(&tt.rule, tt.direction)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_buildContextProfiles(t *testing.T) {
+	service := fakeSecurityPolicyService()
+	obj := fqdnSecurityPolicy(
+		v1alpha1.SecurityPolicyRule{Name: "r1", To: []v1alpha1.SecurityPolicyPeer{{FQDNs: []string{"a.example.com", "b.example.com"}}}},
+		v1alpha1.SecurityPolicyRule{Name: "r2", To: []v1alpha1.SecurityPolicyPeer{{FQDNs: []string{"b.example.com", "a.example.com"}}}},
+		v1alpha1.SecurityPolicyRule{Name: "r3", To: []v1alpha1.SecurityPolicyPeer{{FQDNs: []string{"c.example.com"}}}},
+		v1alpha1.SecurityPolicyRule{Name: "r4", To: []v1alpha1.SecurityPolicyPeer{{PodSelector: &metav1.LabelSelector{}}}},
+	)
+
+	profiles := service.buildContextProfiles(obj, common.ResourceTypeSecurityPolicy)
+	require.Len(t, profiles, 2)
+	assert.Equal(t, buildContextProfilePath(*profiles[0].Id), *profiles[0].Path)
+	assert.Equal(t, []string{"a.example.com", "b.example.com"}, profiles[0].Attributes[0].Value)
+	assert.Equal(t, contextProfileAttributeDomainName, *profiles[0].Attributes[0].Key)
+	assert.Equal(t, []string{"c.example.com"}, profiles[1].Attributes[0].Value)
+	assert.NotEqual(t, *profiles[0].Id, *profiles[1].Id)
+}
+
+func Test_getFinalContextProfiles(t *testing.T) {
+	service := fakeSecurityPolicyService()
+	service.setUpStore(common.TagValueScopeSecurityPolicyUID, false)
+	indexScope := common.TagValueScopeSecurityPolicyUID
+
+	obj := fqdnSecurityPolicy(v1alpha1.SecurityPolicyRule{To: []v1alpha1.SecurityPolicyPeer{{FQDNs: []string{"a.example.com"}}}})
+	profiles := service.getFinalContextProfiles(obj, indexScope, common.ResourceTypeSecurityPolicy)
+	require.Len(t, profiles, 1)
+	require.NoError(t, service.contextProfileStore.Apply(&profiles))
+
+	// No change.
+	assert.Empty(t, service.getFinalContextProfiles(obj, indexScope, common.ResourceTypeSecurityPolicy))
+
+	// The FQDNs are changed, the old context profile is marked for delete.
+	obj.Spec.Rules[0].To[0].FQDNs = []string{"b.example.com"}
+	profiles = service.getFinalContextProfiles(obj, indexScope, common.ResourceTypeSecurityPolicy)
+	stale, changed := service.getStaleUpdateContextProfiles(profiles)
+	require.Len(t, stale, 1)
+	require.Len(t, changed, 1)
+	assert.True(t, *stale[0].MarkedForDelete)
+	assert.Equal(t, []string{"b.example.com"}, changed[0].Attributes[0].Value)
+
+	// All context profiles are marked for delete when the SecurityPolicy is deleted.
+	deleteProfiles := service.getMarkDeleteContextProfiles(indexScope, obj.UID)
+	require.Len(t, deleteProfiles, 1)
+	assert.True(t, *deleteProfiles[0].MarkedForDelete)
+	require.NoError(t, service.contextProfileStore.Apply(&deleteProfiles))
+	assert.Empty(t, service.contextProfileStore.GetByIndex(indexScope, string(obj.UID)))
+}
+
+func Test_ContextProfileEqual(t *testing.T) {
+	id := "profile1"
+	key, datatype := contextProfileAttributeDomainName, contextProfileAttributeDatatypeString
+	p1 := model.PolicyContextProfile{Id: &id, Attributes: []model.PolicyAttributes{{Key: &key, Datatype: &datatype, Value: []string{"a.example.com"}}}}
+	p2 := p1
+	p2.Attributes = []model.PolicyAttributes{{Key: &key, Datatype: &datatype, Value: []string{"b.example.com"}}}
+	assert.Equal(t, (*ContextProfile)(&p1).Value(), (*ContextProfile)(&p1).Value())
+	assert.NotEqual(t, (*ContextProfile)(&p1).Value(), (*ContextProfile)(&p2).Value())
+}
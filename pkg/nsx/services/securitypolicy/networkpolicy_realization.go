@@ -0,0 +1,150 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package securitypolicy
+
+import (
+	"fmt"
+	"net"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+const (
+	NetworkPolicyRealizationStateApplied = "Applied"
+	NetworkPolicyRealizationStateFailed  = "Failed"
+)
+
+// NetworkPolicyRealization is the realization record of a NetworkPolicy. NetworkPolicy has no status
+// in most Kubernetes versions, so the record is kept in a NetworkPolicy annotation.
+type NetworkPolicyRealization struct {
+	// State is Applied or Failed. Applied means the NSX rules are accepted by the NSX Policy API, the realized
+	// state of the rules on NSX is not checked.
+	State string `json:"state"`
+	// RuleCount is the number of the NSX rules generated for the NetworkPolicy, including the isolation rules.
+	RuleCount int `json:"ruleCount"`
+	// UnresolvedNamedPorts are the named ports not resolved to any Pod port, e.g. "ingress/http".
+	// No NSX rule is generated for them.
+	UnresolvedNamedPorts []string `json:"unresolvedNamedPorts,omitempty"`
+	// Warnings are about the fields accepted by Kubernetes but not realized as specified.
+	Warnings []string `json:"warnings,omitempty"`
+	// Message is the realization error when State is Failed.
+	Message string `json:"message,omitempty"`
+}
+
+// GetNetworkPolicyRealization returns the realization record of the NetworkPolicy with the NSX rules in the
+// store. realizeErr is the error returned by CreateOrUpdateSecurityPolicy for the NetworkPolicy.
+func (service *SecurityPolicyService) GetNetworkPolicyRealization(networkPolicy *networkingv1.NetworkPolicy, realizeErr error) *NetworkPolicyRealization {
+	realization := &NetworkPolicyRealization{
+		State:    NetworkPolicyRealizationStateApplied,
+		Warnings: ValidateNetworkPolicy(networkPolicy),
+	}
+	if realizeErr != nil {
+		realization.State = NetworkPolicyRealizationStateFailed
+		realization.Message = realizeErr.Error()
+	}
+	for _, securityPolicy := range service.ListNetworkPolicyByName(networkPolicy.Namespace, networkPolicy.Name) {
+		if securityPolicy.Path != nil {
+			realization.RuleCount += len(service.ruleStore.GetRulesByPolicyPath(*securityPolicy.Path))
+		}
+	}
+	realization.UnresolvedNamedPorts = service.getUnresolvedNamedPorts(networkPolicy)
+	return realization
+}
+
+// getUnresolvedNamedPorts returns the named ports of the NetworkPolicy rules which no Pod port is resolved to.
+func (service *SecurityPolicyService) getUnresolvedNamedPorts(networkPolicy *networkingv1.NetworkPolicy) []string {
+	spAllow, err := service.generateSectionForNetworkPolicy(networkPolicy, common.RuleActionAllow)
+	if err != nil {
+		return nil
+	}
+	if err := service.populateRulesForAllowSection(spAllow, networkPolicy); err != nil {
+		return nil
+	}
+	unresolved := sets.New[string]()
+	for ruleIdx := range spAllow.Spec.Rules {
+		rule := &spAllow.Spec.Rules[ruleIdx]
+		if !service.hasNamedPort(rule) || validateNamedPortRule(rule) != nil {
+			continue
+		}
+		// The ingress rules are populated before the egress rules.
+		direction := "ingress"
+		if ruleIdx >= len(networkPolicy.Spec.Ingress) {
+			direction = "egress"
+		}
+		for _, port := range rule.Ports {
+			if port.Port.Type != intstr.String {
+				continue
+			}
+			portAddress, err := service.resolveNamedPort(spAllow, rule, port)
+			if err != nil {
+				log.Debug("Failed to resolve named port for NetworkPolicy realization", "networkPolicy", networkPolicy.Namespace+"/"+networkPolicy.Name, "port", port.Port.StrVal, "error", err)
+				continue
+			}
+			if len(portAddress) == 0 {
+				unresolved.Insert(direction + "/" + port.Port.StrVal)
+			}
+		}
+	}
+	return sets.List(unresolved)
+}
+
+// ValidateNetworkPolicy returns the warnings for the NetworkPolicy fields which are accepted by Kubernetes but
+// not realized as specified in NSX.
+func ValidateNetworkPolicy(networkPolicy *networkingv1.NetworkPolicy) []string {
+	var warnings []string
+	validatePeers := func(direction string, ruleIdx int, peers []networkingv1.NetworkPolicyPeer) {
+		for _, peer := range peers {
+			if peer.IPBlock == nil {
+				continue
+			}
+			warnings = append(warnings, validateIPBlockExcept(direction, ruleIdx, peer.IPBlock)...)
+		}
+	}
+	validatePorts := func(direction string, ruleIdx int, ports []networkingv1.NetworkPolicyPort) {
+		for _, port := range ports {
+			if port.Port != nil && port.Port.Type == intstr.String && port.EndPort != nil {
+				warnings = append(warnings, fmt.Sprintf("%s[%d]: endPort %d is not supported with named port %s", direction, ruleIdx, *port.EndPort, port.Port.StrVal))
+			}
+		}
+	}
+	for i, ingress := range networkPolicy.Spec.Ingress {
+		validatePeers("ingress", i, ingress.From)
+		validatePorts("ingress", i, ingress.Ports)
+	}
+	for i, egress := range networkPolicy.Spec.Egress {
+		validatePeers("egress", i, egress.To)
+		validatePorts("egress", i, egress.Ports)
+	}
+	return warnings
+}
+
+// validateIPBlockExcept warns the except ranges which are ignored because they are not in the ipBlock CIDR,
+// and the ipBlock which is fully excluded by the except ranges.
+func validateIPBlockExcept(direction string, ruleIdx int, ipBlock *networkingv1.IPBlock) []string {
+	var warnings []string
+	_, cidr, err := net.ParseCIDR(ipBlock.CIDR)
+	if err != nil {
+		return nil
+	}
+	cidrOnes, _ := cidr.Mask.Size()
+	for _, except := range ipBlock.Except {
+		_, exceptNet, err := net.ParseCIDR(except)
+		if err != nil {
+			continue
+		}
+		exceptOnes, _ := exceptNet.Mask.Size()
+		if !cidr.Contains(exceptNet.IP) || exceptOnes < cidrOnes || len(exceptNet.IP) != len(cidr.IP) {
+			warnings = append(warnings, fmt.Sprintf("%s[%d]: ipBlock except %s is not within cidr %s and is ignored", direction, ruleIdx, except, ipBlock.CIDR))
+			continue
+		}
+		if exceptOnes == cidrOnes {
+			warnings = append(warnings, fmt.Sprintf("%s[%d]: ipBlock cidr %s is fully excluded by except %s and matches no address", direction, ruleIdx, ipBlock.CIDR, except))
+		}
+	}
+	return warnings
+}
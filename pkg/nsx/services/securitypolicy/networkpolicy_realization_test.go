@@ -0,0 +1,122 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package securitypolicy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+func TestValidateNetworkPolicy(t *testing.T) {
+	endPort := int32(8080)
+	np := &networkingv1.NetworkPolicy{
+		Spec: networkingv1.NetworkPolicySpec{
+			Ingress: []networkingv1.NetworkPolicyIngressRule{
+				{
+					From: []networkingv1.NetworkPolicyPeer{
+						{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.0/24", Except: []string{"10.0.0.0/28", "10.1.0.0/28", "10.0.0.0/16"}}},
+						{IPBlock: &networkingv1.IPBlock{CIDR: "fd00::/64", Except: []string{"fd00::/64", "10.0.0.0/28"}}},
+					},
+				},
+			},
+			Egress: []networkingv1.NetworkPolicyEgressRule{
+				{
+					Ports: []networkingv1.NetworkPolicyPort{
+						{Port: &intstr.IntOrString{Type: intstr.String, StrVal: "http"}, EndPort: &endPort},
+						{Port: &intstr.IntOrString{Type: intstr.Int, IntVal: 80}, EndPort: &endPort},
+					},
+				},
+			},
+		},
+	}
+	assert.Equal(t, []string{
+		"ingress[0]: ipBlock except 10.1.0.0/28 is not within cidr 10.0.0.0/24 and is ignored",
+		"ingress[0]: ipBlock except 10.0.0.0/16 is not within cidr 10.0.0.0/24 and is ignored",
+		"ingress[0]: ipBlock cidr fd00::/64 is fully excluded by except fd00::/64 and matches no address",
+		"ingress[0]: ipBlock except 10.0.0.0/28 is not within cidr fd00::/64 and is ignored",
+		"egress[0]: endPort 8080 is not supported with named port http",
+	}, ValidateNetworkPolicy(np))
+
+	assert.Empty(t, ValidateNetworkPolicy(&networkingv1.NetworkPolicy{}))
+}
+
+func TestGetNetworkPolicyRealization(t *testing.T) {
+	scheme := runtime.NewScheme()
+	assert.NoError(t, clientgoscheme.AddToScheme(scheme))
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "web", Labels: map[string]string{"app": "web"}},
+		Spec: v1.PodSpec{Containers: []v1.Container{{
+			Name:  "web",
+			Ports: []v1.ContainerPort{{Name: "http", ContainerPort: 80, Protocol: v1.ProtocolTCP}},
+		}}},
+		Status: v1.PodStatus{Phase: v1.PodRunning, PodIP: "10.0.0.5"},
+	}
+	svc := &SecurityPolicyService{
+		Service: common.Service{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(pod).Build(),
+			NSXConfig: &config.NSXOperatorConfig{
+				CoeConfig: &config.CoeConfig{EnableVPCNetwork: true, Cluster: "k8scl-one"},
+			},
+		},
+	}
+	svc.setUpStore(common.TagScopeSecurityPolicyUID, false)
+
+	policyPath := "/orgs/default/projects/p1/vpcs/vpc1/security-policies/np1-allow"
+	assert.NoError(t, svc.securityPolicyStore.Apply(&model.SecurityPolicy{
+		Id:   String("np1-allow"),
+		Path: String(policyPath),
+		Tags: []model.Tag{
+			{Scope: String(common.TagScopeNamespace), Tag: String("ns1")},
+			{Scope: String(common.TagScopeNetworkPolicyName), Tag: String("np1")},
+			{Scope: String(common.TagScopeNetworkPolicyUID), Tag: String("uid1_allow")},
+		},
+	}))
+	var rules []model.Rule
+	for i := 0; i < 2; i++ {
+		rules = append(rules, model.Rule{
+			Id:         String(fmt.Sprintf("rule%d", i)),
+			Path:       String(fmt.Sprintf("%s/rules/rule%d", policyPath, i)),
+			ParentPath: String(policyPath),
+		})
+	}
+	assert.NoError(t, svc.ruleStore.Apply(&rules))
+
+	tcp := v1.ProtocolTCP
+	np := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "np1", UID: "uid1"},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{{
+				Ports: []networkingv1.NetworkPolicyPort{
+					{Protocol: &tcp, Port: &intstr.IntOrString{Type: intstr.String, StrVal: "http"}},
+					{Protocol: &tcp, Port: &intstr.IntOrString{Type: intstr.String, StrVal: "metrics"}},
+				},
+			}},
+		},
+	}
+
+	realization := svc.GetNetworkPolicyRealization(np, nil)
+	assert.Equal(t, &NetworkPolicyRealization{
+		State:                NetworkPolicyRealizationStateApplied,
+		RuleCount:            2,
+		UnresolvedNamedPorts: []string{"ingress/metrics"},
+	}, realization)
+
+	realization = svc.GetNetworkPolicyRealization(np, fmt.Errorf("nsx error"))
+	assert.Equal(t, NetworkPolicyRealizationStateFailed, realization.State)
+	assert.Equal(t, "nsx error", realization.Message)
+}
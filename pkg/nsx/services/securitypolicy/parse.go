@@ -12,6 +12,14 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
+// ruleActionPass is only set on the rules of the internal SecurityPolicies converted from AdminNetworkPolicy,
+// it is not a valid action of the SecurityPolicy CR.
+const ruleActionPass v1alpha1.RuleAction = "Pass"
+
+// nsxRuleActionJumpToApplication skips the remaining rules in the Environment category and continues the
+// evaluation with the Application category where the namespace-scoped policies are realized.
+const nsxRuleActionJumpToApplication = "JUMP_TO_APPLICATION"
+
 var validRuleActions = []string{
 	util.ToUpper(v1alpha1.RuleActionAllow),
 	util.ToUpper(v1alpha1.RuleActionDrop),
@@ -27,6 +35,9 @@ var (
 
 func getRuleAction(rule *v1alpha1.SecurityPolicyRule) (string, error) {
 	ruleAction := util.ToUpper(*rule.Action)
+	if ruleAction == util.ToUpper(ruleActionPass) {
+		return nsxRuleActionJumpToApplication, nil
+	}
 	for _, validRuleAction := range validRuleActions {
 		if ruleAction == validRuleAction {
 			return ruleAction, nil
@@ -47,6 +58,20 @@ func getRuleDirection(rule *v1alpha1.SecurityPolicyRule) (string, error) {
 	}
 }
 
+// getRuleLogging returns whether NSX firewall logging is enabled for the rule and the log label,
+// falling back to the policy level settings if the rule does not set them.
+func getRuleLogging(obj *v1alpha1.SecurityPolicy, rule *v1alpha1.SecurityPolicyRule) (bool, string) {
+	logged := obj.Spec.Logging
+	if rule.Logging != nil {
+		logged = *rule.Logging
+	}
+	logLabel := obj.Spec.LogLabel
+	if rule.LogLabel != "" {
+		logLabel = rule.LogLabel
+	}
+	return logged, logLabel
+}
+
 func getCluster(service *SecurityPolicyService) string {
 	return service.NSXConfig.Cluster
 }
@@ -7,8 +7,51 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
 )
 
 func Test_GetCluster(t *testing.T) {
 	assert.Equal(t, "k8scl-one", getCluster(service))
 }
+
+func Test_getRuleLogging(t *testing.T) {
+	enabled, disabled := true, false
+	tests := []struct {
+		name        string
+		spec        v1alpha1.SecurityPolicySpec
+		rule        v1alpha1.SecurityPolicyRule
+		expLogged   bool
+		expLogLabel string
+	}{
+		{
+			name: "logging not set",
+		},
+		{
+			name:        "policy level default",
+			spec:        v1alpha1.SecurityPolicySpec{Logging: true, LogLabel: "ns1-audit"},
+			expLogged:   true,
+			expLogLabel: "ns1-audit",
+		},
+		{
+			name:        "rule level overrides policy level",
+			spec:        v1alpha1.SecurityPolicySpec{Logging: true, LogLabel: "ns1-audit"},
+			rule:        v1alpha1.SecurityPolicyRule{Logging: &disabled, LogLabel: "db"},
+			expLogged:   false,
+			expLogLabel: "db",
+		},
+		{
+			name:      "rule level only",
+			rule:      v1alpha1.SecurityPolicyRule{Logging: &enabled},
+			expLogged: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := &v1alpha1.SecurityPolicy{Spec: tt.spec}
+			logged, logLabel := getRuleLogging(obj, &tt.rule)
+			assert.Equal(t, tt.expLogged, logged)
+			assert.Equal(t, tt.expLogLabel, logLabel)
+		})
+	}
+}
@@ -27,6 +27,8 @@ func keyFunc(obj interface{}) (string, error) {
 		return *v.Id, nil
 	case *model.Share:
 		return *v.Id, nil
+	case *model.PolicyContextProfile:
+		return *v.Id, nil
 	default:
 		return "", errors.New("keyFunc doesn't support unknown type")
 	}
@@ -54,6 +56,8 @@ func indexBySecurityPolicyUID(obj interface{}) ([]string, error) {
 		return filterTag(o.Tags, common.TagValueScopeSecurityPolicyUID), nil
 	case *model.Share:
 		return filterTag(o.Tags, common.TagValueScopeSecurityPolicyUID), nil
+	case *model.PolicyContextProfile:
+		return filterTag(o.Tags, common.TagValueScopeSecurityPolicyUID), nil
 	default:
 		return nil, errors.New("indexBySecurityPolicyUID doesn't support unknown type")
 	}
@@ -69,6 +73,8 @@ func indexByNetworkPolicyUID(obj interface{}) ([]string, error) {
 		return filterTag(o.Tags, common.TagScopeNetworkPolicyUID), nil
 	case *model.Share:
 		return filterTag(o.Tags, common.TagScopeNetworkPolicyUID), nil
+	case *model.PolicyContextProfile:
+		return filterTag(o.Tags, common.TagScopeNetworkPolicyUID), nil
 	default:
 		return nil, errors.New("indexByNetworkPolicyUID doesn't support unknown type")
 	}
@@ -183,6 +189,11 @@ type ShareStore struct {
 	common.ResourceStore
 }
 
+// ContextProfileStore is a store for context profiles referenced by security policy rule
+type ContextProfileStore struct {
+	common.ResourceStore
+}
+
 func (securityPolicyStore *SecurityPolicyStore) Apply(i interface{}) error {
 	if i == nil {
 		return nil
@@ -356,3 +367,61 @@ func (shareStore *ShareStore) DeleteMultipleObjects(shares []*model.Share) {
 		shareStore.Delete(share)
 	}
 }
+
+func (contextProfileStore *ContextProfileStore) Apply(i interface{}) error {
+	if i == nil {
+		return nil
+	}
+	profiles, ok := i.(*[]model.PolicyContextProfile)
+	if !ok || profiles == nil {
+		return nil
+	}
+	for _, profile := range *profiles {
+		tempProfile := profile
+		if profile.MarkedForDelete != nil && *profile.MarkedForDelete {
+			err := contextProfileStore.Delete(&tempProfile)
+			log.Debug("Delete context profile from store", "contextProfile", tempProfile)
+			if err != nil {
+				return err
+			}
+		} else {
+			err := contextProfileStore.Add(&tempProfile)
+			log.Debug("Add context profile to store", "contextProfile", tempProfile)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (contextProfileStore *ContextProfileStore) GetByIndex(key string, value string) []*model.PolicyContextProfile {
+	profiles := make([]*model.PolicyContextProfile, 0)
+	objs := contextProfileStore.ResourceStore.GetByIndex(key, value)
+	for _, profile := range objs {
+		profiles = append(profiles, profile.(*model.PolicyContextProfile))
+	}
+	return profiles
+}
+
+// ListSecurityPolicies returns all the security policies in the store.
+func (securityPolicyStore *SecurityPolicyStore) ListSecurityPolicies() []*model.SecurityPolicy {
+	objs := securityPolicyStore.List()
+	securityPolicies := make([]*model.SecurityPolicy, 0, len(objs))
+	for _, obj := range objs {
+		securityPolicies = append(securityPolicies, obj.(*model.SecurityPolicy))
+	}
+	return securityPolicies
+}
+
+// GetRulesByPolicyPath returns the rules in the store whose parent is the security policy with policyPath.
+func (ruleStore *RuleStore) GetRulesByPolicyPath(policyPath string) []*model.Rule {
+	var rules []*model.Rule
+	for _, obj := range ruleStore.List() {
+		rule := obj.(*model.Rule)
+		if rule.ParentPath != nil && *rule.ParentPath == policyPath {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
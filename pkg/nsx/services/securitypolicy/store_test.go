@@ -6,6 +6,7 @@ package securitypolicy
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"sync"
 	"testing"
 
@@ -15,9 +16,11 @@ import (
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"go.uber.org/mock/gomock"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	searchmocks "github.com/vmware-tanzu/nsx-operator/pkg/mock/searchclient"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/ratelimiter"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
@@ -729,3 +732,43 @@ func TestRuleStore_getByIndexUUIDAndHash(t *testing.T) {
 		})
 	}
 }
+
+func Test_LoadSecurityPolicyStoresByNamespace(t *testing.T) {
+	policyPath := "/orgs/default/projects/p1/vpcs/vpc1/security-policies/sp1"
+	toStructValues := func(obj interface{}, bindingType bindings.BindingType) []*data.StructValue {
+		dv, errs := common.NewConverter().ConvertToVapi(obj, bindingType)
+		require.Empty(t, errs)
+		return []*data.StructValue{dv.(*data.StructValue)}
+	}
+	policies := toStructValues(model.SecurityPolicy{Id: String("sp1"), Path: String(policyPath)}, model.SecurityPolicyBindingType())
+	rules := toStructValues(model.Rule{Id: String("rule1"), Path: String(policyPath + "/rules/rule1"), ParentPath: String(policyPath)}, model.RuleBindingType())
+
+	ctrl := gomock.NewController(t)
+	qc := searchmocks.NewMockQueryClient(ctrl)
+	service := common.Service{NSXClient: &nsx.Client{
+		QueryClient: qc,
+		NsxConfig:   &config.NSXOperatorConfig{CoeConfig: &config.CoeConfig{Cluster: "k8scl-one:test"}},
+	}}
+
+	qc.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(query string, _ *string, _ *string, _ *int64, _ *bool, _ *string) (model.SearchResponse, error) {
+			assert.Contains(t, query, "ns1")
+			results := policies
+			if strings.Contains(query, "resource_type:"+ResourceTypeRule) {
+				results = rules
+			}
+			count := int64(len(results))
+			return model.SearchResponse{Results: results, ResultCount: &count}, nil
+		}).Times(2)
+	spStore, ruleStore, err := LoadSecurityPolicyStoresByNamespace(service, "ns1")
+	require.NoError(t, err)
+	require.Len(t, spStore.ListSecurityPolicies(), 1)
+	assert.Equal(t, "sp1", *spStore.ListSecurityPolicies()[0].Id)
+	require.Len(t, ruleStore.GetRulesByPolicyPath(policyPath), 1)
+	assert.Empty(t, ruleStore.GetRulesByPolicyPath("/orgs/default/projects/p1/vpcs/vpc1/security-policies/sp2"))
+
+	qc.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(model.SearchResponse{}, fmt.Errorf("search failed")).Times(2)
+	_, _, err = LoadSecurityPolicyStoresByNamespace(service, "ns1")
+	assert.ErrorContains(t, err, "search failed")
+}
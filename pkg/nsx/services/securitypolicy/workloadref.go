@@ -0,0 +1,170 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package securitypolicy
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+// The serviceAccountSelector and serviceRef are realized with the tags which are synced onto the Pod ports by
+// the pod controller, so that the NSX group membership is updated with the ports once the ServiceAccount labels
+// or the Service EndpointSlices are changed. They are converted to the PodSelector on the synced tags before
+// building the NSX group criteria.
+
+func targetHasWorkloadReference(target *v1alpha1.SecurityPolicyTarget) bool {
+	return target.ServiceAccountSelector != nil || target.ServiceRef != nil
+}
+
+func peerHasWorkloadReference(peer *v1alpha1.SecurityPolicyPeer) bool {
+	return peer.ServiceAccountSelector != nil || peer.ServiceRef != nil
+}
+
+func ruleHasWorkloadReference(obj *v1alpha1.SecurityPolicy, rule *v1alpha1.SecurityPolicyRule) bool {
+	for i := range obj.Spec.AppliedTo {
+		if targetHasWorkloadReference(&obj.Spec.AppliedTo[i]) {
+			return true
+		}
+	}
+	for i := range rule.AppliedTo {
+		if targetHasWorkloadReference(&rule.AppliedTo[i]) {
+			return true
+		}
+	}
+	for _, peers := range [][]v1alpha1.SecurityPolicyPeer{getRuleSourcePeers(rule), getRuleDestinationPeers(rule)} {
+		for i := range peers {
+			if peerHasWorkloadReference(&peers[i]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func hasWorkloadReference(obj *v1alpha1.SecurityPolicy) bool {
+	for i := range obj.Spec.AppliedTo {
+		if targetHasWorkloadReference(&obj.Spec.AppliedTo[i]) {
+			return true
+		}
+	}
+	for i := range obj.Spec.Rules {
+		if ruleHasWorkloadReference(obj, &obj.Spec.Rules[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateWorkloadReferences checks the serviceAccountSelector and serviceRef are only used in VPC network, as the
+// tags are only synced onto the VPC SubnetPorts. The named port is not supported with them because the named port
+// is resolved with the Pod labels.
+func (service *SecurityPolicyService) validateWorkloadReferences(obj *v1alpha1.SecurityPolicy) error {
+	if !hasWorkloadReference(obj) {
+		return nil
+	}
+	if !IsVPCEnabled(service) {
+		return &nsxutil.ValidationError{Desc: "serviceAccountSelector and serviceRef are only supported in VPC network"}
+	}
+	for i := range obj.Spec.Rules {
+		rule := &obj.Spec.Rules[i]
+		if service.hasNamedPort(rule) && ruleHasWorkloadReference(obj, rule) {
+			return &nsxutil.ValidationError{Desc: "serviceAccountSelector and serviceRef are not supported with named port"}
+		}
+	}
+	return nil
+}
+
+// serviceAccountSelectorToPodSelector converts the ServiceAccount label selector to the PodSelector on the
+// ServiceAccount label tags of the Pod ports.
+func serviceAccountSelectorToPodSelector(selector *metav1.LabelSelector) *metav1.LabelSelector {
+	podSelector := &metav1.LabelSelector{}
+	if selector.MatchLabels != nil {
+		podSelector.MatchLabels = make(map[string]string, len(selector.MatchLabels))
+		for k, v := range selector.MatchLabels {
+			podSelector.MatchLabels[common.TagScopeServiceAccountLabelPrefix+k] = v
+		}
+	}
+	for _, expr := range selector.MatchExpressions {
+		podExpr := *expr.DeepCopy()
+		// The prefixed key may exceed the tag scope length, normalize it as the Pod port tags and the matchLabels.
+		podExpr.Key = util.NormalizeLabelScope(common.TagScopeServiceAccountLabelPrefix + expr.Key)
+		podSelector.MatchExpressions = append(podSelector.MatchExpressions, podExpr)
+	}
+	return podSelector
+}
+
+// serviceRefToPodSelector converts the Service reference to the PodSelector on the Service member tag of the Pod ports.
+func serviceRefToPodSelector(ref *v1alpha1.ServiceReference) *metav1.LabelSelector {
+	return &metav1.LabelSelector{
+		MatchLabels: map[string]string{common.TagScopeServiceMemberPrefix + ref.Name: common.TagValueServiceMember},
+	}
+}
+
+// mergePodSelectors returns a new selector which requires both the selectors.
+func mergePodSelectors(selector, other *metav1.LabelSelector) *metav1.LabelSelector {
+	if selector == nil {
+		return other
+	}
+	merged := selector.DeepCopy()
+	if len(other.MatchLabels) > 0 && merged.MatchLabels == nil {
+		merged.MatchLabels = make(map[string]string, len(other.MatchLabels))
+	}
+	for k, v := range other.MatchLabels {
+		merged.MatchLabels[k] = v
+	}
+	merged.MatchExpressions = append(merged.MatchExpressions, other.MatchExpressions...)
+	return merged
+}
+
+func normalizeTargetWorkloadReference(target *v1alpha1.SecurityPolicyTarget) {
+	if target.ServiceAccountSelector != nil {
+		target.PodSelector = mergePodSelectors(target.PodSelector, serviceAccountSelectorToPodSelector(target.ServiceAccountSelector))
+		target.ServiceAccountSelector = nil
+	}
+	if target.ServiceRef != nil {
+		target.PodSelector = serviceRefToPodSelector(target.ServiceRef)
+		target.ServiceRef = nil
+	}
+}
+
+func normalizePeerWorkloadReference(peer *v1alpha1.SecurityPolicyPeer, policyNamespace string) {
+	if peer.ServiceAccountSelector != nil {
+		peer.PodSelector = mergePodSelectors(peer.PodSelector, serviceAccountSelectorToPodSelector(peer.ServiceAccountSelector))
+		peer.ServiceAccountSelector = nil
+	}
+	if peer.ServiceRef != nil {
+		peer.PodSelector = serviceRefToPodSelector(peer.ServiceRef)
+		if peer.ServiceRef.Namespace != "" && peer.ServiceRef.Namespace != policyNamespace {
+			peer.NamespaceSelector = &metav1.LabelSelector{
+				MatchLabels: map[string]string{corev1.LabelMetadataName: peer.ServiceRef.Namespace},
+			}
+		}
+		peer.ServiceRef = nil
+	}
+}
+
+// normalizeWorkloadReferences converts the serviceAccountSelector and serviceRef in the policy targets and rule peers
+// to the PodSelector, and then clears them so that the downstream logic only uses the PodSelector.
+func normalizeWorkloadReferences(obj *v1alpha1.SecurityPolicy) {
+	for i := range obj.Spec.AppliedTo {
+		normalizeTargetWorkloadReference(&obj.Spec.AppliedTo[i])
+	}
+	for i := range obj.Spec.Rules {
+		rule := &obj.Spec.Rules[i]
+		for j := range rule.AppliedTo {
+			normalizeTargetWorkloadReference(&rule.AppliedTo[j])
+		}
+		for j := range rule.From {
+			normalizePeerWorkloadReference(&rule.From[j], obj.Namespace)
+		}
+		for j := range rule.To {
+			normalizePeerWorkloadReference(&rule.To[j], obj.Namespace)
+		}
+	}
+}
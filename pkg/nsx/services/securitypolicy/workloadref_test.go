@@ -0,0 +1,98 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package securitypolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+func Test_normalizeWorkloadReferences(t *testing.T) {
+	saSelector := &metav1.LabelSelector{
+		MatchLabels:      map[string]string{"team": "a"},
+		MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: metav1.LabelSelectorOpIn, Values: []string{"web"}}},
+	}
+	obj := &v1alpha1.SecurityPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "sp1"},
+		Spec: v1alpha1.SecurityPolicySpec{
+			AppliedTo: []v1alpha1.SecurityPolicyTarget{
+				{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}, ServiceAccountSelector: saSelector},
+			},
+			Rules: []v1alpha1.SecurityPolicyRule{
+				{
+					AppliedTo: []v1alpha1.SecurityPolicyTarget{{ServiceRef: &v1alpha1.ServiceReference{Name: "web"}}},
+					From: []v1alpha1.SecurityPolicyPeer{
+						{ServiceAccountSelector: saSelector, NamespaceSelector: &metav1.LabelSelector{}},
+						{ServiceRef: &v1alpha1.ServiceReference{Name: "db", Namespace: "ns2"}},
+						{ServiceRef: &v1alpha1.ServiceReference{Name: "cache", Namespace: "ns1"}},
+					},
+				},
+			},
+		},
+	}
+
+	normalizeWorkloadReferences(obj)
+
+	saPrefix := common.TagScopeServiceAccountLabelPrefix
+	assert.Equal(t, v1alpha1.SecurityPolicyTarget{
+		PodSelector: &metav1.LabelSelector{
+			MatchLabels:      map[string]string{"app": "web", saPrefix + "team": "a"},
+			MatchExpressions: []metav1.LabelSelectorRequirement{{Key: saPrefix + "tier", Operator: metav1.LabelSelectorOpIn, Values: []string{"web"}}},
+		},
+	}, obj.Spec.AppliedTo[0])
+	// The selector in the CR is not changed.
+	assert.Equal(t, map[string]string{"team": "a"}, saSelector.MatchLabels)
+
+	rule := obj.Spec.Rules[0]
+	assert.Equal(t, v1alpha1.SecurityPolicyTarget{
+		PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{common.TagScopeServiceMemberPrefix + "web": common.TagValueServiceMember}},
+	}, rule.AppliedTo[0])
+	assert.Equal(t, v1alpha1.SecurityPolicyPeer{
+		PodSelector: &metav1.LabelSelector{
+			MatchLabels:      map[string]string{saPrefix + "team": "a"},
+			MatchExpressions: []metav1.LabelSelectorRequirement{{Key: saPrefix + "tier", Operator: metav1.LabelSelectorOpIn, Values: []string{"web"}}},
+		},
+		NamespaceSelector: &metav1.LabelSelector{},
+	}, rule.From[0])
+	assert.Equal(t, v1alpha1.SecurityPolicyPeer{
+		PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{common.TagScopeServiceMemberPrefix + "db": common.TagValueServiceMember}},
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{corev1.LabelMetadataName: "ns2"}},
+	}, rule.From[1])
+	assert.Equal(t, v1alpha1.SecurityPolicyPeer{
+		PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{common.TagScopeServiceMemberPrefix + "cache": common.TagValueServiceMember}},
+	}, rule.From[2])
+	assert.False(t, hasWorkloadReference(obj))
+}
+
+func Test_validateWorkloadReferences(t *testing.T) {
+	service := fakeSecurityPolicyService()
+
+	newPolicy := func(port intstr.IntOrString) *v1alpha1.SecurityPolicy {
+		return &v1alpha1.SecurityPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "sp1"},
+			Spec: v1alpha1.SecurityPolicySpec{
+				AppliedTo: []v1alpha1.SecurityPolicyTarget{{ServiceRef: &v1alpha1.ServiceReference{Name: "web"}}},
+				Rules: []v1alpha1.SecurityPolicyRule{
+					{Ports: []v1alpha1.SecurityPolicyPort{{Protocol: corev1.ProtocolTCP, Port: port}}},
+				},
+			},
+		}
+	}
+
+	config.SetMixedModeStateForTest(true, false)
+	assert.ErrorContains(t, service.validateWorkloadReferences(newPolicy(intstr.FromInt32(80))), "only supported in VPC network")
+	assert.NoError(t, service.validateWorkloadReferences(&v1alpha1.SecurityPolicy{}))
+
+	config.SetMixedModeStateForTest(false, true)
+	assert.NoError(t, service.validateWorkloadReferences(newPolicy(intstr.FromInt32(80))))
+	assert.ErrorContains(t, service.validateWorkloadReferences(newPolicy(intstr.FromString("http"))), "not supported with named port")
+}
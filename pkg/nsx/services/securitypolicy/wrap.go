@@ -86,6 +86,14 @@ func (service *SecurityPolicyService) wrapRules(rules []model.Rule) ([]*data.Str
 
 	for _, r := range rules {
 		rule := r
+		// The fields left unset are not cleared by the H-API PATCH, set them explicitly to disable the logging and
+		// clear the log label.
+		if rule.Logged == nil {
+			rule.Logged = common.Bool(false)
+		}
+		if rule.Tag == nil {
+			rule.Tag = String("")
+		}
 		rule.ResourceType = &common.ResourceTypeRule // need this field to identify the resource type
 		childRule := model.ChildRule{                // We need to put child rule's id into upper level, otherwise, NSX-T will not find the child rule
 			ResourceType:    resourceType, // Children are not allowed for rule, so we don't need to wrap ServiceEntry into Children
@@ -286,6 +294,28 @@ func (service *SecurityPolicyService) wrapShares(shares []model.Share) ([]*data.
 	return sharesChildren, nil
 }
 
+func (service *SecurityPolicyService) wrapContextProfiles(profiles []model.PolicyContextProfile) ([]*data.StructValue, error) {
+	var profilesChildren []*data.StructValue
+	resourceType := common.ResourceTypeChildContextProfile
+
+	for _, p := range profiles {
+		profile := p
+		profile.ResourceType = &common.ResourceTypeContextProfile // need this field to identify the resource type
+		childProfile := model.ChildPolicyContextProfile{
+			ResourceType:         resourceType,
+			Id:                   profile.Id,
+			MarkedForDelete:      profile.MarkedForDelete,
+			PolicyContextProfile: &profile,
+		}
+		dataValue, errors := NewConverter().ConvertToVapi(childProfile, model.ChildPolicyContextProfileBindingType())
+		if len(errors) > 0 {
+			return nil, errors[0]
+		}
+		profilesChildren = append(profilesChildren, dataValue.(*data.StructValue))
+	}
+	return profilesChildren, nil
+}
+
 func (service *SecurityPolicyService) wrapChildTargetInfra(children []*data.StructValue) ([]*data.StructValue, error) {
 	var infraChildren []*data.StructValue
 	targetType := common.ResourceTypeInfra
@@ -263,6 +263,9 @@ func TestSecurityPolicyService_wrapRules(t *testing.T) {
 				assert.Equal(t, mId, *rc.Id)
 				assert.Equal(t, MarkedForDelete, *rc.MarkedForDelete)
 				assert.NotNil(t, rc.Rule)
+				// Logging is disabled explicitly, as the unset fields are not cleared by the PATCH.
+				assert.False(t, *rc.Rule.Logged)
+				assert.Equal(t, "", *rc.Rule.Tag)
 			}
 		})
 	}
@@ -134,7 +134,7 @@ func (service *StaticRouteService) CreateOrUpdateStaticRoute(ctx context.Context
 	if len(vpc) == 0 {
 		return fmt.Errorf("no vpc found for ns %s", namespace)
 	}
-	err = service.patch(vpc[0].OrgID, vpc[0].ProjectID, vpc[0].ID, nsxStaticRoute)
+	err = service.patch(ctx, vpc[0].OrgID, vpc[0].ProjectID, vpc[0].ID, nsxStaticRoute)
 	if err != nil {
 		return err
 	}
@@ -168,8 +168,8 @@ func (service *StaticRouteService) checkStaticRouteRealizeState(staticRoute *mod
 	return nil
 }
 
-func (service *StaticRouteService) patch(orgId string, projectId string, vpcId string, st *model.StaticRoutes) error {
-	err := service.NSXClient.StaticRouteClient.Patch(orgId, projectId, vpcId, *st.Id, *st)
+func (service *StaticRouteService) patch(ctx context.Context, orgId string, projectId string, vpcId string, st *model.StaticRoutes) error {
+	err := service.NSXClient.WithContext(ctx).StaticRouteClient.Patch(orgId, projectId, vpcId, *st.Id, *st)
 	err = nsxutil.TransNSXApiError(err)
 	if err != nil {
 		return err
@@ -517,7 +517,7 @@ func TestStaticRouteService_CreateOrUpdateStaticRoute(t *testing.T) {
 		defer patchVPC.Reset()
 
 		// Patch patch to return error
-		patchPatch := gomonkey.ApplyPrivateMethod(reflect.TypeOf(service), "patch", func(_ *StaticRouteService, orgId, projectId, vpcId string, st *model.StaticRoutes) error {
+		patchPatch := gomonkey.ApplyPrivateMethod(reflect.TypeOf(service), "patch", func(_ *StaticRouteService, _ context.Context, orgId, projectId, vpcId string, st *model.StaticRoutes) error {
 			return fmt.Errorf("patch error")
 		})
 		defer patchPatch.Reset()
@@ -552,7 +552,7 @@ func TestStaticRouteService_CreateOrUpdateStaticRoute(t *testing.T) {
 		defer patchVPC.Reset()
 
 		// Patch patch to succeed, but StaticRouteClient.Get returns error
-		patchPatch := gomonkey.ApplyPrivateMethod(reflect.TypeOf(service), "patch", func(_ *StaticRouteService, orgId, projectId, vpcId string, st *model.StaticRoutes) error {
+		patchPatch := gomonkey.ApplyPrivateMethod(reflect.TypeOf(service), "patch", func(_ *StaticRouteService, _ context.Context, orgId, projectId, vpcId string, st *model.StaticRoutes) error {
 			return nil
 		})
 		defer patchPatch.Reset()
@@ -588,7 +588,7 @@ func TestStaticRouteService_CreateOrUpdateStaticRoute(t *testing.T) {
 		defer patchVPC.Reset()
 
 		// Patch patch to succeed, but StaticRouteClient.Get returns error
-		patchPatch := gomonkey.ApplyPrivateMethod(reflect.TypeOf(service), "patch", func(_ *StaticRouteService, orgId, projectId, vpcId string, st *model.StaticRoutes) error {
+		patchPatch := gomonkey.ApplyPrivateMethod(reflect.TypeOf(service), "patch", func(_ *StaticRouteService, _ context.Context, orgId, projectId, vpcId string, st *model.StaticRoutes) error {
 			return nil
 		})
 		defer patchPatch.Reset()
@@ -635,7 +635,7 @@ func TestStaticRouteService_CreateOrUpdateStaticRoute(t *testing.T) {
 		defer patchVPC.Reset()
 
 		// Patch patch to succeed, but StaticRouteClient.Get returns error
-		patchPatch := gomonkey.ApplyPrivateMethod(reflect.TypeOf(service), "patch", func(_ *StaticRouteService, orgId, projectId, vpcId string, st *model.StaticRoutes) error {
+		patchPatch := gomonkey.ApplyPrivateMethod(reflect.TypeOf(service), "patch", func(_ *StaticRouteService, _ context.Context, orgId, projectId, vpcId string, st *model.StaticRoutes) error {
 			return nil
 		})
 		defer patchPatch.Reset()
@@ -680,7 +680,7 @@ func TestStaticRouteService_CreateOrUpdateStaticRoute(t *testing.T) {
 		})
 		defer patchVPC.Reset()
 
-		patchPatch := gomonkey.ApplyPrivateMethod(reflect.TypeOf(service), "patch", func(_ *StaticRouteService, orgId, projectId, vpcId string, st *model.StaticRoutes) error {
+		patchPatch := gomonkey.ApplyPrivateMethod(reflect.TypeOf(service), "patch", func(_ *StaticRouteService, _ context.Context, orgId, projectId, vpcId string, st *model.StaticRoutes) error {
 			return nil
 		})
 		defer patchPatch.Reset()
@@ -19,6 +19,14 @@ import (
 
 const AccessModeProjectInNSX string = "Private_TGW"
 
+const (
+	// Generic DHCPv4 option codes used for the Subnet DHCP server options.
+	dhcpOptionCodeNTPServers   int64 = 42
+	dhcpOptionCodeDomainSearch int64 = 119
+	// NSX uses this DHCP lease time in seconds if it is not configured.
+	defaultDHCPLeaseTime int64 = 86400
+)
+
 var (
 	String = common.String
 	Int64  = common.Int64
@@ -144,12 +152,7 @@ func (service *SubnetService) buildSubnet(obj client.Object, tags []model.Tag, i
 			if dhcpMode == "" {
 				dhcpMode = v1alpha1.DHCPConfigModeDeactivated
 			}
-			var dhcpServerAdditionalConfig *model.DhcpServerAdditionalConfig
-			if len(o.Spec.SubnetDHCPConfig.DHCPServerAdditionalConfig.ReservedIPRanges) > 0 {
-				dhcpServerAdditionalConfig = &model.DhcpServerAdditionalConfig{}
-				dhcpServerAdditionalConfig.ReservedIpRanges = o.Spec.SubnetDHCPConfig.DHCPServerAdditionalConfig.ReservedIPRanges
-			}
-			nsxSubnet.SubnetDhcpConfig = service.buildSubnetDHCPConfig(dhcpMode, dhcpServerAdditionalConfig)
+			nsxSubnet.SubnetDhcpConfig = service.buildSubnetDHCPConfig(dhcpMode, buildDHCPServerAdditionalConfig(&o.Spec.SubnetDHCPConfig.DHCPServerAdditionalConfig, true), o.Spec.SubnetDHCPConfig.DHCPServerAdditionalConfig.DNSServers)
 			if o.Spec.IPv4SubnetSize > 0 {
 				nsxSubnet.Ipv4SubnetSize = Int64(int64(o.Spec.IPv4SubnetSize))
 			}
@@ -160,12 +163,7 @@ func (service *SubnetService) buildSubnet(obj client.Object, tags []model.Tag, i
 			if dhcpv6Mode == "" {
 				dhcpv6Mode = string(v1alpha1.DHCPv6ConfigModeDeactivated)
 			}
-			var dhcpv6ServerAdditionalConfig *model.DhcpV6ServerAdditionalConfig
-			if len(o.Spec.SubnetDHCPv6Config.DHCPv6ServerAdditionalConfig.ReservedIPRanges) > 0 {
-				dhcpv6ServerAdditionalConfig = &model.DhcpV6ServerAdditionalConfig{}
-				dhcpv6ServerAdditionalConfig.ReservedIpRanges = o.Spec.SubnetDHCPv6Config.DHCPv6ServerAdditionalConfig.ReservedIPRanges
-			}
-			nsxSubnet.SubnetDhcpv6Config = service.buildSubnetDHCPv6Config(dhcpv6Mode, dhcpv6ServerAdditionalConfig)
+			nsxSubnet.SubnetDhcpv6Config = service.buildSubnetDHCPv6Config(dhcpv6Mode, buildDHCPv6ServerAdditionalConfig(&o.Spec.SubnetDHCPv6Config.DHCPv6ServerAdditionalConfig, true), o.Spec.SubnetDHCPv6Config.DHCPv6ServerAdditionalConfig.DNSServers)
 			if o.Spec.IPv6PrefixLength > 0 {
 				nsxSubnet.Ipv6PrefixLength = Int64(int64(o.Spec.IPv6PrefixLength))
 			}
@@ -219,7 +217,9 @@ func (service *SubnetService) buildSubnet(obj client.Object, tags []model.Tag, i
 			if dhcpMode == "" {
 				dhcpMode = v1alpha1.DHCPConfigModeDeactivated
 			}
-			nsxSubnet.SubnetDhcpConfig = service.buildSubnetDHCPConfig(dhcpMode, nil)
+			// ReservedIPRanges and StaticBindings are not supported in SubnetSet,
+			// the DHCP options are applied to every Subnet in the SubnetSet.
+			nsxSubnet.SubnetDhcpConfig = service.buildSubnetDHCPConfig(dhcpMode, buildDHCPServerAdditionalConfig(&o.Spec.SubnetDHCPConfig.DHCPServerAdditionalConfig, false), o.Spec.SubnetDHCPConfig.DHCPServerAdditionalConfig.DNSServers)
 			// Set IPv4 subnet size only when IPv4 is enabled
 			if o.Spec.IPv4SubnetSize > 0 {
 				nsxSubnet.Ipv4SubnetSize = Int64(int64(o.Spec.IPv4SubnetSize))
@@ -231,7 +231,7 @@ func (service *SubnetService) buildSubnet(obj client.Object, tags []model.Tag, i
 			if dhcpv6Mode == "" {
 				dhcpv6Mode = string(v1alpha1.DHCPv6ConfigModeDeactivated)
 			}
-			nsxSubnet.SubnetDhcpv6Config = service.buildSubnetDHCPv6Config(dhcpv6Mode, nil)
+			nsxSubnet.SubnetDhcpv6Config = service.buildSubnetDHCPv6Config(dhcpv6Mode, buildDHCPv6ServerAdditionalConfig(&o.Spec.SubnetDHCPv6Config.DHCPv6ServerAdditionalConfig, false), o.Spec.SubnetDHCPv6Config.DHCPv6ServerAdditionalConfig.DNSServers)
 			// Set IPv6 prefix length if IPv6 is enabled
 			if o.Spec.IPv6PrefixLength > 0 {
 				nsxSubnet.Ipv6PrefixLength = Int64(int64(o.Spec.IPv6PrefixLength))
@@ -246,24 +246,177 @@ func (service *SubnetService) buildSubnet(obj client.Object, tags []model.Tag, i
 	return nsxSubnet, nil
 }
 
-func (service *SubnetService) buildSubnetDHCPConfig(mode string, dhcpServerAdditionalConfig *model.DhcpServerAdditionalConfig) *model.SubnetDhcpConfig {
+// buildSubnetDHCPConfig builds the NSX DHCP config. NSX keeps the fields omitted in a PATCH request, so the DHCP
+// server options, lease time and DNS servers are sent with explicit empty or default values if they are not
+// configured, otherwise removing them from the spec would not clear them on NSX.
+func (service *SubnetService) buildSubnetDHCPConfig(mode string, dhcpServerAdditionalConfig *model.DhcpServerAdditionalConfig, dnsServers []string) *model.SubnetDhcpConfig {
 	nsxMode := nsxutil.ParseDHCPMode(mode)
 	subnetDhcpConfig := &model.SubnetDhcpConfig{
 		DhcpServerAdditionalConfig: dhcpServerAdditionalConfig,
 		Mode:                       &nsxMode,
+		DnsClientConfig:            buildDNSClientConfig(dnsServers),
+	}
+	if mode != v1alpha1.DHCPConfigModeServer {
+		return subnetDhcpConfig
+	}
+	if subnetDhcpConfig.DhcpServerAdditionalConfig == nil {
+		subnetDhcpConfig.DhcpServerAdditionalConfig = &model.DhcpServerAdditionalConfig{}
+	}
+	if subnetDhcpConfig.DhcpServerAdditionalConfig.LeaseTime == nil {
+		subnetDhcpConfig.DhcpServerAdditionalConfig.LeaseTime = Int64(defaultDHCPLeaseTime)
+	}
+	if subnetDhcpConfig.DhcpServerAdditionalConfig.Options == nil {
+		subnetDhcpConfig.DhcpServerAdditionalConfig.Options = &model.DhcpV4Options{Others: []model.GenericDhcpOption{}}
+	}
+	if subnetDhcpConfig.DnsClientConfig == nil {
+		subnetDhcpConfig.DnsClientConfig = &model.DnsClientConfig{DnsServerIps: []string{}}
 	}
 	return subnetDhcpConfig
 }
 
-func (service *SubnetService) buildSubnetDHCPv6Config(mode string, dhcpServerAdditionalConfig *model.DhcpV6ServerAdditionalConfig) *model.SubnetDhcpv6Config {
+// buildSubnetDHCPv6Config is the DHCPv6 counterpart of buildSubnetDHCPConfig, the lease time is only applicable to
+// the stateful DHCPv6 server.
+func (service *SubnetService) buildSubnetDHCPv6Config(mode string, dhcpServerAdditionalConfig *model.DhcpV6ServerAdditionalConfig, dnsServers []string) *model.SubnetDhcpv6Config {
 	nsxMode := nsxutil.ParseDHCPMode(mode)
 	subnetDhcpv6Config := &model.SubnetDhcpv6Config{
 		Dhcpv6ServerAdditionalConfig: dhcpServerAdditionalConfig,
 		Mode:                         &nsxMode,
+		DnsClientConfig:              buildDNSClientConfig(dnsServers),
+	}
+	if mode != string(v1alpha1.DHCPv6ConfigModeServer) && mode != string(v1alpha1.DHCPv6ConfigModeServerStateless) {
+		return subnetDhcpv6Config
+	}
+	if subnetDhcpv6Config.Dhcpv6ServerAdditionalConfig == nil {
+		subnetDhcpv6Config.Dhcpv6ServerAdditionalConfig = &model.DhcpV6ServerAdditionalConfig{}
+	}
+	if subnetDhcpv6Config.Dhcpv6ServerAdditionalConfig.LeaseTime == nil && mode == string(v1alpha1.DHCPv6ConfigModeServer) {
+		subnetDhcpv6Config.Dhcpv6ServerAdditionalConfig.LeaseTime = Int64(defaultDHCPLeaseTime)
+	}
+	if subnetDhcpv6Config.Dhcpv6ServerAdditionalConfig.DomainNames == nil {
+		subnetDhcpv6Config.Dhcpv6ServerAdditionalConfig.DomainNames = []string{}
+	}
+	if subnetDhcpv6Config.Dhcpv6ServerAdditionalConfig.SntpServers == nil {
+		subnetDhcpv6Config.Dhcpv6ServerAdditionalConfig.SntpServers = []string{}
+	}
+	if subnetDhcpv6Config.DnsClientConfig == nil {
+		subnetDhcpv6Config.DnsClientConfig = &model.DnsClientConfig{DnsServerIps: []string{}}
 	}
 	return subnetDhcpv6Config
 }
 
+// buildDHCPServerAdditionalConfig converts the DHCP server additional config of a Subnet or SubnetSet
+// to the NSX config. It returns nil if nothing is configured so that NSX defaults are kept.
+func buildDHCPServerAdditionalConfig(cfg *v1alpha1.DHCPServerAdditionalConfig, withReservedIPRanges bool) *model.DhcpServerAdditionalConfig {
+	nsxCfg := &model.DhcpServerAdditionalConfig{}
+	configured := false
+	if withReservedIPRanges && len(cfg.ReservedIPRanges) > 0 {
+		nsxCfg.ReservedIpRanges = cfg.ReservedIPRanges
+		configured = true
+	}
+	if cfg.LeaseTime > 0 {
+		nsxCfg.LeaseTime = Int64(cfg.LeaseTime)
+		configured = true
+	}
+	if options := buildDHCPv4Options(cfg); options != nil {
+		nsxCfg.Options = options
+		configured = true
+	}
+	if !configured {
+		return nil
+	}
+	return nsxCfg
+}
+
+func buildDHCPv4Options(cfg *v1alpha1.DHCPServerAdditionalConfig) *model.DhcpV4Options {
+	var options *model.DhcpV4Options
+	if len(cfg.ClasslessStaticRoutes) > 0 {
+		options = &model.DhcpV4Options{Option121: &model.DhcpOption121{}}
+		for _, route := range cfg.ClasslessStaticRoutes {
+			options.Option121.StaticRoutes = append(options.Option121.StaticRoutes, model.ClasslessStaticRoute{
+				Network: String(route.Network),
+				NextHop: String(route.NextHop),
+			})
+		}
+	}
+	for _, o := range []struct {
+		code   int64
+		values []string
+	}{
+		{code: dhcpOptionCodeNTPServers, values: cfg.NTPServers},
+		{code: dhcpOptionCodeDomainSearch, values: cfg.DomainNames},
+	} {
+		if len(o.values) == 0 {
+			continue
+		}
+		if options == nil {
+			options = &model.DhcpV4Options{}
+		}
+		options.Others = append(options.Others, model.GenericDhcpOption{Code: Int64(o.code), Values: o.values})
+	}
+	return options
+}
+
+// buildDHCPv6ServerAdditionalConfig converts the DHCPv6 server additional config of a Subnet or SubnetSet
+// to the NSX config. It returns nil if nothing is configured so that NSX defaults are kept.
+func buildDHCPv6ServerAdditionalConfig(cfg *v1alpha1.DHCPv6ServerAdditionalConfig, withReservedIPRanges bool) *model.DhcpV6ServerAdditionalConfig {
+	nsxCfg := &model.DhcpV6ServerAdditionalConfig{}
+	configured := false
+	if withReservedIPRanges && len(cfg.ReservedIPRanges) > 0 {
+		nsxCfg.ReservedIpRanges = cfg.ReservedIPRanges
+		configured = true
+	}
+	if cfg.LeaseTime > 0 {
+		nsxCfg.LeaseTime = Int64(cfg.LeaseTime)
+		configured = true
+	}
+	if len(cfg.DomainNames) > 0 {
+		nsxCfg.DomainNames = cfg.DomainNames
+		configured = true
+	}
+	if len(cfg.NTPServers) > 0 {
+		nsxCfg.SntpServers = cfg.NTPServers
+		configured = true
+	}
+	if !configured {
+		return nil
+	}
+	return nsxCfg
+}
+
+// mergeDHCPServerAdditionalConfig applies the DHCP options and lease time of a SubnetSet to the
+// existing NSX config of one of its Subnets. The reserved IP ranges on NSX are kept as they are.
+func mergeDHCPServerAdditionalConfig(existing *model.DhcpServerAdditionalConfig, cfg *v1alpha1.DHCPServerAdditionalConfig) *model.DhcpServerAdditionalConfig {
+	merged := buildDHCPServerAdditionalConfig(cfg, false)
+	if existing == nil || len(existing.ReservedIpRanges) == 0 {
+		return merged
+	}
+	if merged == nil {
+		merged = &model.DhcpServerAdditionalConfig{}
+	}
+	merged.ReservedIpRanges = existing.ReservedIpRanges
+	return merged
+}
+
+// mergeDHCPv6ServerAdditionalConfig is the DHCPv6 counterpart of mergeDHCPServerAdditionalConfig.
+func mergeDHCPv6ServerAdditionalConfig(existing *model.DhcpV6ServerAdditionalConfig, cfg *v1alpha1.DHCPv6ServerAdditionalConfig) *model.DhcpV6ServerAdditionalConfig {
+	merged := buildDHCPv6ServerAdditionalConfig(cfg, false)
+	if existing == nil || len(existing.ReservedIpRanges) == 0 {
+		return merged
+	}
+	if merged == nil {
+		merged = &model.DhcpV6ServerAdditionalConfig{}
+	}
+	merged.ReservedIpRanges = existing.ReservedIpRanges
+	return merged
+}
+
+func buildDNSClientConfig(dnsServers []string) *model.DnsClientConfig {
+	if len(dnsServers) == 0 {
+		return nil
+	}
+	return &model.DnsClientConfig{DnsServerIps: dnsServers}
+}
+
 func (service *SubnetService) buildBasicTags(obj client.Object) []model.Tag {
 	return util.BuildBasicTags(getCluster(service), obj, "")
 }
@@ -1535,3 +1535,96 @@ func TestBuildSubnetForSubnet_IPv6(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildDHCPServerAdditionalConfig(t *testing.T) {
+	assert.Nil(t, buildDHCPServerAdditionalConfig(&v1alpha1.DHCPServerAdditionalConfig{}, true))
+	// ReservedIPRanges are ignored for SubnetSet
+	assert.Nil(t, buildDHCPServerAdditionalConfig(&v1alpha1.DHCPServerAdditionalConfig{ReservedIPRanges: []string{"10.0.0.4-10.0.0.10"}}, false))
+
+	cfg := &v1alpha1.DHCPServerAdditionalConfig{
+		ReservedIPRanges: []string{"10.0.0.4-10.0.0.10"},
+		LeaseTime:        3600,
+		DNSServers:       []string{"10.0.0.53"},
+		DomainNames:      []string{"example.com"},
+		NTPServers:       []string{"10.0.0.123"},
+		ClasslessStaticRoutes: []v1alpha1.DHCPClasslessStaticRoute{
+			{Network: "192.168.0.0/16", NextHop: "10.0.0.254"},
+		},
+	}
+	nsxCfg := buildDHCPServerAdditionalConfig(cfg, true)
+	require.NotNil(t, nsxCfg)
+	assert.Equal(t, []string{"10.0.0.4-10.0.0.10"}, nsxCfg.ReservedIpRanges)
+	assert.Equal(t, int64(3600), *nsxCfg.LeaseTime)
+	require.NotNil(t, nsxCfg.Options)
+	assert.Equal(t, []model.ClasslessStaticRoute{{Network: String("192.168.0.0/16"), NextHop: String("10.0.0.254")}}, nsxCfg.Options.Option121.StaticRoutes)
+	assert.Equal(t, []model.GenericDhcpOption{
+		{Code: Int64(42), Values: []string{"10.0.0.123"}},
+		{Code: Int64(119), Values: []string{"example.com"}},
+	}, nsxCfg.Options.Others)
+
+	nsxCfg = buildDHCPServerAdditionalConfig(cfg, false)
+	require.NotNil(t, nsxCfg)
+	assert.Nil(t, nsxCfg.ReservedIpRanges)
+
+	assert.Nil(t, buildDNSClientConfig(nil))
+	assert.Equal(t, &model.DnsClientConfig{DnsServerIps: []string{"10.0.0.53"}}, buildDNSClientConfig(cfg.DNSServers))
+}
+
+func TestBuildSubnetDHCPConfig(t *testing.T) {
+	service := &SubnetService{}
+	// The unset DHCP server options are sent with explicit empty values to clear them on NSX
+	dhcpConfig := service.buildSubnetDHCPConfig(v1alpha1.DHCPConfigModeServer, nil, nil)
+	assert.Equal(t, &model.SubnetDhcpConfig{
+		Mode: String("DHCP_SERVER"),
+		DhcpServerAdditionalConfig: &model.DhcpServerAdditionalConfig{
+			LeaseTime: Int64(defaultDHCPLeaseTime),
+			Options:   &model.DhcpV4Options{Others: []model.GenericDhcpOption{}},
+		},
+		DnsClientConfig: &model.DnsClientConfig{DnsServerIps: []string{}},
+	}, dhcpConfig)
+	// The explicit empty values are not considered as changes
+	assert.False(t, common.CompareResource(SubnetToComparable(&model.VpcSubnet{SubnetDhcpConfig: &model.SubnetDhcpConfig{Mode: String("DHCP_SERVER")}}),
+		SubnetToComparable(&model.VpcSubnet{SubnetDhcpConfig: dhcpConfig})))
+	assert.Equal(t, &model.SubnetDhcpConfig{Mode: String("DHCP_RELAY")}, service.buildSubnetDHCPConfig(v1alpha1.DHCPConfigModeRelay, nil, nil))
+
+	dhcpv6Config := service.buildSubnetDHCPv6Config(string(v1alpha1.DHCPv6ConfigModeServerStateless), nil, []string{"2001:db8::53"})
+	assert.Equal(t, &model.SubnetDhcpv6Config{
+		Mode: String("DHCP_SERVER_STATELESS"),
+		Dhcpv6ServerAdditionalConfig: &model.DhcpV6ServerAdditionalConfig{
+			DomainNames: []string{},
+			SntpServers: []string{},
+		},
+		DnsClientConfig: &model.DnsClientConfig{DnsServerIps: []string{"2001:db8::53"}},
+	}, dhcpv6Config)
+	assert.Equal(t, Int64(defaultDHCPLeaseTime), service.buildSubnetDHCPv6Config(string(v1alpha1.DHCPv6ConfigModeServer), nil, nil).Dhcpv6ServerAdditionalConfig.LeaseTime)
+}
+
+func TestBuildDHCPv6ServerAdditionalConfig(t *testing.T) {
+	assert.Nil(t, buildDHCPv6ServerAdditionalConfig(&v1alpha1.DHCPv6ServerAdditionalConfig{}, true))
+
+	nsxCfg := buildDHCPv6ServerAdditionalConfig(&v1alpha1.DHCPv6ServerAdditionalConfig{
+		ReservedIPRanges: []string{"2001:db8::10-2001:db8::20"},
+		LeaseTime:        7200,
+		DomainNames:      []string{"example.com"},
+		NTPServers:       []string{"2001:db8::123"},
+	}, true)
+	require.NotNil(t, nsxCfg)
+	assert.Equal(t, []string{"2001:db8::10-2001:db8::20"}, nsxCfg.ReservedIpRanges)
+	assert.Equal(t, int64(7200), *nsxCfg.LeaseTime)
+	assert.Equal(t, []string{"example.com"}, nsxCfg.DomainNames)
+	assert.Equal(t, []string{"2001:db8::123"}, nsxCfg.SntpServers)
+}
+
+func TestMergeDHCPServerAdditionalConfig(t *testing.T) {
+	existing := &model.DhcpServerAdditionalConfig{ReservedIpRanges: []string{"10.0.0.4-10.0.0.10"}, LeaseTime: Int64(3600)}
+	// The reserved IP ranges on NSX are kept and the lease time is reset to the SubnetSet spec
+	merged := mergeDHCPServerAdditionalConfig(existing, &v1alpha1.DHCPServerAdditionalConfig{})
+	assert.Equal(t, &model.DhcpServerAdditionalConfig{ReservedIpRanges: []string{"10.0.0.4-10.0.0.10"}}, merged)
+
+	merged = mergeDHCPServerAdditionalConfig(nil, &v1alpha1.DHCPServerAdditionalConfig{LeaseTime: 600})
+	assert.Equal(t, &model.DhcpServerAdditionalConfig{LeaseTime: Int64(600)}, merged)
+
+	merged6 := mergeDHCPv6ServerAdditionalConfig(&model.DhcpV6ServerAdditionalConfig{ReservedIpRanges: []string{"2001:db8::10"}}, &v1alpha1.DHCPv6ServerAdditionalConfig{DomainNames: []string{"example.com"}})
+	assert.Equal(t, &model.DhcpV6ServerAdditionalConfig{ReservedIpRanges: []string{"2001:db8::10"}, DomainNames: []string{"example.com"}}, merged6)
+	assert.Nil(t, mergeDHCPv6ServerAdditionalConfig(nil, &v1alpha1.DHCPv6ServerAdditionalConfig{}))
+}
@@ -38,30 +38,45 @@ func (subnet *Subnet) Value() data.DataValue {
 	// Only compare Mode and DhcpServerAdditionalConfig from SubnetDhcpConfig
 	if subnet.SubnetDhcpConfig != nil {
 		var dhcpServerAdditionalConfig *model.DhcpServerAdditionalConfig
-		// Only compare ReservedIpRanges from DhcpServerAdditionalConfig
+		// Only compare ReservedIpRanges, LeaseTime and Options from DhcpServerAdditionalConfig
 		if subnet.SubnetDhcpConfig.DhcpServerAdditionalConfig != nil {
 			dhcpServerAdditionalConfig = &model.DhcpServerAdditionalConfig{
 				ReservedIpRanges: subnet.SubnetDhcpConfig.DhcpServerAdditionalConfig.ReservedIpRanges,
+				LeaseTime:        comparableLeaseTime(subnet.SubnetDhcpConfig.DhcpServerAdditionalConfig.LeaseTime),
+				Options:          comparableDHCPv4Options(subnet.SubnetDhcpConfig.DhcpServerAdditionalConfig.Options),
+			}
+			// NSX returns the additional config with default values even if it is not configured
+			if len(dhcpServerAdditionalConfig.ReservedIpRanges) == 0 && dhcpServerAdditionalConfig.LeaseTime == nil && dhcpServerAdditionalConfig.Options == nil {
+				dhcpServerAdditionalConfig = nil
 			}
 		}
 		subnetDhcpConfig = &model.SubnetDhcpConfig{
 			Mode:                       subnet.SubnetDhcpConfig.Mode,
 			DhcpServerAdditionalConfig: dhcpServerAdditionalConfig,
+			DnsClientConfig:            comparableDNSClientConfig(subnet.SubnetDhcpConfig.DnsClientConfig),
 		}
 	}
 	var subnetDhcpv6Config *model.SubnetDhcpv6Config
 	// Only compare Mode and Dhcpv6ServerAdditionalConfig from SubnetDhcpv6Config
 	if subnet.SubnetDhcpv6Config != nil {
 		var dhcpv6ServerAdditionalConfig *model.DhcpV6ServerAdditionalConfig
-		// Only compare ReservedIpRanges from DhcpV6ServerAdditionalConfig
+		// Only compare ReservedIpRanges, LeaseTime, DomainNames and SntpServers from DhcpV6ServerAdditionalConfig
 		if subnet.SubnetDhcpv6Config.Dhcpv6ServerAdditionalConfig != nil {
 			dhcpv6ServerAdditionalConfig = &model.DhcpV6ServerAdditionalConfig{
 				ReservedIpRanges: subnet.SubnetDhcpv6Config.Dhcpv6ServerAdditionalConfig.ReservedIpRanges,
+				LeaseTime:        comparableLeaseTime(subnet.SubnetDhcpv6Config.Dhcpv6ServerAdditionalConfig.LeaseTime),
+				DomainNames:      subnet.SubnetDhcpv6Config.Dhcpv6ServerAdditionalConfig.DomainNames,
+				SntpServers:      subnet.SubnetDhcpv6Config.Dhcpv6ServerAdditionalConfig.SntpServers,
+			}
+			if len(dhcpv6ServerAdditionalConfig.ReservedIpRanges) == 0 && dhcpv6ServerAdditionalConfig.LeaseTime == nil &&
+				len(dhcpv6ServerAdditionalConfig.DomainNames) == 0 && len(dhcpv6ServerAdditionalConfig.SntpServers) == 0 {
+				dhcpv6ServerAdditionalConfig = nil
 			}
 		}
 		subnetDhcpv6Config = &model.SubnetDhcpv6Config{
 			Mode:                         subnet.SubnetDhcpv6Config.Mode,
 			Dhcpv6ServerAdditionalConfig: dhcpv6ServerAdditionalConfig,
+			DnsClientConfig:              comparableDNSClientConfig(subnet.SubnetDhcpv6Config.DnsClientConfig),
 		}
 	}
 	s := &Subnet{
@@ -75,6 +90,42 @@ func (subnet *Subnet) Value() data.DataValue {
 	return dataValue
 }
 
+// comparableLeaseTime treats the NSX default lease time as unset, so a Subnet without
+// leaseTime is not considered changed after NSX fills in the default.
+func comparableLeaseTime(leaseTime *int64) *int64 {
+	if leaseTime == nil || *leaseTime == defaultDHCPLeaseTime {
+		return nil
+	}
+	return leaseTime
+}
+
+// comparableDHCPv4Options only keeps the classless static routes and generic options
+// configured by nsx-operator, and treats empty options as unset.
+func comparableDHCPv4Options(options *model.DhcpV4Options) *model.DhcpV4Options {
+	if options == nil {
+		return nil
+	}
+	var staticRoutes []model.ClasslessStaticRoute
+	if options.Option121 != nil {
+		staticRoutes = options.Option121.StaticRoutes
+	}
+	if len(staticRoutes) == 0 && len(options.Others) == 0 {
+		return nil
+	}
+	out := &model.DhcpV4Options{Others: options.Others}
+	if len(staticRoutes) > 0 {
+		out.Option121 = &model.DhcpOption121{StaticRoutes: staticRoutes}
+	}
+	return out
+}
+
+func comparableDNSClientConfig(dnsClientConfig *model.DnsClientConfig) *model.DnsClientConfig {
+	if dnsClientConfig == nil || len(dnsClientConfig.DnsServerIps) == 0 {
+		return nil
+	}
+	return &model.DnsClientConfig{DnsServerIps: dnsClientConfig.DnsServerIps}
+}
+
 func SubnetToComparable(subnet *model.VpcSubnet) Comparable {
 	return (*Subnet)(subnet)
 }
@@ -357,6 +357,92 @@ func TestSubnetToComparable(t *testing.T) {
 			},
 			expectChanged: false,
 		},
+		{
+			name: "SubnetDhcpConfig default LeaseTime not changed",
+			nsxSubnet: &model.VpcSubnet{
+				Id: &id1,
+				SubnetDhcpConfig: &model.SubnetDhcpConfig{
+					Mode: common.String("DHCP_SERVER"),
+				},
+			},
+			existingSubnet: &model.VpcSubnet{
+				Id: &id1,
+				SubnetDhcpConfig: &model.SubnetDhcpConfig{
+					Mode: common.String("DHCP_SERVER"),
+					DhcpServerAdditionalConfig: &model.DhcpServerAdditionalConfig{
+						LeaseTime: common.Int64(86400),
+					},
+					DnsClientConfig: &model.DnsClientConfig{},
+				},
+			},
+			expectChanged: false,
+		},
+		{
+			name: "SubnetDhcpConfig LeaseTime changed",
+			nsxSubnet: &model.VpcSubnet{
+				Id: &id1,
+				SubnetDhcpConfig: &model.SubnetDhcpConfig{
+					Mode: common.String("DHCP_SERVER"),
+					DhcpServerAdditionalConfig: &model.DhcpServerAdditionalConfig{
+						LeaseTime: common.Int64(3600),
+					},
+				},
+			},
+			existingSubnet: &model.VpcSubnet{
+				Id: &id1,
+				SubnetDhcpConfig: &model.SubnetDhcpConfig{
+					Mode: common.String("DHCP_SERVER"),
+					DhcpServerAdditionalConfig: &model.DhcpServerAdditionalConfig{
+						LeaseTime: common.Int64(86400),
+					},
+				},
+			},
+			expectChanged: true,
+		},
+		{
+			name: "SubnetDhcpConfig DNS servers and options changed",
+			nsxSubnet: &model.VpcSubnet{
+				Id: &id1,
+				SubnetDhcpConfig: &model.SubnetDhcpConfig{
+					Mode: common.String("DHCP_SERVER"),
+					DhcpServerAdditionalConfig: &model.DhcpServerAdditionalConfig{
+						Options: &model.DhcpV4Options{
+							Others: []model.GenericDhcpOption{{Code: common.Int64(42), Values: []string{"10.0.0.123"}}},
+						},
+					},
+					DnsClientConfig: &model.DnsClientConfig{DnsServerIps: []string{"10.0.0.53"}},
+				},
+			},
+			existingSubnet: &model.VpcSubnet{
+				Id: &id1,
+				SubnetDhcpConfig: &model.SubnetDhcpConfig{
+					Mode: common.String("DHCP_SERVER"),
+					DhcpServerAdditionalConfig: &model.DhcpServerAdditionalConfig{
+						Options: &model.DhcpV4Options{},
+					},
+				},
+			},
+			expectChanged: true,
+		},
+		{
+			name: "SubnetDhcpv6Config SNTP servers changed",
+			nsxSubnet: &model.VpcSubnet{
+				Id: &id1,
+				SubnetDhcpv6Config: &model.SubnetDhcpv6Config{
+					Mode: common.String("DHCP_SERVER_STATELESS"),
+					Dhcpv6ServerAdditionalConfig: &model.DhcpV6ServerAdditionalConfig{
+						SntpServers: []string{"2001:db8::123"},
+					},
+				},
+			},
+			existingSubnet: &model.VpcSubnet{
+				Id: &id1,
+				SubnetDhcpv6Config: &model.SubnetDhcpv6Config{
+					Mode: common.String("DHCP_SERVER_STATELESS"),
+				},
+			},
+			expectChanged: true,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
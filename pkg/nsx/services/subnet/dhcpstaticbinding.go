@@ -0,0 +1,395 @@
+package subnet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+const (
+	dhcpV4StaticBindingResourceType = "DhcpV4StaticBindingConfig"
+	dhcpV6StaticBindingResourceType = "DhcpV6StaticBindingConfig"
+)
+
+// buildDHCPStaticBindingID generates the NSX DHCP static binding ID from the address family and the MAC address,
+// so that a MAC address can only be bound once per address family on a Subnet.
+func buildDHCPStaticBindingID(resourceType, macAddress string) string {
+	prefix := "dhcpv4"
+	if resourceType == dhcpV6StaticBindingResourceType {
+		prefix = "dhcpv6"
+	}
+	return fmt.Sprintf("%s_%s", prefix, strings.ReplaceAll(strings.ToLower(macAddress), ":", ""))
+}
+
+// ErrInvalidDHCPStaticBinding is returned if the DHCP static bindings of a Subnet CR conflict with each other or
+// with the Subnet CIDRs, it is not retried until the Subnet CR is updated.
+var ErrInvalidDHCPStaticBinding = errors.New("invalid DHCP static binding")
+
+// RealizedDHCPStaticBindings are the DHCP static bindings of a Subnet CR which are realized on the NSX Subnet.
+type RealizedDHCPStaticBindings struct {
+	DHCPv4 []v1alpha1.DHCPStaticBinding
+	DHCPv6 []v1alpha1.DHCPStaticBinding
+}
+
+// dhcpStaticBinding is a DHCP static binding of a Subnet CR with its NSX ID and value.
+type dhcpStaticBinding struct {
+	id      string
+	ipv6    bool
+	binding v1alpha1.DHCPStaticBinding
+	value   *data.StructValue
+}
+
+// buildDHCPStaticBindings builds the NSX DHCP static bindings for a Subnet CR. Bindings are only
+// built for the address families whose DHCP mode is DHCPServer. The MAC addresses and IP addresses must be
+// unique per address family, and the IP addresses must be in the CIDRs of the NSX Subnet.
+func (service *SubnetService) buildDHCPStaticBindings(subnetCR *v1alpha1.Subnet, nsxSubnet *model.VpcSubnet) ([]dhcpStaticBinding, error) {
+	var bindings []dhcpStaticBinding
+	tags := service.buildBasicTags(subnetCR)
+	if string(subnetCR.Spec.SubnetDHCPConfig.Mode) == v1alpha1.DHCPConfigModeServer {
+		if err := validateDHCPStaticBindings(subnetCR.Spec.SubnetDHCPConfig.DHCPServerAdditionalConfig.StaticBindings, nsxSubnet.IpAddresses, false); err != nil {
+			return nil, err
+		}
+		for _, b := range subnetCR.Spec.SubnetDHCPConfig.DHCPServerAdditionalConfig.StaticBindings {
+			id := buildDHCPStaticBindingID(dhcpV4StaticBindingResourceType, b.MACAddress)
+			binding := model.DhcpV4StaticBindingConfig{
+				Id:           String(id),
+				DisplayName:  String(id),
+				ResourceType: dhcpV4StaticBindingResourceType,
+				MacAddress:   String(b.MACAddress),
+				IpAddress:    String(b.IPAddress),
+				Tags:         tags,
+			}
+			if b.HostName != "" {
+				binding.HostName = String(b.HostName)
+			}
+			dataValue, errs := common.NewConverter().ConvertToVapi(binding, model.DhcpV4StaticBindingConfigBindingType())
+			if len(errs) > 0 {
+				return nil, errs[0]
+			}
+			bindings = append(bindings, dhcpStaticBinding{id: id, binding: b, value: dataValue.(*data.StructValue)})
+		}
+	}
+	if string(subnetCR.Spec.SubnetDHCPv6Config.Mode) == string(v1alpha1.DHCPv6ConfigModeServer) {
+		if err := validateDHCPStaticBindings(subnetCR.Spec.SubnetDHCPv6Config.DHCPv6ServerAdditionalConfig.StaticBindings, nsxSubnet.IpAddresses, true); err != nil {
+			return nil, err
+		}
+		for _, b := range subnetCR.Spec.SubnetDHCPv6Config.DHCPv6ServerAdditionalConfig.StaticBindings {
+			id := buildDHCPStaticBindingID(dhcpV6StaticBindingResourceType, b.MACAddress)
+			binding := model.DhcpV6StaticBindingConfig{
+				Id:           String(id),
+				DisplayName:  String(id),
+				ResourceType: dhcpV6StaticBindingResourceType,
+				MacAddress:   String(b.MACAddress),
+				IpAddresses:  []string{b.IPAddress},
+				Tags:         tags,
+			}
+			if b.HostName != "" {
+				binding.DomainNames = []string{b.HostName}
+			}
+			dataValue, errs := common.NewConverter().ConvertToVapi(binding, model.DhcpV6StaticBindingConfigBindingType())
+			if len(errs) > 0 {
+				return nil, errs[0]
+			}
+			bindings = append(bindings, dhcpStaticBinding{id: id, ipv6: true, binding: b, value: dataValue.(*data.StructValue)})
+		}
+	}
+	return bindings, nil
+}
+
+// validateDHCPStaticBindings checks the DHCP static bindings of one address family. The MAC addresses are compared
+// case-insensitively as the CRD list map key is case-sensitive.
+func validateDHCPStaticBindings(bindings []v1alpha1.DHCPStaticBinding, subnetCIDRs []string, ipv6 bool) error {
+	var prefixes []netip.Prefix
+	for _, cidr := range subnetCIDRs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			continue
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	macAddresses := sets.New[string]()
+	ipAddresses := sets.New[netip.Addr]()
+	for _, b := range bindings {
+		macAddress := strings.ToLower(b.MACAddress)
+		if macAddresses.Has(macAddress) {
+			return fmt.Errorf("%w: MAC address %s is bound more than once", ErrInvalidDHCPStaticBinding, b.MACAddress)
+		}
+		macAddresses.Insert(macAddress)
+		ip, err := netip.ParseAddr(b.IPAddress)
+		if err != nil || ip.Is6() != ipv6 {
+			return fmt.Errorf("%w: %s is not a valid IP address of the address family", ErrInvalidDHCPStaticBinding, b.IPAddress)
+		}
+		if ipAddresses.Has(ip) {
+			return fmt.Errorf("%w: IP address %s is bound more than once", ErrInvalidDHCPStaticBinding, b.IPAddress)
+		}
+		ipAddresses.Insert(ip)
+		inSubnet := false
+		for _, prefix := range prefixes {
+			if prefix.Contains(ip) {
+				inSubnet = true
+				break
+			}
+		}
+		if !inSubnet {
+			return fmt.Errorf("%w: IP address %s is not in the Subnet CIDRs %v", ErrInvalidDHCPStaticBinding, b.IPAddress, subnetCIDRs)
+		}
+	}
+	return nil
+}
+
+// listDHCPStaticBindings lists the DHCP static bindings on the NSX Subnet which are created for the Subnet CR with
+// the given UID, they are returned in the Subnet CR format by ID.
+func (service *SubnetService) listDHCPStaticBindings(nsxClient *nsx.Client, subnetInfo *common.VPCResourceInfo, subnetCRUID string) (map[string]v1alpha1.DHCPStaticBinding, error) {
+	bindings := make(map[string]v1alpha1.DHCPStaticBinding)
+	var cursor *string
+	for {
+		result, err := nsxClient.DhcpStaticBindingConfigsClient.List(subnetInfo.OrgID, subnetInfo.ProjectID, subnetInfo.VPCID, subnetInfo.ID, cursor, nil, nil, nil, nil, nil)
+		err = nsxutil.TransNSXApiError(err)
+		if err != nil {
+			return nil, err
+		}
+		for _, dataValue := range result.Results {
+			obj, errs := common.NewConverter().ConvertToGolang(dataValue, model.DhcpStaticBindingConfigBindingType())
+			if len(errs) > 0 {
+				return nil, errs[0]
+			}
+			binding := obj.(model.DhcpStaticBindingConfig)
+			if binding.Id == nil || !isDHCPStaticBindingOwnedBy(binding.Tags, subnetCRUID) {
+				continue
+			}
+			realized, err := convertDHCPStaticBinding(dataValue, binding.ResourceType)
+			if err != nil {
+				return nil, err
+			}
+			bindings[*binding.Id] = realized
+		}
+		if result.Cursor == nil || *result.Cursor == "" {
+			break
+		}
+		cursor = result.Cursor
+	}
+	return bindings, nil
+}
+
+// convertDHCPStaticBinding converts an NSX DHCP static binding to the Subnet CR format.
+func convertDHCPStaticBinding(dataValue *data.StructValue, resourceType string) (v1alpha1.DHCPStaticBinding, error) {
+	if resourceType == dhcpV6StaticBindingResourceType {
+		obj, errs := common.NewConverter().ConvertToGolang(dataValue, model.DhcpV6StaticBindingConfigBindingType())
+		if len(errs) > 0 {
+			return v1alpha1.DHCPStaticBinding{}, errs[0]
+		}
+		binding := obj.(model.DhcpV6StaticBindingConfig)
+		realized := v1alpha1.DHCPStaticBinding{MACAddress: derefString(binding.MacAddress)}
+		if len(binding.IpAddresses) > 0 {
+			realized.IPAddress = binding.IpAddresses[0]
+		}
+		if len(binding.DomainNames) > 0 {
+			realized.HostName = binding.DomainNames[0]
+		}
+		return realized, nil
+	}
+	obj, errs := common.NewConverter().ConvertToGolang(dataValue, model.DhcpV4StaticBindingConfigBindingType())
+	if len(errs) > 0 {
+		return v1alpha1.DHCPStaticBinding{}, errs[0]
+	}
+	binding := obj.(model.DhcpV4StaticBindingConfig)
+	return v1alpha1.DHCPStaticBinding{
+		MACAddress: derefString(binding.MacAddress),
+		IPAddress:  derefString(binding.IpAddress),
+		HostName:   derefString(binding.HostName),
+	}, nil
+}
+
+// isDHCPStaticBindingChanged compares a DHCP static binding of the Subnet CR with the one realized on NSX,
+// NSX may return the MAC address and the IPv6 address in another format.
+func isDHCPStaticBindingChanged(desired, realized v1alpha1.DHCPStaticBinding) bool {
+	if !strings.EqualFold(desired.MACAddress, realized.MACAddress) || desired.HostName != realized.HostName {
+		return true
+	}
+	desiredIP, err1 := netip.ParseAddr(desired.IPAddress)
+	realizedIP, err2 := netip.ParseAddr(realized.IPAddress)
+	if err1 != nil || err2 != nil {
+		return desired.IPAddress != realized.IPAddress
+	}
+	return desiredIP != realizedIP
+}
+
+func isDHCPStaticBindingOwnedBy(tags []model.Tag, subnetCRUID string) bool {
+	for _, tag := range tags {
+		if tag.Scope != nil && *tag.Scope == common.TagScopeSubnetCRUID && tag.Tag != nil && *tag.Tag == subnetCRUID {
+			return true
+		}
+	}
+	return false
+}
+
+// SyncDHCPStaticBindings creates or updates the changed DHCP static bindings of the Subnet CR on the NSX Subnet,
+// and deletes the bindings previously created for the Subnet CR which are removed from the spec. It returns the
+// bindings realized on NSX.
+func (service *SubnetService) SyncDHCPStaticBindings(subnetCR *v1alpha1.Subnet, nsxSubnet *model.VpcSubnet) (*RealizedDHCPStaticBindings, error) {
+	if nsxSubnet == nil || nsxSubnet.Path == nil {
+		return nil, fmt.Errorf("NSX Subnet path is missing for Subnet %s/%s", subnetCR.Namespace, subnetCR.Name)
+	}
+	subnetInfo, err := common.ParseVPCResourcePath(*nsxSubnet.Path)
+	if err != nil {
+		return nil, err
+	}
+	desired, err := service.buildDHCPStaticBindings(subnetCR, nsxSubnet)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build DHCP static bindings for Subnet %s/%s: %w", subnetCR.Namespace, subnetCR.Name, err)
+	}
+	existing, err := service.listDHCPStaticBindings(service.NSXClient, &subnetInfo, string(subnetCR.UID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list DHCP static bindings on NSX Subnet %s: %w", *nsxSubnet.Path, err)
+	}
+	desiredIDs := sets.New[string]()
+	for _, b := range desired {
+		desiredIDs.Insert(b.id)
+	}
+	for id := range existing {
+		if desiredIDs.Has(id) {
+			continue
+		}
+		if err := service.deleteDHCPStaticBinding(service.NSXClient, &subnetInfo, id); err != nil {
+			return nil, err
+		}
+	}
+	realized := &RealizedDHCPStaticBindings{}
+	for _, b := range desired {
+		if existingBinding, ok := existing[b.id]; !ok || isDHCPStaticBindingChanged(b.binding, existingBinding) {
+			err = service.NSXClient.DhcpStaticBindingConfigsClient.Patch(subnetInfo.OrgID, subnetInfo.ProjectID, subnetInfo.VPCID, subnetInfo.ID, b.id, b.value)
+			err = nsxutil.TransNSXApiError(err)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create or update DHCP static binding %s on NSX Subnet %s: %w", b.id, *nsxSubnet.Path, err)
+			}
+			log.Info("Created or updated DHCP static binding", "ID", b.id, "Subnet", *nsxSubnet.Path)
+		}
+		if b.ipv6 {
+			realized.DHCPv6 = append(realized.DHCPv6, b.binding)
+		} else {
+			realized.DHCPv4 = append(realized.DHCPv4, b.binding)
+		}
+	}
+	return realized, nil
+}
+
+// DeleteDHCPStaticBindings deletes the DHCP static bindings created for the Subnet CR on the NSX Subnet,
+// it is called before deleting the NSX Subnet. The NSX requests are sent with ctx.
+func (service *SubnetService) DeleteDHCPStaticBindings(ctx context.Context, nsxSubnet *model.VpcSubnet) error {
+	subnetCRUID := ""
+	for _, tag := range nsxSubnet.Tags {
+		if tag.Scope != nil && *tag.Scope == common.TagScopeSubnetCRUID && tag.Tag != nil {
+			subnetCRUID = *tag.Tag
+		}
+	}
+	// Only the NSX Subnets created for a Subnet CR have DHCP static bindings.
+	if subnetCRUID == "" || nsxSubnet.Path == nil {
+		return nil
+	}
+	subnetInfo, err := common.ParseVPCResourcePath(*nsxSubnet.Path)
+	if err != nil {
+		return err
+	}
+	nsxClient := service.NSXClient.WithContext(ctx)
+	existing, err := service.listDHCPStaticBindings(nsxClient, &subnetInfo, subnetCRUID)
+	if err != nil {
+		return fmt.Errorf("failed to list DHCP static bindings on NSX Subnet %s: %w", *nsxSubnet.Path, err)
+	}
+	for id := range existing {
+		if err := service.deleteDHCPStaticBinding(nsxClient, &subnetInfo, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (service *SubnetService) deleteDHCPStaticBinding(nsxClient *nsx.Client, subnetInfo *common.VPCResourceInfo, id string) error {
+	err := nsxClient.DhcpStaticBindingConfigsClient.Delete(subnetInfo.OrgID, subnetInfo.ProjectID, subnetInfo.VPCID, subnetInfo.ID, id)
+	err = nsxutil.TransNSXApiError(err)
+	if err != nil {
+		return fmt.Errorf("failed to delete DHCP static binding %s on NSX Subnet %s: %w", id, subnetInfo.ID, err)
+	}
+	log.Info("Deleted DHCP static binding", "ID", id, "Subnet", subnetInfo.ID)
+	return nil
+}
+
+// BuildDHCPServerStatus builds the effective DHCP and DHCPv6 server configuration from the NSX Subnet.
+// The static bindings are the ones realized by SyncDHCPStaticBindings, staticBindings is nil if the Subnet CR
+// has no DHCP static binding.
+func BuildDHCPServerStatus(nsxSubnet *model.VpcSubnet, staticBindings *RealizedDHCPStaticBindings) (*v1alpha1.DHCPServerStatus, *v1alpha1.DHCPServerStatus) {
+	var dhcpServer, dhcpv6Server *v1alpha1.DHCPServerStatus
+	if cfg := nsxSubnet.SubnetDhcpConfig; cfg != nil && cfg.Mode != nil && *cfg.Mode == nsxutil.ParseDHCPMode(v1alpha1.DHCPConfigModeServer) {
+		dhcpServer = &v1alpha1.DHCPServerStatus{LeaseTime: defaultDHCPLeaseTime}
+		if cfg.DnsClientConfig != nil {
+			dhcpServer.DNSServers = cfg.DnsClientConfig.DnsServerIps
+		}
+		if additional := cfg.DhcpServerAdditionalConfig; additional != nil {
+			if additional.LeaseTime != nil {
+				dhcpServer.LeaseTime = *additional.LeaseTime
+			}
+			if additional.Options != nil {
+				if additional.Options.Option121 != nil {
+					for _, route := range additional.Options.Option121.StaticRoutes {
+						dhcpServer.ClasslessStaticRoutes = append(dhcpServer.ClasslessStaticRoutes, v1alpha1.DHCPClasslessStaticRoute{
+							Network: derefString(route.Network),
+							NextHop: derefString(route.NextHop),
+						})
+					}
+				}
+				for _, option := range additional.Options.Others {
+					if option.Code == nil {
+						continue
+					}
+					switch *option.Code {
+					case dhcpOptionCodeNTPServers:
+						dhcpServer.NTPServers = option.Values
+					case dhcpOptionCodeDomainSearch:
+						dhcpServer.DomainNames = option.Values
+					}
+				}
+			}
+		}
+		if staticBindings != nil {
+			dhcpServer.StaticBindings = staticBindings.DHCPv4
+		}
+	}
+	if cfg := nsxSubnet.SubnetDhcpv6Config; cfg != nil && cfg.Mode != nil &&
+		(*cfg.Mode == nsxutil.ParseDHCPMode(string(v1alpha1.DHCPv6ConfigModeServer)) || *cfg.Mode == nsxutil.ParseDHCPMode(string(v1alpha1.DHCPv6ConfigModeServerStateless))) {
+		dhcpv6Server = &v1alpha1.DHCPServerStatus{}
+		if *cfg.Mode == nsxutil.ParseDHCPMode(string(v1alpha1.DHCPv6ConfigModeServer)) {
+			dhcpv6Server.LeaseTime = defaultDHCPLeaseTime
+			if staticBindings != nil {
+				dhcpv6Server.StaticBindings = staticBindings.DHCPv6
+			}
+		}
+		if cfg.DnsClientConfig != nil {
+			dhcpv6Server.DNSServers = cfg.DnsClientConfig.DnsServerIps
+		}
+		if additional := cfg.Dhcpv6ServerAdditionalConfig; additional != nil {
+			if additional.LeaseTime != nil && dhcpv6Server.LeaseTime > 0 {
+				dhcpv6Server.LeaseTime = *additional.LeaseTime
+			}
+			dhcpv6Server.DomainNames = additional.DomainNames
+			dhcpv6Server.NTPServers = additional.SntpServers
+		}
+	}
+	return dhcpServer, dhcpv6Server
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
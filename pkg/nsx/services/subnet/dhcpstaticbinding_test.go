@@ -0,0 +1,175 @@
+package subnet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+func TestBuildDHCPStaticBindingID(t *testing.T) {
+	assert.Equal(t, "dhcpv4_005056aabbcc", buildDHCPStaticBindingID(dhcpV4StaticBindingResourceType, "00:50:56:AA:BB:CC"))
+	assert.Equal(t, "dhcpv6_005056aabbcc", buildDHCPStaticBindingID(dhcpV6StaticBindingResourceType, "00:50:56:aa:bb:cc"))
+}
+
+func TestBuildDHCPStaticBindings(t *testing.T) {
+	service := &SubnetService{
+		Service: common.Service{
+			NSXClient: &nsx.Client{},
+			NSXConfig: &config.NSXOperatorConfig{CoeConfig: &config.CoeConfig{Cluster: "cluster1"}},
+		},
+	}
+	bindings := []v1alpha1.DHCPStaticBinding{{MACAddress: "00:50:56:aa:bb:cc", IPAddress: "10.0.0.20", HostName: "vm-1"}}
+	subnetCR := &v1alpha1.Subnet{
+		ObjectMeta: v1.ObjectMeta{Name: "subnet-1", Namespace: "ns-1", UID: "uid-1"},
+		Spec: v1alpha1.SubnetSpec{
+			SubnetDHCPConfig: v1alpha1.SubnetDHCPConfig{
+				Mode:                       v1alpha1.DHCPConfigMode(v1alpha1.DHCPConfigModeServer),
+				DHCPServerAdditionalConfig: v1alpha1.DHCPServerAdditionalConfig{StaticBindings: bindings},
+			},
+			SubnetDHCPv6Config: v1alpha1.SubnetDHCPv6Config{
+				Mode:                         v1alpha1.DHCPv6ConfigModeRelay,
+				DHCPv6ServerAdditionalConfig: v1alpha1.DHCPv6ServerAdditionalConfig{StaticBindings: bindings},
+			},
+		},
+	}
+	nsxSubnet := &model.VpcSubnet{IpAddresses: []string{"10.0.0.0/24"}}
+	values, err := service.buildDHCPStaticBindings(subnetCR, nsxSubnet)
+	require.NoError(t, err)
+	// DHCPv6 bindings are skipped as the DHCPv6 mode is not DHCPServer
+	require.Len(t, values, 1)
+	assert.Equal(t, "dhcpv4_005056aabbcc", values[0].id)
+	assert.False(t, values[0].ipv6)
+	obj, errs := common.NewConverter().ConvertToGolang(values[0].value, model.DhcpV4StaticBindingConfigBindingType())
+	require.Empty(t, errs)
+	binding := obj.(model.DhcpV4StaticBindingConfig)
+	assert.Equal(t, "10.0.0.20", *binding.IpAddress)
+	assert.Equal(t, "vm-1", *binding.HostName)
+	assert.True(t, isDHCPStaticBindingOwnedBy(binding.Tags, "uid-1"))
+	assert.False(t, isDHCPStaticBindingOwnedBy(binding.Tags, "uid-2"))
+
+	realized, err := convertDHCPStaticBinding(values[0].value, dhcpV4StaticBindingResourceType)
+	require.NoError(t, err)
+	assert.Equal(t, bindings[0], realized)
+
+	// The IP address is out of the Subnet CIDRs
+	_, err = service.buildDHCPStaticBindings(subnetCR, &model.VpcSubnet{IpAddresses: []string{"10.0.1.0/24"}})
+	assert.ErrorIs(t, err, ErrInvalidDHCPStaticBinding)
+}
+
+func TestValidateDHCPStaticBindings(t *testing.T) {
+	cidrs := []string{"10.0.0.0/24", "2001:db8::/64"}
+	tests := []struct {
+		name      string
+		bindings  []v1alpha1.DHCPStaticBinding
+		ipv6      bool
+		expectErr string
+	}{
+		{
+			name: "valid",
+			bindings: []v1alpha1.DHCPStaticBinding{
+				{MACAddress: "00:50:56:aa:bb:cc", IPAddress: "10.0.0.20"},
+				{MACAddress: "00:50:56:aa:bb:cd", IPAddress: "10.0.0.21"},
+			},
+		},
+		{
+			name:     "valid IPv6",
+			bindings: []v1alpha1.DHCPStaticBinding{{MACAddress: "00:50:56:aa:bb:cc", IPAddress: "2001:db8::20"}},
+			ipv6:     true,
+		},
+		{
+			name: "duplicate MAC address",
+			bindings: []v1alpha1.DHCPStaticBinding{
+				{MACAddress: "00:50:56:aa:bb:cc", IPAddress: "10.0.0.20"},
+				{MACAddress: "00:50:56:AA:BB:CC", IPAddress: "10.0.0.21"},
+			},
+			expectErr: "MAC address 00:50:56:AA:BB:CC is bound more than once",
+		},
+		{
+			name: "duplicate IP address",
+			bindings: []v1alpha1.DHCPStaticBinding{
+				{MACAddress: "00:50:56:aa:bb:cc", IPAddress: "10.0.0.20"},
+				{MACAddress: "00:50:56:aa:bb:cd", IPAddress: "10.0.0.20"},
+			},
+			expectErr: "IP address 10.0.0.20 is bound more than once",
+		},
+		{
+			name:      "IP address out of the Subnet CIDRs",
+			bindings:  []v1alpha1.DHCPStaticBinding{{MACAddress: "00:50:56:aa:bb:cc", IPAddress: "10.0.1.20"}},
+			expectErr: "IP address 10.0.1.20 is not in the Subnet CIDRs",
+		},
+		{
+			name:      "IPv6 address for DHCPv4",
+			bindings:  []v1alpha1.DHCPStaticBinding{{MACAddress: "00:50:56:aa:bb:cc", IPAddress: "2001:db8::20"}},
+			expectErr: "2001:db8::20 is not a valid IP address",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateDHCPStaticBindings(tt.bindings, cidrs, tt.ipv6)
+			if tt.expectErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, ErrInvalidDHCPStaticBinding)
+			assert.ErrorContains(t, err, tt.expectErr)
+		})
+	}
+}
+
+func TestIsDHCPStaticBindingChanged(t *testing.T) {
+	desired := v1alpha1.DHCPStaticBinding{MACAddress: "00:50:56:aa:bb:cc", IPAddress: "2001:db8::20", HostName: "vm-1"}
+	assert.False(t, isDHCPStaticBindingChanged(desired, v1alpha1.DHCPStaticBinding{MACAddress: "00:50:56:AA:BB:CC", IPAddress: "2001:0db8::0020", HostName: "vm-1"}))
+	assert.True(t, isDHCPStaticBindingChanged(desired, v1alpha1.DHCPStaticBinding{MACAddress: "00:50:56:aa:bb:cc", IPAddress: "2001:db8::21", HostName: "vm-1"}))
+	assert.True(t, isDHCPStaticBindingChanged(desired, v1alpha1.DHCPStaticBinding{MACAddress: "00:50:56:aa:bb:cc", IPAddress: "2001:db8::20"}))
+}
+
+func TestBuildDHCPServerStatus(t *testing.T) {
+	bindings := []v1alpha1.DHCPStaticBinding{{MACAddress: "00:50:56:aa:bb:cc", IPAddress: "10.0.0.20"}}
+
+	dhcpServer, dhcpv6Server := BuildDHCPServerStatus(&model.VpcSubnet{
+		SubnetDhcpConfig: &model.SubnetDhcpConfig{
+			Mode: String("DHCP_SERVER"),
+			DhcpServerAdditionalConfig: &model.DhcpServerAdditionalConfig{
+				Options: &model.DhcpV4Options{
+					Option121: &model.DhcpOption121{StaticRoutes: []model.ClasslessStaticRoute{{Network: String("192.168.0.0/16"), NextHop: String("10.0.0.254")}}},
+					Others: []model.GenericDhcpOption{
+						{Code: Int64(42), Values: []string{"10.0.0.123"}},
+						{Code: Int64(119), Values: []string{"example.com"}},
+					},
+				},
+			},
+			DnsClientConfig: &model.DnsClientConfig{DnsServerIps: []string{"10.0.0.53"}},
+		},
+		SubnetDhcpv6Config: &model.SubnetDhcpv6Config{
+			Mode: String("DHCP_SERVER_STATELESS"),
+			Dhcpv6ServerAdditionalConfig: &model.DhcpV6ServerAdditionalConfig{
+				LeaseTime:   Int64(600),
+				SntpServers: []string{"2001:db8::123"},
+			},
+		},
+	}, &RealizedDHCPStaticBindings{DHCPv4: bindings})
+	assert.Equal(t, &v1alpha1.DHCPServerStatus{
+		LeaseTime:             86400,
+		DNSServers:            []string{"10.0.0.53"},
+		DomainNames:           []string{"example.com"},
+		NTPServers:            []string{"10.0.0.123"},
+		ClasslessStaticRoutes: []v1alpha1.DHCPClasslessStaticRoute{{Network: "192.168.0.0/16", NextHop: "10.0.0.254"}},
+		StaticBindings:        bindings,
+	}, dhcpServer)
+	// Lease time and static bindings are not applicable to the stateless DHCPv6 server
+	assert.Equal(t, &v1alpha1.DHCPServerStatus{NTPServers: []string{"2001:db8::123"}}, dhcpv6Server)
+
+	dhcpServer, dhcpv6Server = BuildDHCPServerStatus(&model.VpcSubnet{
+		SubnetDhcpConfig: &model.SubnetDhcpConfig{Mode: String("DHCP_RELAY")},
+	}, nil)
+	assert.Nil(t, dhcpServer)
+	assert.Nil(t, dhcpv6Server)
+}
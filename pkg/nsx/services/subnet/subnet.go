@@ -99,6 +99,17 @@ func InitializeSubnetService(service common.Service) (*SubnetService, error) {
 	return subnetService, nil
 }
 
+// DriftSources returns the NSX Subnets in the store to be scanned for the drift.
+func (service *SubnetService) DriftSources() []common.DriftSource {
+	return []common.DriftSource{{
+		ResourceType: ResourceTypeSubnet,
+		Store:        &service.SubnetStore.ResourceStore,
+		ToComparable: func(obj interface{}) common.Comparable {
+			return SubnetToComparable(obj.(*model.VpcSubnet))
+		},
+	}}
+}
+
 func (service *SubnetService) RestoreSubnetSet(obj *v1alpha1.SubnetSet, vpcInfo common.VPCResourceInfo, tags []model.Tag) error {
 	nsxSubnets := service.SubnetStore.GetByIndex(common.TagScopeSubnetSetCRUID, string(obj.UID))
 	var errList []error
@@ -127,7 +138,7 @@ func (service *SubnetService) RestoreSubnetSet(obj *v1alpha1.SubnetSet, vpcInfo
 			}
 		}
 		if changed {
-			_, err = service.createOrUpdateSubnet(obj, nsxSubnet, &vpcInfo, true)
+			_, err = service.createOrUpdateSubnet(context.TODO(), obj, nsxSubnet, &vpcInfo, true)
 			if err != nil {
 				errList = append(errList, err)
 			}
@@ -148,7 +159,7 @@ func isSubnetReady(subnet *v1alpha1.Subnet) bool {
 	return false
 }
 
-func (service *SubnetService) CreateOrUpdateSubnet(obj client.Object, vpcInfo common.VPCResourceInfo, tags []model.Tag) (subnet *model.VpcSubnet, err error) {
+func (service *SubnetService) CreateOrUpdateSubnet(ctx context.Context, obj client.Object, vpcInfo common.VPCResourceInfo, tags []model.Tag) (subnet *model.VpcSubnet, err error) {
 	uid := string(obj.GetUID())
 	nsxSubnet, err := service.buildSubnet(obj, tags, []string{})
 
@@ -210,7 +221,7 @@ func (service *SubnetService) CreateOrUpdateSubnet(obj client.Object, vpcInfo co
 			// unrealized Subnet will be saved to the store after full sync
 			// Recheck the realizedstate if the Subnet CR is not ready.
 			if !isSubnetReady(subnet) {
-				if err = service.checkSubnetRealizeState(nsxSubnet); err != nil {
+				if err = service.checkSubnetRealizeState(ctx, nsxSubnet); err != nil {
 					return nil, err
 				}
 			}
@@ -218,10 +229,10 @@ func (service *SubnetService) CreateOrUpdateSubnet(obj client.Object, vpcInfo co
 			return existingSubnet, nil
 		}
 	}
-	return service.createOrUpdateSubnet(obj, nsxSubnet, &vpcInfo, false)
+	return service.createOrUpdateSubnet(ctx, obj, nsxSubnet, &vpcInfo, false)
 }
 
-func (service *SubnetService) checkSubnetRealizeState(nsxSubnet *model.VpcSubnet) error {
+func (service *SubnetService) checkSubnetRealizeState(ctx context.Context, nsxSubnet *model.VpcSubnet) error {
 	realizeService := realizestate.InitializeRealizeState(service.Service)
 	// Failure of CheckRealizeState may result in the creation of an existing Subnet.
 	// For Subnets, it's important to reuse the already created NSXSubnet.
@@ -229,7 +240,7 @@ func (service *SubnetService) checkSubnetRealizeState(nsxSubnet *model.VpcSubnet
 	if err := realizeService.CheckRealizeState(util.NSXTRealizeRetry, *nsxSubnet.Path, []string{}); err != nil {
 		log.Error(err, "Failed to check Subnet realization state", "ID", *nsxSubnet.Id)
 		// Delete the subnet if the realization check fails, avoiding creating duplicate subnets continuously.
-		deleteErr := service.DeleteSubnet(*nsxSubnet)
+		deleteErr := service.DeleteSubnet(ctx, *nsxSubnet)
 		if deleteErr != nil {
 			log.Error(deleteErr, "Failed to delete Subnet after realization check failure", "ID", *nsxSubnet.Id)
 			return fmt.Errorf("realization check failed: %v; deletion failed: %v", err, deleteErr)
@@ -239,8 +250,9 @@ func (service *SubnetService) checkSubnetRealizeState(nsxSubnet *model.VpcSubnet
 	return nil
 }
 
-func (service *SubnetService) createOrUpdateSubnet(obj client.Object, nsxSubnet *model.VpcSubnet, vpcInfo *common.VPCResourceInfo, restoreMode bool) (*model.VpcSubnet, error) {
-	err := service.NSXClient.SubnetsClient.Patch(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, *nsxSubnet.Id, *nsxSubnet)
+func (service *SubnetService) createOrUpdateSubnet(ctx context.Context, obj client.Object, nsxSubnet *model.VpcSubnet, vpcInfo *common.VPCResourceInfo, restoreMode bool) (*model.VpcSubnet, error) {
+	nsxClient := service.NSXClient.WithContext(ctx)
+	err := nsxClient.SubnetsClient.Patch(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, *nsxSubnet.Id, *nsxSubnet)
 	err = nsxutil.TransNSXApiError(err)
 	if err != nil {
 		log.Error(err, "Failed to create or update nsxSubnet", "ID", *nsxSubnet.Id)
@@ -249,12 +261,12 @@ func (service *SubnetService) createOrUpdateSubnet(obj client.Object, nsxSubnet
 	}
 
 	// Get Subnet from NSX after patch operation as NSX renders several fields like `path`/`parent_path`.
-	if *nsxSubnet, err = service.NSXClient.SubnetsClient.Get(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, *nsxSubnet.Id); err != nil {
+	if *nsxSubnet, err = nsxClient.SubnetsClient.Get(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, *nsxSubnet.Id); err != nil {
 		err = nsxutil.TransNSXApiError(err)
 		service.updateSubnetSetConditionOnFail(obj, err)
 		return nil, err
 	}
-	err = service.checkSubnetRealizeState(nsxSubnet)
+	err = service.checkSubnetRealizeState(ctx, nsxSubnet)
 	if err != nil {
 		service.updateSubnetSetConditionOnFail(obj, err)
 		return nil, err
@@ -279,10 +291,15 @@ func (service *SubnetService) createOrUpdateSubnet(obj client.Object, nsxSubnet
 	return nsxSubnet, nil
 }
 
-func (service *SubnetService) DeleteSubnet(nsxSubnet model.VpcSubnet) error {
+func (service *SubnetService) DeleteSubnet(ctx context.Context, nsxSubnet model.VpcSubnet) error {
 	subnetInfo, _ := common.ParseVPCResourcePath(*nsxSubnet.Path)
 	nsxSubnet.MarkedForDelete = &MarkedForDelete
-	err := service.NSXClient.SubnetsClient.Delete(subnetInfo.OrgID, subnetInfo.ProjectID, subnetInfo.VPCID, subnetInfo.ID)
+	// DHCP static bindings are child resources of the NSX Subnet, delete them before the Subnet.
+	if err := service.DeleteDHCPStaticBindings(ctx, &nsxSubnet); err != nil {
+		log.Error(err, "Failed to delete DHCP static bindings of nsxSubnet", "ID", *nsxSubnet.Id)
+		return err
+	}
+	err := service.NSXClient.WithContext(ctx).SubnetsClient.Delete(subnetInfo.OrgID, subnetInfo.ProjectID, subnetInfo.VPCID, subnetInfo.ID)
 	err = nsxutil.TransNSXApiError(err)
 	if err != nil {
 		// GC will finally delete subnets that are not deleted successfully.
@@ -572,16 +589,16 @@ func (service *SubnetService) UpdateSubnetSet(ns string, vpcSubnets []*model.Vpc
 		if updatedSubnet.SubnetDhcpConfig != nil {
 			// Generate a new SubnetDhcpConfig for updatedSubnet to
 			// avoid changing vpcSubnets[i].SubnetDhcpConfig
-			updatedSubnet.SubnetDhcpConfig = service.buildSubnetDHCPConfig(dhcpMode, updatedSubnet.SubnetDhcpConfig.DhcpServerAdditionalConfig)
+			updatedSubnet.SubnetDhcpConfig = service.buildSubnetDHCPConfig(dhcpMode, mergeDHCPServerAdditionalConfig(updatedSubnet.SubnetDhcpConfig.DhcpServerAdditionalConfig, &subnetsetCR.Spec.SubnetDHCPConfig.DHCPServerAdditionalConfig), subnetsetCR.Spec.SubnetDHCPConfig.DHCPServerAdditionalConfig.DNSServers)
 		} else if util.IPAddressTypeIncludesIPv4(subnetsetCR.Spec.IPAddressType) {
 			// When IPAddressType contains IPv4, generate a new SubnetDhcpConfig for updatedSubnet even if SubnetDhcpConfig is nil
-			updatedSubnet.SubnetDhcpConfig = service.buildSubnetDHCPConfig(dhcpMode, nil)
+			updatedSubnet.SubnetDhcpConfig = service.buildSubnetDHCPConfig(dhcpMode, buildDHCPServerAdditionalConfig(&subnetsetCR.Spec.SubnetDHCPConfig.DHCPServerAdditionalConfig, false), subnetsetCR.Spec.SubnetDHCPConfig.DHCPServerAdditionalConfig.DNSServers)
 		}
 		if updatedSubnet.SubnetDhcpv6Config != nil {
-			updatedSubnet.SubnetDhcpv6Config = service.buildSubnetDHCPv6Config(dhcpv6Mode, updatedSubnet.SubnetDhcpv6Config.Dhcpv6ServerAdditionalConfig)
+			updatedSubnet.SubnetDhcpv6Config = service.buildSubnetDHCPv6Config(dhcpv6Mode, mergeDHCPv6ServerAdditionalConfig(updatedSubnet.SubnetDhcpv6Config.Dhcpv6ServerAdditionalConfig, &subnetsetCR.Spec.SubnetDHCPv6Config.DHCPv6ServerAdditionalConfig), subnetsetCR.Spec.SubnetDHCPv6Config.DHCPv6ServerAdditionalConfig.DNSServers)
 		} else if util.IPAddressTypeIncludesIPv6(subnetsetCR.Spec.IPAddressType) {
 			// When IPAddressType contains IPv6, generate a new SubnetDhcpv6Config for updatedSubnet even if SubnetDhcpv6Config is nil
-			updatedSubnet.SubnetDhcpv6Config = service.buildSubnetDHCPv6Config(dhcpv6Mode, nil)
+			updatedSubnet.SubnetDhcpv6Config = service.buildSubnetDHCPv6Config(dhcpv6Mode, buildDHCPv6ServerAdditionalConfig(&subnetsetCR.Spec.SubnetDHCPv6Config.DHCPv6ServerAdditionalConfig, false), subnetsetCR.Spec.SubnetDHCPv6Config.DHCPv6ServerAdditionalConfig.DNSServers)
 		}
 		changed := common.CompareResource(SubnetToComparable(vpcSubnets[i]), SubnetToComparable(&updatedSubnet)) // #nosec G602
 		if !changed {
@@ -595,7 +612,7 @@ func (service *SubnetService) UpdateSubnetSet(ns string, vpcSubnets []*model.Vpc
 			err := fmt.Errorf("failed to parse NSX VPC path for Subnet %s: %s", subnetPath, err)
 			return err
 		}
-		if _, err := service.createOrUpdateSubnet(subnetSet, &updatedSubnet, &vpcInfo, false); err != nil {
+		if _, err := service.createOrUpdateSubnet(context.TODO(), subnetSet, &updatedSubnet, &vpcInfo, false); err != nil {
 			return fmt.Errorf("failed to update Subnet %s in SubnetSet %s: %w", *vpcSubnet.Id, subnetSet.Name, err)
 		}
 		log.Info("Successfully updated SubnetSet", "subnetSet", subnetSet, "Subnet", *vpcSubnet.Id)
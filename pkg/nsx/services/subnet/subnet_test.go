@@ -341,7 +341,7 @@ func TestInitializeSubnetService(t *testing.T) {
 			res := service.ListAllSubnet()
 			assert.Equal(t, tc.expectAllSubnetNum, len(res))
 
-			createdNSXSubnet, err := service.CreateOrUpdateSubnet(tc.existingSubnetCR, *tc.existingVPCInfo, tc.subnetCRTags)
+			createdNSXSubnet, err := service.CreateOrUpdateSubnet(context.Background(), tc.existingSubnetCR, *tc.existingVPCInfo, tc.subnetCRTags)
 			assert.NoError(t, err)
 			assert.Equal(t, tc.expectCreateSubnetUID, *createdNSXSubnet.Id)
 
@@ -636,7 +636,7 @@ func TestSubnetService_createOrUpdateSubnet(t *testing.T) {
 					})
 				// Patch the realization check
 				p.ApplyPrivateMethod(reflect.TypeOf(service), "checkSubnetRealizeState",
-					func(_ *SubnetService, _ *model.VpcSubnet) error {
+					func(_ *SubnetService, _ context.Context, _ *model.VpcSubnet) error {
 						return nil
 					})
 				p.ApplyMethod(reflect.TypeOf(service), "UpdateSubnetSetStatus",
@@ -664,7 +664,7 @@ func TestSubnetService_createOrUpdateSubnet(t *testing.T) {
 					})
 				// Patch the realization check
 				p.ApplyPrivateMethod(reflect.TypeOf(service), "checkSubnetRealizeState",
-					func(_ *SubnetService, _ *model.VpcSubnet) error {
+					func(_ *SubnetService, _ context.Context, _ *model.VpcSubnet) error {
 						return nil
 					})
 			},
@@ -698,7 +698,7 @@ func TestSubnetService_createOrUpdateSubnet(t *testing.T) {
 			// We pass a SubnetSet as the obj to test the status update branch
 			obj := &v1alpha1.SubnetSet{}
 
-			res, err := service.createOrUpdateSubnet(obj, tt.nsxSubnet, tt.vpcInfo, tt.restoreMode)
+			res, err := service.createOrUpdateSubnet(context.Background(), obj, tt.nsxSubnet, tt.vpcInfo, tt.restoreMode)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -807,7 +807,7 @@ func TestSubnetService_DeleteSubnet(t *testing.T) {
 				patches := tt.prepareFunc()
 				defer patches.Reset()
 			}
-			err := service.DeleteSubnet(fakeSubnet)
+			err := service.DeleteSubnet(context.Background(), fakeSubnet)
 			if tt.expectedErr != "" {
 				assert.NotNil(t, err, "Expected an error but got nil")
 				if err != nil {
@@ -2468,7 +2468,7 @@ func TestSubnetService_CreateOrUpdateSubnet_ConnectivityState(t *testing.T) {
 			})
 			defer patches.Reset()
 
-			_, err := service.CreateOrUpdateSubnet(subnetCR, vpcResourceInfo, basicTags)
+			_, err := service.CreateOrUpdateSubnet(context.Background(), subnetCR, vpcResourceInfo, basicTags)
 			require.NoError(t, err)
 
 			if tc.expectUpdate {
@@ -2597,12 +2597,12 @@ func TestSubnetService_CreateOrUpdateSubnet_Consistency(t *testing.T) {
 				}
 				return nil, nil
 			})
-			patches.ApplyFunc((*SubnetService).checkSubnetRealizeState, func(service *SubnetService, nsxSubnet *model.VpcSubnet) error {
+			patches.ApplyFunc((*SubnetService).checkSubnetRealizeState, func(service *SubnetService, ctx context.Context, nsxSubnet *model.VpcSubnet) error {
 				return nil
 			})
 			defer patches.Reset()
 
-			_, err := service.CreateOrUpdateSubnet(subnetCR, vpcResourceInfo, basicTags)
+			_, err := service.CreateOrUpdateSubnet(context.Background(), subnetCR, vpcResourceInfo, basicTags)
 			require.NoError(t, err)
 		})
 	}
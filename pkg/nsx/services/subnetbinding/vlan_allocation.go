@@ -1,6 +1,7 @@
 package subnetbinding
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -116,7 +117,7 @@ func (s *BindingService) listBindingMapsByParentSubnetPath(parentSubnetPath stri
 		Indexer:     cache.NewIndexer(keyFunc, cache.Indexers{}),
 		BindingType: model.SubnetConnectionBindingMapBindingType(),
 	}}
-	_, err := s.SearchResource(ResourceTypeSubnetConnectionBindingMap, queryParam, store, nil)
+	_, err := s.SearchResource(context.TODO(), ResourceTypeSubnetConnectionBindingMap, queryParam, store, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -202,15 +202,26 @@ func (service *SubnetPortService) buildSubnetPort(obj interface{}, nsxSubnet *mo
 	}
 
 	if labelTags != nil {
-		// Append Namespace labels in order as tags
-		labelKeys := make([]string, 0, len(*labelTags))
-		for k := range *labelTags {
-			labelKeys = append(labelKeys, k)
+		// The ServiceAccount label and Service member tags are only used by the SecurityPolicy workload references,
+		// they are appended after the labels and only within the NSX tag count limit to never fail the port over them.
+		labels, policyLabels := make(map[string]string), make(map[string]string)
+		for k, v := range *labelTags {
+			if strings.HasPrefix(k, common.TagScopeServiceAccountLabelPrefix) || strings.HasPrefix(k, common.TagScopeServiceMemberPrefix) {
+				policyLabels[k] = v
+			} else {
+				labels[k] = v
+			}
 		}
-		sort.Strings(labelKeys)
-		for _, k := range labelKeys {
-			tagsFiltered = append(tagsFiltered, model.Tag{Scope: common.String(k), Tag: common.String((*labelTags)[k])})
+		// Append Namespace labels in order as tags, the scopes and values exceeding the NSX limits are truncated and
+		// hashed in the same way as the SecurityPolicy label selectors.
+		tagsFiltered = append(tagsFiltered, buildLabelTags(labels)...)
+		policyTags := buildLabelTags(policyLabels)
+		if room := common.MaxTagsCount - len(tagsFiltered); len(policyTags) > room {
+			room = max(room, 0)
+			log.Warn("Skipped SecurityPolicy workload tags exceeding the NSX tag count limit", "SubnetPort", nsxSubnetPortName, "skipped", len(policyTags)-room)
+			policyTags = policyTags[:room]
 		}
+		tagsFiltered = append(tagsFiltered, policyTags...)
 	}
 
 	nsxSubnetPort := &model.VpcSubnetPort{
@@ -306,6 +317,21 @@ func getCluster(service *SubnetPortService) string {
 	return service.NSXConfig.Cluster
 }
 
+// buildLabelTags returns the normalized labels as tags in order.
+func buildLabelTags(labels map[string]string) []model.Tag {
+	normalizedTags := *util.NormalizeLabels(&labels)
+	labelKeys := make([]string, 0, len(normalizedTags))
+	for k := range normalizedTags {
+		labelKeys = append(labelKeys, k)
+	}
+	sort.Strings(labelKeys)
+	tags := make([]model.Tag, 0, len(labelKeys))
+	for _, k := range labelKeys {
+		tags = append(tags, model.Tag{Scope: common.String(k), Tag: common.String(normalizedTags[k])})
+	}
+	return tags
+}
+
 func (service *SubnetPortService) buildExternalAddressBinding(sp *v1alpha1.SubnetPort, restoreMode bool) (*model.ExternalAddressBinding, error) {
 	addressBinding := service.GetAddressBindingBySubnetPort(sp)
 	if addressBinding == nil {
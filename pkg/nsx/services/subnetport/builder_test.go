@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -24,6 +25,8 @@ import (
 	mock_client "github.com/vmware-tanzu/nsx-operator/pkg/mock/controller-runtime/client"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
 func TestBuildSubnetPort(t *testing.T) {
@@ -803,6 +806,70 @@ func TestBuildSubnetPort(t *testing.T) {
 	}
 }
 
+func TestBuildSubnetPortLabelTags(t *testing.T) {
+	mockCtl := gomock.NewController(t)
+	k8sClient := mock_client.NewMockClient(mockCtl)
+	nsxClient := &nsx.Client{}
+	service := &SubnetPortService{
+		Service: common.Service{
+			Client:    k8sClient,
+			NSXClient: nsxClient,
+			NSXConfig: &config.NSXOperatorConfig{
+				NsxConfig: &config.NsxConfig{
+					EnforcementPoint: "vmc-enforcementpoint",
+				},
+				CoeConfig: &config.CoeConfig{
+					Cluster: "fake_cluster",
+				},
+			},
+		},
+		SubnetPortStore: setupStore(),
+	}
+
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(nsxClient), "NSXCheckVersion",
+		func(_ *nsx.Client, _ int) bool {
+			return true
+		})
+	defer patches.Reset()
+
+	k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	nsxSubnet := &model.VpcSubnet{
+		SubnetDhcpConfig: &model.SubnetDhcpConfig{Mode: common.String("DHCP_DEACTIVATED")},
+		Path:             common.String("fake_path"),
+	}
+	sp := &v1alpha1.SubnetPort{
+		ObjectMeta: metav1.ObjectMeta{
+			UID:       "2ccec3b9-7546-4fd2-812a-1e3a4afd7acc",
+			Name:      "fake_subnetport",
+			Namespace: "fake_ns",
+		},
+	}
+
+	// The ServiceAccount label scope exceeding the NSX limit is normalized.
+	longKey := common.TagScopeServiceAccountLabelPrefix + strings.Repeat("a", common.MaxTagScopeLength)
+	port, err := service.buildSubnetPort(sp, nsxSubnet, "ctx", &map[string]string{longKey: "a"}, false, false, v1alpha1.IPAddressTypeIPv4)
+	assert.Nil(t, err)
+	found := false
+	for _, tag := range port.Tags {
+		assert.LessOrEqual(t, len(*tag.Scope), common.MaxTagScopeLength)
+		if *tag.Scope == util.NormalizeLabelScope(longKey) {
+			found = true
+		}
+	}
+	assert.True(t, found)
+
+	// The SecurityPolicy workload tags exceeding the NSX tag count limit are skipped, the labels are kept.
+	labelTags := map[string]string{"app": "web"}
+	for i := 0; i < common.MaxTagsCount; i++ {
+		labelTags[fmt.Sprintf("%sservice-%d", common.TagScopeServiceMemberPrefix, i)] = common.TagValueServiceMember
+	}
+	port, err = service.buildSubnetPort(sp, nsxSubnet, "ctx", &labelTags, false, false, v1alpha1.IPAddressTypeIPv4)
+	assert.Nil(t, err)
+	assert.Len(t, port.Tags, common.MaxTagsCount)
+	assert.Equal(t, "web", nsxutil.FindTag(port.Tags, "app"))
+}
+
 func TestGetAddressBindingBySubnetPort(t *testing.T) {
 	mockCtl := gomock.NewController(t)
 	k8sClient := mock_client.NewMockClient(mockCtl)
@@ -97,6 +97,33 @@ func setupStore() *SubnetPortStore {
 		}}
 }
 
+// LoadSubnetPortStoreByNamespace returns a SubnetPortStore hydrated from NSX Policy search with the subnet ports
+// created by this cluster for the Pods or SubnetPort CRs of the namespace under the given project. It is used by
+// read-only consumers (e.g. EAS) which do not run the SubnetPortService.
+func LoadSubnetPortStoreByNamespace(service servicecommon.Service, org, project, namespace string) (*SubnetPortStore, error) {
+	store := setupStore()
+	wg := sync.WaitGroup{}
+	fatalErrors := make(chan error, 2)
+	for _, scope := range []string{servicecommon.TagScopeNamespace, servicecommon.TagScopeVMNamespace} {
+		wg.Add(1)
+		tags := []model.Tag{{Scope: String(scope), Tag: String(namespace)}}
+		service.InitializeVPCResourceStore(&wg, fatalErrors, org, project, ResourceTypeSubnetPort, tags, store)
+	}
+	wg.Wait()
+	select {
+	case err := <-fatalErrors:
+		return nil, err
+	default:
+	}
+	return store, nil
+}
+
+// GetByNamespace returns the subnet ports created for Pods or SubnetPort CRs in the namespace.
+func (subnetPortStore *SubnetPortStore) GetByNamespace(namespace string) []*model.VpcSubnetPort {
+	ports := subnetPortStore.GetByIndex(servicecommon.TagScopeNamespace, namespace)
+	return append(ports, subnetPortStore.GetByIndex(servicecommon.TagScopeVMNamespace, namespace)...)
+}
+
 func (service *SubnetPortService) portAlreadyRealized(obj interface{}, nsxSubnetPort *model.VpcSubnetPort) bool {
 	switch o := obj.(type) {
 	case *v1alpha1.SubnetPort:
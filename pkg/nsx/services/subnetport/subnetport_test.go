@@ -1885,3 +1885,58 @@ func TestMergeSubnetPortAddressBinding(t *testing.T) {
 		})
 	}
 }
+
+func TestLoadSubnetPortStoreByNamespace(t *testing.T) {
+	podPortID := "pod-port"
+	vmPortID := "vm-port"
+	otherPortID := "other-port"
+	newPort := func(id, scope, ns string) *model.VpcSubnetPort {
+		return &model.VpcSubnetPort{
+			Id:         common.String(id),
+			Path:       common.String(subnetPath + "/ports/" + id),
+			ParentPath: common.String(subnetPath),
+			Tags:       []model.Tag{{Scope: common.String(scope), Tag: common.String(ns)}},
+		}
+	}
+	service := common.Service{
+		NSXClient: &nsx.Client{
+			QueryClient: &fakeQueryClient{},
+			NsxConfig:   &config.NSXOperatorConfig{CoeConfig: &config.CoeConfig{Cluster: "k8scl-one:test"}},
+		},
+	}
+
+	t.Run("search_error_propagates", func(t *testing.T) {
+		patches := gomonkey.ApplyMethod(reflect.TypeOf(&fakeQueryClient{}), "List", func(_ *fakeQueryClient, _ string, _ *string, _ *string, _ *int64, _ *bool, _ *string) (model.SearchResponse, error) {
+			return model.SearchResponse{}, fmt.Errorf("mock search error")
+		})
+		defer patches.Reset()
+		_, err := LoadSubnetPortStoreByNamespace(service, "org1", "project1", namespace)
+		require.Error(t, err)
+	})
+
+	t.Run("ports_listed_by_namespace", func(t *testing.T) {
+		var queries []string
+		patches := gomonkey.ApplyMethod(reflect.TypeOf(&fakeQueryClient{}), "List", func(_ *fakeQueryClient, query string, _ *string, _ *string, _ *int64, _ *bool, _ *string) (model.SearchResponse, error) {
+			assert.Contains(t, query, "\\/orgs\\/org1\\/projects\\/project1\\/*")
+			assert.Contains(t, query, "tags.tag:"+namespace)
+			queries = append(queries, query)
+			return model.SearchResponse{ResultCount: common.Int64(0)}, nil
+		})
+		defer patches.Reset()
+		store, err := LoadSubnetPortStoreByNamespace(service, "org1", "project1", namespace)
+		require.NoError(t, err)
+		// The Pod and VM namespace tags are searched separately.
+		require.Len(t, queries, 2)
+		assert.NotEqual(t, queries[0], queries[1])
+		require.NoError(t, store.Add(newPort(podPortID, common.TagScopeNamespace, namespace)))
+		require.NoError(t, store.Add(newPort(vmPortID, common.TagScopeVMNamespace, namespace)))
+		require.NoError(t, store.Add(newPort(otherPortID, common.TagScopeNamespace, "ns2")))
+
+		var ids []string
+		for _, p := range store.GetByNamespace(namespace) {
+			ids = append(ids, *p.Id)
+		}
+		assert.ElementsMatch(t, []string{podPortID, vmPortID}, ids)
+		assert.Empty(t, store.GetByNamespace("ns3"))
+	})
+}
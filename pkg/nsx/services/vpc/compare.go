@@ -1,9 +1,13 @@
 package vpc
 
 import (
+	"slices"
+
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 )
 
 // IsVPCChanged checks if the VPCNetworkConfig is changed, currently we only support appending public/private cidrs
@@ -11,3 +15,30 @@ import (
 func IsVPCChanged(nc v1alpha1.VPCNetworkConfiguration, vpc *model.Vpc) bool {
 	return len(nc.Spec.PrivateIPs) != len(vpc.PrivateIps)
 }
+
+type Vpc model.Vpc
+
+func (vpc *Vpc) Key() string {
+	return *vpc.Id
+}
+
+// Value only includes the fields managed by nsx-operator on an existing VPC, i.e. the private IPs and the LB
+// endpoint, the private IPs are sorted as their order is not significant.
+func (vpc *Vpc) Value() data.DataValue {
+	privateIps := slices.Clone(vpc.PrivateIps)
+	slices.Sort(privateIps)
+	var lbEndpoint *model.LoadBalancerVPCEndpoint
+	if vpc.LoadBalancerVpcEndpoint != nil && vpc.LoadBalancerVpcEndpoint.Enabled != nil && *vpc.LoadBalancerVpcEndpoint.Enabled {
+		lbEndpoint = &model.LoadBalancerVPCEndpoint{Enabled: vpc.LoadBalancerVpcEndpoint.Enabled}
+	}
+	v := &model.Vpc{
+		PrivateIps:              privateIps,
+		LoadBalancerVpcEndpoint: lbEndpoint,
+	}
+	dataValue, _ := v.GetDataValue__()
+	return dataValue
+}
+
+func VpcToComparable(vpc *model.Vpc) common.Comparable {
+	return (*Vpc)(vpc)
+}
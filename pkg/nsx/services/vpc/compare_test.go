@@ -3,9 +3,11 @@ package vpc
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 )
 
 func TestIsVPCChanged(t *testing.T) {
@@ -43,3 +45,30 @@ func TestIsVPCChanged(t *testing.T) {
 		})
 	}
 }
+
+func TestVpcToComparable(t *testing.T) {
+	enabled := true
+	disabled := false
+	expected := &model.Vpc{
+		Id:                      common.String("vpc-1"),
+		PrivateIps:              []string{"1.1.1.0/24", "2.2.2.0/24"},
+		LoadBalancerVpcEndpoint: &model.LoadBalancerVPCEndpoint{Enabled: &enabled},
+	}
+	assert.Equal(t, "vpc-1", VpcToComparable(expected).Key())
+
+	// The private IPs order and the fields not managed by nsx-operator are ignored.
+	nsxVPC := &model.Vpc{
+		Id:                      common.String("vpc-1"),
+		DisplayName:             common.String("renamed"),
+		PrivateIps:              []string{"2.2.2.0/24", "1.1.1.0/24"},
+		LoadBalancerVpcEndpoint: &model.LoadBalancerVPCEndpoint{Enabled: &enabled},
+	}
+	assert.False(t, common.CompareResource(VpcToComparable(nsxVPC), VpcToComparable(expected)))
+
+	nsxVPC.PrivateIps = []string{"1.1.1.0/24"}
+	assert.True(t, common.CompareResource(VpcToComparable(nsxVPC), VpcToComparable(expected)))
+
+	// A disabled LB endpoint is the same as an unset one.
+	assert.False(t, common.CompareResource(VpcToComparable(&model.Vpc{LoadBalancerVpcEndpoint: &model.LoadBalancerVPCEndpoint{Enabled: &disabled}}), VpcToComparable(&model.Vpc{})))
+	assert.True(t, common.CompareResource(VpcToComparable(&model.Vpc{LoadBalancerVpcEndpoint: &model.LoadBalancerVPCEndpoint{Enabled: &enabled}}), VpcToComparable(&model.Vpc{})))
+}
@@ -201,6 +201,17 @@ func InitializeVPC(service common.Service) (*VPCService, error) {
 	return VPCService, nil
 }
 
+// DriftSources returns the NSX VPCs in the store to be scanned for the drift.
+func (s *VPCService) DriftSources() []common.DriftSource {
+	return []common.DriftSource{{
+		ResourceType: common.ResourceTypeVpc,
+		Store:        &s.VpcStore.ResourceStore,
+		ToComparable: func(obj interface{}) common.Comparable {
+			return VpcToComparable(obj.(*model.Vpc))
+		},
+	}}
+}
+
 func (s *VPCService) GetCurrentVPCsByNamespace(ctx context.Context, namespace string) []*model.Vpc {
 	namespaceObj, sharedNamespace, err := s.resolveSharedVPCNamespace(ctx, namespace)
 	if err != nil {
@@ -651,7 +662,7 @@ func (s *VPCService) CreateOrUpdateVPC(ctx context.Context, obj *v1alpha1.Networ
 		return nil, err
 	}
 
-	if err := s.createNSXVPC(createdVpc, nc, orgRoot); err != nil {
+	if err := s.createNSXVPC(ctx, createdVpc, nc, orgRoot); err != nil {
 		return nil, err
 	}
 
@@ -686,14 +697,14 @@ func (s *VPCService) CreateOrUpdateVPC(ctx context.Context, obj *v1alpha1.Networ
 	return &newVpc, nil
 }
 
-func (s *VPCService) createNSXVPC(createdVpc *model.Vpc, nc *v1alpha1.VPCNetworkConfiguration, orgRoot *model.OrgRoot) error {
+func (s *VPCService) createNSXVPC(ctx context.Context, createdVpc *model.Vpc, nc *v1alpha1.VPCNetworkConfiguration, orgRoot *model.OrgRoot) error {
 	log.Info("Creating NSX VPC", "VPC", *createdVpc.Id)
 	org, project, err := common.NSXProjectPathToId(nc.Spec.NSXProject)
 	if err != nil {
 		log.Error(err, "Failed to parse NSX project in NetworkConfig", "ProjectPath", nc.Spec.NSXProject)
 		return err
 	}
-	err = s.NSXClient.OrgRootClient.Patch(*orgRoot, &EnforceRevisionCheckParam)
+	err = s.NSXClient.WithContext(ctx).OrgRootClient.Patch(*orgRoot, &EnforceRevisionCheckParam)
 	err = nsxutil.TransNSXApiError(err)
 	if err != nil {
 		log.Error(err, "Failed to create VPC", "Project", project, "VPC", createdVpc.DisplayName)
@@ -1323,7 +1334,7 @@ func (s *VPCService) GetAllVPCsFromNSX() map[string]model.Vpc {
 		BindingType: model.VpcBindingType(),
 	}}
 	query := fmt.Sprintf("(%s:%s)", common.ResourceType, common.ResourceTypeVpc)
-	count, searchErr := s.SearchResource("", query, store, nil)
+	count, searchErr := s.SearchResource(context.TODO(), "", query, store, nil)
 	if searchErr != nil {
 		log.Error(searchErr, "Failed to query VPC from NSX", "query", query)
 	} else {
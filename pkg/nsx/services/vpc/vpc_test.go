@@ -2309,7 +2309,7 @@ func TestListAllVPCsFromNSX(t *testing.T) {
 				Service: common.Service{},
 			}
 			searchResourcePatch := gomonkey.ApplyMethod(reflect.TypeOf(&s.Service), "SearchResource",
-				func(_ *common.Service, _ string, _ string, store common.Store, _ common.Filter) (uint64, error) {
+				func(_ *common.Service, _ context.Context, _ string, _ string, store common.Store, _ common.Filter) (uint64, error) {
 					for i := range tc.vpcs {
 						vpc := tc.vpcs[i]
 						store.TransResourceToStore(vpc)
@@ -2709,7 +2709,7 @@ func TestVPCService_CreateOrUpdateVPC(t *testing.T) {
 				patches.ApplyMethod(reflect.TypeOf(vpcService), "GetCurrentVPCsByNamespace", func(_ *VPCService, ctx context.Context, _ string) []*model.Vpc {
 					return []*model.Vpc{}
 				})
-				patches.ApplyPrivateMethod(reflect.TypeOf(vpcService), "createNSXVPC", func(_ *VPCService, createdVpc *model.Vpc, nc *v1alpha1.VPCNetworkConfiguration, orgRoot *model.OrgRoot) error {
+				patches.ApplyPrivateMethod(reflect.TypeOf(vpcService), "createNSXVPC", func(_ *VPCService, _ context.Context, createdVpc *model.Vpc, nc *v1alpha1.VPCNetworkConfiguration, orgRoot *model.OrgRoot) error {
 					return nil
 				})
 				patches.ApplyPrivateMethod(reflect.TypeOf(vpcService), "checkVPCRealizationState", func(_ *VPCService, createdVpc *model.Vpc, newVpcPath string) error {
@@ -11,8 +11,12 @@ import (
 	"strings"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/audit"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
 	"github.com/vmware-tanzu/nsx-operator/pkg/third_party/retry"
+	"github.com/vmware-tanzu/nsx-operator/pkg/tracing"
 )
 
 // Transport is used in http.Client to replace default implement.
@@ -28,12 +32,29 @@ type Transport struct {
 // It will block the request if the speed is too fast.
 // It will retry the request if nsx-t returns error and error type is retriable or ground
 // It returns the response to the caller.
+// Each attempt is traced as a span of the request context. The requests sent by the clients of Client.WithContext are
+// traced in the span of the caller, the others start a new trace.
+// The requests changing NSX are written to the audit log with their final result, on behalf of the owner in the request
+// context.
 func (t *Transport) RoundTrip(r *http.Request) (*http.Response, error) {
 	var resp *http.Response
 	var resul error
+	attempt := 0
+	auditRequest := audit.Enabled() && audit.IsMutation(r.Method)
+	var auditBody []byte
+	if auditRequest {
+		auditBody = requestBody(r)
+	}
 
 	retry.Do(
-		func() error {
+		func() (err error) {
+			attempt++
+			ctx, span := tracing.Start(r.Context(), "NSX "+r.Method,
+				attribute.String("http.method", r.Method),
+				attribute.String("http.path", r.URL.Path),
+				attribute.Int("attempt", attempt),
+			)
+			defer func() { tracing.End(span, err) }()
 			ep, err := t.selectEndpoint()
 			if err != nil {
 				log.Error(err, "Endpoint is unavailable")
@@ -45,8 +66,11 @@ func (t *Transport) RoundTrip(r *http.Request) (*http.Response, error) {
 			util.UpdateRequestURL(r.URL, ep.Host(), ep.Thumbprint)
 			ep.UpdateHttpRequestAuth(r)
 			ep.UpdateCAforEnvoy(r)
+			span.SetAttributes(attribute.String("nsx.endpoint", ep.Host()))
 			start := time.Now()
+			_, waitSpan := tracing.Start(ctx, "NSX rate limiter wait")
 			ep.wait()
+			tracing.End(waitSpan, nil)
 			util.DumpHttpRequest(r)
 			waitTime := time.Since(start)
 			if resp, resul = t.base().RoundTrip(r); resul != nil {
@@ -58,6 +82,7 @@ func (t *Transport) RoundTrip(r *http.Request) (*http.Response, error) {
 			if resp == nil {
 				return nil
 			}
+			span.SetAttributes(attribute.Int("http.status_code", resp.StatusCode))
 			body, err := io.ReadAll(resp.Body)
 			resp.Body.Close()
 			resp.Body = io.NopCloser(bytes.NewReader(body))
@@ -91,9 +116,35 @@ func (t *Transport) RoundTrip(r *http.Request) (*http.Response, error) {
 		}), retry.LastErrorOnly(true),
 	)
 
+	if auditRequest {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		audit.RecordRequest(r.Context(), r.Method, r.URL, auditBody, statusCode, resul)
+	}
 	return resp, resul
 }
 
+// requestBody returns a copy of the body of r without consuming it.
+func requestBody(r *http.Request) []byte {
+	if r.GetBody == nil {
+		return nil
+	}
+	body, err := r.GetBody()
+	if err != nil {
+		log.Error(err, "Failed to get the body of the request for the audit log")
+		return nil
+	}
+	defer body.Close()
+	content, err := io.ReadAll(body)
+	if err != nil {
+		log.Error(err, "Failed to read the body of the request for the audit log")
+		return nil
+	}
+	return content
+}
+
 func handleRoundTripError(err error, ep *Endpoint) error {
 	log.Error(err, "Failed to request")
 	errString := err.Error()
@@ -20,6 +20,7 @@ var (
 	licenseMutex         sync.Mutex
 	licenseMap           = map[string]bool{}
 	hasVPCNamespacesFunc func() bool // set from cmd/main after mixed-mode init to avoid import cycle
+	dfwLicenseHandlers   []func(isLicensed bool)
 	FeaturesToCheck      = []string{}
 	FeatureLicenseMap    = map[string][]string{
 		FeatureContainer: {
@@ -75,6 +76,34 @@ func UpdateDFWLicense(isLicensed bool) {
 	}
 }
 
+// AddDFWLicenseHandler registers a function called when the DFW license is changed, e.g. the controllers of the DFW
+// features resume the paused policies when the license is restored.
+func AddDFWLicenseHandler(handler func(isLicensed bool)) {
+	licenseMutex.Lock()
+	defer licenseMutex.Unlock()
+	dfwLicenseHandlers = append(dfwLicenseHandlers, handler)
+}
+
+// NotifyDFWLicense calls the registered handlers with the current DFW license.
+func NotifyDFWLicense() {
+	isLicensed := GetDFWLicense()
+	licenseMutex.Lock()
+	handlers := append([]func(bool){}, dfwLicenseHandlers...)
+	licenseMutex.Unlock()
+	for _, handler := range handlers {
+		handler(isLicensed)
+	}
+}
+
+// GetNetworkingLicense returns whether the licenses required by all the controllers are present, i.e. the container
+// license and, with VPC namespaces, the VPC license.
+func GetNetworkingLicense() bool {
+	if !IsLicensed(FeatureContainer) {
+		return false
+	}
+	return !hasVPCNamespaces() || IsLicensed(FeatureVPC)
+}
+
 func IsLicensed(feature string) bool {
 	licenseMutex.Lock()
 	defer licenseMutex.Unlock()
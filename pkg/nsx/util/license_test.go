@@ -235,3 +235,19 @@ func TestUpdateDFWLicense(t *testing.T) {
 	// Clean up
 	SetHasVPCNamespacesFunc(nil)
 }
+
+func TestNotifyDFWLicense(t *testing.T) {
+	var notified []bool
+	AddDFWLicenseHandler(func(isLicensed bool) {
+		notified = append(notified, isLicensed)
+	})
+	defer func() {
+		dfwLicenseHandlers = nil
+	}()
+
+	UpdateDFWLicense(true)
+	NotifyDFWLicense()
+	UpdateDFWLicense(false)
+	NotifyDFWLicense()
+	assert.Equal(t, []bool{true, false}, notified)
+}
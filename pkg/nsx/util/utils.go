@@ -765,7 +765,7 @@ func IsInvalidLicense(err error) bool {
 		}
 		if invalidLicense {
 			UpdateDFWLicense(false)
-			log.Error(err, "Invalid license, nsx-operator will restart", "error message", errorMessage)
+			log.Error(err, "Invalid license, the DFW features are paused until the license is restored", "error message", errorMessage)
 		}
 	}
 	return invalidLicense
@@ -0,0 +1,115 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+)
+
+const (
+	serviceName = "nsx-operator"
+	tracerName  = "github.com/vmware-tanzu/nsx-operator"
+)
+
+var log = logger.Log
+
+// Initialize sets the global tracer provider from the [tracing] section. The returned function flushes the pending
+// spans and stops the exporter, it is a no-op if tracing is disabled.
+func Initialize(cf *config.NSXOperatorConfig) (func(context.Context) error, error) {
+	if cf.TracingConfig == nil || !cf.EnableTracing {
+		return func(context.Context) error { return nil }, nil
+	}
+	var exporter sdktrace.SpanExporter
+	var file *os.File
+	var err error
+	switch cf.TracingExporter {
+	case config.TracingExporterFile:
+		file, err = os.OpenFile(cf.TracingFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open tracing file %s: %w", cf.TracingFile, err)
+		}
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(file))
+	default:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cf.TracingEndpoint)}
+		if cf.TracingInsecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err = otlptracegrpc.New(context.Background(), opts...)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s trace exporter: %w", cf.TracingExporter, err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cf.TracingSampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", serviceName),
+			attribute.String("k8s.cluster.name", cf.Cluster),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+	log.Info("Initialized tracing", "exporter", cf.TracingExporter, "endpoint", cf.TracingEndpoint, "file", cf.TracingFile, "sampleRatio", cf.TracingSampleRatio)
+
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if file != nil {
+			err = errors.Join(err, file.Close())
+		}
+		return err
+	}, nil
+}
+
+// Start starts a span as a child of the span in ctx, or a new trace if there is none. The span is not recorded until
+// Initialize sets the global tracer provider.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End records err on the span if it is not nil, and ends the span.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Reconciler wraps a reconciler to start a span for each reconcile. The controller-runtime logger in the context of the
+// reconcile carries the trace ID, and logger.FromContext returns the operator logger with the trace ID.
+func Reconciler(name string, r reconcile.Reconciler) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		ctx, span := Start(ctx, "Reconcile "+name,
+			attribute.String("k8s.namespace", req.Namespace),
+			attribute.String("k8s.name", req.Name),
+		)
+		if keysAndValues := logger.TraceValues(ctx); keysAndValues != nil {
+			ctx = logf.IntoContext(ctx, logf.FromContext(ctx).WithValues(keysAndValues...))
+		}
+		result, err := r.Reconcile(ctx, req)
+		span.SetAttributes(
+			attribute.Bool("requeue", result.Requeue || result.RequeueAfter > 0),
+			attribute.String("requeue_after", result.RequeueAfter.String()),
+		)
+		End(span, err)
+		return result, err
+	})
+}
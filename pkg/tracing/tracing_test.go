@@ -0,0 +1,86 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+)
+
+func TestReconciler(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(noop.NewTracerProvider())
+
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "ns-1", Name: "subnet-1"}}
+	reconcileErr := errors.New("NSX is unavailable")
+	r := Reconciler("subnet", reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		assert.True(t, trace.SpanContextFromContext(ctx).IsValid())
+		_, span := Start(ctx, "SubnetService.CreateOrUpdateSubnet")
+		End(span, reconcileErr)
+		return reconcile.Result{}, reconcileErr
+	}))
+	_, err := r.Reconcile(context.Background(), req)
+	assert.Equal(t, reconcileErr, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	child, parent := spans[0], spans[1]
+	assert.Equal(t, "SubnetService.CreateOrUpdateSubnet", child.Name())
+	assert.Equal(t, "Reconcile subnet", parent.Name())
+	assert.Equal(t, parent.SpanContext().TraceID(), child.SpanContext().TraceID())
+	assert.Equal(t, parent.SpanContext().SpanID(), child.Parent().SpanID())
+	assert.Contains(t, parent.Attributes(), attribute.String("k8s.namespace", "ns-1"))
+	assert.Contains(t, parent.Attributes(), attribute.String("k8s.name", "subnet-1"))
+	assert.Equal(t, codes.Error, parent.Status().Code)
+	assert.Equal(t, reconcileErr.Error(), parent.Status().Description)
+}
+
+func TestInitialize(t *testing.T) {
+	defer otel.SetTracerProvider(noop.NewTracerProvider())
+
+	shutdown, err := Initialize(&config.NSXOperatorConfig{TracingConfig: &config.TracingConfig{EnableTracing: false}})
+	require.NoError(t, err)
+	assert.NoError(t, shutdown(context.Background()))
+
+	file := filepath.Join(t.TempDir(), "traces.json")
+	cf := &config.NSXOperatorConfig{
+		CoeConfig: &config.CoeConfig{Cluster: "cluster-1"},
+		TracingConfig: &config.TracingConfig{
+			EnableTracing:      true,
+			TracingExporter:    config.TracingExporterFile,
+			TracingFile:        file,
+			TracingSampleRatio: 1,
+		},
+	}
+	shutdown, err = Initialize(cf)
+	require.NoError(t, err)
+	_, span := Start(context.Background(), "SearchResource")
+	End(span, nil)
+	require.NoError(t, shutdown(context.Background()))
+
+	content, err := os.ReadFile(file)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "SearchResource")
+	assert.Contains(t, string(content), "cluster-1")
+
+	cf.TracingFile = filepath.Join(t.TempDir(), "missing", "traces.json")
+	_, err = Initialize(cf)
+	assert.Error(t, err)
+}
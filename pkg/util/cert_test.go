@@ -21,6 +21,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
 	kubefake "k8s.io/client-go/kubernetes/fake"
 	k8stesting "k8s.io/client-go/testing"
 
@@ -63,6 +64,35 @@ func overrideCertDir(t *testing.T) string {
 	return tmpDir
 }
 
+// newSelfSignedRotator returns the rotator of target with the self-signed provider, the certificates stored in the
+// Secret are reused if valid for 7 more days.
+func newSelfSignedRotator(kubeClient kubernetes.Interface, target *CertTarget) *CertRotator {
+	return &CertRotator{
+		KubeClient:  kubeClient,
+		Provider:    &selfSignedCertProvider{kubeClient: kubeClient},
+		Target:      target,
+		RenewBefore: 7 * 24 * time.Hour,
+	}
+}
+
+func generateWebhookCertsWithClient(kubeClient kubernetes.Interface) error {
+	_, err := newSelfSignedRotator(kubeClient, WebhookCertTarget(kubeClient)).Sync(context.TODO())
+	return err
+}
+
+func generateEASCertsWithClient(kubeClient kubernetes.Interface) ([]byte, error) {
+	cert, err := newSelfSignedRotator(kubeClient, EASCertTarget()).Sync(context.TODO())
+	if err != nil {
+		return nil, err
+	}
+	return cert.CABundle, nil
+}
+
+func easSecretCertValid(kubeClient kubernetes.Interface) bool {
+	cert := loadSecretCert(kubeClient, easCertSecretName)
+	return cert != nil && !cert.expiresWithin(7*24*time.Hour)
+}
+
 // ---------------------------------------------------------------------------
 // writeSecureFile
 // ---------------------------------------------------------------------------
@@ -252,7 +282,7 @@ func TestEasSecretCertValid_ValidCert(t *testing.T) {
 	certPEM := generateTestCertPEM(t, time.Now().Add(30*24*time.Hour))
 	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{Name: easCertSecretName, Namespace: namespace},
-		Data:       map[string][]byte{"tls.crt": certPEM},
+		Data:       map[string][]byte{"tls.crt": certPEM, "tls.key": []byte("k")},
 	}
 	assert.True(t, easSecretCertValid(kubefake.NewSimpleClientset(secret)))
 }
@@ -0,0 +1,471 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package util
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
+)
+
+var (
+	// certRetryInterval is the interval to sync a certificate again after a failure, and the minimal interval between
+	// the renewals.
+	certRetryInterval = time.Minute
+	// certResyncInterval is the maximal interval between the syncs of a certificate, so the changes missed by the
+	// provider are still installed.
+	certResyncInterval = 12 * time.Hour
+	csrPollInterval    = 5 * time.Second
+	csrTimeout         = 10 * time.Minute
+)
+
+// CertTarget is a serving certificate managed by a CertRotator.
+type CertTarget struct {
+	// Name is the name of the certificate in the metrics and the logs.
+	Name string
+	// SecretName is the Secret storing the certificate in the namespace of the operator.
+	SecretName   string
+	CACommonName string
+	CommonName   string
+	DNSNames     []string
+	// CertFile and KeyFile are the file names in the certificate directory, the server reloads the files when they
+	// are changed.
+	CertFile string
+	KeyFile  string
+	// AllowNoCABundle reuses a certificate stored in the Secret without a CA, e.g. a certificate pre-provisioned by
+	// the admin and signed by a CA already trusted by the clients.
+	AllowNoCABundle bool
+	// PatchCABundle sets the CA bundle in the configurations of the clients verifying the certificate, e.g. the
+	// webhook configurations or the APIService. It is not called if the CA bundle is empty.
+	PatchCABundle func(ctx context.Context, caBundle []byte) error
+}
+
+// ServingCert is a serving certificate, the key and the CA bundle to verify it.
+type ServingCert struct {
+	CertPEM  []byte
+	KeyPEM   []byte
+	CABundle []byte
+	NotAfter time.Time
+}
+
+func newServingCert(certPEM, keyPEM, caBundle []byte) (*ServingCert, error) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return nil, errors.New("failed to decode certificate PEM")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	if len(keyPEM) == 0 {
+		return nil, errors.New("private key is empty")
+	}
+	return &ServingCert{CertPEM: certPEM, KeyPEM: keyPEM, CABundle: caBundle, NotAfter: cert.NotAfter}, nil
+}
+
+// expiresWithin returns true if the certificate expires in d.
+func (c *ServingCert) expiresWithin(d time.Duration) bool {
+	return !time.Now().Add(d).Before(c.NotAfter)
+}
+
+func (c *ServingCert) equal(other *ServingCert) bool {
+	return other != nil && bytes.Equal(c.CertPEM, other.CertPEM) && bytes.Equal(c.KeyPEM, other.KeyPEM) && bytes.Equal(c.CABundle, other.CABundle)
+}
+
+// CertProvider issues the serving certificates.
+type CertProvider interface {
+	// Name is the name of the provider in the metrics and the logs.
+	Name() string
+	// Issue returns a new certificate for target and stores it in the Secret of target.
+	Issue(ctx context.Context, target *CertTarget) (*ServingCert, error)
+}
+
+// externalCertProvider is a provider of which the certificates are issued and renewed outside of the operator, Issue
+// returns the certificate stored in the Secret.
+type externalCertProvider interface {
+	CertProvider
+	// Watch calls changed when the Secret of target is changed until ctx is done.
+	Watch(ctx context.Context, target *CertTarget, changed func())
+}
+
+// NewCertProvider returns the provider selected in the [cert] section.
+func NewCertProvider(cf *config.NSXOperatorConfig, kubeClient kubernetes.Interface) (CertProvider, error) {
+	switch cf.CertProvider {
+	case config.CertProviderSelfSigned, "":
+		return &selfSignedCertProvider{kubeClient: kubeClient}, nil
+	case config.CertProviderSecret:
+		return &secretCertProvider{kubeClient: kubeClient, caBundleFile: cf.CABundleFile}, nil
+	case config.CertProviderCSR:
+		return &csrCertProvider{kubeClient: kubeClient, signerName: cf.CSRSignerName, caBundleFile: cf.CABundleFile}, nil
+	}
+	return nil, fmt.Errorf("unknown certificate provider %q", cf.CertProvider)
+}
+
+// selfSignedCertProvider generates a CA and signs the certificates with it.
+type selfSignedCertProvider struct {
+	kubeClient kubernetes.Interface
+}
+
+func (p *selfSignedCertProvider) Name() string {
+	return config.CertProviderSelfSigned
+}
+
+func (p *selfSignedCertProvider) Issue(_ context.Context, target *CertTarget) (*ServingCert, error) {
+	caPEM, certPEM, keyPEM, err := newSelfSignedCert(target)
+	if err != nil {
+		return nil, err
+	}
+	if err := storeCertSecret(p.kubeClient, target.SecretName, map[string][]byte{
+		corev1.TLSCertKey:       certPEM,
+		corev1.TLSPrivateKeyKey: keyPEM,
+		caCertKey:               caPEM,
+	}); err != nil {
+		return nil, err
+	}
+	return newServingCert(certPEM, keyPEM, caPEM)
+}
+
+// secretCertProvider reads the certificates from the Secrets written by e.g. cert-manager. The CA bundle is read from
+// ca.crt of the Secret, or from caBundleFile if the Secret has no CA.
+type secretCertProvider struct {
+	kubeClient   kubernetes.Interface
+	caBundleFile string
+}
+
+func (p *secretCertProvider) Name() string {
+	return config.CertProviderSecret
+}
+
+func (p *secretCertProvider) Issue(ctx context.Context, target *CertTarget) (*ServingCert, error) {
+	secret, err := p.kubeClient.CoreV1().Secrets(namespace).Get(ctx, target.SecretName, v1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get certificate secret %s/%s: %w", namespace, target.SecretName, err)
+	}
+	caBundle := secret.Data[caCertKey]
+	if len(caBundle) == 0 {
+		if caBundle, err = readCABundle(p.caBundleFile); err != nil {
+			return nil, err
+		}
+	}
+	cert, err := newServingCert(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey], caBundle)
+	if err != nil {
+		return nil, fmt.Errorf("invalid certificate in secret %s/%s: %w", namespace, target.SecretName, err)
+	}
+	return cert, nil
+}
+
+func (p *secretCertProvider) Watch(ctx context.Context, target *CertTarget, changed func()) {
+	factory := informers.NewSharedInformerFactoryWithOptions(p.kubeClient, 0, informers.WithNamespace(namespace),
+		informers.WithTweakListOptions(func(options *v1.ListOptions) {
+			options.FieldSelector = fields.OneTermEqualSelector("metadata.name", target.SecretName).String()
+		}))
+	informer := factory.Core().V1().Secrets().Informer()
+	if _, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(interface{}) { changed() },
+		UpdateFunc: func(interface{}, interface{}) { changed() },
+	}); err != nil {
+		log.Error(err, "Failed to watch certificate secret, the certificate is only synced periodically", "name", target.SecretName)
+		return
+	}
+	factory.Start(ctx.Done())
+}
+
+// csrCertProvider requests the certificates from signerName through the Kubernetes CSR API. The CA bundle of the
+// signer is read from caBundleFile.
+type csrCertProvider struct {
+	kubeClient   kubernetes.Interface
+	signerName   string
+	caBundleFile string
+}
+
+func (p *csrCertProvider) Name() string {
+	return config.CertProviderCSR
+}
+
+func (p *csrCertProvider) Issue(ctx context.Context, target *CertTarget) (*ServingCert, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 4096)
+	if err != nil {
+		return nil, err
+	}
+	csrDER, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{
+			CommonName:   target.CommonName,
+			Organization: []string{"broadcom.com"},
+		},
+		DNSNames: target.DNSNames,
+	}, key)
+	if err != nil {
+		return nil, err
+	}
+	csr := &certificatesv1.CertificateSigningRequest{
+		ObjectMeta: v1.ObjectMeta{GenerateName: target.SecretName + "-"},
+		Spec: certificatesv1.CertificateSigningRequestSpec{
+			Request:    pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER}),
+			SignerName: p.signerName,
+			Usages: []certificatesv1.KeyUsage{
+				certificatesv1.UsageDigitalSignature,
+				certificatesv1.UsageKeyEncipherment,
+				certificatesv1.UsageServerAuth,
+			},
+		},
+	}
+	csrClient := p.kubeClient.CertificatesV1().CertificateSigningRequests()
+	csr, err = csrClient.Create(ctx, csr, v1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CertificateSigningRequest: %w", err)
+	}
+	log.Info("Created CertificateSigningRequest, waiting for it to be approved and signed", "name", csr.Name, "signer", p.signerName)
+	defer func() {
+		if err := csrClient.Delete(context.TODO(), csr.Name, v1.DeleteOptions{}); err != nil {
+			log.Error(err, "Failed to delete CertificateSigningRequest", "name", csr.Name)
+		}
+	}()
+
+	var certPEM []byte
+	err = wait.PollUntilContextTimeout(ctx, csrPollInterval, csrTimeout, true, func(ctx context.Context) (bool, error) {
+		obj, err := csrClient.Get(ctx, csr.Name, v1.GetOptions{})
+		if err != nil {
+			log.Error(err, "Failed to get CertificateSigningRequest", "name", csr.Name)
+			return false, nil
+		}
+		for _, condition := range obj.Status.Conditions {
+			if condition.Type == certificatesv1.CertificateDenied || condition.Type == certificatesv1.CertificateFailed {
+				return false, fmt.Errorf("CertificateSigningRequest %s is %s: %s", csr.Name, condition.Type, condition.Message)
+			}
+		}
+		certPEM = obj.Status.Certificate
+		return len(certPEM) > 0, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the certificate of CertificateSigningRequest %s: %w", csr.Name, err)
+	}
+	caBundle, err := readCABundle(p.caBundleFile)
+	if err != nil {
+		return nil, err
+	}
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	cert, err := newServingCert(certPEM, keyPEM, caBundle)
+	if err != nil {
+		return nil, err
+	}
+	data := map[string][]byte{corev1.TLSCertKey: certPEM, corev1.TLSPrivateKeyKey: keyPEM}
+	if len(caBundle) > 0 {
+		data[caCertKey] = caBundle
+	}
+	if err := storeCertSecret(p.kubeClient, target.SecretName, data); err != nil {
+		return nil, err
+	}
+	return cert, nil
+}
+
+func readCABundle(file string) ([]byte, error) {
+	if file == "" {
+		return nil, nil
+	}
+	caBundle, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA bundle file %s: %w", file, err)
+	}
+	return caBundle, nil
+}
+
+// CertRotator installs the serving certificate of Target issued by Provider, and renews it RenewBefore before it
+// expires. Installing a certificate patches the CA bundle of the clients, then writes the certificate files which are
+// reloaded by the server.
+type CertRotator struct {
+	KubeClient  kubernetes.Interface
+	Provider    CertProvider
+	Target      *CertTarget
+	RenewBefore time.Duration
+
+	lock    sync.Mutex
+	current *ServingCert
+}
+
+// NewCertRotator returns the rotator of target with the provider selected in the [cert] section.
+func NewCertRotator(cf *config.NSXOperatorConfig, kubeClient kubernetes.Interface, target *CertTarget) (*CertRotator, error) {
+	provider, err := NewCertProvider(cf, kubeClient)
+	if err != nil {
+		return nil, err
+	}
+	return &CertRotator{
+		KubeClient:  kubeClient,
+		Provider:    provider,
+		Target:      target,
+		RenewBefore: time.Duration(cf.CertRenewBefore) * time.Hour,
+	}, nil
+}
+
+// WebhookCertTarget is the serving certificate of the webhook server, the CA bundle is patched in the validating
+// webhook configuration.
+func WebhookCertTarget(kubeClient kubernetes.Interface) *CertTarget {
+	return &CertTarget{
+		Name:         "webhook",
+		SecretName:   certName,
+		CACommonName: "webhook",
+		CommonName:   "vmware-system-nsx-operator-webhook-service.vmware-system-nsx.svc",
+		DNSNames:     []string{"vmware-system-nsx-operator-webhook-service", "vmware-system-nsx-operator-webhook-service.vmware-system-nsx", "vmware-system-nsx-operator-webhook-service.vmware-system-nsx.svc"},
+		CertFile:     corev1.TLSCertKey,
+		KeyFile:      corev1.TLSPrivateKeyKey,
+		PatchCABundle: func(_ context.Context, caBundle []byte) error {
+			return retry.OnError(retry.DefaultRetry, func(err error) bool {
+				return err != nil
+			}, func() error {
+				return updateWebhookConfig(kubeClient, bytes.NewBuffer(caBundle))
+			})
+		},
+	}
+}
+
+// EASCertTarget is the serving certificate of EAS. On WCP the admin may pre-provision a VMCA-signed certificate in
+// the Secret, it is used without a CA bundle:
+//
+//	kubectl create secret tls nsx-operator-eas-cert \
+//	  --cert=eas-vmca.crt --key=eas-vmca.key -n vmware-system-nsx
+//
+// The CA bundle of the APIService is patched by setting PatchCABundle once the server is created.
+func EASCertTarget() *CertTarget {
+	return &CertTarget{
+		Name:            "eas",
+		SecretName:      easCertSecretName,
+		CACommonName:    "eas",
+		CommonName:      "nsx-eas.vmware-system-nsx.svc",
+		DNSNames:        []string{"nsx-eas", "nsx-eas.vmware-system-nsx", "nsx-eas.vmware-system-nsx.svc"},
+		CertFile:        config.EASCertFile,
+		KeyFile:         config.EASKeyFile,
+		AllowNoCABundle: true,
+	}
+}
+
+// Sync installs the certificate if it is not installed yet or it is due to renew. At startup a valid certificate
+// stored in the Secret is reused instead of issuing a new one.
+func (r *CertRotator) Sync(ctx context.Context) (*ServingCert, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	cert, err := r.issue(ctx)
+	if err == nil && !cert.equal(r.current) {
+		err = r.install(ctx, cert)
+	}
+	if err != nil {
+		metrics.CertRotationTotal.WithLabelValues(r.Target.Name, r.Provider.Name(), "failure").Inc()
+		return nil, err
+	}
+	if !cert.equal(r.current) {
+		metrics.CertRotationTotal.WithLabelValues(r.Target.Name, r.Provider.Name(), "success").Inc()
+	}
+	r.current = cert
+	metrics.CertExpiryTimestamp.WithLabelValues(r.Target.Name, r.Provider.Name()).Set(float64(cert.NotAfter.Unix()))
+	return cert, nil
+}
+
+func (r *CertRotator) issue(ctx context.Context) (*ServingCert, error) {
+	if _, ok := r.Provider.(externalCertProvider); ok {
+		cert, err := r.Provider.Issue(ctx, r.Target)
+		if err == nil && cert.expiresWithin(r.RenewBefore) {
+			log.Info("Certificate is not renewed by the issuer yet", "name", r.Target.Name, "secret", r.Target.SecretName, "notAfter", cert.NotAfter)
+		}
+		return cert, err
+	}
+	if r.current != nil && !r.current.expiresWithin(r.RenewBefore) {
+		return r.current, nil
+	}
+	if r.current == nil {
+		if cert := loadSecretCert(r.KubeClient, r.Target.SecretName); cert != nil && !cert.expiresWithin(r.RenewBefore) &&
+			(len(cert.CABundle) > 0 || r.Target.AllowNoCABundle) {
+			log.Info("Using existing certificate from Secret", "name", r.Target.Name, "secret", r.Target.SecretName, "notAfter", cert.NotAfter)
+			return cert, nil
+		}
+	}
+	log.Info("Issuing certificate", "name", r.Target.Name, "provider", r.Provider.Name())
+	return r.Provider.Issue(ctx, r.Target)
+}
+
+// install patches the CA bundle and writes the certificate files. The clients trust both the previous and the new CA
+// until the next rotation, so the requests are verified while the server reloads the certificate.
+func (r *CertRotator) install(ctx context.Context, cert *ServingCert) error {
+	caBundle := cert.CABundle
+	if r.current != nil && len(caBundle) > 0 && len(r.current.CABundle) > 0 && !bytes.Equal(caBundle, r.current.CABundle) {
+		caBundle = append(append([]byte{}, caBundle...), r.current.CABundle...)
+	}
+	if len(caBundle) > 0 && r.Target.PatchCABundle != nil {
+		if err := r.Target.PatchCABundle(ctx, caBundle); err != nil {
+			log.Error(err, "Failed to patch CA bundle", "name", r.Target.Name)
+			return err
+		}
+	}
+	if err := writeCertFiles(r.Target.CertFile, r.Target.KeyFile, cert.CertPEM, cert.KeyPEM); err != nil {
+		return err
+	}
+	log.Info("Installed certificate", "name", r.Target.Name, "provider", r.Provider.Name(), "notAfter", cert.NotAfter)
+	return nil
+}
+
+// Run renews the certificate before it expires until ctx is done. The certificate of an external provider is also
+// installed when its Secret is changed. Sync should be called once before Run.
+func (r *CertRotator) Run(ctx context.Context) {
+	changed := make(chan struct{}, 1)
+	if provider, ok := r.Provider.(externalCertProvider); ok {
+		provider.Watch(ctx, r.Target, func() {
+			select {
+			case changed <- struct{}{}:
+			default:
+			}
+		})
+	}
+	delay := r.nextSync(nil)
+	for {
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-changed:
+			timer.Stop()
+		case <-timer.C:
+		}
+		_, err := r.Sync(ctx)
+		if err != nil {
+			log.Error(err, "Failed to sync certificate", "name", r.Target.Name, "provider", r.Provider.Name())
+		}
+		delay = r.nextSync(err)
+	}
+}
+
+// Current returns the installed certificate, or nil if no certificate is installed yet.
+func (r *CertRotator) Current() *ServingCert {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.current
+}
+
+func (r *CertRotator) nextSync(err error) time.Duration {
+	current := r.Current()
+	if err != nil || current == nil {
+		return certRetryInterval
+	}
+	delay := time.Until(current.NotAfter.Add(-r.RenewBefore))
+	return min(max(delay, certRetryInterval), certResyncInterval)
+}
@@ -0,0 +1,246 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package util
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
+)
+
+func testCertTarget(patched *[][]byte) *CertTarget {
+	target := EASCertTarget()
+	target.AllowNoCABundle = false
+	target.PatchCABundle = func(_ context.Context, caBundle []byte) error {
+		*patched = append(*patched, caBundle)
+		return nil
+	}
+	return target
+}
+
+// testKeyPEM returns the PEM of a new RSA key and the key.
+func testKeyPEM(t *testing.T) ([]byte, *rsa.PrivateKey) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}), key
+}
+
+func TestNewCertProvider(t *testing.T) {
+	kubeClient := kubefake.NewSimpleClientset()
+	for _, name := range []string{config.CertProviderSelfSigned, config.CertProviderSecret, config.CertProviderCSR} {
+		provider, err := NewCertProvider(&config.NSXOperatorConfig{CertConfig: &config.CertConfig{CertProvider: name}}, kubeClient)
+		require.NoError(t, err)
+		assert.Equal(t, name, provider.Name())
+	}
+	_, err := NewCertProvider(&config.NSXOperatorConfig{CertConfig: &config.CertConfig{CertProvider: "vault"}}, kubeClient)
+	assert.Error(t, err)
+}
+
+func TestCertRotator_SelfSigned(t *testing.T) {
+	tmpDir := overrideCertDir(t)
+	kubeClient := kubefake.NewSimpleClientset()
+	var patched [][]byte
+	rotator := newSelfSignedRotator(kubeClient, testCertTarget(&patched))
+
+	first, err := rotator.Sync(context.TODO())
+	require.NoError(t, err)
+	require.Len(t, patched, 1)
+	assert.Equal(t, first.CABundle, patched[0])
+	certPEM, err := os.ReadFile(filepath.Join(tmpDir, config.EASCertFile))
+	require.NoError(t, err)
+	assert.Equal(t, first.CertPEM, certPEM)
+	secret, err := kubeClient.CoreV1().Secrets(namespace).Get(context.TODO(), easCertSecretName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, first.CABundle, secret.Data[caCertKey])
+
+	// The certificate is not renewed before it is due.
+	second, err := rotator.Sync(context.TODO())
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+	assert.Len(t, patched, 1)
+
+	// The certificate stored in the Secret is reused at startup.
+	restarted := newSelfSignedRotator(kubeClient, testCertTarget(&patched))
+	reused, err := restarted.Sync(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, first.CertPEM, reused.CertPEM)
+
+	// The renewed certificate is installed, and both the old and new CAs are trusted.
+	rotator.RenewBefore = 2 * 365 * 24 * time.Hour
+	renewed, err := rotator.Sync(context.TODO())
+	require.NoError(t, err)
+	assert.NotEqual(t, first.CertPEM, renewed.CertPEM)
+	require.Len(t, patched, 3)
+	assert.Equal(t, append(append([]byte{}, renewed.CABundle...), first.CABundle...), patched[2])
+	certPEM, err = os.ReadFile(filepath.Join(tmpDir, config.EASCertFile))
+	require.NoError(t, err)
+	assert.Equal(t, renewed.CertPEM, certPEM)
+}
+
+func TestCertRotator_Secret(t *testing.T) {
+	tmpDir := overrideCertDir(t)
+	keyPEM, _ := testKeyPEM(t)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: easCertSecretName, Namespace: namespace},
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       generateTestCertPEM(t, time.Now().Add(90*24*time.Hour)),
+			corev1.TLSPrivateKeyKey: keyPEM,
+		},
+	}
+	kubeClient := kubefake.NewSimpleClientset(secret)
+	caFile := filepath.Join(t.TempDir(), "ca.crt")
+	require.NoError(t, os.WriteFile(caFile, []byte("ca-from-file"), 0644))
+	var patched [][]byte
+	rotator := &CertRotator{
+		KubeClient:  kubeClient,
+		Provider:    &secretCertProvider{kubeClient: kubeClient, caBundleFile: caFile},
+		Target:      testCertTarget(&patched),
+		RenewBefore: 30 * 24 * time.Hour,
+	}
+
+	// The CA bundle is read from the file if the Secret has no CA.
+	cert, err := rotator.Sync(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("ca-from-file")}, patched)
+	certPEM, err := os.ReadFile(filepath.Join(tmpDir, config.EASCertFile))
+	require.NoError(t, err)
+	assert.Equal(t, cert.CertPEM, certPEM)
+
+	// The unchanged certificate read again from the Secret is neither installed nor counted as a rotation.
+	rotations := testutil.ToFloat64(metrics.CertRotationTotal.WithLabelValues(rotator.Target.Name, rotator.Provider.Name(), "success"))
+	_, err = rotator.Sync(context.TODO())
+	require.NoError(t, err)
+	assert.Len(t, patched, 1)
+	assert.Equal(t, rotations, testutil.ToFloat64(metrics.CertRotationTotal.WithLabelValues(rotator.Target.Name, rotator.Provider.Name(), "success")))
+
+	// The certificate renewed by the issuer is installed.
+	secret.Data[corev1.TLSCertKey] = generateTestCertPEM(t, time.Now().Add(180*24*time.Hour))
+	secret.Data[caCertKey] = []byte("ca-from-secret")
+	_, err = kubeClient.CoreV1().Secrets(namespace).Update(context.TODO(), secret, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	renewed, err := rotator.Sync(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, secret.Data[corev1.TLSCertKey], renewed.CertPEM)
+	require.Len(t, patched, 2)
+	assert.Equal(t, []byte("ca-from-secretca-from-file"), patched[1])
+	certPEM, err = os.ReadFile(filepath.Join(tmpDir, config.EASCertFile))
+	require.NoError(t, err)
+	assert.Equal(t, renewed.CertPEM, certPEM)
+
+	// The installed certificate is kept if the Secret is deleted.
+	require.NoError(t, kubeClient.CoreV1().Secrets(namespace).Delete(context.TODO(), easCertSecretName, metav1.DeleteOptions{}))
+	_, err = rotator.Sync(context.TODO())
+	assert.Error(t, err)
+	certPEM, err = os.ReadFile(filepath.Join(tmpDir, config.EASCertFile))
+	require.NoError(t, err)
+	assert.Equal(t, renewed.CertPEM, certPEM)
+}
+
+func TestCSRCertProvider(t *testing.T) {
+	overrideCertDir(t)
+	_, caKey := testKeyPEM(t)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().AddDate(1, 0, 0),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caFile := filepath.Join(t.TempDir(), "ca.crt")
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER})
+	require.NoError(t, os.WriteFile(caFile, caPEM, 0644))
+
+	newClient := func(deny bool) *kubefake.Clientset {
+		kubeClient := kubefake.NewSimpleClientset()
+		// The fake clientset doesn't generate names, and the signer approves the CSR when it is created.
+		kubeClient.PrependReactor("create", "certificatesigningrequests", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			csr := action.(k8stesting.CreateAction).GetObject().(*certificatesv1.CertificateSigningRequest)
+			csr.Name = csr.GenerateName + "1"
+			if deny {
+				csr.Status.Conditions = []certificatesv1.CertificateSigningRequestCondition{{Type: certificatesv1.CertificateDenied, Message: "denied by test"}}
+				return false, nil, nil
+			}
+			block, _ := pem.Decode(csr.Spec.Request)
+			request, err := x509.ParseCertificateRequest(block.Bytes)
+			require.NoError(t, err)
+			template := &x509.Certificate{
+				SerialNumber: big.NewInt(2),
+				Subject:      request.Subject,
+				DNSNames:     request.DNSNames,
+				NotBefore:    time.Now(),
+				NotAfter:     time.Now().AddDate(0, 3, 0),
+				ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+			}
+			certDER, err := x509.CreateCertificate(rand.Reader, template, caTemplate, request.PublicKey, caKey)
+			require.NoError(t, err)
+			csr.Status.Certificate = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+			return false, nil, nil
+		})
+		return kubeClient
+	}
+
+	kubeClient := newClient(false)
+	provider := &csrCertProvider{kubeClient: kubeClient, signerName: "example.com/nsx-operator", caBundleFile: caFile}
+	target := EASCertTarget()
+	cert, err := provider.Issue(context.TODO(), target)
+	require.NoError(t, err)
+	assert.Equal(t, caPEM, cert.CABundle)
+	block, _ := pem.Decode(cert.CertPEM)
+	issued, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	assert.Equal(t, target.DNSNames, issued.DNSNames)
+	secret, err := kubeClient.CoreV1().Secrets(namespace).Get(context.TODO(), easCertSecretName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.True(t, bytes.Equal(cert.KeyPEM, secret.Data[corev1.TLSPrivateKeyKey]))
+	// The CSR is deleted once the certificate is issued.
+	csrs, err := kubeClient.CertificatesV1().CertificateSigningRequests().List(context.TODO(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, csrs.Items)
+
+	kubeClient = newClient(true)
+	provider = &csrCertProvider{kubeClient: kubeClient, signerName: "example.com/nsx-operator", caBundleFile: caFile}
+	_, err = provider.Issue(context.TODO(), target)
+	assert.ErrorContains(t, err, "denied by test")
+}
+
+func TestCertRotator_NextSync(t *testing.T) {
+	rotator := &CertRotator{RenewBefore: 30 * 24 * time.Hour}
+	assert.Equal(t, certRetryInterval, rotator.nextSync(nil))
+
+	rotator.current = &ServingCert{NotAfter: time.Now().Add(365 * 24 * time.Hour)}
+	assert.Equal(t, certResyncInterval, rotator.nextSync(nil))
+	assert.Equal(t, certRetryInterval, rotator.nextSync(assert.AnError))
+
+	rotator.current = &ServingCert{NotAfter: time.Now().Add(30*24*time.Hour + time.Hour)}
+	delay := rotator.nextSync(nil)
+	assert.True(t, delay > 59*time.Minute && delay <= time.Hour, "delay %s", delay)
+
+	rotator.current = &ServingCert{NotAfter: time.Now().Add(time.Hour)}
+	assert.Equal(t, certRetryInterval, rotator.nextSync(nil))
+}
@@ -0,0 +1,120 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// leaseObservePeriod is the same as the default retry period of the leader election.
+var leaseObservePeriod = 2 * time.Second
+
+// LeaseFence replaces the fixed delay after the leader election. A standby replica observes the leader election Lease,
+// and records with its own clock when the renewal of the leader is seen. The Lease can only be acquired once it is not
+// renewed for the lease duration, while the old leader may still be writing until its own renew deadline expires and
+// its controllers are stopped. After the election the new leader waits until a lease duration plus a renew deadline
+// have passed since the last renewal it has seen, which is not affected by the time synchronization between the nodes.
+type LeaseFence struct {
+	reader        client.Reader
+	key           types.NamespacedName
+	leaseDuration time.Duration
+	renewDeadline time.Duration
+	// identityPrefix is the prefix of the leader election identity of this replica, controller-runtime uses the
+	// hostname followed by "_" and a random UUID as the identity.
+	identityPrefix string
+
+	mu           sync.Mutex
+	holder       string
+	renewTime    *metav1.MicroTime
+	observedTime time.Time
+}
+
+func NewLeaseFence(reader client.Reader, namespace, name string, leaseDuration, renewDeadline time.Duration) (*LeaseFence, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hostname: %w", err)
+	}
+	return &LeaseFence{
+		reader:         reader,
+		key:            types.NamespacedName{Namespace: namespace, Name: name},
+		leaseDuration:  leaseDuration,
+		renewDeadline:  renewDeadline,
+		identityPrefix: hostname + "_",
+	}, nil
+}
+
+// Observe polls the Lease until ctx is done.
+func (f *LeaseFence) Observe(ctx context.Context) {
+	wait.UntilWithContext(ctx, f.observe, leaseObservePeriod)
+}
+
+func (f *LeaseFence) observe(ctx context.Context) {
+	lease := &coordinationv1.Lease{}
+	if err := f.reader.Get(ctx, f.key, lease); err != nil {
+		log.Debug("Failed to get the leader election Lease", "lease", f.key, "error", err)
+		return
+	}
+	holder := ""
+	if lease.Spec.HolderIdentity != nil {
+		holder = *lease.Spec.HolderIdentity
+	}
+	// Keep the last leader seen by the standby after this replica acquires the Lease.
+	if f.isSelf(holder) {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if holder != f.holder || f.observedTime.IsZero() || !lease.Spec.RenewTime.Equal(f.renewTime) {
+		f.holder = holder
+		f.renewTime = lease.Spec.RenewTime
+		f.observedTime = time.Now()
+	}
+}
+
+func (f *LeaseFence) isSelf(holder string) bool {
+	return strings.HasPrefix(holder, f.identityPrefix)
+}
+
+// Wait is called after this replica is elected. It blocks until the previous leader must have stopped writing, and
+// then checks this replica still holds the Lease.
+func (f *LeaseFence) Wait(ctx context.Context) error {
+	f.mu.Lock()
+	holder, observedTime := f.holder, f.observedTime
+	f.mu.Unlock()
+
+	var delay time.Duration
+	if observedTime.IsZero() {
+		// The Lease was never observed, fall back to waiting a full lease duration.
+		delay = f.leaseDuration
+	} else if holder != "" {
+		// The Lease is empty if the previous leader released it on shutdown.
+		delay = time.Until(observedTime.Add(f.leaseDuration + f.renewDeadline))
+	}
+	if delay > 0 {
+		log.Info("Waiting for the previous leader to stop", "previousLeader", holder, "delay", delay)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	lease := &coordinationv1.Lease{}
+	if err := f.reader.Get(ctx, f.key, lease); err != nil {
+		return fmt.Errorf("failed to get Lease %s: %w", f.key, err)
+	}
+	if lease.Spec.HolderIdentity == nil || !f.isSelf(*lease.Spec.HolderIdentity) {
+		return fmt.Errorf("lease %s is not held by this replica", f.key)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package util
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestLeaseFence(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	lease := &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "vmware-system-nsx", Name: "nsx-operator"},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity: Ptr("old-leader_1"),
+			RenewTime:      &metav1.MicroTime{Time: time.Now()},
+		},
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(lease).Build()
+	fence, err := NewLeaseFence(k8sClient, "vmware-system-nsx", "nsx-operator", time.Hour, time.Minute)
+	require.NoError(t, err)
+	ctx := context.TODO()
+	updateHolder := func(holder string) {
+		existing := &coordinationv1.Lease{}
+		require.NoError(t, k8sClient.Get(ctx, client.ObjectKeyFromObject(lease), existing))
+		existing.Spec.HolderIdentity = Ptr(holder)
+		existing.Spec.RenewTime = &metav1.MicroTime{Time: time.Now()}
+		require.NoError(t, k8sClient.Update(ctx, existing))
+	}
+
+	fence.observe(ctx)
+	assert.Equal(t, "old-leader_1", fence.holder)
+	firstObserved := fence.observedTime
+	assert.False(t, firstObserved.IsZero())
+
+	// The observed time is not changed if the leader does not renew the Lease.
+	fence.observe(ctx)
+	assert.Equal(t, firstObserved, fence.observedTime)
+
+	// The previous leader is kept after this replica acquires the Lease.
+	updateHolder(fence.identityPrefix + "2")
+	fence.observe(ctx)
+	assert.Equal(t, "old-leader_1", fence.holder)
+
+	t.Run("WaitForPreviousLeader", func(t *testing.T) {
+		timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(t, fence.Wait(timeoutCtx), context.DeadlineExceeded)
+	})
+
+	t.Run("LeaseExpired", func(t *testing.T) {
+		// The Lease expired, but the renew deadline of the previous leader may not have passed yet.
+		fence.observedTime = time.Now().Add(-time.Hour)
+		timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(t, fence.Wait(timeoutCtx), context.DeadlineExceeded)
+	})
+
+	t.Run("RenewDeadlinePassed", func(t *testing.T) {
+		fence.observedTime = time.Now().Add(-2 * time.Hour)
+		assert.NoError(t, fence.Wait(ctx))
+	})
+
+	t.Run("LeaseLost", func(t *testing.T) {
+		updateHolder("another-leader_3")
+		assert.ErrorContains(t, fence.Wait(ctx), "is not held by this replica")
+	})
+}
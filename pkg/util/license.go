@@ -0,0 +1,166 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+var (
+	NSXLicenseStatus               = "nsx-operator-license-status"
+	AnnotationContainerLicense     = "operator_container_license"
+	AnnotationDFWLicense           = "operator_dfw_license"
+	AnnotationLicenseLastCheckTime = "operator_license_last_check_time"
+	AnnotationLicenseError         = "operator_license_error"
+	ReasonLicenseCheckFailed       = "LicenseCheckFailed"
+	ReasonLicenseCheckSucceeded    = "LicenseCheckSucceeded"
+	ReasonDFWLicenseMissing        = "DFWLicenseMissing"
+	ReasonDFWLicenseRestored       = "DFWLicenseRestored"
+)
+
+var (
+	ncpConfigGVK = schema.GroupVersionKind{Group: "nsx.vmware.com", Version: "v1", Kind: "NCPConfig"}
+	// noNCPConfigRetryInterval is the interval to check again whether the NCPConfig CRD is installed.
+	noNCPConfigRetryInterval = time.Hour
+)
+
+// LicenseReporter publishes the result of the NSX license checks. The license of the features and the last check are
+// set as annotations of the cluster-scoped NCPConfig nsx-operator-license-status, the changes are recorded as Events
+// of the NCPConfig, and the license is exposed in metrics.
+type LicenseReporter struct {
+	Client   client.Client
+	Recorder record.EventRecorder
+	// Elected is closed when this replica is elected as the leader, the NCPConfig is only updated by the leader. The
+	// NCPConfig is always updated if Elected is nil.
+	Elected <-chan struct{}
+
+	lock      sync.Mutex
+	reported  bool
+	dfw       bool
+	lastError string
+	// skipUntil avoids querying the NCPConfig on every check if the NCPConfig CRD is not installed.
+	skipUntil time.Time
+}
+
+// Report publishes the license got by the last check, checkErr is the error of the check.
+func (r *LicenseReporter) Report(checkErr error) {
+	now := time.Now()
+	container := nsxutil.IsLicensed(nsxutil.FeatureContainer)
+	dfw := nsxutil.GetDFWLicense()
+	errMsg := ""
+	if checkErr != nil {
+		errMsg = checkErr.Error()
+		metrics.NSXLicenseCheckFailTotal.Inc()
+	}
+	metrics.NSXLicenseCheckTimestamp.Set(float64(now.Unix()))
+	for _, feature := range nsxutil.FeaturesToCheck {
+		metrics.NSXLicense.WithLabelValues(feature).Set(boolToFloat(nsxutil.IsLicensed(feature)))
+	}
+
+	if !r.isElected() {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if now.Before(r.skipUntil) {
+		return
+	}
+	obj, err := r.updateStatus(map[string]string{
+		AnnotationContainerLicense:     strconv.FormatBool(container),
+		AnnotationDFWLicense:           strconv.FormatBool(dfw),
+		AnnotationLicenseLastCheckTime: now.UTC().Format(time.RFC3339),
+		AnnotationLicenseError:         errMsg,
+	})
+	if meta.IsNoMatchError(err) {
+		log.Info("NCPConfig CRD is not installed, the license status is only exposed in metrics")
+		r.skipUntil = now.Add(noNCPConfigRetryInterval)
+		return
+	} else if err != nil {
+		log.Error(err, "Failed to update the license status", "ncpconfig", NSXLicenseStatus)
+		return
+	}
+
+	if r.Recorder != nil {
+		if errMsg != "" && errMsg != r.lastError {
+			r.Recorder.Event(obj, v1.EventTypeWarning, ReasonLicenseCheckFailed, fmt.Sprintf("NSX license check failed: %s", errMsg))
+		} else if errMsg == "" && r.lastError != "" {
+			r.Recorder.Event(obj, v1.EventTypeNormal, ReasonLicenseCheckSucceeded, "NSX license check succeeded")
+		}
+		if !dfw && (!r.reported || r.dfw) {
+			r.Recorder.Event(obj, v1.EventTypeWarning, ReasonDFWLicenseMissing, "DFW license is missing, the security policies are paused")
+		} else if dfw && r.reported && !r.dfw {
+			r.Recorder.Event(obj, v1.EventTypeNormal, ReasonDFWLicenseRestored, "DFW license is restored, the security policies are resumed")
+		}
+	}
+	r.reported, r.dfw, r.lastError = true, dfw, errMsg
+}
+
+func (r *LicenseReporter) isElected() bool {
+	if r.Elected == nil {
+		return true
+	}
+	select {
+	case <-r.Elected:
+		return true
+	default:
+		return false
+	}
+}
+
+// updateStatus sets the annotations of the license status NCPConfig, it is created if not found. An empty annotation
+// is removed.
+func (r *LicenseReporter) updateStatus(annotations map[string]string) (*unstructured.Unstructured, error) {
+	ctx := context.TODO()
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(ncpConfigGVK)
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		if err := r.Client.Get(ctx, types.NamespacedName{Name: NSXLicenseStatus}, obj); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return err
+			}
+			obj.SetName(NSXLicenseStatus)
+			obj.SetAnnotations(mergeAnnotations(map[string]string{}, annotations))
+			log.Info("ncpconfig not exists, create ncpconfig for license status", "ncpconfig", NSXLicenseStatus)
+			return r.Client.Create(ctx, obj)
+		}
+		obj.SetAnnotations(mergeAnnotations(obj.GetAnnotations(), annotations))
+		return r.Client.Update(ctx, obj)
+	})
+	return obj, err
+}
+
+func mergeAnnotations(existing, annotations map[string]string) map[string]string {
+	if existing == nil {
+		existing = map[string]string{}
+	}
+	for k, v := range annotations {
+		if v == "" {
+			delete(existing, k)
+		} else {
+			existing[k] = v
+		}
+	}
+	return existing
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
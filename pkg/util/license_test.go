@@ -0,0 +1,89 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mock_client "github.com/vmware-tanzu/nsx-operator/pkg/mock/controller-runtime/client"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+func TestLicenseReporter(t *testing.T) {
+	mockCtl := gomock.NewController(t)
+	k8sClient := mock_client.NewMockClient(mockCtl)
+	defer mockCtl.Finish()
+	defer nsxutil.UpdateLicense(nsxutil.FeatureContainer, false)
+	defer nsxutil.UpdateLicense(nsxutil.LicenseDFW, false)
+
+	recorder := record.NewFakeRecorder(10)
+	elected := make(chan struct{})
+	reporter := &LicenseReporter{Client: k8sClient, Recorder: recorder, Elected: elected}
+	events := func() []string {
+		var result []string
+		for len(recorder.Events) > 0 {
+			result = append(result, <-recorder.Events)
+		}
+		return result
+	}
+	var annotations map[string]string
+	saveAnnotations := func(obj client.Object) error {
+		annotations = obj.GetAnnotations()
+		return nil
+	}
+
+	// The status is not published by a standby replica.
+	reporter.Report(errors.New("NSX license check failed"))
+	assert.Empty(t, events())
+	close(elected)
+
+	// The NCPConfig is created with the error on the first check.
+	k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(apierrors.NewNotFound(schema.GroupResource{Group: "nsx.vmware.com", Resource: "ncpconfigs"}, NSXLicenseStatus))
+	k8sClient.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+		assert.Equal(t, NSXLicenseStatus, obj.GetName())
+		return saveAnnotations(obj)
+	})
+	reporter.Report(errors.New("NSX license check failed"))
+	assert.Equal(t, "false", annotations[AnnotationContainerLicense])
+	assert.Equal(t, "false", annotations[AnnotationDFWLicense])
+	assert.Equal(t, "NSX license check failed", annotations[AnnotationLicenseError])
+	assert.NotEmpty(t, annotations[AnnotationLicenseLastCheckTime])
+	assert.Equal(t, []string{
+		"Warning LicenseCheckFailed NSX license check failed: NSX license check failed",
+		"Warning DFWLicenseMissing DFW license is missing, the security policies are paused",
+	}, events())
+
+	// The error is removed when the license is got, and the DFW license is restored.
+	nsxutil.UpdateLicense(nsxutil.FeatureContainer, true)
+	nsxutil.UpdateLicense(nsxutil.LicenseDFW, true)
+	k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+		obj.SetAnnotations(annotations)
+		return nil
+	})
+	k8sClient.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+		return saveAnnotations(obj)
+	})
+	reporter.Report(nil)
+	assert.Equal(t, "true", annotations[AnnotationContainerLicense])
+	assert.Equal(t, "true", annotations[AnnotationDFWLicense])
+	assert.NotContains(t, annotations, AnnotationLicenseError)
+	assert.Equal(t, []string{
+		"Normal LicenseCheckSucceeded NSX license check succeeded",
+		"Normal DFWLicenseRestored DFW license is restored, the security policies are resumed",
+	}, events())
+
+	// The NCPConfig is not queried again for a while if the CRD is not installed.
+	k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(&meta.NoKindMatchError{GroupKind: ncpConfigGVK.GroupKind()})
+	reporter.Report(nil)
+	reporter.Report(nil)
+	require.Empty(t, events())
+}
@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"sync/atomic"
 	"time"
 
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -26,6 +29,18 @@ var (
 	RestoreStatusSuccess     = "SUCCESS"
 )
 
+// restoreInProgress is true while the operator restores the NSX resources after NSX is restored from a backup.
+var restoreInProgress atomic.Bool
+
+// nsxRestoreEndTime is the RestoreEndTime of the NSX restore status read by the last CompareNSXRestore, the progress
+// of the restore is only resumed for the same NSX restore.
+var nsxRestoreEndTime atomic.Int64
+
+// IsRestoreInProgress returns true while ProcessRestore is running.
+func IsRestoreInProgress() bool {
+	return restoreInProgress.Load()
+}
+
 type ReconcilerProvider interface {
 	RestoreReconcile() error
 	CollectGarbage(ctx context.Context) error
@@ -65,6 +80,13 @@ func CompareNSXRestore(k8sClient client.Client, nsxClient *nsx.Client) (bool, er
 			return false, err
 		} else if forceRestore {
 			log.Info("Force restore trigger for testing case")
+			// NSX may not be restored, the progress is still resumed only if NSX is not restored again.
+			if restoreStatus, err := nsxClient.StatusClient.Get(nil); err != nil {
+				log.Error(err, "Failed to get NSX restore status for force restore")
+				nsxRestoreEndTime.Store(0)
+			} else {
+				nsxRestoreEndTime.Store(getNSXRestoreEndTime(restoreStatus))
+			}
 			return true, nil
 		}
 	}
@@ -94,13 +116,21 @@ func CompareNSXRestore(k8sClient client.Client, nsxClient *nsx.Client) (bool, er
 	} else if *restoreStatus.Status.Value != RestoreStatusSuccess {
 		return false, fmt.Errorf("NSX restore not succeeds with status %s", *restoreStatus.Status.Value)
 	}
-	endTime := *restoreStatus.RestoreEndTime
+	endTime := getNSXRestoreEndTime(restoreStatus)
 	if lastEndTime < endTime {
+		nsxRestoreEndTime.Store(endTime)
 		return true, nil
 	}
 	return false, nil
 }
 
+func getNSXRestoreEndTime(restoreStatus model.ClusterRestoreStatus) int64 {
+	if restoreStatus.RestoreEndTime == nil {
+		return 0
+	}
+	return *restoreStatus.RestoreEndTime
+}
+
 func updateRestoreEndTime(k8sClient client.Client) error {
 	ctx := context.TODO()
 	gvk := schema.GroupVersionKind{
@@ -125,36 +155,72 @@ func updateRestoreEndTime(k8sClient client.Client) error {
 	})
 }
 
-func ProcessRestore(reconcilerList []ReconcilerProvider, client client.Client) error {
+// ProcessRestore collects the garbage and restores the resources with the reconcilers in order. The progress is
+// recorded in the NCPConfig nsx-restore-status, if the operator restarts before the restore completes, the garbage
+// collection and the controllers completed are skipped.
+func ProcessRestore(reconcilerList []ReconcilerProvider, client client.Client, recorder record.EventRecorder) error {
 	log.Info("Enter restore mode")
+	restoreInProgress.Store(true)
+	defer restoreInProgress.Store(false)
+	var reconcilers []ReconcilerProvider
+	var names []string
+	for _, reconciler := range reconcilerList {
+		if reconciler != nil {
+			reconcilers = append(reconcilers, reconciler)
+			names = append(names, restoreControllerName(reconciler))
+		}
+	}
+	tracker, err := startRestoreTracker(client, recorder, names)
+	if err != nil {
+		return err
+	}
+	err = processRestore(reconcilers, tracker)
+	if err == nil {
+		log.Info("Restore reconcile succeeds in restore mode")
+	}
+	tracker.finish(err)
+	if err != nil {
+		return err
+	}
+
+	if err := updateRestoreEndTime(client); err != nil {
+		return fmt.Errorf("failed to update restore end time: %w", err)
+	}
+	return nil
+}
+
+func processRestore(reconcilers []ReconcilerProvider, tracker *restoreTracker) error {
 	var errList []error
-	// Collect Garbage with reverse order, e.g. SubnetPort -> Subnet -> VPC
-	for i := len(reconcilerList) - 1; i >= 0; i-- {
-		if reconcilerList[i] != nil {
-			if err := reconcilerList[i].CollectGarbage(context.TODO()); err != nil {
+	if tracker.progress.GarbageCollected {
+		log.Info("Skip garbage collection completed before restart in restore mode")
+	} else {
+		// Collect Garbage with reverse order, e.g. SubnetPort -> Subnet -> VPC
+		for i := len(reconcilers) - 1; i >= 0; i-- {
+			if err := reconcilers[i].CollectGarbage(context.TODO()); err != nil {
 				errList = append(errList, err)
 			}
 		}
+		if len(errList) > 0 {
+			return fmt.Errorf("failed to collect garbage: %v", errList)
+		}
+		log.Info("Garbage collection succeeds in restore mode")
+		tracker.garbageCollected()
 	}
-	if len(errList) > 0 {
-		return fmt.Errorf("failed to collect garbage: %v", errList)
-	}
-	log.Info("Garbage collection succeeds in restore mode")
 	// Restore resource in order, e.g. VPC -> Subnet -> SubnetPort
-	for _, reconciler := range reconcilerList {
-		if reconciler != nil {
-			if err := reconciler.RestoreReconcile(); err != nil {
-				errList = append(errList, err)
-			}
+	for i, reconciler := range reconcilers {
+		if tracker.completed(i) {
+			log.Info("Skip controller restored before restart", "controller", tracker.progress.Controllers[i].Name)
+			continue
+		}
+		tracker.startController(i)
+		err := reconciler.RestoreReconcile()
+		tracker.finishController(i, err)
+		if err != nil {
+			errList = append(errList, err)
 		}
 	}
 	if len(errList) > 0 {
 		return fmt.Errorf("failed to restore resources: %v", errList)
 	}
-	log.Info("Restore reconcile succeeds in restore mode")
-
-	if err := updateRestoreEndTime(client); err != nil {
-		return fmt.Errorf("failed to update restore end time: %w", err)
-	}
 	return nil
 }
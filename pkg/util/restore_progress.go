@@ -0,0 +1,335 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
+)
+
+var (
+	AnnotationRestoreProgress     = "operator_restore_progress"
+	ReasonRestoreStarted          = "RestoreStarted"
+	ReasonRestoreResumed          = "RestoreResumed"
+	ReasonRestoreSucceeded        = "RestoreSucceeded"
+	ReasonRestoreFailed           = "RestoreFailed"
+	ReasonControllerRestored      = "ControllerRestored"
+	ReasonControllerRestoreFailed = "ControllerRestoreFailed"
+)
+
+// Phases of the restore
+const (
+	RestorePhaseGarbageCollecting = "GarbageCollecting"
+	RestorePhaseRestoring         = "Restoring"
+	RestorePhaseSucceeded         = "Succeeded"
+	RestorePhaseFailed            = "Failed"
+)
+
+// Phases of the restore of a controller
+const (
+	ControllerRestorePending   = "Pending"
+	ControllerRestoreRunning   = "Running"
+	ControllerRestoreCompleted = "Completed"
+	ControllerRestoreFailed    = "Failed"
+)
+
+// restoreProgressSaveInterval is the minimum interval to record the object counts of the running controller.
+var restoreProgressSaveInterval = 30 * time.Second
+
+// RestoreProgress is the progress of restoring the resources after NSX restore. It is recorded as JSON in the
+// operator_restore_progress annotation of the NCPConfig nsx-restore-status, so that a restore interrupted by an
+// operator restart resumes from the controllers not completed.
+type RestoreProgress struct {
+	// NSXRestoreEndTime is the RestoreEndTime of the NSX restore status when the restore started, the progress is not
+	// resumed once NSX is restored again.
+	NSXRestoreEndTime int64  `json:"nsxRestoreEndTime"`
+	Phase             string `json:"phase"`
+	// Attempts is the number of times the restore started, it is more than 1 if the restore is resumed.
+	Attempts         int                         `json:"attempts"`
+	GarbageCollected bool                        `json:"garbageCollected"`
+	StartTime        metav1.Time                 `json:"startTime"`
+	LastUpdateTime   metav1.Time                 `json:"lastUpdateTime"`
+	CompletionTime   *metav1.Time                `json:"completionTime,omitempty"`
+	Message          string                      `json:"message,omitempty"`
+	Controllers      []ControllerRestoreProgress `json:"controllers"`
+}
+
+// ControllerRestoreProgress is the progress of RestoreReconcile of a controller. The object counts are only recorded
+// by the controllers which restore the objects one by one.
+type ControllerRestoreProgress struct {
+	Name           string       `json:"name"`
+	Phase          string       `json:"phase"`
+	Total          int          `json:"total"`
+	Restored       int          `json:"restored"`
+	Failed         int          `json:"failed"`
+	StartTime      *metav1.Time `json:"startTime,omitempty"`
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	Message        string       `json:"message,omitempty"`
+}
+
+// resumable returns true if the progress is of an unfinished restore of the same NSX restore with the same
+// controllers.
+func (p *RestoreProgress) resumable(nsxRestoreEndTime int64, names []string) bool {
+	if p.Phase == RestorePhaseSucceeded || p.NSXRestoreEndTime != nsxRestoreEndTime || len(p.Controllers) != len(names) {
+		return false
+	}
+	for i, name := range names {
+		if p.Controllers[i].Name != name {
+			return false
+		}
+	}
+	return true
+}
+
+// restoreTracker records the progress of ProcessRestore in the NCPConfig nsx-restore-status, the changes are recorded
+// as Events of the NCPConfig and the object counts are exposed in metrics.
+type restoreTracker struct {
+	client   client.Client
+	recorder record.EventRecorder
+	obj      *unstructured.Unstructured
+
+	lock     sync.Mutex
+	progress *RestoreProgress
+	// current is the index of the running controller, -1 if no controller is running.
+	current   int
+	lastSaved time.Time
+}
+
+// activeRestoreTracker is the tracker of the running ProcessRestore, the controllers record the restored objects to it.
+var activeRestoreTracker atomic.Pointer[restoreTracker]
+
+// RecordRestoreTotal records the number of objects to restore by the controller running RestoreReconcile.
+func RecordRestoreTotal(total int) {
+	if t := activeRestoreTracker.Load(); t != nil {
+		t.recordObjects(func(c *ControllerRestoreProgress) { c.Total = total })
+	}
+}
+
+// RecordRestoreResult records the result of restoring an object by the controller running RestoreReconcile.
+func RecordRestoreResult(restored bool) {
+	if t := activeRestoreTracker.Load(); t != nil {
+		t.recordObjects(func(c *ControllerRestoreProgress) {
+			if restored {
+				c.Restored++
+			} else {
+				c.Failed++
+			}
+		})
+	}
+}
+
+// GetRestoreProgress returns the progress of the last restore, it is nil if the operator has not restored.
+func GetRestoreProgress(ctx context.Context, k8sClient client.Client) (*RestoreProgress, error) {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(ncpConfigGVK)
+	if err := k8sClient.Get(ctx, types.NamespacedName{Name: NSXRestoreStatus}, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	value, ok := obj.GetAnnotations()[AnnotationRestoreProgress]
+	if !ok {
+		return nil, nil
+	}
+	progress := &RestoreProgress{}
+	if err := json.Unmarshal([]byte(value), progress); err != nil {
+		return nil, fmt.Errorf("failed to parse %s annotation of %s: %w", AnnotationRestoreProgress, NSXRestoreStatus, err)
+	}
+	return progress, nil
+}
+
+func restoreControllerName(reconciler ReconcilerProvider) string {
+	return strings.TrimPrefix(reflect.TypeOf(reconciler).String(), "*")
+}
+
+// startRestoreTracker loads the progress recorded in the NCPConfig, the progress is resumed if it is of an unfinished
+// restore of the same NSX restore with the same controllers, otherwise a new progress is started.
+func startRestoreTracker(k8sClient client.Client, recorder record.EventRecorder, names []string) (*restoreTracker, error) {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(ncpConfigGVK)
+	if err := k8sClient.Get(context.TODO(), types.NamespacedName{Name: NSXRestoreStatus}, obj); err != nil {
+		return nil, fmt.Errorf("failed to get %s: %w", NSXRestoreStatus, err)
+	}
+	annotations := obj.GetAnnotations()
+	endTime := nsxRestoreEndTime.Load()
+
+	now := metav1.Now()
+	progress := &RestoreProgress{}
+	resumed := false
+	if value, ok := annotations[AnnotationRestoreProgress]; ok {
+		if err := json.Unmarshal([]byte(value), progress); err != nil {
+			log.Error(err, "Failed to parse restore progress, restore from the beginning", "ncpconfig", NSXRestoreStatus)
+		} else {
+			resumed = progress.resumable(endTime, names)
+		}
+	}
+	if !resumed {
+		progress = &RestoreProgress{NSXRestoreEndTime: endTime, StartTime: now}
+		for _, name := range names {
+			progress.Controllers = append(progress.Controllers, ControllerRestoreProgress{Name: name, Phase: ControllerRestorePending})
+		}
+	}
+	progress.Attempts++
+	progress.Phase = RestorePhaseGarbageCollecting
+	if progress.GarbageCollected {
+		progress.Phase = RestorePhaseRestoring
+	}
+	progress.Message = ""
+	progress.CompletionTime = nil
+
+	t := &restoreTracker{client: k8sClient, recorder: recorder, obj: obj, progress: progress, current: -1}
+	metrics.RestoreInProgress.Set(1)
+	metrics.RestoreObjects.Reset()
+	metrics.RestoreControllerDuration.Reset()
+	for i := range progress.Controllers {
+		t.setMetrics(&progress.Controllers[i])
+	}
+	if resumed {
+		completed := 0
+		for _, c := range progress.Controllers {
+			if c.Phase == ControllerRestoreCompleted {
+				completed++
+			}
+		}
+		log.Info("Resume restore", "attempts", progress.Attempts, "completedControllers", completed)
+		t.event(v1.EventTypeNormal, ReasonRestoreResumed, fmt.Sprintf("Resumed restore after NSX restore, %d of %d controllers completed", completed, len(names)))
+	} else {
+		t.event(v1.EventTypeNormal, ReasonRestoreStarted, "Started restore after NSX restore")
+	}
+	t.save()
+	activeRestoreTracker.Store(t)
+	return t, nil
+}
+
+// completed returns true if the controller has completed the restore before the restart.
+func (t *restoreTracker) completed(index int) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.progress.Controllers[index].Phase == ControllerRestoreCompleted
+}
+
+func (t *restoreTracker) garbageCollected() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.progress.GarbageCollected = true
+	t.progress.Phase = RestorePhaseRestoring
+	t.save()
+}
+
+func (t *restoreTracker) startController(index int) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	now := metav1.Now()
+	t.current = index
+	t.progress.Controllers[index] = ControllerRestoreProgress{
+		Name:      t.progress.Controllers[index].Name,
+		Phase:     ControllerRestoreRunning,
+		StartTime: &now,
+	}
+	t.setMetrics(&t.progress.Controllers[index])
+	t.save()
+}
+
+func (t *restoreTracker) finishController(index int, err error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	now := metav1.Now()
+	t.current = -1
+	c := &t.progress.Controllers[index]
+	c.CompletionTime = &now
+	metrics.RestoreControllerDuration.WithLabelValues(c.Name).Set(now.Sub(c.StartTime.Time).Seconds())
+	if err != nil {
+		c.Phase = ControllerRestoreFailed
+		c.Message = err.Error()
+		t.event(v1.EventTypeWarning, ReasonControllerRestoreFailed, fmt.Sprintf("%s failed to restore, %d of %d objects failed: %v", c.Name, c.Failed, c.Total, err))
+	} else {
+		c.Phase = ControllerRestoreCompleted
+		t.event(v1.EventTypeNormal, ReasonControllerRestored, fmt.Sprintf("%s restored %d objects", c.Name, c.Restored))
+	}
+	t.save()
+}
+
+// finish records the result of the restore, the tracker is not active anymore.
+func (t *restoreTracker) finish(err error) {
+	activeRestoreTracker.CompareAndSwap(t, nil)
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	metrics.RestoreInProgress.Set(0)
+	if err != nil {
+		t.progress.Phase = RestorePhaseFailed
+		t.progress.Message = err.Error()
+		t.event(v1.EventTypeWarning, ReasonRestoreFailed, fmt.Sprintf("Restore failed: %v", err))
+	} else {
+		now := metav1.Now()
+		t.progress.Phase = RestorePhaseSucceeded
+		t.progress.CompletionTime = &now
+		t.event(v1.EventTypeNormal, ReasonRestoreSucceeded, fmt.Sprintf("Restore succeeded in %s", now.Sub(t.progress.StartTime.Time).Round(time.Second)))
+	}
+	t.save()
+}
+
+func (t *restoreTracker) recordObjects(update func(c *ControllerRestoreProgress)) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.current < 0 {
+		return
+	}
+	c := &t.progress.Controllers[t.current]
+	update(c)
+	t.setMetrics(c)
+	if time.Since(t.lastSaved) >= restoreProgressSaveInterval {
+		t.save()
+	}
+}
+
+func (t *restoreTracker) setMetrics(c *ControllerRestoreProgress) {
+	metrics.RestoreObjects.WithLabelValues(c.Name, "total").Set(float64(c.Total))
+	metrics.RestoreObjects.WithLabelValues(c.Name, "restored").Set(float64(c.Restored))
+	metrics.RestoreObjects.WithLabelValues(c.Name, "failed").Set(float64(c.Failed))
+}
+
+func (t *restoreTracker) event(eventType, reason, message string) {
+	if t.recorder != nil {
+		t.recorder.Event(t.obj, eventType, reason, message)
+	}
+}
+
+// save records the progress in the NCPConfig, the restore goes on if the progress fails to be recorded.
+func (t *restoreTracker) save() {
+	t.lastSaved = time.Now()
+	t.progress.LastUpdateTime = metav1.NewTime(t.lastSaved)
+	data, err := json.Marshal(t.progress)
+	if err != nil {
+		log.Error(err, "Failed to marshal restore progress")
+		return
+	}
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		if err := t.client.Get(context.TODO(), types.NamespacedName{Name: NSXRestoreStatus}, t.obj); err != nil {
+			return err
+		}
+		t.obj.SetAnnotations(mergeAnnotations(t.obj.GetAnnotations(), map[string]string{AnnotationRestoreProgress: string(data)}))
+		return t.client.Update(context.TODO(), t.obj)
+	})
+	if err != nil {
+		log.Error(err, "Failed to record restore progress", "ncpconfig", NSXRestoreStatus)
+	}
+}
@@ -0,0 +1,201 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
+	mock_client "github.com/vmware-tanzu/nsx-operator/pkg/mock/controller-runtime/client"
+)
+
+// newRestoreStatusClient returns a client serving the NCPConfig nsx-restore-status with the annotations.
+func newRestoreStatusClient(t *testing.T, annotations map[string]string) *mock_client.MockClient {
+	mockCtl := gomock.NewController(t)
+	k8sClient := mock_client.NewMockClient(mockCtl)
+	k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+		assert.Equal(t, NSXRestoreStatus, key.Name)
+		copied := map[string]string{}
+		for k, v := range annotations {
+			copied[k] = v
+		}
+		obj.SetName(NSXRestoreStatus)
+		obj.SetAnnotations(copied)
+		return nil
+	}).AnyTimes()
+	k8sClient.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+		for k := range annotations {
+			delete(annotations, k)
+		}
+		for k, v := range obj.GetAnnotations() {
+			annotations[k] = v
+		}
+		return nil
+	}).AnyTimes()
+	return k8sClient
+}
+
+// progressReconciler restores objects and records the results.
+type progressReconciler struct {
+	objects      int
+	failed       int
+	collected    int
+	restoreCalls int
+}
+
+func (r *progressReconciler) RestoreReconcile() error {
+	r.restoreCalls++
+	RecordRestoreTotal(r.objects)
+	for i := 0; i < r.objects; i++ {
+		RecordRestoreResult(i >= r.failed)
+	}
+	if r.failed > 0 {
+		return errors.New("mocked restore error")
+	}
+	return nil
+}
+
+func (r *progressReconciler) CollectGarbage(_ context.Context) error {
+	r.collected++
+	return nil
+}
+
+func (r *progressReconciler) StartController(_ ctrl.Manager, _ webhook.Server) error {
+	return nil
+}
+
+func TestProcessRestore_Progress(t *testing.T) {
+	annotations := map[string]string{AnnotationRestoreEndTime: "-1"}
+	k8sClient := newRestoreStatusClient(t, annotations)
+	recorder := record.NewFakeRecorder(20)
+	events := func() []string {
+		var result []string
+		for len(recorder.Events) > 0 {
+			result = append(result, <-recorder.Events)
+		}
+		return result
+	}
+	vpc := &progressReconciler{objects: 2}
+	subnet := &progressReconciler{objects: 3, failed: 1}
+	reconcilerList := []ReconcilerProvider{vpc, nil, subnet}
+	name := restoreControllerName(vpc)
+
+	// The progress is recorded when a controller fails to restore.
+	err := ProcessRestore(reconcilerList, k8sClient, recorder)
+	assert.ErrorContains(t, err, "mocked restore error")
+	assert.False(t, IsRestoreInProgress())
+	progress, err := GetRestoreProgress(context.TODO(), k8sClient)
+	require.NoError(t, err)
+	assert.Equal(t, RestorePhaseFailed, progress.Phase)
+	assert.Equal(t, 1, progress.Attempts)
+	assert.True(t, progress.GarbageCollected)
+	require.Len(t, progress.Controllers, 2)
+	assert.Equal(t, name, progress.Controllers[0].Name)
+	assert.Equal(t, ControllerRestoreCompleted, progress.Controllers[0].Phase)
+	assert.Equal(t, 2, progress.Controllers[0].Total)
+	assert.Equal(t, 2, progress.Controllers[0].Restored)
+	assert.Equal(t, ControllerRestoreFailed, progress.Controllers[1].Phase)
+	assert.Equal(t, 3, progress.Controllers[1].Total)
+	assert.Equal(t, 2, progress.Controllers[1].Restored)
+	assert.Equal(t, 1, progress.Controllers[1].Failed)
+	assert.NotNil(t, progress.Controllers[1].CompletionTime)
+	assert.Equal(t, "-1", annotations[AnnotationRestoreEndTime])
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.RestoreObjects.WithLabelValues(name, "failed")))
+	assert.Equal(t, 0.0, testutil.ToFloat64(metrics.RestoreInProgress))
+	assert.Equal(t, []string{
+		"Normal RestoreStarted Started restore after NSX restore",
+		"Normal ControllerRestored util.progressReconciler restored 2 objects",
+		"Warning ControllerRestoreFailed util.progressReconciler failed to restore, 1 of 3 objects failed: mocked restore error",
+		"Warning RestoreFailed Restore failed: failed to restore resources: [mocked restore error]",
+	}, events())
+
+	// The restore resumes from the failed controller after restart.
+	subnet.failed = 0
+	require.NoError(t, ProcessRestore(reconcilerList, k8sClient, recorder))
+	assert.Equal(t, 1, vpc.collected)
+	assert.Equal(t, 1, vpc.restoreCalls)
+	assert.Equal(t, 2, subnet.restoreCalls)
+	progress, err = GetRestoreProgress(context.TODO(), k8sClient)
+	require.NoError(t, err)
+	assert.Equal(t, RestorePhaseSucceeded, progress.Phase)
+	assert.Equal(t, 2, progress.Attempts)
+	assert.NotNil(t, progress.CompletionTime)
+	assert.Equal(t, ControllerRestoreCompleted, progress.Controllers[1].Phase)
+	assert.Equal(t, 3, progress.Controllers[1].Restored)
+	assert.NotEqual(t, "-1", annotations[AnnotationRestoreEndTime])
+	restoreEvents := events()
+	require.Len(t, restoreEvents, 3)
+	assert.Equal(t, "Normal RestoreResumed Resumed restore after NSX restore, 1 of 2 controllers completed", restoreEvents[0])
+	assert.Contains(t, restoreEvents[2], "Normal RestoreSucceeded Restore succeeded in")
+
+	// A new restore starts from the beginning once the last one succeeded.
+	require.NoError(t, ProcessRestore(reconcilerList, k8sClient, nil))
+	assert.Equal(t, 2, vpc.collected)
+	assert.Equal(t, 2, vpc.restoreCalls)
+	progress, err = GetRestoreProgress(context.TODO(), k8sClient)
+	require.NoError(t, err)
+	assert.Equal(t, 1, progress.Attempts)
+
+	// A failed restore is not resumed once NSX is restored again, the completed controllers restore again.
+	subnet.failed = 1
+	assert.Error(t, ProcessRestore(reconcilerList, k8sClient, nil))
+	assert.Equal(t, 3, vpc.restoreCalls)
+	subnet.failed = 0
+	nsxRestoreEndTime.Store(100)
+	defer nsxRestoreEndTime.Store(0)
+	require.NoError(t, ProcessRestore(reconcilerList, k8sClient, nil))
+	assert.Equal(t, 4, vpc.collected)
+	assert.Equal(t, 4, vpc.restoreCalls)
+	progress, err = GetRestoreProgress(context.TODO(), k8sClient)
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), progress.NSXRestoreEndTime)
+	assert.Equal(t, 1, progress.Attempts)
+}
+
+func TestRestoreProgress_Resumable(t *testing.T) {
+	progress := &RestoreProgress{
+		NSXRestoreEndTime: 100,
+		Phase:             RestorePhaseFailed,
+		Controllers:       []ControllerRestoreProgress{{Name: "vpc"}, {Name: "subnet"}},
+	}
+	assert.True(t, progress.resumable(100, []string{"vpc", "subnet"}))
+	// NSX is restored again before the restore completed.
+	assert.False(t, progress.resumable(200, []string{"vpc", "subnet"}))
+	// The controllers changed after upgrade.
+	assert.False(t, progress.resumable(100, []string{"vpc", "subnet", "subnetport"}))
+	assert.False(t, progress.resumable(100, []string{"subnet", "vpc"}))
+	progress.Phase = RestorePhaseSucceeded
+	assert.False(t, progress.resumable(100, []string{"vpc", "subnet"}))
+}
+
+func TestGetRestoreProgress(t *testing.T) {
+	annotations := map[string]string{}
+	k8sClient := newRestoreStatusClient(t, annotations)
+	progress, err := GetRestoreProgress(context.TODO(), k8sClient)
+	assert.NoError(t, err)
+	assert.Nil(t, progress)
+
+	data, err := json.Marshal(&RestoreProgress{Phase: RestorePhaseRestoring, Attempts: 1})
+	require.NoError(t, err)
+	annotations[AnnotationRestoreProgress] = string(data)
+	progress, err = GetRestoreProgress(context.TODO(), k8sClient)
+	require.NoError(t, err)
+	assert.Equal(t, RestorePhaseRestoring, progress.Phase)
+
+	annotations[AnnotationRestoreProgress] = "{"
+	_, err = GetRestoreProgress(context.TODO(), k8sClient)
+	assert.ErrorContains(t, err, "failed to parse")
+}
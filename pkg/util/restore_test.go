@@ -218,6 +218,7 @@ func TestUpdateRestoreEndTime(t *testing.T) {
 }
 
 func TestProcessRestore(t *testing.T) {
+	k8sClient := newRestoreStatusClient(t, map[string]string{AnnotationRestoreEndTime: "-1"})
 	reconcilerList := []ReconcilerProvider{
 		&fakeReconcilerProvider{"VPC reconciler"},
 		&fakeReconcilerProvider{"Subnet reconciler"},
@@ -284,7 +285,7 @@ func TestProcessRestore(t *testing.T) {
 			if patches != nil {
 				defer patches.Reset()
 			}
-			err := ProcessRestore(reconcilerList, nil)
+			err := ProcessRestore(reconcilerList, k8sClient, nil)
 			if tt.expectedErr != "" {
 				assert.Contains(t, err.Error(), tt.expectedErr)
 			} else {
@@ -0,0 +1,570 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
+)
+
+const (
+	shardLeasePrefix  = "nsx-operator-shard-"
+	memberLeasePrefix = "nsx-operator-member-"
+	// LabelShardMember labels the Leases renewed by the replicas taking part in the sharding.
+	LabelShardMember = "nsx-operator.vmware.com/shard-member"
+	// memberLeaseGCFactor is the number of lease durations after which the leader deletes the Lease of a member
+	// which stopped renewing it.
+	memberLeaseGCFactor = 10
+
+	shardActionAcquire = "acquire"
+	shardActionRelease = "release"
+	shardActionLost    = "lost"
+)
+
+// shardSyncPeriod is the interval to renew the Leases and to rebalance the shards.
+var shardSyncPeriod = 2 * time.Second
+
+var (
+	activeShardManager atomic.Pointer[ShardManager]
+	shardLeader        atomic.Bool
+)
+
+// ShardedReconcilerProvider is implemented by the reconcilers of the namespaced resources which are started on all
+// the replicas in sharding mode. The webhook server runs on the leader only, so RegisterWebhooks registers the
+// webhooks of a controller already started when the replica is elected.
+type ShardedReconcilerProvider interface {
+	ReconcilerProvider
+	RegisterWebhooks(mgr ctrl.Manager, hookServer webhook.Server)
+}
+
+// ShardOf returns the shard of the namespace.
+func ShardOf(namespace string, shardCount int) int {
+	h := fnv.New32a()
+	h.Write([]byte(namespace))
+	return int(h.Sum32() % uint32(shardCount))
+}
+
+// shardMember returns the member the shard is assigned to. The rendezvous hashing only moves the shards assigned to
+// a member joining or leaving, and all the replicas agree on the assignment as long as they see the same members.
+func shardMember(shard int, members []string) string {
+	var owner string
+	var maxScore uint64
+	for _, member := range members {
+		h := fnv.New64a()
+		fmt.Fprintf(h, "%s/%d", member, shard)
+		if score := mix64(h.Sum64()); owner == "" || score > maxScore {
+			owner, maxScore = member, score
+		}
+	}
+	return owner
+}
+
+// mix64 is the finalizer of splitmix64, FNV alone scores the members poorly when they only differ by a suffix.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// leaseObservation records with the clock of this replica when the renewal of a Lease is seen, as LeaseFence does,
+// so the expiry of a Lease is not affected by the time synchronization between the nodes.
+type leaseObservation struct {
+	holder       string
+	renewTime    *metav1.MicroTime
+	observedTime time.Time
+}
+
+func (o *leaseObservation) observe(lease *coordinationv1.Lease, now time.Time) {
+	holder := leaseHolder(lease)
+	if holder != o.holder || o.observedTime.IsZero() || !lease.Spec.RenewTime.Equal(o.renewTime) {
+		o.holder = holder
+		o.renewTime = lease.Spec.RenewTime
+		o.observedTime = now
+	}
+}
+
+func (o *leaseObservation) expired(now time.Time, duration time.Duration) bool {
+	return now.Sub(o.observedTime) >= duration
+}
+
+func leaseHolder(lease *coordinationv1.Lease) string {
+	if lease.Spec.HolderIdentity == nil {
+		return ""
+	}
+	return *lease.Spec.HolderIdentity
+}
+
+type shardState struct {
+	// held is true while this replica holds the shard Lease.
+	held bool
+	// renewedAt is the time of the last successful renewal of the shard Lease by this replica.
+	renewedAt time.Time
+	// active is true once the NSX stores are updated after the shard is acquired.
+	active    bool
+	preparing bool
+	// draining is true when the shard is assigned to another member, the shard is released once the in-flight
+	// reconciles are done.
+	draining bool
+	inFlight int
+	// observation is the Lease held by another replica.
+	observation leaseObservation
+}
+
+// ShardManager distributes the namespaces of the sharded controllers across the replicas. Each replica renews a
+// member Lease, the shards are assigned to the live members by rendezvous hashing, and a replica processes the
+// namespaces of a shard only while it holds the shard Lease. A shard moved to another member is released once its
+// in-flight reconciles are done, and a shard whose holder stops renewing it is taken over after the lease duration.
+type ShardManager struct {
+	client        client.Client
+	namespace     string
+	member        string
+	identity      string
+	shardCount    int
+	leaseDuration time.Duration
+	// renewDeadline is shorter than the lease duration, so this replica stops processing a shard it fails to renew
+	// before another replica can take it over.
+	renewDeadline time.Duration
+	// prepare is called after shards are acquired and before their namespaces are processed, to update the NSX
+	// stores with the changes made by the previous holders.
+	prepare func(ctx context.Context) error
+	now     func() time.Time
+
+	mu       sync.Mutex
+	shards   []*shardState
+	handlers []func(shard int)
+
+	members map[string]*leaseObservation
+}
+
+func NewShardManager(c client.Client, namespace string, shardCount int, leaseDuration time.Duration, prepare func(ctx context.Context) error) (*ShardManager, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hostname: %w", err)
+	}
+	return newShardManager(c, namespace, strings.ToLower(hostname), shardCount, leaseDuration, prepare), nil
+}
+
+func newShardManager(c client.Client, namespace, member string, shardCount int, leaseDuration time.Duration, prepare func(ctx context.Context) error) *ShardManager {
+	shards := make([]*shardState, shardCount)
+	for i := range shards {
+		shards[i] = &shardState{}
+	}
+	return &ShardManager{
+		client:        c,
+		namespace:     namespace,
+		member:        member,
+		identity:      member + "_" + string(uuid.NewUUID()),
+		shardCount:    shardCount,
+		leaseDuration: leaseDuration,
+		renewDeadline: leaseDuration * 2 / 3,
+		prepare:       prepare,
+		now:           time.Now,
+		shards:        shards,
+		members:       map[string]*leaseObservation{},
+	}
+}
+
+// SetShardManager enables the sharding with the manager.
+func SetShardManager(m *ShardManager) {
+	activeShardManager.Store(m)
+}
+
+// ShardingEnabled returns true if the namespaced controllers are sharded across the replicas.
+func ShardingEnabled() bool {
+	return activeShardManager.Load() != nil
+}
+
+// SetLeader records whether this replica is the leader running the global work.
+func SetLeader(leader bool) {
+	shardLeader.Store(leader)
+}
+
+// IsLeader returns true if this replica runs the global work, such as the garbage collection. Without sharding the
+// controllers only run on the leader, so it is always true.
+func IsLeader() bool {
+	return !ShardingEnabled() || shardLeader.Load()
+}
+
+// NamespaceInShard returns true if the namespace belongs to the shard.
+func NamespaceInShard(namespace string, shard int) bool {
+	m := activeShardManager.Load()
+	return m != nil && namespace != "" && ShardOf(namespace, m.shardCount) == shard
+}
+
+// OwnsNamespace returns true if the namespace is processed by this replica, the cluster scoped objects are processed
+// by the leader.
+func OwnsNamespace(namespace string) bool {
+	m := activeShardManager.Load()
+	if m == nil {
+		return true
+	}
+	if namespace == "" {
+		return IsLeader()
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.owns(m.shards[ShardOf(namespace, m.shardCount)])
+}
+
+// BeginShardReconcile returns false if the namespace is processed by another replica. Otherwise the shard is kept
+// by this replica until done is called. The cluster scoped objects are processed by the leader.
+func BeginShardReconcile(namespace string) (done func(), ok bool) {
+	m := activeShardManager.Load()
+	if m == nil {
+		return func() {}, true
+	}
+	if namespace == "" {
+		return func() {}, IsLeader()
+	}
+	return m.begin(namespace)
+}
+
+// AddShardAcquireHandler adds a handler called with the shard once this replica starts processing it.
+func AddShardAcquireHandler(handler func(shard int)) {
+	if m := activeShardManager.Load(); m != nil {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		m.handlers = append(m.handlers, handler)
+	}
+}
+
+func (m *ShardManager) begin(namespace string) (func(), bool) {
+	shard := ShardOf(namespace, m.shardCount)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	state := m.shards[shard]
+	if !m.owns(state) {
+		return nil, false
+	}
+	state.inFlight++
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			m.mu.Lock()
+			defer m.mu.Unlock()
+			state.inFlight--
+		})
+	}, true
+}
+
+// owns is called with the lock held.
+func (m *ShardManager) owns(state *shardState) bool {
+	return state.held && state.active && !state.draining && m.now().Sub(state.renewedAt) < m.renewDeadline
+}
+
+// Start renews the Leases until ctx is done, then releases the shards so the other replicas take them over without
+// waiting for the lease duration.
+func (m *ShardManager) Start(ctx context.Context) error {
+	log.Info("Starting shard manager", "member", m.member, "shardCount", m.shardCount, "leaseDuration", m.leaseDuration)
+	wait.UntilWithContext(ctx, m.sync, shardSyncPeriod)
+	releaseCtx, cancel := context.WithTimeout(context.Background(), m.renewDeadline)
+	defer cancel()
+	m.releaseAll(releaseCtx)
+	return nil
+}
+
+// NeedLeaderElection implements LeaderElectionRunnable, the shard manager runs on all the replicas.
+func (m *ShardManager) NeedLeaderElection() bool {
+	return false
+}
+
+func (m *ShardManager) sync(ctx context.Context) {
+	members, err := m.syncMembers(ctx)
+	if err != nil {
+		// The shards are not renewed, and this replica stops processing them after the renew deadline.
+		log.Error(err, "Failed to sync shard members")
+		return
+	}
+	for shard := 0; shard < m.shardCount; shard++ {
+		m.syncShard(ctx, shard, shardMember(shard, members) == m.member)
+	}
+	m.prepareShards(ctx)
+	m.updateMetrics(len(members))
+}
+
+// syncMembers renews the member Lease of this replica and returns the live members.
+func (m *ShardManager) syncMembers(ctx context.Context) ([]string, error) {
+	now := m.now()
+	key := types.NamespacedName{Namespace: m.namespace, Name: memberLeasePrefix + m.member}
+	lease := &coordinationv1.Lease{}
+	if err := m.client.Get(ctx, key, lease); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get member Lease %s: %w", key, err)
+		}
+		lease = &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name, Labels: map[string]string{LabelShardMember: m.member}},
+			Spec:       m.leaseSpec(now),
+		}
+		if err := m.client.Create(ctx, lease); err != nil {
+			return nil, fmt.Errorf("failed to create member Lease %s: %w", key, err)
+		}
+	} else {
+		lease.Spec = m.leaseSpec(now)
+		if err := m.client.Update(ctx, lease); err != nil {
+			return nil, fmt.Errorf("failed to renew member Lease %s: %w", key, err)
+		}
+	}
+
+	leases := &coordinationv1.LeaseList{}
+	if err := m.client.List(ctx, leases, client.InNamespace(m.namespace), client.HasLabels{LabelShardMember}); err != nil {
+		return nil, fmt.Errorf("failed to list member Leases: %w", err)
+	}
+	members := []string{m.member}
+	seen := map[string]bool{}
+	for i := range leases.Items {
+		lease := &leases.Items[i]
+		member := lease.Labels[LabelShardMember]
+		if member == m.member {
+			continue
+		}
+		seen[member] = true
+		observation, ok := m.members[member]
+		if !ok {
+			observation = &leaseObservation{}
+			m.members[member] = observation
+		}
+		observation.observe(lease, now)
+		if !observation.expired(now, m.leaseDuration) {
+			members = append(members, member)
+		} else if IsLeader() && observation.expired(now, memberLeaseGCFactor*m.leaseDuration) {
+			log.Info("Deleting the Lease of a stopped shard member", "member", member)
+			if err := m.client.Delete(ctx, lease); client.IgnoreNotFound(err) != nil {
+				log.Error(err, "Failed to delete member Lease", "lease", lease.Name)
+			}
+		}
+	}
+	for member := range m.members {
+		if !seen[member] {
+			delete(m.members, member)
+		}
+	}
+	sort.Strings(members)
+	return members, nil
+}
+
+func (m *ShardManager) leaseSpec(now time.Time) coordinationv1.LeaseSpec {
+	renewTime := metav1.NewMicroTime(now)
+	durationSeconds := int32(m.leaseDuration.Seconds())
+	return coordinationv1.LeaseSpec{
+		HolderIdentity:       &m.identity,
+		LeaseDurationSeconds: &durationSeconds,
+		RenewTime:            &renewTime,
+	}
+}
+
+// syncShard renews, releases or acquires the shard Lease depending on whether the shard is assigned to this replica.
+func (m *ShardManager) syncShard(ctx context.Context, shard int, assigned bool) {
+	now := m.now()
+	key := types.NamespacedName{Namespace: m.namespace, Name: fmt.Sprintf("%s%d", shardLeasePrefix, shard)}
+	lease := &coordinationv1.Lease{}
+	if err := m.client.Get(ctx, key, lease); err != nil {
+		if !apierrors.IsNotFound(err) {
+			log.Error(err, "Failed to get shard Lease", "lease", key)
+			return
+		}
+		if !assigned {
+			return
+		}
+		lease = &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name},
+			Spec:       m.leaseSpec(now),
+		}
+		lease.Spec.AcquireTime = lease.Spec.RenewTime
+		if err := m.client.Create(ctx, lease); err != nil {
+			log.Debug("Failed to create shard Lease", "lease", key, "error", err)
+			return
+		}
+		m.acquired(shard, now)
+		return
+	}
+
+	m.mu.Lock()
+	state := m.shards[shard]
+	if leaseHolder(lease) != m.identity {
+		lost := state.held
+		if lost {
+			m.shards[shard] = &shardState{}
+			state = m.shards[shard]
+		}
+		state.observation.observe(lease, now)
+		acquire := assigned && (state.observation.holder == "" || state.observation.expired(now, m.leaseDuration))
+		m.mu.Unlock()
+		if lost {
+			log.Info("Shard is taken over by another replica", "shard", shard, "holder", leaseHolder(lease))
+			metrics.ShardHandoffTotal.WithLabelValues(shardActionLost).Inc()
+		}
+		if !acquire {
+			return
+		}
+		var transitions int32
+		if lease.Spec.LeaseTransitions != nil {
+			transitions = *lease.Spec.LeaseTransitions + 1
+		}
+		lease.Spec = m.leaseSpec(now)
+		lease.Spec.AcquireTime = lease.Spec.RenewTime
+		lease.Spec.LeaseTransitions = &transitions
+		if err := m.client.Update(ctx, lease); err != nil {
+			log.Debug("Failed to acquire shard Lease", "lease", key, "error", err)
+			return
+		}
+		m.acquired(shard, now)
+		return
+	}
+
+	// The Lease was updated by this replica although the update returned an error.
+	state.held = true
+	if !assigned && !state.draining {
+		log.Info("Shard is assigned to another member, draining it", "shard", shard, "inFlight", state.inFlight)
+	}
+	state.draining = !assigned
+	release := state.draining && state.inFlight == 0
+	m.mu.Unlock()
+
+	if release {
+		lease.Spec.HolderIdentity = nil
+		lease.Spec.RenewTime = nil
+		lease.Spec.AcquireTime = nil
+		if err := m.client.Update(ctx, lease); err != nil {
+			log.Error(err, "Failed to release shard Lease", "lease", key)
+			return
+		}
+		m.mu.Lock()
+		m.shards[shard] = &shardState{}
+		m.mu.Unlock()
+		log.Info("Released shard", "shard", shard)
+		metrics.ShardHandoffTotal.WithLabelValues(shardActionRelease).Inc()
+		return
+	}
+	renewTime := metav1.NewMicroTime(now)
+	lease.Spec.RenewTime = &renewTime
+	if err := m.client.Update(ctx, lease); err != nil {
+		log.Error(err, "Failed to renew shard Lease", "lease", key)
+		return
+	}
+	m.mu.Lock()
+	state.renewedAt = now
+	m.mu.Unlock()
+}
+
+func (m *ShardManager) acquired(shard int, now time.Time) {
+	m.mu.Lock()
+	m.shards[shard] = &shardState{held: true, renewedAt: now}
+	m.mu.Unlock()
+	log.Info("Acquired shard Lease", "shard", shard)
+	metrics.ShardHandoffTotal.WithLabelValues(shardActionAcquire).Inc()
+}
+
+// prepareShards updates the NSX stores once for the shards acquired in this sync, then activates them and notifies
+// the handlers, which enqueue the objects of the shards. A failed update is retried in the next sync.
+func (m *ShardManager) prepareShards(ctx context.Context) {
+	m.mu.Lock()
+	var pending []int
+	for shard, state := range m.shards {
+		if state.held && !state.active && !state.preparing {
+			state.preparing = true
+			pending = append(pending, shard)
+		}
+	}
+	m.mu.Unlock()
+	if len(pending) == 0 {
+		return
+	}
+
+	go func() {
+		var err error
+		if m.prepare != nil {
+			err = m.prepare(ctx)
+		}
+		m.mu.Lock()
+		var activated []int
+		for _, shard := range pending {
+			state := m.shards[shard]
+			state.preparing = false
+			// The shard may be released or lost while preparing.
+			if err == nil && state.held {
+				state.active = true
+				activated = append(activated, shard)
+			}
+		}
+		handlers := append([]func(int){}, m.handlers...)
+		m.mu.Unlock()
+		if err != nil {
+			log.Error(err, "Failed to update NSX stores for the acquired shards", "shards", pending)
+			return
+		}
+		log.Info("Processing shards", "shards", activated)
+		for _, shard := range activated {
+			for _, handler := range handlers {
+				handler(shard)
+			}
+		}
+	}()
+}
+
+// releaseAll releases the shards held by this replica and deletes its member Lease on shutdown.
+func (m *ShardManager) releaseAll(ctx context.Context) {
+	m.mu.Lock()
+	var held []int
+	for shard, state := range m.shards {
+		if state.held {
+			held = append(held, shard)
+		}
+		m.shards[shard] = &shardState{}
+	}
+	m.mu.Unlock()
+
+	for _, shard := range held {
+		key := types.NamespacedName{Namespace: m.namespace, Name: fmt.Sprintf("%s%d", shardLeasePrefix, shard)}
+		lease := &coordinationv1.Lease{}
+		if err := m.client.Get(ctx, key, lease); err != nil || leaseHolder(lease) != m.identity {
+			continue
+		}
+		lease.Spec.HolderIdentity = nil
+		lease.Spec.RenewTime = nil
+		lease.Spec.AcquireTime = nil
+		if err := m.client.Update(ctx, lease); err != nil {
+			log.Error(err, "Failed to release shard Lease on shutdown", "lease", key)
+		}
+	}
+	member := &coordinationv1.Lease{ObjectMeta: metav1.ObjectMeta{Namespace: m.namespace, Name: memberLeasePrefix + m.member}}
+	if err := m.client.Delete(ctx, member); client.IgnoreNotFound(err) != nil {
+		log.Error(err, "Failed to delete member Lease on shutdown", "lease", member.Name)
+	}
+	log.Info("Released shards on shutdown", "shards", held)
+}
+
+func (m *ShardManager) updateMetrics(memberCount int) {
+	m.mu.Lock()
+	owned := 0
+	for _, state := range m.shards {
+		if m.owns(state) {
+			owned++
+		}
+	}
+	m.mu.Unlock()
+	metrics.ShardsOwned.Set(float64(owned))
+	metrics.ShardMembers.Set(float64(memberCount))
+}
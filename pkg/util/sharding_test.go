@@ -0,0 +1,206 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const shardTestNamespace = "vmware-system-nsx"
+
+func TestShardOf(t *testing.T) {
+	counts := make([]int, 8)
+	for i := 0; i < 800; i++ {
+		ns := fmt.Sprintf("ns-%d", i)
+		shard := ShardOf(ns, 8)
+		require.True(t, shard >= 0 && shard < 8)
+		assert.Equal(t, shard, ShardOf(ns, 8))
+		counts[shard]++
+	}
+	for shard, count := range counts {
+		assert.NotZero(t, count, "shard %d", shard)
+	}
+}
+
+func TestShardMember(t *testing.T) {
+	assert.Equal(t, "", shardMember(0, nil))
+	members := []string{"nsx-operator-0", "nsx-operator-1", "nsx-operator-2"}
+	assigned := map[string]int{}
+	before := map[int]string{}
+	for shard := 0; shard < 64; shard++ {
+		before[shard] = shardMember(shard, members)
+		assigned[before[shard]]++
+	}
+	for _, member := range members {
+		assert.NotZero(t, assigned[member], "member %s", member)
+	}
+	// Only the shards assigned to the new member are moved.
+	joined := append([]string{"nsx-operator-3"}, members...)
+	moved := 0
+	for shard := 0; shard < 64; shard++ {
+		if after := shardMember(shard, joined); after != before[shard] {
+			assert.Equal(t, "nsx-operator-3", after)
+			moved++
+		}
+	}
+	assert.NotZero(t, moved)
+}
+
+// namespaceOfShard returns a namespace belonging to the shard.
+func namespaceOfShard(shard, shardCount int) string {
+	for i := 0; ; i++ {
+		if ns := fmt.Sprintf("ns-%d", i); ShardOf(ns, shardCount) == shard {
+			return ns
+		}
+	}
+}
+
+func TestShardManager(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	ctx := context.TODO()
+	now := time.Now()
+	clock := func() time.Time { return now }
+	const shardCount = 8
+
+	newManager := func(member string) (*ShardManager, *[]int) {
+		m := newShardManager(k8sClient, shardTestNamespace, member, shardCount, 15*time.Second, func(context.Context) error { return nil })
+		m.now = clock
+		var acquired []int
+		m.handlers = append(m.handlers, func(shard int) {
+			m.mu.Lock()
+			defer m.mu.Unlock()
+			acquired = append(acquired, shard)
+		})
+		return m, &acquired
+	}
+	ownedShards := func(m *ShardManager) []int {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		var owned []int
+		for shard, state := range m.shards {
+			if m.owns(state) {
+				owned = append(owned, shard)
+			}
+		}
+		return owned
+	}
+	allShards := []int{0, 1, 2, 3, 4, 5, 6, 7}
+
+	// A single member acquires all the shards, and processes them once the stores are updated.
+	m1, acquired1 := newManager("member-a")
+	m1.sync(ctx)
+	require.Eventually(t, func() bool { return len(ownedShards(m1)) == shardCount }, time.Second, 10*time.Millisecond)
+	m1.mu.Lock()
+	assert.ElementsMatch(t, allShards, *acquired1)
+	m1.mu.Unlock()
+
+	// A new member waits for the shards assigned to it to be released.
+	m2, acquired2 := newManager("member-b")
+	m2.sync(ctx)
+	assert.Empty(t, ownedShards(m2))
+	var moved []int
+	for shard := 0; shard < shardCount; shard++ {
+		if shardMember(shard, []string{"member-a", "member-b"}) == "member-b" {
+			moved = append(moved, shard)
+		}
+	}
+	require.NotEmpty(t, moved)
+
+	// A moved shard is drained until its in-flight reconcile is done.
+	done, ok := m1.begin(namespaceOfShard(moved[0], shardCount))
+	require.True(t, ok)
+	m1.sync(ctx)
+	assert.Len(t, ownedShards(m1), shardCount-len(moved))
+	_, ok = m1.begin(namespaceOfShard(moved[0], shardCount))
+	assert.False(t, ok)
+	// The other moved shards are released and taken over.
+	m2.sync(ctx)
+	require.Eventually(t, func() bool { return len(ownedShards(m2)) == len(moved)-1 }, time.Second, 10*time.Millisecond)
+	assert.NotContains(t, ownedShards(m2), moved[0])
+	done()
+	m1.sync(ctx)
+	m2.sync(ctx)
+	require.Eventually(t, func() bool { return len(ownedShards(m2)) == len(moved) }, time.Second, 10*time.Millisecond)
+	assert.ElementsMatch(t, moved, ownedShards(m2))
+	for _, shard := range ownedShards(m1) {
+		assert.NotContains(t, moved, shard)
+	}
+	m2.mu.Lock()
+	assert.ElementsMatch(t, moved, *acquired2)
+	m2.mu.Unlock()
+
+	// A stopped member stops processing its shards after the renew deadline, and the other member takes them over
+	// after the lease duration.
+	now = now.Add(11 * time.Second)
+	assert.Empty(t, ownedShards(m1))
+	m2.sync(ctx)
+	assert.Len(t, ownedShards(m2), len(moved))
+	now = now.Add(5 * time.Second)
+	m2.sync(ctx)
+	require.Eventually(t, func() bool { return len(ownedShards(m2)) == shardCount }, time.Second, 10*time.Millisecond)
+
+	// The shards are released on shutdown.
+	m2.releaseAll(ctx)
+	assert.Empty(t, ownedShards(m2))
+	for shard := 0; shard < shardCount; shard++ {
+		lease := &coordinationv1.Lease{}
+		key := types.NamespacedName{Namespace: shardTestNamespace, Name: fmt.Sprintf("%s%d", shardLeasePrefix, shard)}
+		require.NoError(t, k8sClient.Get(ctx, key, lease))
+		assert.Nil(t, lease.Spec.HolderIdentity)
+	}
+	leases := &coordinationv1.LeaseList{}
+	require.NoError(t, k8sClient.List(ctx, leases, client.HasLabels{LabelShardMember}))
+	assert.Len(t, leases.Items, 1)
+}
+
+func TestBeginShardReconcile(t *testing.T) {
+	defer SetShardManager(nil)
+	defer SetLeader(false)
+
+	// All the namespaces are processed without sharding.
+	done, ok := BeginShardReconcile("ns-1")
+	assert.True(t, ok)
+	done()
+	assert.True(t, IsLeader())
+	assert.False(t, NamespaceInShard("ns-1", 0))
+	assert.True(t, OwnsNamespace("ns-1"))
+
+	m := newShardManager(nil, shardTestNamespace, "member-a", 4, 15*time.Second, nil)
+	SetShardManager(m)
+	assert.True(t, ShardingEnabled())
+	assert.False(t, IsLeader())
+	_, ok = BeginShardReconcile("")
+	assert.False(t, ok)
+	SetLeader(true)
+	_, ok = BeginShardReconcile("")
+	assert.True(t, ok)
+
+	shard := ShardOf("ns-1", 4)
+	assert.True(t, NamespaceInShard("ns-1", shard))
+	_, ok = BeginShardReconcile("ns-1")
+	assert.False(t, ok)
+	assert.False(t, OwnsNamespace("ns-1"))
+	m.shards[shard] = &shardState{held: true, active: true, renewedAt: time.Now()}
+	assert.True(t, OwnsNamespace("ns-1"))
+	done, ok = BeginShardReconcile("ns-1")
+	require.True(t, ok)
+	assert.Equal(t, 1, m.shards[shard].inFlight)
+	done()
+	done()
+	assert.Equal(t, 0, m.shards[shard].inFlight)
+}
@@ -60,6 +60,12 @@ func NormalizeLabels(matchLabels *map[string]string) *map[string]string {
 	return &newLabels
 }
 
+// NormalizeLabelScope normalizes the label key in the same way as NormalizeLabels, so that the selector keys
+// match the normalized tag scopes.
+func NormalizeLabelScope(key string) string {
+	return NormalizeLabelKey(key, truncateLabelHash)
+}
+
 func NormalizeLabelKey(key string, shaFn func(data string) string) string {
 	if len(key) <= common.MaxTagScopeLength {
 		return key
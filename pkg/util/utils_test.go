@@ -44,6 +44,7 @@ func TestNormalizeLabelKey(t *testing.T) {
 	assert.Equal(t, NormalizeLabelKey(shortKey, truncateLabelHash), shortKey)
 	longKey := strings.Repeat("a", 129) + "/def"
 	assert.Equal(t, NormalizeLabelKey(longKey, truncateLabelHash), "def")
+	assert.Equal(t, NormalizeLabelScope(longKey), "def")
 }
 
 func TestNormalizeLabels(t *testing.T) {